@@ -16,6 +16,7 @@ package main
 
 import (
 	"context"
+	"fmt"
 	"net/http"
 	"time"
 
@@ -35,6 +36,7 @@ import (
 	"github.com/pipe-cd/pipecd/pkg/app/ops/orphancommandcleaner"
 	"github.com/pipe-cd/pipecd/pkg/app/ops/pipedstatsbuilder"
 	"github.com/pipe-cd/pipecd/pkg/app/ops/planpreviewoutputcleaner"
+	"github.com/pipe-cd/pipecd/pkg/app/ops/postgresqlensurer"
 	"github.com/pipe-cd/pipecd/pkg/app/ops/staledpipedstatcleaner"
 	"github.com/pipe-cd/pipecd/pkg/cache/rediscache"
 	"github.com/pipe-cd/pipecd/pkg/cli"
@@ -113,7 +115,7 @@ func (s *ops) run(ctx context.Context, input cli.Input) error {
 	}()
 
 	// Prepare sql database.
-	if cfg.Datastore.Type == model.DataStoreMySQL {
+	if cfg.Datastore.Type == model.DataStoreMySQL || cfg.Datastore.Type == model.DataStorePostgreSQL {
 		if err := ensureSQLDatabase(ctx, cfg, input.Logger); err != nil {
 			input.Logger.Error("failed to ensure prepare SQL database", zap.Error(err))
 			return err
@@ -256,27 +258,49 @@ func (s *ops) run(ctx context.Context, input cli.Input) error {
 	return nil
 }
 
+type sqlEnsurer interface {
+	Run(ctx context.Context) error
+	Close() error
+}
+
 func ensureSQLDatabase(ctx context.Context, cfg *config.ControlPlaneSpec, logger *zap.Logger) error {
-	mysqlEnsurer, err := mysqlensurer.NewMySQLEnsurer(
-		cfg.Datastore.MySQLConfig.URL,
-		cfg.Datastore.MySQLConfig.Database,
-		cfg.Datastore.MySQLConfig.UsernameFile,
-		cfg.Datastore.MySQLConfig.PasswordFile,
-		logger,
+	var (
+		ensurer sqlEnsurer
+		err     error
 	)
+	switch cfg.Datastore.Type {
+	case model.DataStoreMySQL:
+		ensurer, err = mysqlensurer.NewMySQLEnsurer(
+			cfg.Datastore.MySQLConfig.URL,
+			cfg.Datastore.MySQLConfig.Database,
+			cfg.Datastore.MySQLConfig.UsernameFile,
+			cfg.Datastore.MySQLConfig.PasswordFile,
+			logger,
+		)
+	case model.DataStorePostgreSQL:
+		ensurer, err = postgresqlensurer.NewPostgreSQLEnsurer(
+			cfg.Datastore.PostgreSQLConfig.URL,
+			cfg.Datastore.PostgreSQLConfig.Database,
+			cfg.Datastore.PostgreSQLConfig.UsernameFile,
+			cfg.Datastore.PostgreSQLConfig.PasswordFile,
+			logger,
+		)
+	default:
+		err = fmt.Errorf("unsupported sql datastore type %q", cfg.Datastore.Type)
+	}
 	if err != nil {
 		logger.Error("failed to create SQL ensurer instance", zap.Error(err))
 		return err
 	}
 	defer func() {
 		// Close connection held by the client.
-		if err := mysqlEnsurer.Close(); err != nil {
+		if err := ensurer.Close(); err != nil {
 			logger.Error("failed to close database ensurer connection", zap.Error(err))
 		}
 	}()
 
 	logger.Info("start running SQL schema and indexes ensurer")
-	if err = mysqlEnsurer.Run(ctx); err != nil {
+	if err = ensurer.Run(ctx); err != nil {
 		logger.Error("failed to ensure SQL schema and indexes", zap.Error(err))
 		return err
 	}
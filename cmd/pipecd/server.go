@@ -33,6 +33,7 @@ import (
 	"github.com/pipe-cd/pipecd/pkg/app/server/apikeyverifier"
 	"github.com/pipe-cd/pipecd/pkg/app/server/applicationlivestatestore"
 	"github.com/pipe-cd/pipecd/pkg/app/server/commandoutputstore"
+	"github.com/pipe-cd/pipecd/pkg/app/server/commandstore"
 	"github.com/pipe-cd/pipecd/pkg/app/server/grpcapi"
 	"github.com/pipe-cd/pipecd/pkg/app/server/grpcapi/grpcapimetrics"
 	"github.com/pipe-cd/pipecd/pkg/app/server/httpapi"
@@ -51,6 +52,7 @@ import (
 	"github.com/pipe-cd/pipecd/pkg/datastore/filedb"
 	"github.com/pipe-cd/pipecd/pkg/datastore/firestore"
 	"github.com/pipe-cd/pipecd/pkg/datastore/mysql"
+	"github.com/pipe-cd/pipecd/pkg/datastore/postgresql"
 	"github.com/pipe-cd/pipecd/pkg/filestore"
 	"github.com/pipe-cd/pipecd/pkg/filestore/gcs"
 	"github.com/pipe-cd/pipecd/pkg/filestore/minio"
@@ -215,7 +217,7 @@ func (s *server) run(ctx context.Context, input cli.Input) error {
 				datastore.NewPipedStore(ds, datastore.PipedCommander),
 				input.Logger,
 			)
-			service = grpcapi.NewPipedAPI(ctx, ds, cache, sls, alss, las, statCache, cmdOutputStore, unregisteredAppStore, cfg.Address, input.Logger)
+			service = grpcapi.NewPipedAPI(ctx, ds, cache, sls, alss, las, statCache, cmdOutputStore, unregisteredAppStore, cfg.DeploymentWindows, cfg.Address, input.Logger)
 			opts    = []rpc.Option{
 				rpc.WithPort(s.pipedAPIPort),
 				rpc.WithGracePeriod(s.gracePeriod),
@@ -294,6 +296,7 @@ func (s *server) run(ctx context.Context, input cli.Input) error {
 			cache,
 			sls,
 			alss,
+			las,
 			unregisteredAppStore,
 			apiKeyLastUsedCache,
 			insightProvider,
@@ -336,7 +339,7 @@ func (s *server) run(ctx context.Context, input cli.Input) error {
 			return err
 		}
 
-		h := httpapi.NewHandler(
+		h, err := httpapi.NewHandler(
 			signer,
 			s.staticDir,
 			encryptDecrypter,
@@ -346,8 +349,17 @@ func (s *server) run(ctx context.Context, input cli.Input) error {
 			cfg.SharedSSOConfigMap(),
 			datastore.NewProjectStore(ds, datastore.WebCommander),
 			!s.insecureCookie,
+			cfg.SlackApproval,
+			datastore.NewDeploymentStore(ds, datastore.WebCommander),
+			commandstore.NewStore(datastore.WebCommander, ds, cache, input.Logger),
+			cfg.RegistryWebhooks,
+			datastore.NewEventStore(ds, datastore.WebCommander),
 			input.Logger,
 		)
+		if err != nil {
+			input.Logger.Error("failed to create http handler", zap.Error(err))
+			return err
+		}
 		httpServer := &http.Server{
 			Addr:    fmt.Sprintf(":%d", s.httpPort),
 			Handler: h,
@@ -484,6 +496,15 @@ func createDatastore(ctx context.Context, cfg *config.ControlPlaneSpec, fs files
 			options = append(options, mysql.WithAuthenticationFile(mqConfig.UsernameFile, mqConfig.PasswordFile))
 		}
 		return mysql.NewMySQL(mqConfig.URL, mqConfig.Database, options...)
+	case model.DataStorePostgreSQL:
+		pgConfig := cfg.Datastore.PostgreSQLConfig
+		options := []postgresql.Option{
+			postgresql.WithLogger(logger),
+		}
+		if pgConfig.UsernameFile != "" || pgConfig.PasswordFile != "" {
+			options = append(options, postgresql.WithAuthenticationFile(pgConfig.UsernameFile, pgConfig.PasswordFile))
+		}
+		return postgresql.NewPostgreSQL(pgConfig.URL, pgConfig.Database, options...)
 	case model.DataStoreFileDB:
 		options := []filedb.Option{
 			filedb.WithLogger(logger),
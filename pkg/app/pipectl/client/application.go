@@ -31,6 +31,7 @@ func SyncApplication(
 	ctx context.Context,
 	cli apiservice.Client,
 	appID string,
+	overrideDeploymentWindows bool,
 	checkInterval, timeout time.Duration,
 	logger *zap.Logger,
 ) (string, error) {
@@ -38,7 +39,8 @@ func SyncApplication(
 	defer cancel()
 
 	req := &apiservice.SyncApplicationRequest{
-		ApplicationId: appID,
+		ApplicationId:             appID,
+		OverrideDeploymentWindows: overrideDeploymentWindows,
 	}
 	resp, err := cli.SyncApplication(ctx, req)
 	if err != nil {
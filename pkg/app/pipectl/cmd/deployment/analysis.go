@@ -0,0 +1,81 @@
+// Copyright 2024 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deployment
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/pipe-cd/pipecd/pkg/app/server/service/apiservice"
+	"github.com/pipe-cd/pipecd/pkg/cli"
+)
+
+type analysis struct {
+	root *command
+
+	deploymentID string
+	stageID      string
+	stdout       io.Writer
+}
+
+func newAnalysisCommand(root *command) *cobra.Command {
+	c := &analysis{
+		root:   root,
+		stdout: os.Stdout,
+	}
+	cmd := &cobra.Command{
+		Use:   "analysis",
+		Short: "Get the analysis reports of the ANALYSIS stages of a deployment.",
+		RunE:  cli.WithContext(c.run),
+	}
+
+	cmd.Flags().StringVar(&c.deploymentID, "deployment-id", c.deploymentID, "The deployment ID.")
+	cmd.Flags().StringVar(&c.stageID, "stage-id", c.stageID, "The ID of the ANALYSIS stage. Default is all ANALYSIS stages of the deployment.")
+
+	cmd.MarkFlagRequired("deployment-id")
+
+	return cmd
+}
+
+func (c *analysis) run(ctx context.Context, input cli.Input) error {
+	cli, err := c.root.clientOptions.NewClient(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to initialize client: %w", err)
+	}
+	defer cli.Close()
+
+	req := &apiservice.GetAnalysisReportRequest{
+		DeploymentId: c.deploymentID,
+		StageId:      c.stageID,
+	}
+
+	resp, err := cli.GetAnalysisReport(ctx, req)
+	if err != nil {
+		return fmt.Errorf("failed to get analysis report: %w", err)
+	}
+
+	bytes, err := json.Marshal(resp)
+	if err != nil {
+		return fmt.Errorf("failed to marshal analysis report: %w", err)
+	}
+
+	fmt.Fprintln(c.stdout, string(bytes))
+	return nil
+}
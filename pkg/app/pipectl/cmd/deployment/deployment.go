@@ -36,6 +36,8 @@ func NewCommand() *cobra.Command {
 	cmd.AddCommand(newWaitStatusCommand(c))
 	cmd.AddCommand(newLogsCommand(c))
 	cmd.AddCommand(newListCommand(c))
+	cmd.AddCommand(newRejectCommand(c))
+	cmd.AddCommand(newAnalysisCommand(c))
 
 	c.clientOptions.RegisterPersistentFlags(cmd)
 
@@ -0,0 +1,106 @@
+// Copyright 2024 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deployment
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"go.uber.org/zap"
+
+	"github.com/pipe-cd/pipecd/pkg/app/server/service/apiservice"
+	"github.com/pipe-cd/pipecd/pkg/cli"
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+type reject struct {
+	root *command
+
+	deploymentID string
+	stageID      string
+	comment      string
+}
+
+func newRejectCommand(root *command) *cobra.Command {
+	c := &reject{
+		root: root,
+	}
+	cmd := &cobra.Command{
+		Use:   "reject",
+		Short: "Reject a WAIT_APPROVAL stage of a running deployment.",
+		RunE:  cli.WithContext(c.run),
+	}
+
+	cmd.Flags().StringVar(&c.deploymentID, "deployment-id", c.deploymentID, "The deployment ID.")
+	cmd.Flags().StringVar(&c.stageID, "stage-id", c.stageID, "The ID of the stage to reject. Default is the running WAIT_APPROVAL stage of the deployment.")
+	cmd.Flags().StringVar(&c.comment, "comment", c.comment, "The reason of the rejection.")
+
+	cmd.MarkFlagRequired("deployment-id")
+
+	return cmd
+}
+
+func (c *reject) run(ctx context.Context, input cli.Input) error {
+	cli, err := c.root.clientOptions.NewClient(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to initialize client: %w", err)
+	}
+	defer cli.Close()
+
+	stageID := c.stageID
+	if stageID == "" {
+		resp, err := cli.GetDeployment(ctx, &apiservice.GetDeploymentRequest{
+			DeploymentId: c.deploymentID,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to get deployment: %w", err)
+		}
+		stageID, err = findWaitingApprovalStage(resp.Deployment)
+		if err != nil {
+			return err
+		}
+	}
+
+	req := &apiservice.RejectStageRequest{
+		DeploymentId: c.deploymentID,
+		StageId:      stageID,
+		Comment:      c.comment,
+	}
+	resp, err := cli.RejectStage(ctx, req)
+	if err != nil {
+		return fmt.Errorf("failed to reject stage: %w", err)
+	}
+
+	input.Logger.Info("Successfully requested to reject the stage",
+		zap.String("deployment-id", c.deploymentID),
+		zap.String("stage-id", stageID),
+		zap.String("command-id", resp.CommandId),
+	)
+	return nil
+}
+
+// findWaitingApprovalStage returns the ID of the WAIT_APPROVAL stage which is currently running.
+func findWaitingApprovalStage(d *model.Deployment) (string, error) {
+	for _, s := range d.Stages {
+		if s.Name != model.StageWaitApproval.String() {
+			continue
+		}
+		if s.Status == model.StageStatus_STAGE_RUNNING {
+			return s.Id, nil
+		}
+	}
+	return "", fmt.Errorf("no running WAIT_APPROVAL stage was found in deployment %s, please specify --stage-id", d.Id)
+}
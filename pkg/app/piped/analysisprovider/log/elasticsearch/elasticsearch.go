@@ -0,0 +1,337 @@
+// Copyright 2024 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package elasticsearch
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/pipe-cd/pipecd/pkg/app/piped/analysisprovider/log"
+)
+
+const (
+	ProviderType   = "Elasticsearch"
+	defaultTimeout = 30 * time.Second
+
+	defaultIndex          = "*"
+	defaultTimestampField = "@timestamp"
+	defaultMessageField   = "message"
+	defaultSeverityField  = "log.level"
+)
+
+type client interface {
+	Search(ctx context.Context, index string, req *searchRequest) (*searchResponse, error)
+}
+
+type searchRequest struct {
+	Size           int                    `json:"size"`
+	TrackTotalHits bool                   `json:"track_total_hits"`
+	Sort           []map[string]string    `json:"sort,omitempty"`
+	Query          map[string]interface{} `json:"query"`
+}
+
+type searchResponse struct {
+	Hits struct {
+		Total struct {
+			Value int `json:"value"`
+			// "eq" if the value is accurate, "gte" if it is a lower bound.
+			Relation string `json:"relation"`
+		} `json:"total"`
+		Hits []struct {
+			Source map[string]interface{} `json:"_source"`
+		} `json:"hits"`
+	} `json:"hits"`
+}
+
+// Provider is a client for Elasticsearch and OpenSearch.
+type Provider struct {
+	client client
+
+	address        string
+	index          string
+	timestampField string
+	messageField   string
+	severityField  string
+	username       string
+	password       string
+	apiKey         string
+
+	timeout time.Duration
+	logger  *zap.Logger
+}
+
+func NewProvider(address string, opts ...Option) (*Provider, error) {
+	if address == "" {
+		return nil, fmt.Errorf("address is required")
+	}
+
+	p := &Provider{
+		address:        strings.TrimSuffix(address, "/"),
+		index:          defaultIndex,
+		timestampField: defaultTimestampField,
+		messageField:   defaultMessageField,
+		severityField:  defaultSeverityField,
+		timeout:        defaultTimeout,
+		logger:         zap.NewNop(),
+	}
+	for _, opt := range opts {
+		opt(p)
+	}
+	p.client = &httpClient{
+		client:   &http.Client{},
+		address:  p.address,
+		username: p.username,
+		password: p.password,
+		apiKey:   p.apiKey,
+	}
+	return p, nil
+}
+
+type Option func(*Provider)
+
+func WithTimeout(timeout time.Duration) Option {
+	return func(p *Provider) {
+		p.timeout = timeout
+	}
+}
+
+func WithLogger(logger *zap.Logger) Option {
+	return func(p *Provider) {
+		p.logger = logger.Named("elasticsearch-provider")
+	}
+}
+
+func WithBasicAuth(username, password string) Option {
+	return func(p *Provider) {
+		p.username = username
+		p.password = password
+	}
+}
+
+// WithAPIKey sets the base64 encoded API key sent as "Authorization: ApiKey" header.
+func WithAPIKey(apiKey string) Option {
+	return func(p *Provider) {
+		p.apiKey = apiKey
+	}
+}
+
+// WithIndex sets the index pattern where the logs are searched. Defaults to "*".
+func WithIndex(index string) Option {
+	return func(p *Provider) {
+		if index != "" {
+			p.index = index
+		}
+	}
+}
+
+// WithFields sets the fields of the documents holding the timestamp, the message and the severity.
+// Empty values keep the defaults: "@timestamp", "message" and "log.level".
+func WithFields(timestampField, messageField, severityField string) Option {
+	return func(p *Provider) {
+		if timestampField != "" {
+			p.timestampField = timestampField
+		}
+		if messageField != "" {
+			p.messageField = messageField
+		}
+		if severityField != "" {
+			p.severityField = severityField
+		}
+	}
+}
+
+func (p *Provider) Type() string {
+	return ProviderType
+}
+
+// QueryEntries counts the documents matched with the given query within the given range.
+// The query can be either a query DSL object like `{"match": {"log.level": "error"}}`
+// or a query string like `log.level:error AND service.name:foo`.
+func (p *Provider) QueryEntries(ctx context.Context, query string, queryRange log.QueryRange, sampleSize int) (*log.QueryResult, error) {
+	if err := queryRange.Validate(); err != nil {
+		return nil, err
+	}
+	req, err := p.makeSearchRequest(query, queryRange, sampleSize)
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := context.WithTimeout(ctx, p.timeout)
+	defer cancel()
+
+	resp, err := p.client.Search(ctx, p.index, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to search documents for %s: %w", ProviderType, err)
+	}
+	result := &log.QueryResult{
+		Count:     resp.Hits.Total.Value,
+		Truncated: resp.Hits.Total.Relation == "gte",
+	}
+	for _, h := range resp.Hits.Hits {
+		if len(result.Samples) >= sampleSize {
+			break
+		}
+		result.Samples = append(result.Samples, p.convertDocument(h.Source))
+	}
+	p.logger.Debug("counted documents",
+		zap.String("query", query),
+		zap.Int("count", result.Count),
+		zap.Bool("truncated", result.Truncated),
+	)
+	return result, nil
+}
+
+// makeSearchRequest builds the request to search the documents matched with the given query
+// within the given range ordered by newest.
+func (p *Provider) makeSearchRequest(query string, queryRange log.QueryRange, sampleSize int) (*searchRequest, error) {
+	filters := []interface{}{
+		map[string]interface{}{
+			"range": map[string]interface{}{
+				p.timestampField: map[string]string{
+					"gte":    queryRange.From.UTC().Format(time.RFC3339),
+					"lte":    queryRange.To.UTC().Format(time.RFC3339),
+					"format": "strict_date_optional_time",
+				},
+			},
+		},
+	}
+	switch query = strings.TrimSpace(query); {
+	case query == "":
+	case strings.HasPrefix(query, "{"):
+		var dsl map[string]interface{}
+		if err := json.Unmarshal([]byte(query), &dsl); err != nil {
+			return nil, fmt.Errorf("invalid query DSL: %w", err)
+		}
+		filters = append(filters, dsl)
+	default:
+		filters = append(filters, map[string]interface{}{
+			"query_string": map[string]string{
+				"query": query,
+			},
+		})
+	}
+	if sampleSize < 0 {
+		sampleSize = 0
+	}
+	return &searchRequest{
+		Size:           sampleSize,
+		TrackTotalHits: true,
+		Sort:           []map[string]string{{p.timestampField: "desc"}},
+		Query: map[string]interface{}{
+			"bool": map[string]interface{}{
+				"filter": filters,
+			},
+		},
+	}, nil
+}
+
+func (p *Provider) convertDocument(source map[string]interface{}) log.Entry {
+	var entry log.Entry
+	switch ts := lookupField(source, p.timestampField).(type) {
+	case string:
+		if t, err := time.Parse(time.RFC3339Nano, ts); err == nil {
+			entry.Timestamp = t
+		}
+	case float64:
+		// Epoch milliseconds.
+		entry.Timestamp = time.UnixMilli(int64(ts)).UTC()
+	}
+	if v, ok := lookupField(source, p.severityField).(string); ok {
+		entry.Severity = strings.ToUpper(v)
+	}
+	switch msg := lookupField(source, p.messageField).(type) {
+	case nil:
+		data, _ := json.Marshal(source)
+		entry.Message = string(data)
+	case string:
+		entry.Message = msg
+	default:
+		data, _ := json.Marshal(msg)
+		entry.Message = string(data)
+	}
+	return entry
+}
+
+// lookupField returns the value of the given dotted field name like "log.level"
+// from the given document where the field can be either flattened or nested.
+func lookupField(source map[string]interface{}, field string) interface{} {
+	if v, ok := source[field]; ok {
+		return v
+	}
+	for i := 0; i < len(field); i++ {
+		if field[i] != '.' {
+			continue
+		}
+		child, ok := source[field[:i]].(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if v := lookupField(child, field[i+1:]); v != nil {
+			return v
+		}
+	}
+	return nil
+}
+
+type httpClient struct {
+	client   *http.Client
+	address  string
+	username string
+	password string
+	apiKey   string
+}
+
+func (c *httpClient) Search(ctx context.Context, index string, sr *searchRequest) (*searchResponse, error) {
+	body, err := json.Marshal(sr)
+	if err != nil {
+		return nil, err
+	}
+	path := fmt.Sprintf("/%s/_search", url.PathEscape(index))
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.address+path, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if c.username != "" && c.password != "" {
+		req.SetBasicAuth(c.username, c.password)
+	}
+	if c.apiKey != "" {
+		req.Header.Set("Authorization", "ApiKey "+c.apiKey)
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return nil, fmt.Errorf("elasticsearch returned %d on %s: %s", resp.StatusCode, path, strings.TrimSpace(string(msg)))
+	}
+	var out searchResponse
+	if err := json.NewDecoder(resp.Body).Decode(&out); err != nil {
+		return nil, fmt.Errorf("failed to decode the response: %w", err)
+	}
+	return &out, nil
+}
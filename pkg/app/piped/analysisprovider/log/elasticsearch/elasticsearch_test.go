@@ -0,0 +1,183 @@
+// Copyright 2024 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package elasticsearch
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/pipe-cd/pipecd/pkg/app/piped/analysisprovider/log"
+)
+
+var testQueryRange = log.QueryRange{
+	From: time.Date(2009, time.January, 1, 0, 0, 0, 0, time.UTC),
+	To:   time.Date(2009, time.January, 1, 0, 5, 0, 0, time.UTC),
+}
+
+func TestType(t *testing.T) {
+	t.Parallel()
+
+	p := Provider{}
+	assert.Equal(t, ProviderType, p.Type())
+}
+
+func TestProviderQueryEntries(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name       string
+		response   string
+		err        error
+		query      string
+		sampleSize int
+		want       *log.QueryResult
+		wantQuery  string
+		wantErr    bool
+	}{
+		{
+			name:    "search failed",
+			err:     fmt.Errorf("search error"),
+			query:   "log.level:error",
+			wantErr: true,
+		},
+		{
+			name:    "invalid query DSL",
+			query:   `{"match": `,
+			wantErr: true,
+		},
+		{
+			name:       "query string",
+			response:   `{"hits":{"total":{"value":0,"relation":"eq"},"hits":[]}}`,
+			query:      "log.level:error",
+			sampleSize: 2,
+			want:       &log.QueryResult{},
+			wantQuery:  `{"bool":{"filter":[{"range":{"@timestamp":{"format":"strict_date_optional_time","gte":"2009-01-01T00:00:00Z","lte":"2009-01-01T00:05:00Z"}}},{"query_string":{"query":"log.level:error"}}]}}`,
+		},
+		{
+			name: "query DSL",
+			response: `{"hits":{"total":{"value":3,"relation":"eq"},"hits":[
+				{"_source":{"@timestamp":"2009-01-01T00:04:00.123Z","log":{"level":"error"},"message":"failed to connect"}},
+				{"_source":{"@timestamp":1230768180000,"log.level":"warn","message":{"code":13}}},
+				{"_source":{"@timestamp":"2009-01-01T00:02:00Z","code":14}}
+			]}}`,
+			query:      `{"match": {"log.level": "error"}}`,
+			sampleSize: 3,
+			want: &log.QueryResult{
+				Count: 3,
+				Samples: []log.Entry{
+					{Timestamp: time.Date(2009, time.January, 1, 0, 4, 0, 123000000, time.UTC), Severity: "ERROR", Message: "failed to connect"},
+					{Timestamp: time.Date(2009, time.January, 1, 0, 3, 0, 0, time.UTC), Severity: "WARN", Message: `{"code":13}`},
+					{Timestamp: time.Date(2009, time.January, 1, 0, 2, 0, 0, time.UTC), Message: `{"@timestamp":"2009-01-01T00:02:00Z","code":14}`},
+				},
+			},
+			wantQuery: `{"bool":{"filter":[{"range":{"@timestamp":{"format":"strict_date_optional_time","gte":"2009-01-01T00:00:00Z","lte":"2009-01-01T00:05:00Z"}}},{"match":{"log.level":"error"}}]}}`,
+		},
+		{
+			name:     "lower bound of total hits",
+			response: `{"hits":{"total":{"value":10000,"relation":"gte"},"hits":[]}}`,
+			want: &log.QueryResult{
+				Count:     10000,
+				Truncated: true,
+			},
+			wantQuery: `{"bool":{"filter":[{"range":{"@timestamp":{"format":"strict_date_optional_time","gte":"2009-01-01T00:00:00Z","lte":"2009-01-01T00:05:00Z"}}}]}}`,
+		},
+	}
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			client := &fakeClient{err: tc.err}
+			if tc.response != "" {
+				client.resp = &searchResponse{}
+				require.NoError(t, json.Unmarshal([]byte(tc.response), client.resp))
+			}
+			p := Provider{
+				client:         client,
+				index:          "logs-*",
+				timestampField: defaultTimestampField,
+				messageField:   defaultMessageField,
+				severityField:  defaultSeverityField,
+				timeout:        defaultTimeout,
+				logger:         zap.NewNop(),
+			}
+			got, err := p.QueryEntries(context.Background(), tc.query, testQueryRange, tc.sampleSize)
+			assert.Equal(t, tc.wantErr, err != nil)
+			assert.Equal(t, tc.want, got)
+			if tc.wantErr {
+				return
+			}
+			require.Len(t, client.requests, 1)
+			assert.Equal(t, []string{"logs-*"}, client.indexes)
+			assert.Equal(t, tc.sampleSize, client.requests[0].Size)
+			query, err := json.Marshal(client.requests[0].Query)
+			require.NoError(t, err)
+			assert.JSONEq(t, tc.wantQuery, string(query))
+		})
+	}
+}
+
+func TestHTTPClient(t *testing.T) {
+	t.Parallel()
+
+	var (
+		path string
+		auth string
+		body []byte
+	)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		path = r.URL.Path
+		auth = r.Header.Get("Authorization")
+		body, _ = io.ReadAll(r.Body)
+		fmt.Fprint(w, `{"hits":{"total":{"value":1,"relation":"eq"},"hits":[{"_source":{"ts":"2009-01-01T00:04:00Z","msg":"error 0","level":"error"}}]}}`)
+	}))
+	defer server.Close()
+
+	p, err := NewProvider(server.URL,
+		WithIndex("app-logs"),
+		WithAPIKey("key"),
+		WithFields("ts", "msg", "level"),
+	)
+	require.NoError(t, err)
+
+	got, err := p.QueryEntries(context.Background(), "service:foo", testQueryRange, 1)
+	require.NoError(t, err)
+	assert.Equal(t, &log.QueryResult{
+		Count: 1,
+		Samples: []log.Entry{
+			{Timestamp: time.Date(2009, time.January, 1, 0, 4, 0, 0, time.UTC), Severity: "ERROR", Message: "error 0"},
+		},
+	}, got)
+	assert.Equal(t, "/app-logs/_search", path)
+	assert.Equal(t, "ApiKey key", auth)
+	assert.JSONEq(t, `{
+		"size": 1,
+		"track_total_hits": true,
+		"sort": [{"ts": "desc"}],
+		"query": {"bool": {"filter": [
+			{"range": {"ts": {"format": "strict_date_optional_time", "gte": "2009-01-01T00:00:00Z", "lte": "2009-01-01T00:05:00Z"}}},
+			{"query_string": {"query": "service:foo"}}
+		]}}
+	}`, string(body))
+}
@@ -0,0 +1,36 @@
+// Copyright 2024 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package elasticsearch
+
+import (
+	"context"
+)
+
+// fakeClient returns the given response and records the requests.
+type fakeClient struct {
+	resp     *searchResponse
+	err      error
+	indexes  []string
+	requests []*searchRequest
+}
+
+func (f *fakeClient) Search(_ context.Context, index string, req *searchRequest) (*searchResponse, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	f.indexes = append(f.indexes, index)
+	f.requests = append(f.requests, req)
+	return f.resp, nil
+}
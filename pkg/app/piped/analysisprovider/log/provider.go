@@ -16,13 +16,69 @@ package log
 
 import (
 	"context"
+	"fmt"
+	"time"
 )
 
+const timeFormat = "2006-01-02 15:04:05 MST"
+
 // Provider represents a client for log provider which provides logs for analysis.
 type Provider interface {
 	Type() string
-	// Evaluate runs the given query against the log provider,
-	// and then checks if there is at least one error log.
-	// Returns the result reason if non-error occurred.
-	Evaluate(ctx context.Context, query string) (result bool, reason string, err error)
+	// QueryEntries runs the given query against the log provider within the given range.
+	// It gives back the number of matched log entries and
+	// at most sampleSize entries of them ordered by newest as samples.
+	QueryEntries(ctx context.Context, query string, queryRange QueryRange, sampleSize int) (result *QueryResult, err error)
+}
+
+// QueryResult represents the log entries matched with a query.
+type QueryResult struct {
+	// The number of matched log entries.
+	Count int
+	// Whether the count reached the limit of the provider so that
+	// the actual number of matched log entries may be larger than Count.
+	Truncated bool
+	// Some of the matched log entries.
+	Samples []Entry
+}
+
+type Entry struct {
+	Timestamp time.Time
+	// The severity of the log entry like ERROR. Empty if unknown.
+	Severity string
+	Message  string
+}
+
+func (e *Entry) String() string {
+	// Timestamp is shown in UTC.
+	if e.Severity == "" {
+		return fmt.Sprintf("%s %s", e.Timestamp.UTC().Format(timeFormat), e.Message)
+	}
+	return fmt.Sprintf("%s [%s] %s", e.Timestamp.UTC().Format(timeFormat), e.Severity, e.Message)
+}
+
+// QueryRange represents a sliced time range.
+type QueryRange struct {
+	// Required: Start of the queried time period
+	From time.Time
+	// End of the queried time period. Defaults to the current time.
+	To time.Time
+}
+
+func (q *QueryRange) String() string {
+	// Timestamps are shown in UTC.
+	return fmt.Sprintf("from: %q, to: %q", q.From.UTC().Format(timeFormat), q.To.UTC().Format(timeFormat))
+}
+
+func (q *QueryRange) Validate() error {
+	if q.From.IsZero() {
+		return fmt.Errorf("start of the query range is required")
+	}
+	if q.To.IsZero() {
+		q.To = time.Now()
+	}
+	if q.From.After(q.To) {
+		return fmt.Errorf("\"to\" should be after \"from\"")
+	}
+	return nil
 }
@@ -16,28 +16,183 @@ package stackdriver
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
 	"time"
+
+	"go.uber.org/zap"
+	logging "google.golang.org/api/logging/v2"
+	"google.golang.org/api/option"
+
+	"github.com/pipe-cd/pipecd/pkg/app/piped/analysisprovider/log"
+)
+
+const (
+	ProviderType   = "StackdriverLogging"
+	defaultTimeout = 30 * time.Second
+	// The maximum number of entries returned by a single request of Cloud Logging API.
+	pageSize = 1000
+	// The maximum number of pages read to count the matched entries.
+	maxPages = 10
 )
 
-const ProviderType = "StackdriverLogging"
+type client interface {
+	ListEntries(ctx context.Context, req *logging.ListLogEntriesRequest) (*logging.ListLogEntriesResponse, error)
+}
 
 // Provider is a client for stackdriver.
 type Provider struct {
-	serviceAccount []byte
+	client    client
+	projectID string
 
 	timeout time.Duration
+	logger  *zap.Logger
+}
+
+func NewProvider(serviceAccount []byte, opts ...Option) (*Provider, error) {
+	p := &Provider{
+		timeout: defaultTimeout,
+		logger:  zap.NewNop(),
+	}
+	for _, opt := range opts {
+		opt(p)
+	}
+
+	if p.projectID == "" {
+		var sa struct {
+			ProjectID string `json:"project_id"`
+		}
+		if err := json.Unmarshal(serviceAccount, &sa); err != nil {
+			return nil, fmt.Errorf("failed to parse service account: %w", err)
+		}
+		if sa.ProjectID == "" {
+			return nil, fmt.Errorf("project id is required because the service account does not contain it")
+		}
+		p.projectID = sa.ProjectID
+	}
+
+	svc, err := logging.NewService(context.Background(), option.WithCredentialsJSON(serviceAccount))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create cloud logging client: %w", err)
+	}
+	p.client = &loggingClient{svc: svc}
+	return p, nil
+}
+
+type Option func(*Provider)
+
+func WithTimeout(timeout time.Duration) Option {
+	return func(p *Provider) {
+		p.timeout = timeout
+	}
 }
 
-func NewProvider(serviceAccount []byte) (*Provider, error) {
-	return &Provider{
-		serviceAccount: serviceAccount,
-	}, nil
+func WithLogger(logger *zap.Logger) Option {
+	return func(p *Provider) {
+		p.logger = logger.Named("stackdriver-provider")
+	}
+}
+
+// WithProjectID sets the project where the logs are read.
+// The project of the service account is used by default.
+func WithProjectID(projectID string) Option {
+	return func(p *Provider) {
+		p.projectID = projectID
+	}
 }
 
 func (p *Provider) Type() string {
 	return ProviderType
 }
 
-func (p *Provider) Evaluate(ctx context.Context, query string) (bool, string, error) {
-	return false, "", nil
+// QueryEntries counts the log entries matched with the given filter within the given range.
+// The counting stops at maxPages*pageSize entries and the result is marked as truncated.
+func (p *Provider) QueryEntries(ctx context.Context, query string, queryRange log.QueryRange, sampleSize int) (*log.QueryResult, error) {
+	if err := queryRange.Validate(); err != nil {
+		return nil, err
+	}
+	ctx, cancel := context.WithTimeout(ctx, p.timeout)
+	defer cancel()
+
+	req := &logging.ListLogEntriesRequest{
+		ResourceNames: []string{fmt.Sprintf("projects/%s", p.projectID)},
+		Filter:        makeFilter(query, queryRange),
+		OrderBy:       "timestamp desc",
+		PageSize:      pageSize,
+	}
+	result := &log.QueryResult{}
+	for page := 0; ; page++ {
+		if page == maxPages {
+			result.Truncated = true
+			break
+		}
+		resp, err := p.client.ListEntries(ctx, req)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list log entries: %w", err)
+		}
+		result.Count += len(resp.Entries)
+		for _, e := range resp.Entries {
+			if len(result.Samples) >= sampleSize {
+				break
+			}
+			result.Samples = append(result.Samples, convertEntry(e))
+		}
+		if resp.NextPageToken == "" {
+			break
+		}
+		req.PageToken = resp.NextPageToken
+	}
+	p.logger.Debug("counted log entries",
+		zap.String("query", req.Filter),
+		zap.Int("count", result.Count),
+		zap.Bool("truncated", result.Truncated),
+	)
+	return result, nil
+}
+
+// makeFilter restricts the given filter to the given range.
+func makeFilter(query string, queryRange log.QueryRange) string {
+	timeRange := fmt.Sprintf("timestamp>=%q AND timestamp<=%q",
+		queryRange.From.UTC().Format(time.RFC3339),
+		queryRange.To.UTC().Format(time.RFC3339),
+	)
+	if query == "" {
+		return timeRange
+	}
+	return fmt.Sprintf("(%s) AND %s", query, timeRange)
+}
+
+func convertEntry(e *logging.LogEntry) log.Entry {
+	entry := log.Entry{
+		Severity: e.Severity,
+		Message:  e.TextPayload,
+	}
+	if t, err := time.Parse(time.RFC3339Nano, e.Timestamp); err == nil {
+		entry.Timestamp = t
+	}
+	if entry.Message != "" {
+		return entry
+	}
+	payload := e.JsonPayload
+	if len(payload) == 0 {
+		payload = e.ProtoPayload
+	}
+	// Prefer the well-known message field of structured logs.
+	var fields struct {
+		Message string `json:"message"`
+	}
+	if err := json.Unmarshal(payload, &fields); err == nil && fields.Message != "" {
+		entry.Message = fields.Message
+		return entry
+	}
+	entry.Message = string(payload)
+	return entry
+}
+
+type loggingClient struct {
+	svc *logging.Service
+}
+
+func (c *loggingClient) ListEntries(ctx context.Context, req *logging.ListLogEntriesRequest) (*logging.ListLogEntriesResponse, error) {
+	return c.svc.Entries.List(req).Context(ctx).Do()
 }
@@ -0,0 +1,200 @@
+// Copyright 2024 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cloudwatch
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/pipe-cd/pipecd/pkg/app/piped/analysisprovider/metrics"
+)
+
+var testQueryRange = metrics.QueryRange{
+	From: time.Date(2009, time.January, 1, 0, 0, 0, 0, time.UTC),
+	To:   time.Date(2009, time.January, 1, 0, 5, 0, 0, time.UTC),
+}
+
+func TestType(t *testing.T) {
+	t.Parallel()
+
+	p := Provider{}
+	assert.Equal(t, ProviderType, p.Type())
+}
+
+func TestProviderQueryPoints(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name       string
+		client     *fakeClient
+		want       []metrics.DataPoint
+		wantErr    bool
+		wantNoData bool
+	}{
+		{
+			name: "query failed",
+			client: &fakeClient{
+				err: fmt.Errorf("query error"),
+			},
+			wantErr: true,
+		},
+		{
+			name:       "no data points",
+			client:     &fakeClient{pages: [][]metricDataResult{{{Label: "cpu"}}}},
+			wantErr:    true,
+			wantNoData: true,
+		},
+		{
+			name: "mismatched timestamps and values",
+			client: &fakeClient{
+				pages: [][]metricDataResult{
+					{
+						{
+							Label:      "cpu",
+							Timestamps: []time.Time{time.Date(2009, time.January, 1, 0, 1, 0, 0, time.UTC)},
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "multiple series across pages",
+			client: &fakeClient{
+				pages: [][]metricDataResult{
+					{
+						{
+							Label: "service-a",
+							Timestamps: []time.Time{
+								time.Date(2009, time.January, 1, 0, 1, 0, 0, time.UTC),
+								time.Date(2009, time.January, 1, 0, 2, 0, 0, time.UTC),
+							},
+							Values: []float64{0.1, 0.2},
+						},
+					},
+					{
+						{
+							Label:      "service-b",
+							Timestamps: []time.Time{time.Date(2009, time.January, 1, 0, 1, 0, 0, time.UTC)},
+							Values:     []float64{0.3},
+						},
+					},
+				},
+			},
+			want: []metrics.DataPoint{
+				{Timestamp: 1230768060, Value: 0.1},
+				{Timestamp: 1230768120, Value: 0.2},
+				{Timestamp: 1230768060, Value: 0.3},
+			},
+		},
+	}
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			p := Provider{
+				client:  tc.client,
+				period:  defaultPeriod,
+				timeout: defaultTimeout,
+				logger:  zap.NewNop(),
+			}
+			got, err := p.QueryPoints(context.Background(), `SELECT AVG(CPUUtilization) FROM "AWS/ECS"`, testQueryRange)
+			assert.Equal(t, tc.wantErr, err != nil)
+			assert.Equal(t, tc.wantNoData, errors.Is(err, metrics.ErrNoDataFound))
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestHTTPClientGetMetricData(t *testing.T) {
+	t.Parallel()
+
+	var form url.Values
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		form, _ = url.ParseQuery(string(body))
+		fmt.Fprint(w, `<GetMetricDataResponse xmlns="http://monitoring.amazonaws.com/doc/2010-08-01/">
+  <GetMetricDataResult>
+    <MetricDataResults>
+      <member>
+        <Timestamps>
+          <member>2009-01-01T00:01:00Z</member>
+          <member>2009-01-01T00:02:00Z</member>
+        </Timestamps>
+        <Values>
+          <member>0.1</member>
+          <member>0.2</member>
+        </Values>
+        <Id>q</Id>
+        <Label>cpu</Label>
+        <StatusCode>PartialData</StatusCode>
+      </member>
+    </MetricDataResults>
+    <NextToken>token</NextToken>
+  </GetMetricDataResult>
+</GetMetricDataResponse>`)
+	}))
+	defer server.Close()
+
+	c := &httpClient{
+		client:   server.Client(),
+		endpoint: server.URL,
+	}
+	got, err := c.GetMetricData(context.Background(), &getMetricDataInput{
+		Expression: `SELECT AVG(CPUUtilization) FROM "AWS/ECS"`,
+		StartTime:  testQueryRange.From,
+		EndTime:    testQueryRange.To,
+		Period:     60,
+		NextToken:  "prev",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, &getMetricDataOutput{
+		Results: []metricDataResult{
+			{
+				ID:         "q",
+				Label:      "cpu",
+				StatusCode: "PartialData",
+				Timestamps: []time.Time{
+					time.Date(2009, time.January, 1, 0, 1, 0, 0, time.UTC),
+					time.Date(2009, time.January, 1, 0, 2, 0, 0, time.UTC),
+				},
+				Values: []float64{0.1, 0.2},
+			},
+		},
+		NextToken: "token",
+	}, got)
+	assert.Equal(t, url.Values{
+		"Action":                                []string{"GetMetricData"},
+		"Version":                               []string{"2010-08-01"},
+		"StartTime":                             []string{"2009-01-01T00:00:00Z"},
+		"EndTime":                               []string{"2009-01-01T00:05:00Z"},
+		"ScanBy":                                []string{"TimestampAscending"},
+		"MetricDataQueries.member.1.Id":         []string{"q"},
+		"MetricDataQueries.member.1.Expression": []string{`SELECT AVG(CPUUtilization) FROM "AWS/ECS"`},
+		"MetricDataQueries.member.1.Period":     []string{"60"},
+		"NextToken":                             []string{"prev"},
+	}, form)
+}
@@ -15,6 +15,7 @@
 package factory
 
 import (
+	"context"
 	"encoding/base64"
 	"fmt"
 	"os"
@@ -23,7 +24,10 @@ import (
 	"go.uber.org/zap"
 
 	"github.com/pipe-cd/pipecd/pkg/app/piped/analysisprovider/metrics"
+	"github.com/pipe-cd/pipecd/pkg/app/piped/analysisprovider/metrics/cloudmonitoring"
+	"github.com/pipe-cd/pipecd/pkg/app/piped/analysisprovider/metrics/cloudwatch"
 	"github.com/pipe-cd/pipecd/pkg/app/piped/analysisprovider/metrics/datadog"
+	"github.com/pipe-cd/pipecd/pkg/app/piped/analysisprovider/metrics/newrelic"
 	"github.com/pipe-cd/pipecd/pkg/app/piped/analysisprovider/metrics/prometheus"
 	"github.com/pipe-cd/pipecd/pkg/config"
 	"github.com/pipe-cd/pipecd/pkg/model"
@@ -89,6 +93,47 @@ func NewProvider(analysisTempCfg *config.TemplatableAnalysisMetrics, providerCfg
 			options = append(options, datadog.WithAddress(cfg.Address))
 		}
 		return datadog.NewProvider(apiKey, applicationKey, options...)
+	case model.AnalysisProviderCloudWatch:
+		cfg := providerCfg.CloudWatchConfig
+		options := []cloudwatch.Option{
+			cloudwatch.WithLogger(logger),
+			cloudwatch.WithTimeout(analysisTempCfg.Timeout.Duration()),
+		}
+		creds := cloudwatch.Credentials{
+			CredentialsFile: cfg.CredentialsFile,
+			Profile:         cfg.Profile,
+			RoleARN:         cfg.RoleARN,
+			TokenFile:       cfg.TokenFile,
+		}
+		return cloudwatch.NewProvider(context.Background(), cfg.Region, creds, options...)
+	case model.AnalysisProviderNewRelic:
+		cfg := providerCfg.NewRelicConfig
+		a, err := os.ReadFile(cfg.APIKeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read the api-key file: %w", err)
+		}
+		options := []newrelic.Option{
+			newrelic.WithLogger(logger),
+			newrelic.WithTimeout(analysisTempCfg.Timeout.Duration()),
+		}
+		if cfg.Address != "" {
+			options = append(options, newrelic.WithAddress(cfg.Address))
+		}
+		return newrelic.NewProvider(cfg.AccountID, strings.TrimSpace(string(a)), options...)
+	case model.AnalysisProviderCloudMonitoring:
+		cfg := providerCfg.CloudMonitoringConfig
+		sa, err := os.ReadFile(cfg.ServiceAccountFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read the service account file: %w", err)
+		}
+		options := []cloudmonitoring.Option{
+			cloudmonitoring.WithLogger(logger),
+			cloudmonitoring.WithTimeout(analysisTempCfg.Timeout.Duration()),
+		}
+		if cfg.ProjectID != "" {
+			options = append(options, cloudmonitoring.WithProjectID(cfg.ProjectID))
+		}
+		return cloudmonitoring.NewProvider(context.Background(), sa, options...)
 	default:
 		return nil, fmt.Errorf("any of providers config not found")
 	}
@@ -0,0 +1,33 @@
+// Copyright 2024 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package newrelic
+
+import (
+	"context"
+)
+
+type fakeClient struct {
+	results []map[string]interface{}
+	err     error
+	queries []string
+}
+
+func (f *fakeClient) QueryNRQL(_ context.Context, _ int64, nrql string) ([]map[string]interface{}, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	f.queries = append(f.queries, nrql)
+	return f.results, nil
+}
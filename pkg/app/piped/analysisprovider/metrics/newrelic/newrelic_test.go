@@ -0,0 +1,182 @@
+// Copyright 2024 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package newrelic
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/pipe-cd/pipecd/pkg/app/piped/analysisprovider/metrics"
+)
+
+var testQueryRange = metrics.QueryRange{
+	From: time.Date(2009, time.January, 1, 0, 0, 0, 0, time.UTC),
+	To:   time.Date(2009, time.January, 1, 0, 5, 0, 0, time.UTC),
+}
+
+func TestType(t *testing.T) {
+	t.Parallel()
+
+	p := Provider{}
+	assert.Equal(t, ProviderType, p.Type())
+}
+
+func TestProviderQueryPoints(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name       string
+		client     *fakeClient
+		want       []metrics.DataPoint
+		wantErr    bool
+		wantNoData bool
+	}{
+		{
+			name: "query failed",
+			client: &fakeClient{
+				err: fmt.Errorf("query error"),
+			},
+			wantErr: true,
+		},
+		{
+			name: "no events found",
+			client: &fakeClient{
+				results: []map[string]interface{}{
+					{"beginTimeSeconds": 1230768000.0, "endTimeSeconds": 1230768060.0, "average.duration": nil},
+				},
+			},
+			wantErr:    true,
+			wantNoData: true,
+		},
+		{
+			name: "multiple values selected",
+			client: &fakeClient{
+				results: []map[string]interface{}{
+					{"average.duration": 0.1, "max.duration": 0.5},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "time series",
+			client: &fakeClient{
+				results: []map[string]interface{}{
+					{"beginTimeSeconds": 1230768000.0, "endTimeSeconds": 1230768060.0, "average.duration": 0.1},
+					{"beginTimeSeconds": 1230768060.0, "endTimeSeconds": 1230768120.0, "average.duration": nil},
+					{"beginTimeSeconds": 1230768120.0, "endTimeSeconds": 1230768180.0, "average.duration": 0.3},
+				},
+			},
+			want: []metrics.DataPoint{
+				{Timestamp: 1230768000, Value: 0.1},
+				{Timestamp: 1230768120, Value: 0.3},
+			},
+		},
+		{
+			name: "single nested value",
+			client: &fakeClient{
+				results: []map[string]interface{}{
+					{"percentile.duration": map[string]interface{}{"95": 0.3}},
+				},
+			},
+			want: []metrics.DataPoint{
+				{Timestamp: 1230768300, Value: 0.3},
+			},
+		},
+	}
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			p := Provider{
+				client:    tc.client,
+				accountID: 1,
+				timeout:   defaultTimeout,
+				logger:    zap.NewNop(),
+			}
+			got, err := p.QueryPoints(context.Background(), "SELECT average(duration) FROM Transaction TIMESERIES 1 minute ", testQueryRange)
+			assert.Equal(t, tc.wantErr, err != nil)
+			assert.Equal(t, tc.wantNoData, errors.Is(err, metrics.ErrNoDataFound))
+			assert.Equal(t, tc.want, got)
+			if tc.client.err == nil {
+				assert.Equal(t, []string{"SELECT average(duration) FROM Transaction TIMESERIES 1 minute SINCE 1230768000000 UNTIL 1230768300000"}, tc.client.queries)
+			}
+		})
+	}
+}
+
+func TestHTTPClientQueryNRQL(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name     string
+		response string
+		want     []map[string]interface{}
+		wantErr  bool
+	}{
+		{
+			name:     "results",
+			response: `{"data":{"actor":{"account":{"nrql":{"results":[{"average.duration":0.1}]}}}}}`,
+			want:     []map[string]interface{}{{"average.duration": 0.1}},
+		},
+		{
+			name:     "errors",
+			response: `{"data":{"actor":{"account":{"nrql":null}}},"errors":[{"message":"NRQL Syntax Error"}]}`,
+			wantErr:  true,
+		},
+	}
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			var (
+				apiKey string
+				body   struct {
+					Variables struct {
+						AccountID int64  `json:"accountId"`
+						NRQL      string `json:"nrql"`
+					} `json:"variables"`
+				}
+			)
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				apiKey = r.Header.Get("API-Key")
+				json.NewDecoder(r.Body).Decode(&body)
+				fmt.Fprint(w, tc.response)
+			}))
+			defer server.Close()
+
+			c := &httpClient{
+				client:  server.Client(),
+				address: server.URL,
+				apiKey:  "key",
+			}
+			got, err := c.QueryNRQL(context.Background(), 123, "SELECT average(duration) FROM Transaction")
+			assert.Equal(t, tc.wantErr, err != nil)
+			assert.Equal(t, tc.want, got)
+			require.Equal(t, "key", apiKey)
+			assert.Equal(t, int64(123), body.Variables.AccountID)
+			assert.Equal(t, "SELECT average(duration) FROM Transaction", body.Variables.NRQL)
+		})
+	}
+}
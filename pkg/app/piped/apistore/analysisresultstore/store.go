@@ -34,11 +34,13 @@ var (
 type apiClient interface {
 	GetLatestAnalysisResult(ctx context.Context, req *pipedservice.GetLatestAnalysisResultRequest, opts ...grpc.CallOption) (*pipedservice.GetLatestAnalysisResultResponse, error)
 	PutLatestAnalysisResult(ctx context.Context, req *pipedservice.PutLatestAnalysisResultRequest, opts ...grpc.CallOption) (*pipedservice.PutLatestAnalysisResultResponse, error)
+	PutAnalysisReport(ctx context.Context, req *pipedservice.PutAnalysisReportRequest, opts ...grpc.CallOption) (*pipedservice.PutAnalysisReportResponse, error)
 }
 
 type Store interface {
 	GetLatestAnalysisResult(ctx context.Context, applicationID string) (*model.AnalysisResult, error)
 	PutLatestAnalysisResult(ctx context.Context, applicationID string, analysisResult *model.AnalysisResult) error
+	PutAnalysisReport(ctx context.Context, report *model.AnalysisReport) error
 }
 
 type store struct {
@@ -83,3 +85,18 @@ func (s *store) PutLatestAnalysisResult(ctx context.Context, applicationID strin
 	}
 	return nil
 }
+
+func (s *store) PutAnalysisReport(ctx context.Context, report *model.AnalysisReport) error {
+	_, err := s.apiClient.PutAnalysisReport(ctx, &pipedservice.PutAnalysisReportRequest{
+		Report: report,
+	})
+	if err != nil {
+		s.logger.Error("failed to put the analysis report",
+			zap.String("deployment-id", report.DeploymentId),
+			zap.String("stage-id", report.StageId),
+			zap.Error(err),
+		)
+		return err
+	}
+	return nil
+}
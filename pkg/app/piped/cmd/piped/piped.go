@@ -19,6 +19,7 @@ import (
 	"context"
 	"crypto/tls"
 	"encoding/base64"
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"net/http/pprof"
@@ -833,6 +834,13 @@ func (p *piped) sendPipedMeta(ctx context.Context, client pipedservice.Client, c
 			if cfg.WebAddress == "" {
 				cfg.WebAddress = res.WebBaseUrl
 			}
+			if res.ProjectDeploymentWindows != "" {
+				var dw config.DeploymentWindows
+				if err := json.Unmarshal([]byte(res.ProjectDeploymentWindows), &dw); err != nil {
+					return fmt.Errorf("failed to decode project deployment windows: %w", err)
+				}
+				cfg.ProjectDeploymentWindows = &dw
+			}
 			return nil
 		}
 		logger.Warn("failed to report piped meta to control-plane, wait to the next retry",
@@ -86,6 +86,7 @@ type liveResourceLister interface {
 type analysisResultStore interface {
 	GetLatestAnalysisResult(ctx context.Context, applicationID string) (*model.AnalysisResult, error)
 	PutLatestAnalysisResult(ctx context.Context, applicationID string, analysisResult *model.AnalysisResult) error
+	PutAnalysisReport(ctx context.Context, report *model.AnalysisReport) error
 }
 
 type notifier interface {
@@ -103,6 +104,8 @@ type DeploymentController interface {
 var (
 	plannerStaleDuration   = time.Hour
 	schedulerStaleDuration = time.Hour
+	// The interval to recheck the deployments queued by deployment windows.
+	queuedDeploymentRecheckInterval = time.Minute
 )
 
 type controller struct {
@@ -127,6 +130,9 @@ type controller struct {
 	// Because when the deployment lister returns not fresh data
 	// we use this to ignore the ones that have been handled previously.
 	donePlanners map[string]time.Time
+	// Map from application ID to the time when the deployments
+	// of that application queued by deployment windows should be rechecked.
+	queuedApplications map[string]time.Time
 	// Map from application ID to the scheduler
 	// of a running deployment of that application.
 	schedulers map[string]*scheduler
@@ -187,6 +193,7 @@ func NewController(
 
 		planners:                              make(map[string]*planner),
 		donePlanners:                          make(map[string]time.Time),
+		queuedApplications:                    make(map[string]time.Time),
 		schedulers:                            make(map[string]*scheduler),
 		doneSchedulers:                        make(map[string]time.Time),
 		mostRecentlySuccessfulCommits:         make(map[string]string),
@@ -323,6 +330,11 @@ func (c *controller) syncPlanners(ctx context.Context) {
 			delete(c.donePlanners, id)
 		}
 	}
+	for id, t := range c.queuedApplications {
+		if time.Now().After(t) {
+			delete(c.queuedApplications, id)
+		}
+	}
 
 	// Find all completed ones and add them to donePlaners list.
 	for id, p := range c.planners {
@@ -334,9 +346,16 @@ func (c *controller) syncPlanners(ctx context.Context) {
 			zap.String("app", id),
 			zap.Int("count", len(c.planners)),
 		)
-		c.donePlanners[p.ID()] = p.DoneTimestamp()
 		delete(c.planners, id)
 
+		// The deployment was queued by deployment windows,
+		// so it will be planned again after the recheck interval.
+		if p.DoneDeploymentStatus() == model.DeploymentStatus_DEPLOYMENT_PENDING {
+			c.queuedApplications[id] = p.DoneTimestamp().Add(queuedDeploymentRecheckInterval)
+			continue
+		}
+		c.donePlanners[p.ID()] = p.DoneTimestamp()
+
 		// Application will be marked as NOT deploying when planner's deployment was completed.
 		if p.DoneDeploymentStatus().IsCompleted() {
 			if err := reportApplicationDeployingStatus(ctx, c.apiClient, id, false); err != nil {
@@ -370,6 +389,15 @@ func (c *controller) syncPlanners(ctx context.Context) {
 			)
 			continue
 		}
+		// Wait for the recheck of the deployments queued by deployment windows
+		// unless the deployment was requested to override them.
+		if _, ok := c.queuedApplications[appID]; ok && !d.Trigger.OverrideDeploymentWindows {
+			c.logger.Debug("temporarily skip planning because deployments of this application are queued by deployment windows",
+				zap.String("deployment", d.Id),
+				zap.String("app", d.ApplicationId),
+			)
+			continue
+		}
 		// For each application, only one deployment can be planned at the same time.
 		if p, ok := c.planners[appID]; ok {
 			c.logger.Info("temporarily skip planning because another deployment is planning",
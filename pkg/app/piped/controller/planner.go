@@ -18,6 +18,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"path/filepath"
 	"time"
 
@@ -208,6 +209,18 @@ func (p *planner) Run(ctx context.Context) error {
 		controllermetrics.UpdateDeploymentStatus(p.deployment, p.doneDeploymentStatus)
 	}()
 
+	if !p.deployment.Trigger.OverrideDeploymentWindows {
+		blocked, err := p.checkDeploymentWindows(ctx, in.TargetDSP)
+		if err != nil {
+			p.doneDeploymentStatus = model.DeploymentStatus_DEPLOYMENT_FAILURE
+			span.SetStatus(codes.Error, err.Error())
+			return p.reportDeploymentFailed(ctx, fmt.Sprintf("Unable to check the deployment windows (%v)", err))
+		}
+		if blocked {
+			return nil
+		}
+	}
+
 	planner, ok := p.plannerRegistry.Planner(p.deployment.Kind)
 	if !ok {
 		p.doneDeploymentStatus = model.DeploymentStatus_DEPLOYMENT_FAILURE
@@ -241,6 +254,81 @@ func (p *planner) Run(ctx context.Context) error {
 	return p.reportDeploymentPlanned(ctx, out)
 }
 
+// checkDeploymentWindows checks whether the deployment is allowed to start by the project and application deployment windows.
+// When it is not allowed, the deployment is kept as PENDING to be rechecked later or is marked as failed
+// according to the action configured in the blocking windows.
+func (p *planner) checkDeploymentWindows(ctx context.Context, dsp deploysource.Provider) (blocked bool, err error) {
+	ds, err := dsp.Get(ctx, io.Discard)
+	if err != nil {
+		return false, fmt.Errorf("failed to prepare the target deploy source: %w", err)
+	}
+
+	w, reason, err := config.FindBlockingDeploymentWindows(p.nowFunc(), p.pipedConfig.ProjectDeploymentWindows, ds.GenericApplicationConfig.DeploymentWindows)
+	if err != nil {
+		return false, err
+	}
+	if w == nil {
+		return false, nil
+	}
+
+	if w.Action == config.DeploymentWindowActionReject {
+		p.doneDeploymentStatus = model.DeploymentStatus_DEPLOYMENT_FAILURE
+		return true, p.reportDeploymentFailed(ctx, reason)
+	}
+
+	p.logger.Info(fmt.Sprintf("deployment is queued because it is blocked by deployment windows: %s", reason))
+	p.doneDeploymentStatus = model.DeploymentStatus_DEPLOYMENT_PENDING
+
+	// Nothing to report when the same reason was already reported in the previous check.
+	if p.deployment.StatusReason == reason {
+		return true, nil
+	}
+	return true, p.reportDeploymentBlocked(ctx, reason)
+}
+
+func (p *planner) reportDeploymentBlocked(ctx context.Context, reason string) error {
+	var (
+		err   error
+		retry = pipedservice.NewRetry(10)
+		req   = &pipedservice.ReportDeploymentStatusChangedRequest{
+			DeploymentId:              p.deployment.Id,
+			Status:                    model.DeploymentStatus_DEPLOYMENT_PENDING,
+			StatusReason:              reason,
+			DeploymentChainId:         p.deployment.DeploymentChainId,
+			DeploymentChainBlockIndex: p.deployment.DeploymentChainBlockIndex,
+		}
+	)
+
+	users, groups, err := p.getApplicationNotificationMentions(model.NotificationEventType_EVENT_DEPLOYMENT_BLOCKED)
+	if err != nil {
+		p.logger.Error("failed to get the list of users or groups", zap.Error(err))
+	}
+
+	defer func() {
+		p.notifier.Notify(model.NotificationEvent{
+			Type: model.NotificationEventType_EVENT_DEPLOYMENT_BLOCKED,
+			Metadata: &model.NotificationEventDeploymentBlocked{
+				Deployment:        p.deployment,
+				Reason:            reason,
+				MentionedAccounts: users,
+				MentionedGroups:   groups,
+			},
+		})
+	}()
+
+	for retry.WaitNext(ctx) {
+		if _, err = p.apiClient.ReportDeploymentStatusChanged(ctx, req); err == nil {
+			return nil
+		}
+		err = fmt.Errorf("failed to report deployment status to control-plane: %v", err)
+	}
+
+	if err != nil {
+		p.logger.Error("failed to mark deployment to be blocked", zap.Error(err))
+	}
+	return err
+}
+
 func (p *planner) reportDeploymentPlanned(ctx context.Context, out pln.Output) error {
 	var (
 		err   error
@@ -0,0 +1,185 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"context"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+
+	"github.com/pipe-cd/pipecd/pkg/app/piped/deploysource"
+	"github.com/pipe-cd/pipecd/pkg/app/piped/metadatastore"
+	"github.com/pipe-cd/pipecd/pkg/app/server/service/pipedservice"
+	"github.com/pipe-cd/pipecd/pkg/config"
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+type fakeAPIClient struct {
+	apiClient
+	statusChangedRequests []*pipedservice.ReportDeploymentStatusChangedRequest
+	completedRequests     []*pipedservice.ReportDeploymentCompletedRequest
+}
+
+func (c *fakeAPIClient) ReportDeploymentStatusChanged(ctx context.Context, req *pipedservice.ReportDeploymentStatusChangedRequest, opts ...grpc.CallOption) (*pipedservice.ReportDeploymentStatusChangedResponse, error) {
+	c.statusChangedRequests = append(c.statusChangedRequests, req)
+	return &pipedservice.ReportDeploymentStatusChangedResponse{}, nil
+}
+
+func (c *fakeAPIClient) ReportDeploymentCompleted(ctx context.Context, req *pipedservice.ReportDeploymentCompletedRequest, opts ...grpc.CallOption) (*pipedservice.ReportDeploymentCompletedResponse, error) {
+	c.completedRequests = append(c.completedRequests, req)
+	return &pipedservice.ReportDeploymentCompletedResponse{}, nil
+}
+
+type fakeNotifier struct {
+	events []model.NotificationEvent
+}
+
+func (n *fakeNotifier) Notify(event model.NotificationEvent) {
+	n.events = append(n.events, event)
+}
+
+type fakeDeploySourceProvider struct {
+	deploysource.Provider
+	ds *deploysource.DeploySource
+}
+
+func (p *fakeDeploySourceProvider) Get(ctx context.Context, logWriter io.Writer) (*deploysource.DeploySource, error) {
+	return p.ds, nil
+}
+
+func TestPlannerCheckDeploymentWindows(t *testing.T) {
+	t.Parallel()
+
+	const blockedReason = "Deployments are allowed only during the configured allow windows"
+	newWindows := func(action config.DeploymentWindowAction) *config.DeploymentWindows {
+		return &config.DeploymentWindows{
+			Timezone: "UTC",
+			Action:   action,
+			Allow: []config.DeploymentWindow{
+				{Schedule: "0 9 * * *", Duration: config.Duration(9 * time.Hour)},
+			},
+		}
+	}
+	insideWindow := time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC)
+	outsideWindow := time.Date(2025, 1, 1, 20, 0, 0, 0, time.UTC)
+
+	testcases := []struct {
+		name          string
+		windows       *config.DeploymentWindows
+		now           time.Time
+		statusReason  string
+		wantBlocked   bool
+		wantStatus    model.DeploymentStatus
+		wantChanged   []model.DeploymentStatus
+		wantCompleted []model.DeploymentStatus
+		wantEvents    []model.NotificationEventType
+	}{
+		{
+			name:        "deploy inside window",
+			windows:     newWindows(config.DeploymentWindowActionQueue),
+			now:         insideWindow,
+			wantBlocked: false,
+			wantStatus:  model.DeploymentStatus_DEPLOYMENT_PENDING,
+		},
+		{
+			name:        "queued outside window",
+			windows:     newWindows(config.DeploymentWindowActionQueue),
+			now:         outsideWindow,
+			wantBlocked: true,
+			wantStatus:  model.DeploymentStatus_DEPLOYMENT_PENDING,
+			wantChanged: []model.DeploymentStatus{model.DeploymentStatus_DEPLOYMENT_PENDING},
+			wantEvents:  []model.NotificationEventType{model.NotificationEventType_EVENT_DEPLOYMENT_BLOCKED},
+		},
+		{
+			name:         "queued outside window with the reason already reported",
+			windows:      newWindows(config.DeploymentWindowActionQueue),
+			now:          outsideWindow,
+			statusReason: blockedReason,
+			wantBlocked:  true,
+			wantStatus:   model.DeploymentStatus_DEPLOYMENT_PENDING,
+		},
+		{
+			name:          "rejected outside window",
+			windows:       newWindows(config.DeploymentWindowActionReject),
+			now:           outsideWindow,
+			wantBlocked:   true,
+			wantStatus:    model.DeploymentStatus_DEPLOYMENT_FAILURE,
+			wantCompleted: []model.DeploymentStatus{model.DeploymentStatus_DEPLOYMENT_FAILURE},
+			wantEvents:    []model.NotificationEventType{model.NotificationEventType_EVENT_DEPLOYMENT_FAILED},
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			d := &model.Deployment{
+				Id:            "deployment-id",
+				ApplicationId: "app-id",
+				Status:        model.DeploymentStatus_DEPLOYMENT_PENDING,
+				StatusReason:  tc.statusReason,
+			}
+			ac := &fakeAPIClient{}
+			n := &fakeNotifier{}
+			p := &planner{
+				deployment:           d,
+				apiClient:            ac,
+				metadataStore:        metadatastore.NewMetadataStore(ac, d),
+				notifier:             n,
+				pipedConfig:          &config.PipedSpec{},
+				doneDeploymentStatus: d.Status,
+				nowFunc:              func() time.Time { return tc.now },
+				logger:               zap.NewNop(),
+			}
+			dsp := &fakeDeploySourceProvider{
+				ds: &deploysource.DeploySource{
+					GenericApplicationConfig: config.GenericApplicationSpec{
+						DeploymentWindows: tc.windows,
+					},
+				},
+			}
+
+			blocked, err := p.checkDeploymentWindows(context.Background(), dsp)
+			require.NoError(t, err)
+			assert.Equal(t, tc.wantBlocked, blocked)
+			assert.Equal(t, tc.wantStatus, p.doneDeploymentStatus)
+
+			var changed []model.DeploymentStatus
+			for _, req := range ac.statusChangedRequests {
+				changed = append(changed, req.Status)
+				assert.Equal(t, blockedReason, req.StatusReason)
+			}
+			assert.Equal(t, tc.wantChanged, changed)
+
+			var completed []model.DeploymentStatus
+			for _, req := range ac.completedRequests {
+				completed = append(completed, req.Status)
+				assert.Equal(t, blockedReason, req.StatusReason)
+			}
+			assert.Equal(t, tc.wantCompleted, completed)
+
+			var events []model.NotificationEventType
+			for _, e := range n.events {
+				events = append(events, e.Type)
+			}
+			assert.Equal(t, tc.wantEvents, events)
+		})
+	}
+}
@@ -859,6 +859,10 @@ func (a appAnalysisResultStore) PutLatestAnalysisResult(ctx context.Context, ana
 	return a.store.PutLatestAnalysisResult(ctx, a.applicationID, analysisResult)
 }
 
+func (a appAnalysisResultStore) PutAnalysisReport(ctx context.Context, report *model.AnalysisReport) error {
+	return a.store.PutAnalysisReport(ctx, report)
+}
+
 // notifyStageStartEvent sends notification evnet STAGE_STARTED
 func (s *scheduler) notifyStageStartEvent(stage *model.PipelineStage) {
 	s.notifier.Notify(model.NotificationEvent{
@@ -40,6 +40,7 @@ import (
 	"github.com/pipe-cd/pipecd/pkg/backoff"
 	"github.com/pipe-cd/pipecd/pkg/config"
 	"github.com/pipe-cd/pipecd/pkg/git"
+	"github.com/pipe-cd/pipecd/pkg/githosting"
 	"github.com/pipe-cd/pipecd/pkg/model"
 	"github.com/pipe-cd/pipecd/pkg/regexpool"
 	"github.com/pipe-cd/pipecd/pkg/yamlprocessor"
@@ -48,7 +49,9 @@ import (
 const (
 	// The latest value and Event name are supposed.
 	defaultCommitMessageFormat = "Replace values with %q set by Event %q"
-	defaultCheckInterval       = time.Minute
+	// The Event name, ID and the latest value are supposed.
+	defaultPullRequestBodyFormat = "This pull request was created by PipeCD Event Watcher to update the values set by Event %q.\n\n- Event ID: %s\n- Value: %s"
+	defaultCheckInterval         = time.Minute
 
 	retryPushNum      = 3
 	retryPushInterval = 5 * time.Second
@@ -75,7 +78,9 @@ type gitClient interface {
 type apiClient interface {
 	GetLatestEvent(ctx context.Context, req *pipedservice.GetLatestEventRequest, opts ...grpc.CallOption) (*pipedservice.GetLatestEventResponse, error)
 	ReportEventStatuses(ctx context.Context, req *pipedservice.ReportEventStatusesRequest, opts ...grpc.CallOption) (*pipedservice.ReportEventStatusesResponse, error)
+	RegisterEvent(ctx context.Context, req *pipedservice.RegisterEventRequest, opts ...grpc.CallOption) (*pipedservice.RegisterEventResponse, error)
 	ListApplications(ctx context.Context, in *pipedservice.ListApplicationsRequest, opts ...grpc.CallOption) (*pipedservice.ListApplicationsResponse, error)
+	SyncApplication(ctx context.Context, req *pipedservice.SyncApplicationRequest, opts ...grpc.CallOption) (*pipedservice.SyncApplicationResponse, error)
 }
 
 type watcher struct {
@@ -97,6 +102,9 @@ type watcher struct {
 	executionMilestoneMap sync.Map
 	// Cache for the last scanned commit and event watcher configs for each application.
 	lastScannedConfig sync.Map
+	// A map from repo-id to the provider used to open pull requests on its Git hosting service.
+	// It is built before starting goroutines and never modified after that.
+	hostingProviders map[string]githosting.Provider
 }
 
 type eventWatcherCache struct {
@@ -106,8 +114,9 @@ type eventWatcherCache struct {
 }
 
 type eventWatcherConfig struct {
-	GitPath string
-	Configs []config.EventWatcherConfig
+	ApplicationID string
+	GitPath       string
+	Configs       []config.EventWatcherConfig
 }
 
 func NewWatcher(cfg *config.PipedSpec, eventLister eventLister, gitClient gitClient, apiClient apiClient, logger *zap.Logger) Watcher {
@@ -122,6 +131,7 @@ func NewWatcher(cfg *config.PipedSpec, eventLister eventLister, gitClient gitCli
 
 // Run spawns goroutines for each git repository. They periodically fetch the latest Event
 // from the control-plane to compare the value with one in the git repository.
+// It also spawns goroutines for each image poller to register events for the new image tags.
 func (w *watcher) Run(ctx context.Context) error {
 	w.logger.Info("start running event watcher")
 
@@ -133,6 +143,29 @@ func (w *watcher) Run(ctx context.Context) error {
 	defer os.RemoveAll(workingDir)
 	w.workingDir = workingDir
 
+	w.hostingProviders = make(map[string]githosting.Provider, len(w.config.Repositories))
+	for _, r := range w.config.Repositories {
+		if r.HostingProvider == nil {
+			continue
+		}
+		p, err := newHostingProvider(r)
+		if err != nil {
+			w.logger.Error("failed to create git hosting provider", zap.String("repo-id", r.RepoID), zap.Error(err))
+			return err
+		}
+		w.hostingProviders[r.RepoID] = p
+	}
+
+	pollers := make([]*imagePoller, 0, len(w.config.EventWatcher.ImagePollers))
+	for _, cfg := range w.config.EventWatcher.ImagePollers {
+		p, err := newImagePoller(cfg, w.apiClient, w.logger)
+		if err != nil {
+			w.logger.Error("failed to create image poller", zap.String("image", cfg.Image), zap.Error(err))
+			return err
+		}
+		pollers = append(pollers, p)
+	}
+
 	for _, r := range w.config.Repositories {
 		repo, err := w.cloneRepo(ctx, r)
 		if err != nil {
@@ -145,6 +178,14 @@ func (w *watcher) Run(ctx context.Context) error {
 		go w.run(ctx, repo, r)
 	}
 
+	for _, p := range pollers {
+		w.wg.Add(1)
+		go func() {
+			defer w.wg.Done()
+			p.run(ctx)
+		}()
+	}
+
 	w.wg.Wait()
 	return nil
 }
@@ -255,8 +296,9 @@ func (w *watcher) run(ctx context.Context, repo git.Repo, repoCfg config.PipedRe
 					c := v.(*eventWatcherCache)
 					if c.HeadCommit == headCommit.Hash {
 						ew := eventWatcherConfig{
-							GitPath: c.GitPath,
-							Configs: c.Configs,
+							ApplicationID: app.Id,
+							GitPath:       c.GitPath,
+							Configs:       c.Configs,
 						}
 						cfgs = append(cfgs, ew)
 						continue
@@ -282,8 +324,9 @@ func (w *watcher) run(ctx context.Context, repo git.Repo, repoCfg config.PipedRe
 				}
 
 				ew := eventWatcherConfig{
-					GitPath: app.GitPath.Path,
-					Configs: appCfg.EventWatcher,
+					ApplicationID: app.Id,
+					GitPath:       app.GitPath.Path,
+					Configs:       appCfg.EventWatcher,
 				}
 				cfgs = append(cfgs, ew)
 			}
@@ -304,6 +347,27 @@ func (w *watcher) run(ctx context.Context, repo git.Repo, repoCfg config.PipedRe
 	}
 }
 
+// newHostingProvider returns the provider to open pull requests on the Git hosting service of the given repository.
+func newHostingProvider(repoCfg config.PipedRepository) (githosting.Provider, error) {
+	cfg := repoCfg.HostingProvider
+	token, err := os.ReadFile(cfg.TokenFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read token file %s: %w", cfg.TokenFile, err)
+	}
+	repository := cfg.Repository
+	if repository == "" {
+		if repository, err = githosting.RepositoryFromRemote(repoCfg.Remote); err != nil {
+			return nil, err
+		}
+	}
+	return githosting.NewProvider(githosting.Config{
+		Kind:       githosting.Kind(cfg.Type),
+		APIURL:     cfg.APIURL,
+		Repository: repository,
+		Token:      strings.TrimSpace(string(token)),
+	})
+}
+
 // cloneRepo clones the git repository under the working directory.
 func (w *watcher) cloneRepo(ctx context.Context, repoCfg config.PipedRepository) (git.Repo, error) {
 	dst, err := os.MkdirTemp(w.workingDir, repoCfg.RepoID)
@@ -347,8 +411,16 @@ func (w *watcher) execute(ctx context.Context, repo git.Repo, repoID string, eve
 		outDatedDuration    = time.Hour
 		gitUpdateEvent      = false
 		branchHandledEvents = make(map[string][]*pipedservice.ReportEventStatusesRequest_Event, len(eventCfgs))
+		branchPullRequests  = make(map[string]githosting.PullRequest)
 		gitNoChangeEvents   = make([]*pipedservice.ReportEventStatusesRequest_Event, 0)
+		syncedEvents        = make([]*pipedservice.ReportEventStatusesRequest_Event, 0)
+		syncedMaxTimestamp  int64
 	)
+	// The applications have already been triggered to sync, so the statuses of their events are reported
+	// and the milestone is advanced even if the other handlers fail. Otherwise, they would be synced again.
+	defer func() {
+		w.reportSyncedEvents(ctx, repoID, syncedEvents, syncedMaxTimestamp)
+	}()
 	for _, e := range eventCfgs {
 		for _, cfg := range e.Configs {
 			var (
@@ -399,8 +471,24 @@ func (w *watcher) execute(ctx context.Context, repo git.Repo, repoID string, eve
 				continue
 			}
 			switch handler.Type {
-			case config.EventWatcherHandlerTypeGitUpdate:
-				branchName, err := w.commitFiles(ctx, latestEvent, matcher.Name, handler.Config.CommitMessage, e.GitPath, handler.Config.Replacements, tmpRepo, handler.Config.MakePullRequest)
+			case config.EventWatcherHandlerTypeSyncApplication:
+				syncedEvents = append(syncedEvents, w.syncApplication(ctx, latestEvent, e.ApplicationID, handler.Config.SyncStrategy))
+				if latestEvent.CreatedAt > syncedMaxTimestamp {
+					syncedMaxTimestamp = latestEvent.CreatedAt
+				}
+			case config.EventWatcherHandlerTypeGitUpdate, config.EventWatcherHandlerTypeImageUpdate:
+				var (
+					branchName string
+					numFiles   int
+					err        error
+				)
+				if handler.Type == config.EventWatcherHandlerTypeImageUpdate {
+					branchName, err = w.commitImages(ctx, latestEvent, matcher.Name, handler.Config.CommitMessage, e.GitPath, handler.Config.ImageUpdates, tmpRepo, handler.Config.MakePullRequest)
+					numFiles = len(handler.Config.ImageUpdates)
+				} else {
+					branchName, err = w.commitFiles(ctx, latestEvent, matcher.Name, handler.Config.CommitMessage, e.GitPath, handler.Config.Replacements, tmpRepo, handler.Config.MakePullRequest)
+					numFiles = len(handler.Config.Replacements)
+				}
 				noChange := errors.Is(err, errNoChanges)
 				if err != nil && !noChange {
 					w.logger.Error("failed to commit outdated files", zap.Error(err))
@@ -421,8 +509,11 @@ func (w *watcher) execute(ctx context.Context, repo git.Repo, repoID string, eve
 					handledEvent.StatusDescription = "Nothing to commit"
 					gitNoChangeEvents = append(gitNoChangeEvents, handledEvent)
 				} else {
-					handledEvent.StatusDescription = fmt.Sprintf("Successfully updated %d files in the %q repository", len(handler.Config.Replacements), repoID)
+					handledEvent.StatusDescription = fmt.Sprintf("Successfully updated %d files in the %q repository", numFiles, repoID)
 					branchHandledEvents[branchName] = append(branchHandledEvents[branchName], handledEvent)
+					if handler.Config.MakePullRequest {
+						branchPullRequests[branchName] = makePullRequest(latestEvent, matcher.Name, handler.Config, branchName, tmpRepo.GetClonedBranch())
+					}
 				}
 				if latestEvent.CreatedAt > maxTimestamp {
 					maxTimestamp = latestEvent.CreatedAt
@@ -478,6 +569,9 @@ func (w *watcher) execute(ctx context.Context, repo git.Repo, repoID string, eve
 		})
 
 		if err == nil {
+			if pr, ok := branchPullRequests[branch]; ok {
+				w.openPullRequest(ctx, repoID, pr, events)
+			}
 			if _, err := w.apiClient.ReportEventStatuses(ctx, &pipedservice.ReportEventStatusesRequest{Events: events}); err != nil {
 				zlogger.Error("failed to report event statuses", zap.Error(err))
 			}
@@ -513,6 +607,90 @@ func (w *watcher) execute(ctx context.Context, repo git.Repo, repoID string, eve
 	return nil
 }
 
+// reportSyncedEvents reports the statuses of the events handled by syncing applications
+// and advances the milestone of the repository to the given timestamp.
+func (w *watcher) reportSyncedEvents(ctx context.Context, repoID string, events []*pipedservice.ReportEventStatusesRequest_Event, maxTimestamp int64) {
+	if len(events) == 0 {
+		return
+	}
+	if _, err := w.apiClient.ReportEventStatuses(ctx, &pipedservice.ReportEventStatusesRequest{Events: events}); err != nil {
+		w.logger.Error("failed to report event statuses", zap.Error(err))
+	}
+	// The milestone may have already been advanced by the other handlers.
+	if v, ok := w.executionMilestoneMap.Load(repoID); ok && v.(int64) >= maxTimestamp {
+		return
+	}
+	w.executionMilestoneMap.Store(repoID, maxTimestamp)
+}
+
+// openPullRequest opens the given pull request on the Git hosting service of the repository
+// and records the result into the statuses of the given events which have been pushed to its head branch.
+func (w *watcher) openPullRequest(ctx context.Context, repoID string, pr githosting.PullRequest, events []*pipedservice.ReportEventStatusesRequest_Event) {
+	logger := w.logger.With(
+		zap.String("repo-id", repoID),
+		zap.String("branch", pr.Head),
+	)
+	provider, ok := w.hostingProviders[repoID]
+	if !ok {
+		logger.Warn("no git hosting provider is configured for the repository so only the branch was pushed")
+		return
+	}
+
+	result, err := provider.CreatePullRequest(ctx, pr)
+	if result == nil {
+		logger.Error("failed to open pull request", zap.Error(err))
+		for i := range events {
+			events[i].Status = model.EventStatus_EVENT_FAILURE
+			events[i].StatusDescription = fmt.Sprintf("Pushed changes to branch %q but failed to open pull request: %v", pr.Head, err)
+		}
+		return
+	}
+
+	logger.Info(fmt.Sprintf("successfully opened pull request %s", result.URL))
+	for i := range events {
+		events[i].PullRequestUrl = result.URL
+		events[i].StatusDescription = fmt.Sprintf("%s and opened pull request %s", events[i].StatusDescription, result.URL)
+		if err != nil {
+			events[i].StatusDescription = fmt.Sprintf("%s (failed to apply some settings of the pull request: %v)", events[i].StatusDescription, err)
+		}
+	}
+	if err != nil {
+		logger.Warn("failed to apply some settings of the pull request", zap.String("url", result.URL), zap.Error(err))
+	}
+}
+
+// syncApplication triggers a sync of the given application and returns the status of the given event.
+func (w *watcher) syncApplication(ctx context.Context, event *model.Event, appID, syncStrategy string) *pipedservice.ReportEventStatusesRequest_Event {
+	logger := w.logger.With(
+		zap.String("application-id", appID),
+		zap.String("event-id", event.Id),
+	)
+	strategy := model.SyncStrategy_AUTO
+	if syncStrategy != "" {
+		strategy = model.SyncStrategy(model.SyncStrategy_value[syncStrategy])
+	}
+
+	resp, err := w.apiClient.SyncApplication(ctx, &pipedservice.SyncApplicationRequest{
+		ApplicationId: appID,
+		SyncStrategy:  strategy,
+	})
+	if err != nil {
+		logger.Error("failed to trigger sync of application", zap.Error(err))
+		return &pipedservice.ReportEventStatusesRequest_Event{
+			Id:                event.Id,
+			Status:            model.EventStatus_EVENT_FAILURE,
+			StatusDescription: fmt.Sprintf("Failed to trigger sync of application %q: %v", appID, err),
+		}
+	}
+
+	logger.Info(fmt.Sprintf("successfully triggered sync of application with command %s", resp.CommandId))
+	return &pipedservice.ReportEventStatusesRequest_Event{
+		Id:                event.Id,
+		Status:            model.EventStatus_EVENT_SUCCESS,
+		StatusDescription: fmt.Sprintf("Successfully triggered sync of application %q", appID),
+	}
+}
+
 // updateValues inspects all Event-definition and pushes the changes to git repo if there is.
 // NOTE: This will be removed.
 func (w *watcher) updateValues(ctx context.Context, repo git.Repo, repoID string, eventCfgs []config.EventWatcherEvent, commitMsg string) error {
@@ -707,6 +885,39 @@ func (w *watcher) commitFiles(ctx context.Context, latestEvent *model.Event, eve
 		}
 		changes[filePath] = newContent
 	}
+	return w.commitChanges(ctx, latestEvent, eventName, commitMsg, changes, repo, newBranch)
+}
+
+// commitImages updates the given images with the event data and commits the changed files.
+func (w *watcher) commitImages(ctx context.Context, latestEvent *model.Event, eventName, commitMsg, gitPath string, images []config.EventWatcherImageUpdate, repo git.Repo, newBranch bool) (string, error) {
+	changes := make(map[string][]byte, len(images))
+	for _, u := range images {
+		filePath := u.File
+		if gitPath != "" {
+			filePath = fmt.Sprintf("%s/%s", gitPath, u.File)
+		}
+		path := filepath.Join(repo.GetPath(), filePath)
+		newContent, upToDate, err := modifyImage(path, u, latestEvent.Data)
+		if err != nil {
+			w.logger.Error("failed to modify file", zap.Error(err))
+			return "", err
+		}
+		if upToDate {
+			continue
+		}
+
+		if err := os.WriteFile(path, newContent, os.ModePerm); err != nil {
+			w.logger.Error("failed to write file", zap.Error(err))
+			return "", err
+		}
+		changes[filePath] = newContent
+	}
+	return w.commitChanges(ctx, latestEvent, eventName, commitMsg, changes, repo, newBranch)
+}
+
+// commitChanges commits the given changed files and returns the branch where they are committed.
+// errNoChanges is returned if there is no change.
+func (w *watcher) commitChanges(ctx context.Context, latestEvent *model.Event, eventName, commitMsg string, changes map[string][]byte, repo git.Repo, newBranch bool) (string, error) {
 	if len(changes) == 0 {
 		return "", errNoChanges
 	}
@@ -890,6 +1101,32 @@ func parseCommitMsg(msg string, args argsTemplate) string {
 	return buf.String()
 }
 
+// makePullRequest returns the pull request to merge the given head branch into the base branch.
+// The title and body are rendered with the same template arguments as the commit message.
+func makePullRequest(event *model.Event, eventName string, cfg config.EventWatcherHandlerConfig, head, base string) githosting.PullRequest {
+	args := argsTemplate{
+		Value:     event.Data,
+		EventName: eventName,
+	}
+	title := parseCommitMsg(cfg.CommitMessage, args)
+	if cfg.PullRequest.Title != "" {
+		title = parseCommitMsg(cfg.PullRequest.Title, args)
+	}
+	body := fmt.Sprintf(defaultPullRequestBodyFormat, eventName, event.Id, event.Data)
+	if cfg.PullRequest.Body != "" {
+		body = parseCommitMsg(cfg.PullRequest.Body, args)
+	}
+	return githosting.PullRequest{
+		Title:     title,
+		Body:      body,
+		Head:      head,
+		Base:      base,
+		Reviewers: cfg.PullRequest.Reviewers,
+		Labels:    cfg.PullRequest.Labels,
+		AutoMerge: cfg.PullRequest.AutoMerge,
+	}
+}
+
 // makeBranchName generates a new branch name in the format {eventName}-{uuid} if newBranch is true.
 // If newBranch is false, the function returns the existing branch name.
 func makeBranchName(newBranch bool, eventName, branch string) string {
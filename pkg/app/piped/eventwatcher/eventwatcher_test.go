@@ -15,9 +15,17 @@
 package eventwatcher
 
 import (
+	"context"
+	"errors"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+
+	"github.com/pipe-cd/pipecd/pkg/app/server/service/pipedservice"
+	"github.com/pipe-cd/pipecd/pkg/config"
+	"github.com/pipe-cd/pipecd/pkg/githosting"
+	"github.com/pipe-cd/pipecd/pkg/model"
 )
 
 func TestConvertStr(t *testing.T) {
@@ -299,3 +307,268 @@ func TestGetBranchName(t *testing.T) {
 		})
 	}
 }
+
+func TestMakePullRequest(t *testing.T) {
+	t.Parallel()
+	event := &model.Event{
+		Id:   "event-id",
+		Name: "helloworld-image-update",
+		Data: "v0.2.0",
+	}
+	testcases := []struct {
+		name string
+		cfg  config.EventWatcherHandlerConfig
+		want githosting.PullRequest
+	}{
+		{
+			name: "default title and body",
+			cfg: config.EventWatcherHandlerConfig{
+				MakePullRequest: true,
+			},
+			want: githosting.PullRequest{
+				Title: `Replace values with "v0.2.0" set by Event "helloworld-image-update"`,
+				Body:  "This pull request was created by PipeCD Event Watcher to update the values set by Event \"helloworld-image-update\".\n\n- Event ID: event-id\n- Value: v0.2.0",
+				Head:  "helloworld-image-update-xxx",
+				Base:  "main",
+			},
+		},
+		{
+			name: "title from commit message",
+			cfg: config.EventWatcherHandlerConfig{
+				CommitMessage:   "Update to {{ .Value }}",
+				MakePullRequest: true,
+			},
+			want: githosting.PullRequest{
+				Title: "Update to v0.2.0",
+				Body:  "This pull request was created by PipeCD Event Watcher to update the values set by Event \"helloworld-image-update\".\n\n- Event ID: event-id\n- Value: v0.2.0",
+				Head:  "helloworld-image-update-xxx",
+				Base:  "main",
+			},
+		},
+		{
+			name: "configured pull request",
+			cfg: config.EventWatcherHandlerConfig{
+				CommitMessage:   "Update to {{ .Value }}",
+				MakePullRequest: true,
+				PullRequest: config.EventWatcherPullRequest{
+					Title:     "[{{ .EventName }}] Release {{ .Value }}",
+					Body:      "Release {{ .Value }}",
+					Reviewers: []string{"foo", "org/team"},
+					Labels:    []string{"release"},
+					AutoMerge: true,
+				},
+			},
+			want: githosting.PullRequest{
+				Title:     "[helloworld-image-update] Release v0.2.0",
+				Body:      "Release v0.2.0",
+				Head:      "helloworld-image-update-xxx",
+				Base:      "main",
+				Reviewers: []string{"foo", "org/team"},
+				Labels:    []string{"release"},
+				AutoMerge: true,
+			},
+		},
+	}
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			got := makePullRequest(event, event.Name, tc.cfg, "helloworld-image-update-xxx", "main")
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+type fakeHostingProvider struct {
+	result *githosting.PullRequestResult
+	err    error
+}
+
+func (p *fakeHostingProvider) CreatePullRequest(_ context.Context, _ githosting.PullRequest) (*githosting.PullRequestResult, error) {
+	return p.result, p.err
+}
+
+func TestOpenPullRequest(t *testing.T) {
+	t.Parallel()
+	const url = "https://github.com/org/repo/pull/1"
+	testcases := []struct {
+		name      string
+		providers map[string]githosting.Provider
+		want      *pipedservice.ReportEventStatusesRequest_Event
+	}{
+		{
+			name: "no provider",
+			want: &pipedservice.ReportEventStatusesRequest_Event{
+				Id:                "event-id",
+				Status:            model.EventStatus_EVENT_SUCCESS,
+				StatusDescription: "Successfully updated 1 files in the \"repo\" repository",
+			},
+		},
+		{
+			name: "opened",
+			providers: map[string]githosting.Provider{
+				"repo": &fakeHostingProvider{result: &githosting.PullRequestResult{Number: 1, URL: url}},
+			},
+			want: &pipedservice.ReportEventStatusesRequest_Event{
+				Id:                "event-id",
+				Status:            model.EventStatus_EVENT_SUCCESS,
+				StatusDescription: "Successfully updated 1 files in the \"repo\" repository and opened pull request " + url,
+				PullRequestUrl:    url,
+			},
+		},
+		{
+			name: "opened with some settings failed",
+			providers: map[string]githosting.Provider{
+				"repo": &fakeHostingProvider{result: &githosting.PullRequestResult{Number: 1, URL: url}, err: errors.New("labels are not supported")},
+			},
+			want: &pipedservice.ReportEventStatusesRequest_Event{
+				Id:                "event-id",
+				Status:            model.EventStatus_EVENT_SUCCESS,
+				StatusDescription: "Successfully updated 1 files in the \"repo\" repository and opened pull request " + url + " (failed to apply some settings of the pull request: labels are not supported)",
+				PullRequestUrl:    url,
+			},
+		},
+		{
+			name: "failed to open",
+			providers: map[string]githosting.Provider{
+				"repo": &fakeHostingProvider{err: errors.New("unauthorized")},
+			},
+			want: &pipedservice.ReportEventStatusesRequest_Event{
+				Id:                "event-id",
+				Status:            model.EventStatus_EVENT_FAILURE,
+				StatusDescription: "Pushed changes to branch \"event-xxx\" but failed to open pull request: unauthorized",
+			},
+		},
+	}
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			w := &watcher{
+				logger:           zap.NewNop(),
+				hostingProviders: tc.providers,
+			}
+			events := []*pipedservice.ReportEventStatusesRequest_Event{
+				{
+					Id:                "event-id",
+					Status:            model.EventStatus_EVENT_SUCCESS,
+					StatusDescription: "Successfully updated 1 files in the \"repo\" repository",
+				},
+			}
+			w.openPullRequest(context.Background(), "repo", githosting.PullRequest{Head: "event-xxx", Base: "main"}, events)
+			assert.Equal(t, tc.want, events[0])
+		})
+	}
+}
+
+func TestSyncApplication(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name         string
+		syncStrategy string
+		syncErr      error
+		want         *pipedservice.ReportEventStatusesRequest_Event
+		wantSynced   []*pipedservice.SyncApplicationRequest
+	}{
+		{
+			name: "default strategy",
+			want: &pipedservice.ReportEventStatusesRequest_Event{
+				Id:                "event-id",
+				Status:            model.EventStatus_EVENT_SUCCESS,
+				StatusDescription: `Successfully triggered sync of application "app-id"`,
+			},
+			wantSynced: []*pipedservice.SyncApplicationRequest{
+				{ApplicationId: "app-id", SyncStrategy: model.SyncStrategy_AUTO},
+			},
+		},
+		{
+			name:         "pipeline sync",
+			syncStrategy: "PIPELINE",
+			want: &pipedservice.ReportEventStatusesRequest_Event{
+				Id:                "event-id",
+				Status:            model.EventStatus_EVENT_SUCCESS,
+				StatusDescription: `Successfully triggered sync of application "app-id"`,
+			},
+			wantSynced: []*pipedservice.SyncApplicationRequest{
+				{ApplicationId: "app-id", SyncStrategy: model.SyncStrategy_PIPELINE},
+			},
+		},
+		{
+			name:    "failed to sync",
+			syncErr: errors.New("unavailable"),
+			want: &pipedservice.ReportEventStatusesRequest_Event{
+				Id:                "event-id",
+				Status:            model.EventStatus_EVENT_FAILURE,
+				StatusDescription: `Failed to trigger sync of application "app-id": unavailable`,
+			},
+		},
+	}
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			client := &fakeAPIClient{syncErr: tc.syncErr}
+			w := &watcher{
+				apiClient: client,
+				logger:    zap.NewNop(),
+			}
+			got := w.syncApplication(context.Background(), &model.Event{Id: "event-id"}, "app-id", tc.syncStrategy)
+			assert.Equal(t, tc.want, got)
+			assert.Equal(t, tc.wantSynced, client.synced)
+		})
+	}
+}
+
+func TestReportSyncedEvents(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name          string
+		milestone     int64
+		events        []*pipedservice.ReportEventStatusesRequest_Event
+		maxTimestamp  int64
+		wantMilestone int64
+	}{
+		{
+			name:          "no synced events",
+			milestone:     10,
+			maxTimestamp:  20,
+			wantMilestone: 10,
+		},
+		{
+			name:      "advance the milestone",
+			milestone: 10,
+			events: []*pipedservice.ReportEventStatusesRequest_Event{
+				{Id: "event-id", Status: model.EventStatus_EVENT_SUCCESS},
+			},
+			maxTimestamp:  20,
+			wantMilestone: 20,
+		},
+		{
+			name:      "milestone already advanced by the other handlers",
+			milestone: 30,
+			events: []*pipedservice.ReportEventStatusesRequest_Event{
+				{Id: "event-id", Status: model.EventStatus_EVENT_SUCCESS},
+			},
+			maxTimestamp:  20,
+			wantMilestone: 30,
+		},
+	}
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			client := &fakeAPIClient{}
+			w := &watcher{
+				apiClient: client,
+				logger:    zap.NewNop(),
+			}
+			w.executionMilestoneMap.Store("repo", tc.milestone)
+			w.reportSyncedEvents(context.Background(), "repo", tc.events, tc.maxTimestamp)
+			assert.Equal(t, tc.events, client.reported)
+			v, _ := w.executionMilestoneMap.Load("repo")
+			assert.Equal(t, tc.wantMilestone, v)
+		})
+	}
+}
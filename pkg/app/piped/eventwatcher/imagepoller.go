@@ -0,0 +1,211 @@
+// Copyright 2024 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eventwatcher
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/Masterminds/semver/v3"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/pipe-cd/pipecd/pkg/app/server/service/pipedservice"
+	"github.com/pipe-cd/pipecd/pkg/config"
+	"github.com/pipe-cd/pipecd/pkg/oci"
+)
+
+const (
+	defaultImagePollInterval = 5 * time.Minute
+	dockerHubRegistryHost    = "registry-1.docker.io"
+)
+
+type tagLister func(ctx context.Context, repositoryURL string, opts ...oci.ListTagsOption) ([]string, error)
+
+// imagePoller periodically lists the tags of an image repository
+// and registers an event when a new tag matching the conditions is found.
+type imagePoller struct {
+	config    config.PipedEventWatcherImagePoller
+	semver    *semver.Constraints
+	regex     *regexp.Regexp
+	options   []oci.ListTagsOption
+	listTags  tagLister
+	apiClient apiClient
+	logger    *zap.Logger
+
+	// The data of the latest event registered for the image.
+	lastData string
+}
+
+func newImagePoller(cfg config.PipedEventWatcherImagePoller, apiClient apiClient, logger *zap.Logger) (*imagePoller, error) {
+	p := &imagePoller{
+		config:    cfg,
+		listTags:  oci.ListTags,
+		apiClient: apiClient,
+		logger: logger.With(
+			zap.String("image", cfg.Image),
+			zap.String("event-name", cfg.EventName),
+		),
+	}
+	if cfg.SemVer != "" {
+		c, err := semver.NewConstraint(cfg.SemVer)
+		if err != nil {
+			return nil, fmt.Errorf("invalid semver constraint %q: %w", cfg.SemVer, err)
+		}
+		p.semver = c
+	}
+	if cfg.Regex != "" {
+		// The tags must match the whole pattern.
+		r, err := regexp.Compile("^(?:" + cfg.Regex + ")$")
+		if err != nil {
+			return nil, fmt.Errorf("invalid regex %q: %w", cfg.Regex, err)
+		}
+		p.regex = r
+	}
+
+	if cfg.Username != "" {
+		p.options = append(p.options, oci.WithUsername(cfg.Username))
+	}
+	if cfg.PasswordFile != "" {
+		password, err := os.ReadFile(cfg.PasswordFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read password file %s: %w", cfg.PasswordFile, err)
+		}
+		p.options = append(p.options, oci.WithPassword(strings.TrimSpace(string(password))))
+	}
+	if cfg.Insecure {
+		p.options = append(p.options, oci.WithInsecure())
+	}
+	return p, nil
+}
+
+// run polls the image repository until the given context is done.
+func (p *imagePoller) run(ctx context.Context) {
+	interval := time.Duration(p.config.PollInterval)
+	if interval == 0 {
+		interval = defaultImagePollInterval
+	}
+
+	p.logger.Info("start polling image tags")
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		if err := p.poll(ctx); err != nil {
+			p.logger.Error("failed to poll image tags. will retry in the next loop", zap.Error(err))
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// poll registers an event if the latest tag of the image has been changed since the last poll.
+func (p *imagePoller) poll(ctx context.Context) error {
+	tags, err := p.listTags(ctx, ociRepositoryURL(p.config.Image), p.options...)
+	if err != nil {
+		return err
+	}
+	tag, ok := p.selectTag(tags)
+	if !ok {
+		p.logger.Info("no tag matching the conditions was found")
+		return nil
+	}
+
+	data := p.config.Image + ":" + tag
+	if data == p.lastData {
+		return nil
+	}
+	// Check the latest registered event not to register the same one again after restarting.
+	if p.lastData == "" {
+		resp, err := p.apiClient.GetLatestEvent(ctx, &pipedservice.GetLatestEventRequest{
+			Name:   p.config.EventName,
+			Labels: p.config.Labels,
+		})
+		if err != nil && status.Code(err) != codes.NotFound {
+			return fmt.Errorf("failed to get the latest event: %w", err)
+		}
+		if err == nil && resp.Event.Data == data {
+			p.lastData = data
+			return nil
+		}
+	}
+
+	resp, err := p.apiClient.RegisterEvent(ctx, &pipedservice.RegisterEventRequest{
+		Name:   p.config.EventName,
+		Data:   data,
+		Labels: p.config.Labels,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to register event: %w", err)
+	}
+	p.lastData = data
+	p.logger.Info(fmt.Sprintf("registered event for the new tag %s", tag), zap.String("event-id", resp.EventId))
+	return nil
+}
+
+// selectTag returns the latest tag matching the conditions.
+// The highest version is chosen if the semver constraint is given,
+// otherwise the lexicographically greatest one is chosen.
+func (p *imagePoller) selectTag(tags []string) (string, bool) {
+	var (
+		latest        string
+		latestVersion *semver.Version
+	)
+	for _, t := range tags {
+		if p.regex != nil && !p.regex.MatchString(t) {
+			continue
+		}
+		if p.semver == nil {
+			if t > latest {
+				latest = t
+			}
+			continue
+		}
+		v, err := semver.NewVersion(t)
+		if err != nil || !p.semver.Check(v) {
+			continue
+		}
+		if latestVersion == nil || v.GreaterThan(latestVersion) {
+			latest, latestVersion = t, v
+		}
+	}
+	return latest, latest != ""
+}
+
+// ociRepositoryURL returns the OCI URL of the given image repository.
+// The images on Docker Hub can be given without the registry, e.g. org/helloworld or nginx.
+func ociRepositoryURL(image string) string {
+	host, path, ok := strings.Cut(image, "/")
+	switch {
+	case !ok:
+		return fmt.Sprintf("oci://%s/library/%s", dockerHubRegistryHost, image)
+	case host == "docker.io":
+		if !strings.Contains(path, "/") {
+			path = "library/" + path
+		}
+		return fmt.Sprintf("oci://%s/%s", dockerHubRegistryHost, path)
+	case !strings.ContainsAny(host, ".:") && host != "localhost":
+		return fmt.Sprintf("oci://%s/%s", dockerHubRegistryHost, image)
+	default:
+		return "oci://" + image
+	}
+}
@@ -0,0 +1,205 @@
+// Copyright 2024 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eventwatcher
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/pipe-cd/pipecd/pkg/app/server/service/pipedservice"
+	"github.com/pipe-cd/pipecd/pkg/config"
+	"github.com/pipe-cd/pipecd/pkg/model"
+	"github.com/pipe-cd/pipecd/pkg/oci"
+)
+
+type fakeAPIClient struct {
+	apiClient
+	latestEvent *model.Event
+	registered  []*pipedservice.RegisterEventRequest
+	synced      []*pipedservice.SyncApplicationRequest
+	syncErr     error
+	reported    []*pipedservice.ReportEventStatusesRequest_Event
+}
+
+func (c *fakeAPIClient) GetLatestEvent(_ context.Context, _ *pipedservice.GetLatestEventRequest, _ ...grpc.CallOption) (*pipedservice.GetLatestEventResponse, error) {
+	if c.latestEvent == nil {
+		return nil, status.Error(codes.NotFound, "no events found")
+	}
+	return &pipedservice.GetLatestEventResponse{Event: c.latestEvent}, nil
+}
+
+func (c *fakeAPIClient) RegisterEvent(_ context.Context, req *pipedservice.RegisterEventRequest, _ ...grpc.CallOption) (*pipedservice.RegisterEventResponse, error) {
+	c.registered = append(c.registered, req)
+	return &pipedservice.RegisterEventResponse{EventId: "event-id"}, nil
+}
+
+func (c *fakeAPIClient) ReportEventStatuses(_ context.Context, req *pipedservice.ReportEventStatusesRequest, _ ...grpc.CallOption) (*pipedservice.ReportEventStatusesResponse, error) {
+	c.reported = append(c.reported, req.Events...)
+	return &pipedservice.ReportEventStatusesResponse{}, nil
+}
+
+func (c *fakeAPIClient) SyncApplication(_ context.Context, req *pipedservice.SyncApplicationRequest, _ ...grpc.CallOption) (*pipedservice.SyncApplicationResponse, error) {
+	if c.syncErr != nil {
+		return nil, c.syncErr
+	}
+	c.synced = append(c.synced, req)
+	return &pipedservice.SyncApplicationResponse{CommandId: "command-id"}, nil
+}
+
+func TestImagePollerSelectTag(t *testing.T) {
+	t.Parallel()
+
+	tags := []string{"latest", "v1.0.0", "v1.2.0", "v1.10.0", "v2.0.0", "v2.1.0-rc.1", "main-20250102", "main-20250101"}
+	testcases := []struct {
+		name    string
+		cfg     config.PipedEventWatcherImagePoller
+		want    string
+		wantErr bool
+	}{
+		{
+			name: "highest version",
+			cfg:  config.PipedEventWatcherImagePoller{SemVer: ">=1.0.0"},
+			want: "v2.0.0",
+		},
+		{
+			name: "highest version in range",
+			cfg:  config.PipedEventWatcherImagePoller{SemVer: ">=1.0.0, <2.0.0"},
+			want: "v1.10.0",
+		},
+		{
+			name: "semver and regex",
+			cfg:  config.PipedEventWatcherImagePoller{SemVer: ">=1.0.0", Regex: `v1\.[0-2]\..*`},
+			want: "v1.2.0",
+		},
+		{
+			name: "lexicographically greatest",
+			cfg:  config.PipedEventWatcherImagePoller{Regex: "main-[0-9]+"},
+			want: "main-20250102",
+		},
+		{
+			name:    "no matching tag",
+			cfg:     config.PipedEventWatcherImagePoller{SemVer: ">=3.0.0"},
+			wantErr: true,
+		},
+	}
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			p, err := newImagePoller(tc.cfg, nil, zap.NewNop())
+			require.NoError(t, err)
+
+			got, ok := p.selectTag(tags)
+			assert.Equal(t, tc.wantErr, !ok)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestImagePollerPoll(t *testing.T) {
+	t.Parallel()
+
+	cfg := config.PipedEventWatcherImagePoller{
+		Image:     "ghcr.io/org/helloworld",
+		SemVer:    ">=1.0.0",
+		EventName: "helloworld-image-update",
+		Labels:    map[string]string{"env": "prod"},
+	}
+	testcases := []struct {
+		name        string
+		latestEvent *model.Event
+		tags        [][]string
+		want        []string
+	}{
+		{
+			name: "register only new tags",
+			tags: [][]string{
+				{"v1.0.0"},
+				{"v1.0.0"},
+				{"v1.0.0", "v1.1.0"},
+			},
+			want: []string{"ghcr.io/org/helloworld:v1.0.0", "ghcr.io/org/helloworld:v1.1.0"},
+		},
+		{
+			name:        "already registered before restarting",
+			latestEvent: &model.Event{Data: "ghcr.io/org/helloworld:v1.0.0"},
+			tags: [][]string{
+				{"v1.0.0"},
+				{"v1.0.0", "v1.1.0"},
+			},
+			want: []string{"ghcr.io/org/helloworld:v1.1.0"},
+		},
+		{
+			name:        "registered before restarting but outdated",
+			latestEvent: &model.Event{Data: "ghcr.io/org/helloworld:v0.9.0"},
+			tags: [][]string{
+				{"v1.0.0"},
+			},
+			want: []string{"ghcr.io/org/helloworld:v1.0.0"},
+		},
+	}
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			client := &fakeAPIClient{latestEvent: tc.latestEvent}
+			p, err := newImagePoller(cfg, client, zap.NewNop())
+			require.NoError(t, err)
+
+			for _, tags := range tc.tags {
+				p.listTags = func(_ context.Context, repositoryURL string, _ ...oci.ListTagsOption) ([]string, error) {
+					assert.Equal(t, "oci://ghcr.io/org/helloworld", repositoryURL)
+					return tags, nil
+				}
+				require.NoError(t, p.poll(context.Background()))
+			}
+
+			got := make([]string, 0, len(client.registered))
+			for _, r := range client.registered {
+				assert.Equal(t, cfg.EventName, r.Name)
+				assert.Equal(t, cfg.Labels, r.Labels)
+				got = append(got, r.Data)
+			}
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestOCIRepositoryURL(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		image string
+		want  string
+	}{
+		{image: "nginx", want: "oci://registry-1.docker.io/library/nginx"},
+		{image: "org/helloworld", want: "oci://registry-1.docker.io/org/helloworld"},
+		{image: "docker.io/nginx", want: "oci://registry-1.docker.io/library/nginx"},
+		{image: "docker.io/org/helloworld", want: "oci://registry-1.docker.io/org/helloworld"},
+		{image: "ghcr.io/org/helloworld", want: "oci://ghcr.io/org/helloworld"},
+		{image: "localhost:5000/helloworld", want: "oci://localhost:5000/helloworld"},
+		{image: "localhost/helloworld", want: "oci://localhost/helloworld"},
+	}
+	for _, tc := range testcases {
+		assert.Equal(t, tc.want, ociRepositoryURL(tc.image), tc.image)
+	}
+}
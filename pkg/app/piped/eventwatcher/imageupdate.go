@@ -0,0 +1,233 @@
+// Copyright 2024 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eventwatcher
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/pipe-cd/pipecd/pkg/config"
+	"github.com/pipe-cd/pipecd/pkg/yamlprocessor"
+)
+
+// imageReference represents the image given as the event data.
+type imageReference struct {
+	// The image name without tag and digest. Empty if only the tag was given.
+	name   string
+	tag    string
+	digest string
+}
+
+// parseImageReference parses the given event data which is either
+// a tag (e.g. v1.2.0) or an image reference with a tag or a digest
+// (e.g. ghcr.io/org/app:v1.2.0, ghcr.io/org/app@sha256:...).
+func parseImageReference(data string) (imageReference, error) {
+	if data == "" {
+		return imageReference{}, fmt.Errorf("empty image reference")
+	}
+	if name, digest, ok := strings.Cut(data, "@"); ok {
+		if name == "" || digest == "" {
+			return imageReference{}, fmt.Errorf("invalid image reference %q", data)
+		}
+		// The tag is ignored when the digest is given as well as container runtimes.
+		if i := strings.LastIndex(name, ":"); i > strings.LastIndex(name, "/") {
+			name = name[:i]
+		}
+		return imageReference{name: name, digest: digest}, nil
+	}
+	i := strings.LastIndex(data, ":")
+	if i > strings.LastIndex(data, "/") {
+		if i == 0 || i == len(data)-1 {
+			return imageReference{}, fmt.Errorf("invalid image reference %q", data)
+		}
+		return imageReference{name: data[:i], tag: data[i+1:]}, nil
+	}
+	if strings.Contains(data, "/") {
+		return imageReference{}, fmt.Errorf("image reference %q must contain a tag or a digest", data)
+	}
+	return imageReference{tag: data}, nil
+}
+
+// modifyImage returns a new content of the file at the given path
+// updated with the given image reference. True as a second returned value means it's already up-to-date.
+func modifyImage(path string, u config.EventWatcherImageUpdate, data string) ([]byte, bool, error) {
+	ref, err := parseImageReference(data)
+	if err != nil {
+		return nil, false, err
+	}
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to read file: %w", err)
+	}
+	if u.HelmValues != nil {
+		return modifyHelmValuesImage(content, *u.HelmValues, ref)
+	}
+	return modifyKustomizeImage(content, u.KustomizeImage, ref)
+}
+
+// modifyKustomizeImage updates the images field of the given kustomization in the same way
+// as `kustomize edit set image`. The image is added if it is not in the list yet.
+func modifyKustomizeImage(content []byte, image string, ref imageReference) ([]byte, bool, error) {
+	var doc yaml.Node
+	if err := yaml.Unmarshal(content, &doc); err != nil {
+		return nil, false, fmt.Errorf("failed to parse kustomization: %w", err)
+	}
+	if len(doc.Content) == 0 || doc.Content[0].Kind != yaml.MappingNode {
+		return nil, false, fmt.Errorf("kustomization must be a mapping")
+	}
+	root := doc.Content[0]
+
+	images := mappingValue(root, "images")
+	if images == nil {
+		images = &yaml.Node{Kind: yaml.SequenceNode, Tag: "!!seq"}
+		root.Content = append(root.Content, scalarNode("images"), images)
+	}
+	if images.Kind != yaml.SequenceNode {
+		return nil, false, fmt.Errorf("images field of kustomization must be a list")
+	}
+
+	var entry *yaml.Node
+	for _, n := range images.Content {
+		if v := mappingValue(n, "name"); v != nil && v.Value == image {
+			entry = n
+			break
+		}
+	}
+	if entry == nil {
+		entry = &yaml.Node{Kind: yaml.MappingNode, Tag: "!!map", Content: []*yaml.Node{scalarNode("name"), scalarNode(image)}}
+		images.Content = append(images.Content, entry)
+	}
+
+	changed := false
+	switch {
+	case ref.name == "":
+	case ref.name == image:
+		changed = deleteMappingValue(entry, "newName") || changed
+	default:
+		changed = setMappingValue(entry, "newName", ref.name) || changed
+	}
+	if ref.digest != "" {
+		changed = deleteMappingValue(entry, "newTag") || changed
+		changed = setMappingValue(entry, "digest", ref.digest) || changed
+	} else {
+		changed = deleteMappingValue(entry, "digest") || changed
+		changed = setMappingValue(entry, "newTag", ref.tag) || changed
+	}
+	if !changed {
+		return nil, true, nil
+	}
+
+	var buf bytes.Buffer
+	enc := yaml.NewEncoder(&buf)
+	enc.SetIndent(2)
+	if err := enc.Encode(&doc); err != nil {
+		return nil, false, fmt.Errorf("failed to encode kustomization: %w", err)
+	}
+	if err := enc.Close(); err != nil {
+		return nil, false, fmt.Errorf("failed to encode kustomization: %w", err)
+	}
+	return buf.Bytes(), false, nil
+}
+
+// modifyHelmValuesImage updates the fields of the image in the given Helm values.
+func modifyHelmValuesImage(content []byte, cfg config.EventWatcherHelmValuesImage, ref imageReference) ([]byte, bool, error) {
+	processor, err := yamlprocessor.NewProcessor(content)
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to parse yaml file: %w", err)
+	}
+
+	fields := make(map[string]string, 2)
+	if ref.name != "" && cfg.RepositoryField != "" {
+		fields[cfg.RepositoryField] = ref.name
+	}
+	if ref.digest != "" {
+		if cfg.DigestField == "" {
+			return nil, false, fmt.Errorf("digestField must be set to update the image with digest %s", ref.digest)
+		}
+		fields[cfg.DigestField] = ref.digest
+	} else {
+		fields[cfg.TagField] = ref.tag
+	}
+
+	changed := false
+	for field, newValue := range fields {
+		v, err := processor.GetValue(field)
+		if err != nil {
+			return nil, false, fmt.Errorf("failed to get value at %s: %w", field, err)
+		}
+		value, err := convertStr(v)
+		if err != nil {
+			return nil, false, fmt.Errorf("a value of unknown type is defined at %s: %w", field, err)
+		}
+		if newValue == value {
+			continue
+		}
+		if err := processor.ReplaceString(field, newValue); err != nil {
+			return nil, false, fmt.Errorf("failed to replace value at %s with %s: %w", field, newValue, err)
+		}
+		changed = true
+	}
+	if !changed {
+		return nil, true, nil
+	}
+	return processor.Bytes(), false, nil
+}
+
+func scalarNode(value string) *yaml.Node {
+	return &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: value}
+}
+
+// mappingValue returns the value node of the given key in the mapping node.
+func mappingValue(n *yaml.Node, key string) *yaml.Node {
+	if n.Kind != yaml.MappingNode {
+		return nil
+	}
+	for i := 0; i+1 < len(n.Content); i += 2 {
+		if n.Content[i].Value == key {
+			return n.Content[i+1]
+		}
+	}
+	return nil
+}
+
+// setMappingValue sets the given string value to the key in the mapping node.
+// It returns true if the mapping node was changed.
+func setMappingValue(n *yaml.Node, key, value string) bool {
+	if v := mappingValue(n, key); v != nil {
+		if v.Kind == yaml.ScalarNode && v.Value == value {
+			return false
+		}
+		*v = *scalarNode(value)
+		return true
+	}
+	n.Content = append(n.Content, scalarNode(key), scalarNode(value))
+	return true
+}
+
+// deleteMappingValue deletes the key from the mapping node.
+// It returns true if the mapping node was changed.
+func deleteMappingValue(n *yaml.Node, key string) bool {
+	for i := 0; i+1 < len(n.Content); i += 2 {
+		if n.Content[i].Value == key {
+			n.Content = append(n.Content[:i], n.Content[i+2:]...)
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,258 @@
+// Copyright 2024 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eventwatcher
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pipe-cd/pipecd/pkg/config"
+)
+
+func TestParseImageReference(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		data    string
+		want    imageReference
+		wantErr bool
+	}{
+		{data: "v1.2.0", want: imageReference{tag: "v1.2.0"}},
+		{data: "ghcr.io/org/app:v1.2.0", want: imageReference{name: "ghcr.io/org/app", tag: "v1.2.0"}},
+		{data: "localhost:5000/app:v1.2.0", want: imageReference{name: "localhost:5000/app", tag: "v1.2.0"}},
+		{data: "ghcr.io/org/app@sha256:abc", want: imageReference{name: "ghcr.io/org/app", digest: "sha256:abc"}},
+		{data: "ghcr.io/org/app:v1.2.0@sha256:abc", want: imageReference{name: "ghcr.io/org/app", digest: "sha256:abc"}},
+		{data: "ghcr.io/org/app", wantErr: true},
+		{data: "localhost:5000/app", wantErr: true},
+		{data: "app:", wantErr: true},
+		{data: "", wantErr: true},
+	}
+	for _, tc := range testcases {
+		got, err := parseImageReference(tc.data)
+		assert.Equal(t, tc.wantErr, err != nil, tc.data)
+		assert.Equal(t, tc.want, got, tc.data)
+	}
+}
+
+func TestModifyKustomizeImage(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name         string
+		content      string
+		image        string
+		data         string
+		want         string
+		wantUpToDate bool
+	}{
+		{
+			name: "update tag",
+			content: `apiVersion: kustomize.config.k8s.io/v1beta1
+kind: Kustomization
+resources:
+  - deployment.yaml
+# Images deployed by this kustomization.
+images:
+  - name: helloworld
+    newName: ghcr.io/org/helloworld
+    newTag: v0.1.0
+  - name: nginx
+    newTag: "1.25"
+`,
+			image: "helloworld",
+			data:  "ghcr.io/org/helloworld:v0.2.0",
+			want: `apiVersion: kustomize.config.k8s.io/v1beta1
+kind: Kustomization
+resources:
+  - deployment.yaml
+# Images deployed by this kustomization.
+images:
+  - name: helloworld
+    newName: ghcr.io/org/helloworld
+    newTag: v0.2.0
+  - name: nginx
+    newTag: "1.25"
+`,
+		},
+		{
+			name: "update only tag",
+			content: `images:
+  - name: helloworld
+    newName: ghcr.io/org/helloworld
+    newTag: v0.1.0
+`,
+			image: "helloworld",
+			data:  "v0.2.0",
+			want: `images:
+  - name: helloworld
+    newName: ghcr.io/org/helloworld
+    newTag: v0.2.0
+`,
+		},
+		{
+			name: "replace tag with digest",
+			content: `images:
+  - name: helloworld
+    newTag: v0.1.0
+`,
+			image: "helloworld",
+			data:  "helloworld@sha256:abc",
+			want: `images:
+  - name: helloworld
+    digest: sha256:abc
+`,
+		},
+		{
+			name: "add image",
+			content: `resources:
+  - deployment.yaml
+`,
+			image: "helloworld",
+			data:  "ghcr.io/org/helloworld:v0.2.0",
+			want: `resources:
+  - deployment.yaml
+images:
+  - name: helloworld
+    newName: ghcr.io/org/helloworld
+    newTag: v0.2.0
+`,
+		},
+		{
+			name: "already up-to-date",
+			content: `images:
+  - name: helloworld
+    newName: ghcr.io/org/helloworld
+    newTag: v0.2.0
+`,
+			image:        "helloworld",
+			data:         "ghcr.io/org/helloworld:v0.2.0",
+			wantUpToDate: true,
+		},
+	}
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			ref, err := parseImageReference(tc.data)
+			require.NoError(t, err)
+
+			got, upToDate, err := modifyKustomizeImage([]byte(tc.content), tc.image, ref)
+			require.NoError(t, err)
+			assert.Equal(t, tc.wantUpToDate, upToDate)
+			assert.Equal(t, tc.want, string(got))
+		})
+	}
+}
+
+func TestModifyHelmValuesImage(t *testing.T) {
+	t.Parallel()
+
+	content := `replicaCount: 2
+image:
+  repository: ghcr.io/org/helloworld # The image repository.
+  tag: v0.1.0
+  digest: ""
+`
+	testcases := []struct {
+		name         string
+		cfg          config.EventWatcherHelmValuesImage
+		data         string
+		want         string
+		wantUpToDate bool
+		wantErr      bool
+	}{
+		{
+			name: "update repository and tag",
+			cfg: config.EventWatcherHelmValuesImage{
+				RepositoryField: "$.image.repository",
+				TagField:        "$.image.tag",
+			},
+			data: "docker.io/org/helloworld:v0.2.0",
+			want: `replicaCount: 2
+image:
+  repository: docker.io/org/helloworld # The image repository.
+  tag: v0.2.0
+  digest: ""
+`,
+		},
+		{
+			name: "update only tag",
+			cfg: config.EventWatcherHelmValuesImage{
+				TagField: "$.image.tag",
+			},
+			data: "docker.io/org/helloworld:v0.2.0",
+			want: `replicaCount: 2
+image:
+  repository: ghcr.io/org/helloworld # The image repository.
+  tag: v0.2.0
+  digest: ""
+`,
+		},
+		{
+			name: "update digest",
+			cfg: config.EventWatcherHelmValuesImage{
+				TagField:    "$.image.tag",
+				DigestField: "$.image.digest",
+			},
+			data: "ghcr.io/org/helloworld@sha256:abc",
+			want: `replicaCount: 2
+image:
+  repository: ghcr.io/org/helloworld # The image repository.
+  tag: v0.1.0
+  digest: "sha256:abc"
+`,
+		},
+		{
+			name: "digest without digest field",
+			cfg: config.EventWatcherHelmValuesImage{
+				TagField: "$.image.tag",
+			},
+			data:    "ghcr.io/org/helloworld@sha256:abc",
+			wantErr: true,
+		},
+		{
+			name: "already up-to-date",
+			cfg: config.EventWatcherHelmValuesImage{
+				RepositoryField: "$.image.repository",
+				TagField:        "$.image.tag",
+			},
+			data:         "ghcr.io/org/helloworld:v0.1.0",
+			wantUpToDate: true,
+		},
+		{
+			name: "missing field",
+			cfg: config.EventWatcherHelmValuesImage{
+				TagField: "$.image.version",
+			},
+			data:    "v0.2.0",
+			wantErr: true,
+		},
+	}
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			ref, err := parseImageReference(tc.data)
+			require.NoError(t, err)
+
+			got, upToDate, err := modifyHelmValuesImage([]byte(content), tc.cfg, ref)
+			assert.Equal(t, tc.wantErr, err != nil)
+			assert.Equal(t, tc.wantUpToDate, upToDate)
+			assert.Equal(t, tc.want, string(got))
+		})
+	}
+}
@@ -126,6 +126,7 @@ func (e *Executor) Execute(sig executor.StopSignal) model.StageStatus {
 	}()
 
 	// Run analyses with metrics providers.
+	metricsAnalyzers := make([]*metricsAnalyzer, 0, len(options.Metrics))
 	for i := range options.Metrics {
 		cfg, err := e.getMetricsConfig(options.Metrics[i], templateCfg)
 		if err != nil {
@@ -141,31 +142,58 @@ func (e *Executor) Execute(sig executor.StopSignal) model.StageStatus {
 		id := fmt.Sprintf("metrics-%d", i)
 		args := e.buildAppArgs(options.Metrics[i].Template.AppArgs)
 		analyzer := newMetricsAnalyzer(id, *cfg, e.startTime, provider, e.AnalysisResultStore, args, e.Logger, e.LogPersister)
+		metricsAnalyzers = append(metricsAnalyzers, analyzer)
 
+		// All metrics are evaluated together by the scorer when scoring is enabled.
+		if options.Scoring != nil {
+			continue
+		}
 		eg.Go(func() error {
 			e.LogPersister.Infof("[%s] Start metrics analyzer every %s with query template: %q", analyzer.id, cfg.Interval.Duration(), cfg.Query)
 			return analyzer.run(ctxWithTimeout)
 		})
 	}
+	var scorer *metricsScorer
+	if options.Scoring != nil {
+		scorer = newMetricsScorer(*options.Scoring, metricsAnalyzers, e.Logger, e.LogPersister)
+		eg.Go(func() error {
+			e.LogPersister.Infof("[scoring] Start scoring %d metrics every %s", len(metricsAnalyzers), options.Scoring.Interval.Duration())
+			return scorer.run(ctxWithTimeout)
+		})
+	}
 	// Run analyses with logging providers.
+	logAnalyzers := make([]*logAnalyzer, 0, len(options.Logs))
 	for i := range options.Logs {
-		analyzer, err := e.newAnalyzerForLog(i, &options.Logs[i], templateCfg)
+		cfg, err := e.getLogConfig(&options.Logs[i], templateCfg)
+		if err != nil {
+			e.LogPersister.Errorf("Failed to get log config: %v", err)
+			return model.StageStatus_STAGE_FAILURE
+		}
+		provider, err := e.newLogProvider(cfg)
 		if err != nil {
-			e.LogPersister.Errorf("Failed to spawn analyzer for %s: %v", options.Logs[i].Provider, err)
+			e.LogPersister.Errorf("Failed to generate log provider: %v", err)
 			return model.StageStatus_STAGE_FAILURE
 		}
+
+		id := fmt.Sprintf("log-%d", i)
+		args := e.buildAppArgs(options.Logs[i].Template.AppArgs)
+		analyzer := newLogAnalyzer(id, *cfg, provider, args, e.Logger, e.LogPersister)
+		logAnalyzers = append(logAnalyzers, analyzer)
+
 		eg.Go(func() error {
-			e.LogPersister.Infof("[%s] Start log analyzer", analyzer.id)
+			e.LogPersister.Infof("[%s] Start log analyzer every %s with query template: %q", analyzer.id, cfg.Interval.Duration(), cfg.Query)
 			return analyzer.run(ctxWithTimeout)
 		})
 	}
 	// Run analyses with http providers.
+	httpAnalyzers := make([]*analyzer, 0, len(options.HTTPS))
 	for i := range options.HTTPS {
 		analyzer, err := e.newAnalyzerForHTTP(i, &options.HTTPS[i], templateCfg)
 		if err != nil {
 			e.LogPersister.Errorf("Failed to spawn analyzer for HTTP: %v", err)
 			return model.StageStatus_STAGE_FAILURE
 		}
+		httpAnalyzers = append(httpAnalyzers, analyzer)
 		eg.Go(func() error {
 			e.LogPersister.Infof("[%s] Start http analyzer", analyzer.id)
 			return analyzer.run(ctxWithTimeout)
@@ -174,24 +202,64 @@ func (e *Executor) Execute(sig executor.StopSignal) model.StageStatus {
 
 	if err := eg.Wait(); err != nil {
 		e.LogPersister.Errorf("Analysis failed: %s", err.Error())
+		e.reportAnalysis(ctx, model.StageStatus_STAGE_FAILURE, err.Error(), metricsAnalyzers, logAnalyzers, httpAnalyzers, scorer)
 		return model.StageStatus_STAGE_FAILURE
 	}
 
 	status = executor.DetermineStageStatus(sig.Signal(), e.Stage.Status, status)
+	e.reportAnalysis(ctx, status, "", metricsAnalyzers, logAnalyzers, httpAnalyzers, scorer)
 	if status != model.StageStatus_STAGE_SUCCESS {
 		return status
 	}
 
 	e.LogPersister.Success("All analyses were successful")
-	err = e.AnalysisResultStore.PutLatestAnalysisResult(ctx, &model.AnalysisResult{
+	result := &model.AnalysisResult{
 		StartTime: e.startTime.Unix(),
-	})
+	}
+	if scorer != nil {
+		result.Score, result.MetricsScores = scorer.score()
+	}
+	err = e.AnalysisResultStore.PutLatestAnalysisResult(ctx, result)
 	if err != nil {
 		e.Logger.Error("failed to send the analysis result", zap.Error(err))
 	}
 	return status
 }
 
+// reportAnalysis sends the details of all analyses to the control plane
+// to explain why the stage ended with the given status.
+func (e *Executor) reportAnalysis(ctx context.Context, status model.StageStatus, reason string, metricsAnalyzers []*metricsAnalyzer, logAnalyzers []*logAnalyzer, httpAnalyzers []*analyzer, scorer *metricsScorer) {
+	report := &model.AnalysisReport{
+		DeploymentId:  e.Deployment.Id,
+		StageId:       e.Stage.Id,
+		ApplicationId: e.Deployment.ApplicationId,
+		Status:        status,
+		Reason:        reason,
+		Metrics:       make([]*model.AnalysisMetricsReport, 0, len(metricsAnalyzers)),
+		Logs:          make([]*model.AnalysisLogReport, 0, len(logAnalyzers)),
+		Https:         make([]*model.AnalysisHTTPReport, 0, len(httpAnalyzers)),
+		StartedAt:     e.startTime.Unix(),
+		CompletedAt:   time.Now().Unix(),
+	}
+	for _, a := range metricsAnalyzers {
+		report.Metrics = append(report.Metrics, a.report())
+	}
+	for _, a := range logAnalyzers {
+		report.Logs = append(report.Logs, a.report())
+	}
+	for _, a := range httpAnalyzers {
+		report.Https = append(report.Https, a.report())
+	}
+	if scorer != nil {
+		report.Score = scorer.lastScore
+		report.IntervalScores = scorer.intervalScores
+	}
+	// The report should be sent even if the stage was cancelled.
+	if err := e.AnalysisResultStore.PutAnalysisReport(context.WithoutCancel(ctx), report); err != nil {
+		e.Logger.Error("failed to send the analysis report", zap.Error(err))
+	}
+}
+
 const elapsedTimeKey = "elapsedTime"
 
 // saveElapsedTime stores the elapsed time of analysis stage into metadata persister.
@@ -221,22 +289,6 @@ func (e *Executor) retrievePreviousElapsedTime() time.Duration {
 	return et
 }
 
-func (e *Executor) newAnalyzerForLog(i int, templatable *config.TemplatableAnalysisLog, templateCfg *config.AnalysisTemplateSpec) (*analyzer, error) {
-	cfg, err := e.getLogConfig(templatable, templateCfg)
-	if err != nil {
-		return nil, err
-	}
-	provider, err := e.newLogProvider(cfg.Provider)
-	if err != nil {
-		return nil, err
-	}
-	id := fmt.Sprintf("log-%d", i)
-	runner := func(ctx context.Context, query string) (bool, string, error) {
-		return provider.Evaluate(ctx, query)
-	}
-	return newAnalyzer(id, provider.Type(), cfg.Query, runner, time.Duration(cfg.Interval), cfg.FailureLimit, cfg.SkipOnNoData, e.Logger, e.LogPersister), nil
-}
-
 func (e *Executor) newAnalyzerForHTTP(i int, templatable *config.TemplatableAnalysisHTTP, templateCfg *config.AnalysisTemplateSpec) (*analyzer, error) {
 	cfg, err := e.getHTTPConfig(templatable, templateCfg)
 	if err != nil {
@@ -262,12 +314,12 @@ func (e *Executor) newMetricsProvider(providerName string, templatable config.Te
 	return provider, nil
 }
 
-func (e *Executor) newLogProvider(providerName string) (log.Provider, error) {
-	cfg, ok := e.PipedConfig.GetAnalysisProvider(providerName)
+func (e *Executor) newLogProvider(analysisCfg *config.AnalysisLog) (log.Provider, error) {
+	cfg, ok := e.PipedConfig.GetAnalysisProvider(analysisCfg.Provider)
 	if !ok {
-		return nil, fmt.Errorf("unknown provider name %s", providerName)
+		return nil, fmt.Errorf("unknown provider name %s", analysisCfg.Provider)
 	}
-	provider, err := logfactory.NewProvider(&cfg, e.Logger)
+	provider, err := logfactory.NewProvider(analysisCfg, &cfg, e.Logger)
 	if err != nil {
 		return nil, err
 	}
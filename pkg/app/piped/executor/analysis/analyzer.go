@@ -24,6 +24,7 @@ import (
 
 	"github.com/pipe-cd/pipecd/pkg/app/piped/analysisprovider/metrics"
 	"github.com/pipe-cd/pipecd/pkg/app/piped/executor"
+	"github.com/pipe-cd/pipecd/pkg/model"
 )
 
 // analyzer contains a query for an analysis provider.
@@ -37,6 +38,8 @@ type analyzer struct {
 	failureLimit int
 	skipOnNoData bool
 
+	evaluationRecorder
+
 	logger       *zap.Logger
 	logPersister executor.LogPersister
 }
@@ -87,11 +90,14 @@ func (a *analyzer) run(ctx context.Context) error {
 			}
 			if errors.Is(err, metrics.ErrNoDataFound) && a.skipOnNoData {
 				a.logPersister.Infof("[%s] The query result evaluation was skipped because \"skipOnNoData\" is true even though no data returned. Reason: %v. Performed query: %q", a.id, err, a.query)
+				a.skip(err.Error())
 				continue
 			}
 			if err != nil {
 				reason = fmt.Sprintf("failed to run query: %s", err.Error())
 			}
+			a.reason = reason
+			a.record(expected, nil)
 
 			if expected {
 				a.logPersister.Successf("[%s] The query result is expected one. Reason: %s. Performed query: %q", a.id, reason, a.query)
@@ -108,3 +114,63 @@ func (a *analyzer) run(ctx context.Context) error {
 		}
 	}
 }
+
+// report returns the details of all evaluations of the HTTP analysis.
+func (a *analyzer) report() *model.AnalysisHTTPReport {
+	return &model.AnalysisHTTPReport{
+		Id:          a.id,
+		Provider:    a.providerType,
+		Score:       a.passRate(),
+		Evaluations: a.reports,
+	}
+}
+
+// evaluationRecorder keeps the results of the evaluations to be reported.
+type evaluationRecorder struct {
+	// The number of evaluations and the ones with the expected result.
+	evaluations int
+	passes      int
+	// The details of each evaluation to be reported.
+	reports []*model.AnalysisEvaluation
+	// The queries performed and the failure reason in the current evaluation.
+	queries []*model.AnalysisQuery
+	reason  string
+}
+
+// record counts the given result of an evaluation and adds it to the report.
+func (r *evaluationRecorder) record(expected bool, err error) {
+	r.evaluations++
+	verdict := model.AnalysisVerdict_VERDICT_FAILED
+	if expected {
+		r.passes++
+		verdict = model.AnalysisVerdict_VERDICT_PASSED
+	}
+	reason := r.reason
+	if err != nil {
+		reason = err.Error()
+	}
+	r.addReport(verdict, reason)
+}
+
+// skip adds the evaluation which was not counted because of the given reason to the report.
+func (r *evaluationRecorder) skip(reason string) {
+	r.addReport(model.AnalysisVerdict_VERDICT_SKIPPED, reason)
+}
+
+func (r *evaluationRecorder) addReport(verdict model.AnalysisVerdict, reason string) {
+	r.reports = append(r.reports, &model.AnalysisEvaluation{
+		EvaluatedAt: time.Now().Unix(),
+		Verdict:     verdict,
+		Reason:      reason,
+		Queries:     r.queries,
+	})
+	r.queries, r.reason = nil, ""
+}
+
+// passRate returns the percentage of the evaluations with the expected result.
+func (r *evaluationRecorder) passRate() float64 {
+	if r.evaluations == 0 {
+		return 0
+	}
+	return 100 * float64(r.passes) / float64(r.evaluations)
+}
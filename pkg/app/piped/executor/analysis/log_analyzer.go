@@ -0,0 +1,252 @@
+// Copyright 2024 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analysis
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/pipe-cd/pipecd/pkg/app/piped/analysisprovider/log"
+	"github.com/pipe-cd/pipecd/pkg/app/piped/executor"
+	"github.com/pipe-cd/pipecd/pkg/config"
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+const defaultLogSampleSize = 5
+
+// errNoLogEntries is returned when no log entries are found for the analyzed
+// variant while "skipOnNoData" is true.
+var errNoLogEntries = errors.New("no log entries found")
+
+type logAnalyzer struct {
+	id       string
+	cfg      config.AnalysisLog
+	provider log.Provider
+	// Application-specific arguments using when rendering the query.
+	argsTemplate argsTemplate
+	logger       *zap.Logger
+	logPersister executor.LogPersister
+
+	evaluationRecorder
+}
+
+func newLogAnalyzer(id string, cfg config.AnalysisLog, provider log.Provider, argsTemplate argsTemplate, logger *zap.Logger, logPersister executor.LogPersister) *logAnalyzer {
+	return &logAnalyzer{
+		id:           id,
+		cfg:          cfg,
+		provider:     provider,
+		argsTemplate: argsTemplate,
+		logPersister: logPersister,
+		logger: logger.With(
+			zap.String("analyzer-id", id),
+		),
+	}
+}
+
+// run starts an analysis which runs the query at the given interval, until the context is done.
+// It returns an error when the number of failures exceeds the the failureLimit.
+func (a *logAnalyzer) run(ctx context.Context) error {
+	ticker := time.NewTicker(a.cfg.Interval.Duration())
+	defer ticker.Stop()
+
+	failureCount := 0
+	for {
+		select {
+		case <-ticker.C:
+			var (
+				expected bool
+				err      error
+			)
+			a.queries, a.reason = nil, ""
+			switch a.cfg.Strategy {
+			case "", config.AnalysisStrategyThreshold:
+				expected, err = a.analyzeWithThreshold(ctx)
+			case config.AnalysisStrategyCanaryBaseline:
+				expected, err = a.analyzeWithCanary(ctx, baselineVariantName, a.cfg.BaselineArgs)
+			case config.AnalysisStrategyCanaryPrimary:
+				expected, err = a.analyzeWithCanary(ctx, primaryVariantName, a.cfg.PrimaryArgs)
+			default:
+				return fmt.Errorf("unknown strategy %q given", a.cfg.Strategy)
+			}
+			// Ignore parent's context deadline exceeded error, and return immediately.
+			if errors.Is(err, context.DeadlineExceeded) && ctx.Err() == context.DeadlineExceeded {
+				return nil
+			}
+			if errors.Is(err, errNoLogEntries) {
+				a.logPersister.Infof("[%s] The query result evaluation was skipped because \"skipOnNoData\" is true though no log entries were found", a.id)
+				a.skip(err.Error())
+				continue
+			}
+			if err != nil {
+				a.logPersister.Errorf("[%s] Unexpected error: %v", a.id, err)
+			}
+			a.record(expected, err)
+			if expected {
+				a.logPersister.Successf("[%s] The query result is expected one", a.id)
+				continue
+			}
+			failureCount++
+			if failureCount > a.cfg.FailureLimit {
+				return fmt.Errorf("analysis '%s' failed because the failure number exceeded the failure limit (%d)", a.id, a.cfg.FailureLimit)
+			}
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
+
+// analyzeWithThreshold returns false if the number of matched log entries exceeds maxCount.
+// Return an error if the evaluation could not be executed normally.
+func (a *logAnalyzer) analyzeWithThreshold(ctx context.Context) (bool, error) {
+	queryRange := a.queryRange()
+	result, err := a.query(ctx, "", a.cfg.Query, queryRange)
+	if err != nil {
+		return false, err
+	}
+	if result.Count == 0 && a.cfg.SkipOnNoData {
+		return false, errNoLogEntries
+	}
+	if result.Count <= a.cfg.MaxCount {
+		return true, nil
+	}
+
+	a.logPersister.Errorf("[%s] Failed because %s log entries were found while the maximum is %d. Performed query: %q", a.id, countString(result), a.cfg.MaxCount, a.cfg.Query)
+	a.reason = fmt.Sprintf("%s log entries were found while the maximum is %d", countString(result), a.cfg.MaxCount)
+	a.logPersister.Infof("[%s] Performed query range: %s", a.id, &queryRange)
+	a.persistSamples(result)
+	return false, nil
+}
+
+// analyzeWithCanary returns false if the number of matched log entries for Canary exceeds
+// both of maxCount and maxRatio times the one for the given control variant.
+// Return an error if the evaluation could not be executed normally.
+func (a *logAnalyzer) analyzeWithCanary(ctx context.Context, controlVariant string, controlArgs map[string]string) (bool, error) {
+	canaryQuery, err := renderVariantQuery(a.cfg.Query, a.argsTemplate, a.cfg.CanaryArgs, canaryVariantName)
+	if err != nil {
+		return false, fmt.Errorf("failed to render query template for Canary: %w", err)
+	}
+	controlQuery, err := renderVariantQuery(a.cfg.Query, a.argsTemplate, controlArgs, controlVariant)
+	if err != nil {
+		return false, fmt.Errorf("failed to render query template for %s: %w", controlVariant, err)
+	}
+
+	queryRange := a.queryRange()
+	canary, err := a.query(ctx, canaryVariantName, canaryQuery, queryRange)
+	if err != nil {
+		return false, fmt.Errorf("failed to query log entries for the Canary variant: %w", err)
+	}
+	if canary.Count == 0 && a.cfg.SkipOnNoData {
+		return false, errNoLogEntries
+	}
+	control, err := a.query(ctx, controlVariant, controlQuery, queryRange)
+	if err != nil {
+		return false, fmt.Errorf("failed to query log entries for the %s variant: %w", controlVariant, err)
+	}
+
+	maxRatio := a.maxRatio()
+	limit := math.Max(float64(a.cfg.MaxCount), maxRatio*float64(control.Count))
+	if float64(canary.Count) <= limit {
+		return true, nil
+	}
+
+	a.logPersister.Errorf("[%s] Failed because %s log entries were found for Canary while %s log entries were found for %s (maxCount: %d, maxRatio: %g)",
+		a.id, countString(canary), countString(control), controlVariant, a.cfg.MaxCount, maxRatio)
+	a.reason = fmt.Sprintf("%s log entries were found for Canary while %s log entries were found for %s", countString(canary), countString(control), controlVariant)
+	a.logPersister.Infof("[%s] Performed query range: %s", a.id, &queryRange)
+	a.logPersister.Infof("[%s] Performed query for Canary: %q", a.id, canaryQuery)
+	a.logPersister.Infof("[%s] Performed query for %s: %q", a.id, controlVariant, controlQuery)
+	a.persistSamples(canary)
+	return false, nil
+}
+
+func (a *logAnalyzer) queryRange() log.QueryRange {
+	now := time.Now()
+	return log.QueryRange{
+		From: now.Add(-a.cfg.Interval.Duration()),
+		To:   now,
+	}
+}
+
+// query runs the given query and keeps it with the number of returned log entries for the report.
+func (a *logAnalyzer) query(ctx context.Context, variant, query string, queryRange log.QueryRange) (*log.QueryResult, error) {
+	sampleSize := a.cfg.SampleSize
+	if sampleSize == 0 {
+		sampleSize = defaultLogSampleSize
+	}
+	a.logPersister.Infof("[%s] Run query: %q, in range: %v", a.id, query, &queryRange)
+	result, err := a.provider.QueryEntries(ctx, query, queryRange, sampleSize)
+	q := &model.AnalysisQuery{
+		Variant: variant,
+		Query:   query,
+		From:    queryRange.From.Unix(),
+		To:      queryRange.To.Unix(),
+	}
+	a.queries = append(a.queries, q)
+	if err != nil {
+		return nil, fmt.Errorf("failed to run query: %w: performed query: %q", err, query)
+	}
+	q.LogEntryCount = int64(result.Count)
+	a.logPersister.Infof("[%s] Got %s log entries from the query: %q", a.id, countString(result), query)
+	return result, nil
+}
+
+func (a *logAnalyzer) maxRatio() float64 {
+	if a.cfg.MaxRatio == 0 {
+		return 1
+	}
+	return a.cfg.MaxRatio
+}
+
+// report returns the details of all evaluations.
+func (a *logAnalyzer) report() *model.AnalysisLogReport {
+	r := &model.AnalysisLogReport{
+		Id:          a.id,
+		Provider:    a.cfg.Provider,
+		Strategy:    a.cfg.Strategy,
+		MaxCount:    int64(a.cfg.MaxCount),
+		Score:       a.passRate(),
+		Evaluations: a.reports,
+	}
+	switch a.cfg.Strategy {
+	case "":
+		r.Strategy = config.AnalysisStrategyThreshold
+	case config.AnalysisStrategyCanaryBaseline, config.AnalysisStrategyCanaryPrimary:
+		r.MaxRatio = a.maxRatio()
+	}
+	return r
+}
+
+// persistSamples writes the sample log entries to the stage log.
+func (a *logAnalyzer) persistSamples(result *log.QueryResult) {
+	if len(result.Samples) == 0 {
+		return
+	}
+	a.logPersister.Infof("[%s] Sample log entries:", a.id)
+	for i := range result.Samples {
+		a.logPersister.Infof("[%s] %s", a.id, &result.Samples[i])
+	}
+}
+
+func countString(result *log.QueryResult) string {
+	if result.Truncated {
+		return fmt.Sprintf("at least %d", result.Count)
+	}
+	return fmt.Sprintf("%d", result.Count)
+}
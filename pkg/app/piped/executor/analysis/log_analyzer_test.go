@@ -0,0 +1,288 @@
+// Copyright 2024 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analysis
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+
+	"github.com/pipe-cd/pipecd/pkg/app/piped/analysisprovider/log"
+	"github.com/pipe-cd/pipecd/pkg/config"
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+// fakeLogProvider returns the result registered for each query.
+type fakeLogProvider struct {
+	results map[string]*log.QueryResult
+	err     error
+}
+
+func (f *fakeLogProvider) Type() string { return "" }
+func (f *fakeLogProvider) QueryEntries(_ context.Context, query string, _ log.QueryRange, sampleSize int) (*log.QueryResult, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	r, ok := f.results[query]
+	if !ok {
+		return &log.QueryResult{}, nil
+	}
+	out := *r
+	if len(out.Samples) > sampleSize {
+		out.Samples = out.Samples[:sampleSize]
+	}
+	return &out, nil
+}
+
+type recordingLogPersister struct {
+	fakeLogPersister
+	infos []string
+}
+
+func (l *recordingLogPersister) Infof(format string, a ...interface{}) {
+	l.infos = append(l.infos, fmt.Sprintf(format, a...))
+}
+
+func Test_logAnalyzer_analyzeWithThreshold(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name        string
+		cfg         config.AnalysisLog
+		provider    *fakeLogProvider
+		want        bool
+		wantErr     bool
+		wantSamples []string
+	}{
+		{
+			name: "query failed",
+			cfg:  config.AnalysisLog{Query: "severity>=ERROR"},
+			provider: &fakeLogProvider{
+				err: fmt.Errorf("query failed"),
+			},
+			want:    false,
+			wantErr: true,
+		},
+		{
+			name:     "no log entries",
+			cfg:      config.AnalysisLog{Query: "severity>=ERROR"},
+			provider: &fakeLogProvider{},
+			want:     true,
+		},
+		{
+			name:     "no log entries with skipOnNoData",
+			cfg:      config.AnalysisLog{Query: "severity>=ERROR", SkipOnNoData: true},
+			provider: &fakeLogProvider{},
+			want:     false,
+			wantErr:  true,
+		},
+		{
+			name: "log entries within the maximum",
+			cfg:  config.AnalysisLog{Query: "severity>=ERROR", MaxCount: 3},
+			provider: &fakeLogProvider{
+				results: map[string]*log.QueryResult{
+					"severity>=ERROR": {Count: 3},
+				},
+			},
+			want: true,
+		},
+		{
+			name: "log entries exceed the maximum",
+			cfg:  config.AnalysisLog{Query: "severity>=ERROR", SampleSize: 1},
+			provider: &fakeLogProvider{
+				results: map[string]*log.QueryResult{
+					"severity>=ERROR": {
+						Count: 2,
+						Samples: []log.Entry{
+							{Timestamp: time.Date(2009, time.January, 1, 0, 4, 0, 0, time.UTC), Severity: "ERROR", Message: "failed to connect"},
+							{Timestamp: time.Date(2009, time.January, 1, 0, 3, 0, 0, time.UTC), Severity: "ERROR", Message: "failed to read"},
+						},
+					},
+				},
+			},
+			want:        false,
+			wantSamples: []string{"[id] 2009-01-01 00:04:00 UTC [ERROR] failed to connect"},
+		},
+	}
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			lp := &recordingLogPersister{}
+			a := newLogAnalyzer("id", tc.cfg, tc.provider, argsTemplate{}, zap.NewNop(), lp)
+			got, err := a.analyzeWithThreshold(context.Background())
+			assert.Equal(t, tc.wantErr, err != nil)
+			assert.Equal(t, tc.want, got)
+			for _, s := range tc.wantSamples {
+				assert.Contains(t, lp.infos, s)
+			}
+		})
+	}
+}
+
+func Test_logAnalyzer_analyzeWithCanary(t *testing.T) {
+	t.Parallel()
+
+	const query = `severity>=ERROR AND labels.variant="{{ .Variant.Name }}"{{ with .VariantCustomArgs.pod }} AND resource.labels.pod_name="{{ . }}"{{ end }}`
+	testcases := []struct {
+		name     string
+		cfg      config.AnalysisLog
+		provider *fakeLogProvider
+		want     bool
+		wantErr  bool
+	}{
+		{
+			name: "query failed",
+			cfg:  config.AnalysisLog{Query: query},
+			provider: &fakeLogProvider{
+				err: fmt.Errorf("query failed"),
+			},
+			want:    false,
+			wantErr: true,
+		},
+		{
+			name: "invalid query template",
+			cfg:  config.AnalysisLog{Query: "{{ .Variant.Name "},
+			provider: &fakeLogProvider{
+				err: fmt.Errorf("query failed"),
+			},
+			want:    false,
+			wantErr: true,
+		},
+		{
+			name: "canary has as many entries as baseline",
+			cfg:  config.AnalysisLog{Query: query},
+			provider: &fakeLogProvider{
+				results: map[string]*log.QueryResult{
+					`severity>=ERROR AND labels.variant="canary"`:   {Count: 10},
+					`severity>=ERROR AND labels.variant="baseline"`: {Count: 10},
+				},
+			},
+			want: true,
+		},
+		{
+			name: "canary has more entries than baseline",
+			cfg:  config.AnalysisLog{Query: query},
+			provider: &fakeLogProvider{
+				results: map[string]*log.QueryResult{
+					`severity>=ERROR AND labels.variant="canary"`:   {Count: 11},
+					`severity>=ERROR AND labels.variant="baseline"`: {Count: 10},
+				},
+			},
+			want: false,
+		},
+		{
+			name: "canary has more entries than baseline within the ratio",
+			cfg:  config.AnalysisLog{Query: query, MaxRatio: 1.5},
+			provider: &fakeLogProvider{
+				results: map[string]*log.QueryResult{
+					`severity>=ERROR AND labels.variant="canary"`:   {Count: 15},
+					`severity>=ERROR AND labels.variant="baseline"`: {Count: 10},
+				},
+			},
+			want: true,
+		},
+		{
+			name: "canary has no entries with skipOnNoData",
+			cfg:  config.AnalysisLog{Query: query, SkipOnNoData: true},
+			provider: &fakeLogProvider{
+				results: map[string]*log.QueryResult{
+					`severity>=ERROR AND labels.variant="baseline"`: {Count: 10},
+				},
+			},
+			want:    false,
+			wantErr: true,
+		},
+		{
+			name: "canary has a few entries while baseline has none",
+			cfg:  config.AnalysisLog{Query: query, MaxCount: 2},
+			provider: &fakeLogProvider{
+				results: map[string]*log.QueryResult{
+					`severity>=ERROR AND labels.variant="canary"`: {Count: 2},
+				},
+			},
+			want: true,
+		},
+		{
+			name: "variant args are rendered",
+			cfg: config.AnalysisLog{
+				Query:        query,
+				CanaryArgs:   map[string]string{"pod": "app-canary"},
+				BaselineArgs: map[string]string{"pod": "app-baseline"},
+			},
+			provider: &fakeLogProvider{
+				results: map[string]*log.QueryResult{
+					`severity>=ERROR AND labels.variant="canary" AND resource.labels.pod_name="app-canary"`:     {Count: 5},
+					`severity>=ERROR AND labels.variant="baseline" AND resource.labels.pod_name="app-baseline"`: {Count: 1},
+				},
+			},
+			want: false,
+		},
+	}
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			a := newLogAnalyzer("id", tc.cfg, tc.provider, argsTemplate{}, zap.NewNop(), &fakeLogPersister{})
+			got, err := a.analyzeWithCanary(context.Background(), baselineVariantName, tc.cfg.BaselineArgs)
+			assert.Equal(t, tc.wantErr, err != nil)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func Test_logAnalyzer_report(t *testing.T) {
+	t.Parallel()
+
+	cfg := config.AnalysisLog{
+		Provider: "provider",
+		Query:    "severity>=ERROR",
+		MaxCount: 1,
+	}
+	provider := &fakeLogProvider{
+		results: map[string]*log.QueryResult{
+			"severity>=ERROR": {Count: 2},
+		},
+	}
+	a := newLogAnalyzer("log-0", cfg, provider, argsTemplate{}, zap.NewNop(), &fakeLogPersister{})
+	expected, err := a.analyzeWithThreshold(context.Background())
+	assert.NoError(t, err)
+	a.record(expected, err)
+	a.skip("no log entries found")
+
+	r := a.report()
+	assert.Equal(t, "log-0", r.Id)
+	assert.Equal(t, config.AnalysisStrategyThreshold, r.Strategy)
+	assert.Equal(t, int64(1), r.MaxCount)
+	assert.Equal(t, 0.0, r.MaxRatio)
+	assert.Equal(t, 0.0, r.Score)
+	assert.Len(t, r.Evaluations, 2)
+
+	failed := r.Evaluations[0]
+	assert.Equal(t, model.AnalysisVerdict_VERDICT_FAILED, failed.Verdict)
+	assert.Equal(t, "2 log entries were found while the maximum is 1", failed.Reason)
+	assert.Len(t, failed.Queries, 1)
+	assert.Equal(t, "severity>=ERROR", failed.Queries[0].Query)
+	assert.Equal(t, int64(2), failed.Queries[0].LogEntryCount)
+
+	skipped := r.Evaluations[1]
+	assert.Equal(t, model.AnalysisVerdict_VERDICT_SKIPPED, skipped.Verdict)
+	assert.Equal(t, "no log entries found", skipped.Reason)
+	assert.Empty(t, skipped.Queries)
+}
@@ -27,14 +27,19 @@ import (
 	"github.com/pipe-cd/pipecd/pkg/app/piped/analysisprovider/metrics"
 	"github.com/pipe-cd/pipecd/pkg/app/piped/apistore/analysisresultstore"
 	"github.com/pipe-cd/pipecd/pkg/app/piped/executor"
+	"github.com/pipe-cd/pipecd/pkg/app/piped/executor/analysis/bayesian"
+	"github.com/pipe-cd/pipecd/pkg/app/piped/executor/analysis/kolmogorovsmirnov"
 	"github.com/pipe-cd/pipecd/pkg/app/piped/executor/analysis/mannwhitney"
 	"github.com/pipe-cd/pipecd/pkg/config"
+	"github.com/pipe-cd/pipecd/pkg/model"
 )
 
 const (
 	canaryVariantName   = "canary"
 	baselineVariantName = "baseline"
 	primaryVariantName  = "primary"
+	// The primary variant of the previous deployment.
+	previousVariantName = "previous"
 )
 
 type metricsAnalyzer struct {
@@ -47,6 +52,8 @@ type metricsAnalyzer struct {
 	argsTemplate argsTemplate
 	logger       *zap.Logger
 	logPersister executor.LogPersister
+
+	evaluationRecorder
 }
 
 func newMetricsAnalyzer(id string, cfg config.AnalysisMetrics, stageStartTime time.Time, provider metrics.Provider, analysisResultStore executor.AnalysisResultStore, argsTemplate argsTemplate, logger *zap.Logger, logPersister executor.LogPersister) *metricsAnalyzer {
@@ -74,26 +81,11 @@ func (a *metricsAnalyzer) run(ctx context.Context) error {
 	for {
 		select {
 		case <-ticker.C:
-			var (
-				expected bool
-				err      error
-			)
-			switch a.cfg.Strategy {
-			case config.AnalysisStrategyThreshold:
-				expected, err = a.analyzeWithThreshold(ctx)
-			case config.AnalysisStrategyPrevious:
-				var firstDeploy bool
-				expected, firstDeploy, err = a.analyzeWithPrevious(ctx)
-				if firstDeploy {
-					a.logPersister.Infof("[%s] PreviousAnalysis cannot be executed because this seems to be the first deployment, so it is considered as a success", a.id)
-					return nil
-				}
-			case config.AnalysisStrategyCanaryBaseline:
-				expected, err = a.analyzeWithCanaryBaseline(ctx)
-			case config.AnalysisStrategyCanaryPrimary:
-				expected, err = a.analyzeWithCanaryPrimary(ctx)
-			default:
-				return fmt.Errorf("unknown strategy %q given", a.cfg.Strategy)
+			expected, firstDeploy, err := a.evaluate(ctx)
+			if firstDeploy {
+				a.logPersister.Infof("[%s] PreviousAnalysis cannot be executed because this seems to be the first deployment, so it is considered as a success", a.id)
+				a.skip("no previous deployment to compare with")
+				return nil
 			}
 			// Ignore parent's context deadline exceeded error, and return immediately.
 			if errors.Is(err, context.DeadlineExceeded) && ctx.Err() == context.DeadlineExceeded {
@@ -101,11 +93,13 @@ func (a *metricsAnalyzer) run(ctx context.Context) error {
 			}
 			if errors.Is(err, metrics.ErrNoDataFound) && a.cfg.SkipOnNoData {
 				a.logPersister.Infof("[%s] The query result evaluation was skipped because \"skipOnNoData\" is true though no data returned. Reason: %v", a.id, err)
+				a.skip(err.Error())
 				continue
 			}
 			if err != nil {
 				a.logPersister.Errorf("[%s] Unexpected error: %v", a.id, err)
 			}
+			a.record(expected, err)
 			if expected {
 				a.logPersister.Successf("[%s] The query result is expected one", a.id)
 				continue
@@ -120,6 +114,92 @@ func (a *metricsAnalyzer) run(ctx context.Context) error {
 	}
 }
 
+// evaluate runs the query once and judges the result with the configured strategy.
+// firstDeploy is true when the PREVIOUS strategy has no previous deployment to compare with.
+func (a *metricsAnalyzer) evaluate(ctx context.Context) (expected, firstDeploy bool, err error) {
+	a.queries, a.reason = nil, ""
+	switch a.cfg.Strategy {
+	case config.AnalysisStrategyThreshold:
+		expected, err = a.analyzeWithThreshold(ctx)
+	case config.AnalysisStrategyPrevious:
+		expected, firstDeploy, err = a.analyzeWithPrevious(ctx)
+	case config.AnalysisStrategyCanaryBaseline:
+		expected, err = a.analyzeWithCanaryBaseline(ctx)
+	case config.AnalysisStrategyCanaryPrimary:
+		expected, err = a.analyzeWithCanaryPrimary(ctx)
+	default:
+		err = fmt.Errorf("unknown strategy %q given", a.cfg.Strategy)
+	}
+	return
+}
+
+// queryPoints runs the given query and keeps it with the returned data points for the report.
+func (a *metricsAnalyzer) queryPoints(ctx context.Context, variant, query string, queryRange metrics.QueryRange) ([]metrics.DataPoint, error) {
+	points, err := a.provider.QueryPoints(ctx, query, queryRange)
+	q := &model.AnalysisQuery{
+		Variant:    variant,
+		Query:      query,
+		From:       queryRange.From.Unix(),
+		To:         queryRange.To.Unix(),
+		DataPoints: make([]*model.AnalysisDataPoint, 0, len(points)),
+	}
+	for _, p := range points {
+		q.DataPoints = append(q.DataPoints, &model.AnalysisDataPoint{
+			Timestamp: p.Timestamp,
+			Value:     p.Value,
+		})
+	}
+	a.queries = append(a.queries, q)
+	return points, err
+}
+
+// score returns the percentage of the evaluations with the expected result.
+func (a *metricsAnalyzer) score() *model.AnalysisMetricsScore {
+	s := &model.AnalysisMetricsScore{
+		Id:              a.id,
+		Provider:        a.cfg.Provider,
+		Query:           a.cfg.Query,
+		StatisticalTest: a.statisticalTest(),
+		Weight:          a.weight(),
+		Score:           a.passRate(),
+		Evaluations:     int32(a.evaluations),
+		Passes:          int32(a.passes),
+	}
+	return s
+}
+
+// report returns the details of all evaluations.
+func (a *metricsAnalyzer) report() *model.AnalysisMetricsReport {
+	r := &model.AnalysisMetricsReport{
+		Id:          a.id,
+		Provider:    a.cfg.Provider,
+		Strategy:    a.cfg.Strategy,
+		Score:       a.passRate(),
+		Evaluations: a.reports,
+	}
+	if a.cfg.Strategy == config.AnalysisStrategyThreshold {
+		r.Expected = a.cfg.Expected.String()
+	} else {
+		r.StatisticalTest = a.statisticalTest()
+		r.Deviation = a.cfg.Deviation
+	}
+	return r
+}
+
+func (a *metricsAnalyzer) statisticalTest() string {
+	if a.cfg.StatisticalTest == "" {
+		return config.AnalysisStatisticalTestMannWhitney
+	}
+	return a.cfg.StatisticalTest
+}
+
+func (a *metricsAnalyzer) weight() float64 {
+	if a.cfg.Weight == 0 {
+		return 1
+	}
+	return a.cfg.Weight
+}
+
 // analyzeWithThreshold returns false if any data point is out of the prediction range.
 // Return an error if the evaluation could not be executed normally.
 func (a *metricsAnalyzer) analyzeWithThreshold(ctx context.Context) (bool, error) {
@@ -134,7 +214,7 @@ func (a *metricsAnalyzer) analyzeWithThreshold(ctx context.Context) (bool, error
 	}
 
 	a.logPersister.Infof("[%s] Run query: %q, in range: %v", a.id, a.cfg.Query, queryRange)
-	points, err := a.provider.QueryPoints(ctx, a.cfg.Query, queryRange)
+	points, err := a.queryPoints(ctx, "", a.cfg.Query, queryRange)
 	if err != nil {
 		return false, fmt.Errorf("failed to run query: %w", err)
 	}
@@ -155,6 +235,7 @@ func (a *metricsAnalyzer) analyzeWithThreshold(ctx context.Context) (bool, error
 	}
 	if !expected {
 		a.logPersister.Errorf("[%s] Failed because it found a data point (%s) that is outside the expected range (%s). Performed query: %q", a.id, &outiler, &a.cfg.Expected, a.cfg.Query)
+		a.reason = fmt.Sprintf("found a data point (%s) that is outside the expected range (%s)", &outiler, &a.cfg.Expected)
 		return false, nil
 	}
 
@@ -172,7 +253,7 @@ func (a *metricsAnalyzer) analyzeWithPrevious(ctx context.Context) (expected, fi
 	}
 
 	a.logPersister.Infof("[%s] Run query: %q, in range: %v", a.id, a.cfg.Query, queryRange)
-	points, err := a.provider.QueryPoints(ctx, a.cfg.Query, queryRange)
+	points, err := a.queryPoints(ctx, primaryVariantName, a.cfg.Query, queryRange)
 	if err != nil {
 		return false, false, fmt.Errorf("failed to run query: %w: performed query: %q", err, a.cfg.Query)
 	}
@@ -200,7 +281,7 @@ func (a *metricsAnalyzer) analyzeWithPrevious(ctx context.Context) (expected, fi
 	}
 
 	a.logPersister.Infof("[%s] Run query: %q, in range: %v", a.id, a.cfg.Query, prevQueryRange)
-	prevPoints, err := a.provider.QueryPoints(ctx, a.cfg.Query, prevQueryRange)
+	prevPoints, err := a.queryPoints(ctx, previousVariantName, a.cfg.Query, prevQueryRange)
 	if err != nil {
 		return false, false, fmt.Errorf("failed to run query to fetch metrics for the previous deployment: %w: performed query: %q", err, a.cfg.Query)
 	}
@@ -218,6 +299,7 @@ func (a *metricsAnalyzer) analyzeWithPrevious(ctx context.Context) (expected, fi
 	}
 	if !expected {
 		a.logPersister.Errorf("[%s] The difference between Current Primary and Previous one is statistically significant", a.id)
+		a.reason = "the difference between current Primary and previous one is statistically significant"
 		a.logPersister.Infof("[%s] Performed query range for current Primary: %q", a.id, &queryRange)
 		a.logPersister.Infof("[%s] Performed query range for previous Primary: %q", a.id, &prevQueryRange)
 		a.logPersister.Infof("[%s] Performed query: %q", a.id, a.cfg.Query)
@@ -253,7 +335,7 @@ func (a *metricsAnalyzer) analyzeWithCanaryBaseline(ctx context.Context) (bool,
 
 	// Fetch data points from Canary.
 	a.logPersister.Infof("[%s] Run query: %q, in range: %v", a.id, canaryQuery, queryRange)
-	canaryPoints, err := a.provider.QueryPoints(ctx, canaryQuery, queryRange)
+	canaryPoints, err := a.queryPoints(ctx, canaryVariantName, canaryQuery, queryRange)
 	if err != nil {
 		return false, fmt.Errorf("failed to run query to fetch metrics for the Canary variant: %w: query range: %s: performed query: %q", err, &queryRange, canaryQuery)
 	}
@@ -266,7 +348,7 @@ func (a *metricsAnalyzer) analyzeWithCanaryBaseline(ctx context.Context) (bool,
 
 	// Fetch data points from Baseline.
 	a.logPersister.Infof("[%s] Run query: %q, in range: %v", a.id, baselineQuery, queryRange)
-	baselinePoints, err := a.provider.QueryPoints(ctx, baselineQuery, queryRange)
+	baselinePoints, err := a.queryPoints(ctx, baselineVariantName, baselineQuery, queryRange)
 	if err != nil {
 		return false, fmt.Errorf("failed to run query to fetch metrics for the Baseline variant: %w: query range: %s: performed query: %q", err, &queryRange, baselineQuery)
 	}
@@ -286,6 +368,7 @@ func (a *metricsAnalyzer) analyzeWithCanaryBaseline(ctx context.Context) (bool,
 	}
 	if !expected {
 		a.logPersister.Errorf("[%s] The difference between Canary and Baseline is statistically significant", a.id)
+		a.reason = "the difference between Canary and Baseline is statistically significant"
 		a.logPersister.Infof("[%s] Performed query range: %q", a.id, &queryRange)
 		a.logPersister.Infof("[%s] Performed query for Canary: %q", a.id, canaryQuery)
 		a.logPersister.Infof("[%s] Performed query for Baseline: %q", a.id, baselineQuery)
@@ -320,7 +403,7 @@ func (a *metricsAnalyzer) analyzeWithCanaryPrimary(ctx context.Context) (bool, e
 	}
 
 	a.logPersister.Infof("[%s] Run query: %q, in range: %v", a.id, canaryQuery, queryRange)
-	canaryPoints, err := a.provider.QueryPoints(ctx, canaryQuery, queryRange)
+	canaryPoints, err := a.queryPoints(ctx, canaryVariantName, canaryQuery, queryRange)
 	if err != nil {
 		return false, fmt.Errorf("failed to run query to fetch metrics for the Canary variant: %w: performed query: %q", err, canaryQuery)
 	}
@@ -332,7 +415,7 @@ func (a *metricsAnalyzer) analyzeWithCanaryPrimary(ctx context.Context) (bool, e
 	}
 
 	a.logPersister.Infof("[%s] Run query: %q, in range: %v", a.id, primaryQuery, queryRange)
-	primaryPoints, err := a.provider.QueryPoints(ctx, primaryQuery, queryRange)
+	primaryPoints, err := a.queryPoints(ctx, primaryVariantName, primaryQuery, queryRange)
 	if err != nil {
 		return false, fmt.Errorf("failed to run query to fetch metrics for the Primary variant: %w: performed query: %q", err, primaryQuery)
 	}
@@ -351,6 +434,7 @@ func (a *metricsAnalyzer) analyzeWithCanaryPrimary(ctx context.Context) (bool, e
 	}
 	if !expected {
 		a.logPersister.Errorf("[%s] The difference between Canary and Primary is statistically significant", a.id)
+		a.reason = "the difference between Canary and Primary is statistically significant"
 		a.logPersister.Infof("[%s] Performed query range: %q", a.id, &queryRange)
 		a.logPersister.Infof("[%s] Performed query for Canary: %q", a.id, canaryQuery)
 		a.logPersister.Infof("[%s] Performed query for Primary: %q", a.id, primaryQuery)
@@ -367,7 +451,7 @@ func (a *metricsAnalyzer) analyzeWithCanaryPrimary(ctx context.Context) (bool, e
 	return true, nil
 }
 
-// compare compares the given two samples using Mann-Whitney U test.
+// compare compares the given two samples using the configured statistical test.
 // Considered as failure if it deviates in the specified direction as the third argument.
 // If both of the point values is empty, this returns true.
 func (a *metricsAnalyzer) compare(experiment, control []float64, deviation string) (acceptable bool, err error) {
@@ -381,6 +465,28 @@ func (a *metricsAnalyzer) compare(experiment, control []float64, deviation strin
 	if len(control) == 0 {
 		return false, fmt.Errorf("no data points of Control found")
 	}
+	switch deviation {
+	case config.AnalysisDeviationEither, config.AnalysisDeviationLow, config.AnalysisDeviationHigh:
+	default:
+		return false, fmt.Errorf("unknown deviation %q given", deviation)
+	}
+	switch a.statisticalTest() {
+	case config.AnalysisStatisticalTestMannWhitney:
+		return compareWithMannWhitney(experiment, control, deviation)
+	case config.AnalysisStatisticalTestKolmogorovSmirnov:
+		return compareWithKolmogorovSmirnov(experiment, control, deviation)
+	case config.AnalysisStatisticalTestBayesian:
+		return compareWithBayesian(experiment, control, deviation)
+	default:
+		return false, fmt.Errorf("unknown statistical test %q given", a.cfg.StatisticalTest)
+	}
+}
+
+// alpha is the significance level of the hypothesis tests. Typically 5% is used.
+const alpha = 0.05
+
+// compareWithMannWhitney compares the given two samples using Mann-Whitney U test.
+func compareWithMannWhitney(experiment, control []float64, deviation string) (bool, error) {
 	var alternativeHypothesis mannwhitney.LocationHypothesis
 	switch deviation {
 	case config.AnalysisDeviationEither:
@@ -389,8 +495,6 @@ func (a *metricsAnalyzer) compare(experiment, control []float64, deviation strin
 		alternativeHypothesis = mannwhitney.LocationLess
 	case config.AnalysisDeviationHigh:
 		alternativeHypothesis = mannwhitney.LocationGreater
-	default:
-		return false, fmt.Errorf("unknown deviation %q given", deviation)
 	}
 	res, err := mannwhitney.MannWhitneyUTest(experiment, control, alternativeHypothesis)
 	if errors.Is(err, mannwhitney.ErrSamplesEqual) {
@@ -401,15 +505,51 @@ func (a *metricsAnalyzer) compare(experiment, control []float64, deviation strin
 		return false, fmt.Errorf("failed to perform the Mann-Whitney U test: %w", err)
 	}
 
-	// alpha is the significance level. Typically 5% is used.
-	const alpha = 0.05
 	// If the p-value is greater than the significance level,
 	// we cannot say that the distributions in the two groups differed significantly.
 	// See: https://support.minitab.com/en-us/minitab-express/1/help-and-how-to/basic-statistics/inference/how-to/two-samples/mann-whitney-test/interpret-the-results/key-results/
-	if res.P > alpha {
-		return true, nil
+	return res.P > alpha, nil
+}
+
+// compareWithKolmogorovSmirnov compares the distributions of the given two samples
+// using the two-sample Kolmogorov-Smirnov test.
+func compareWithKolmogorovSmirnov(experiment, control []float64, deviation string) (bool, error) {
+	var alternativeHypothesis kolmogorovsmirnov.Hypothesis
+	switch deviation {
+	case config.AnalysisDeviationEither:
+		alternativeHypothesis = kolmogorovsmirnov.Differs
+	case config.AnalysisDeviationLow:
+		alternativeHypothesis = kolmogorovsmirnov.Less
+	case config.AnalysisDeviationHigh:
+		alternativeHypothesis = kolmogorovsmirnov.Greater
+	}
+	res, err := kolmogorovsmirnov.Test(experiment, control, alternativeHypothesis)
+	if err != nil {
+		return false, fmt.Errorf("failed to perform the Kolmogorov-Smirnov test: %w", err)
+	}
+	return res.P > alpha, nil
+}
+
+// credibility is the probability used to decide that the means of two samples differ.
+const credibility = 0.95
+
+// compareWithBayesian compares the means of the given two samples
+// by the posterior probability that the experiment is greater than the control.
+// For EITHER deviation, it fails when the credible interval of the difference excludes zero.
+func compareWithBayesian(experiment, control []float64, deviation string) (bool, error) {
+	res, err := bayesian.CompareMeans(experiment, control)
+	if err != nil {
+		return false, fmt.Errorf("failed to compare the means: %w", err)
+	}
+	switch deviation {
+	case config.AnalysisDeviationLow:
+		return res.ProbabilityGreater >= 1-credibility, nil
+	case config.AnalysisDeviationHigh:
+		return res.ProbabilityGreater <= credibility, nil
+	default:
+		lower, upper := res.CredibleInterval(credibility)
+		return lower <= 0 && 0 <= upper, nil
 	}
-	return false, nil
 }
 
 // argsTemplate is a collection of available template arguments.
@@ -443,12 +583,17 @@ type variantArgs struct {
 
 // renderQuery applies the given variant args to the query template.
 func (a *metricsAnalyzer) renderQuery(queryTemplate string, variantCustomArgs map[string]string, variant string) (string, error) {
+	return renderVariantQuery(queryTemplate, a.argsTemplate, variantCustomArgs, variant)
+}
+
+// renderVariantQuery applies the given application args and variant args to the query template.
+func renderVariantQuery(queryTemplate string, appArgs argsTemplate, variantCustomArgs map[string]string, variant string) (string, error) {
 	args := argsTemplate{
 		Variant:           variantArgs{Name: variant},
 		VariantCustomArgs: variantCustomArgs,
-		App:               a.argsTemplate.App,
-		K8s:               a.argsTemplate.K8s,
-		AppCustomArgs:     a.argsTemplate.AppCustomArgs,
+		App:               appArgs.App,
+		K8s:               appArgs.K8s,
+		AppCustomArgs:     appArgs.AppCustomArgs,
 	}
 
 	t, err := template.New("AnalysisTemplate").Parse(queryTemplate)
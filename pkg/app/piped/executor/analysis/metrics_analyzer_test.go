@@ -24,6 +24,7 @@ import (
 
 	"github.com/pipe-cd/pipecd/pkg/app/piped/analysisprovider/metrics"
 	"github.com/pipe-cd/pipecd/pkg/config"
+	"github.com/pipe-cd/pipecd/pkg/model"
 )
 
 type fakeMetricsProvider struct {
@@ -313,6 +314,195 @@ func Test_metricsAnalyzer_compare(t *testing.T) {
 			wantExpected: true,
 			wantErr:      false,
 		},
+		{
+			name: "kolmogorov-smirnov: no significance",
+			metricsAnalyzer: &metricsAnalyzer{
+				id: "id",
+				cfg: config.AnalysisMetrics{
+					Provider:        "provider",
+					Query:           "query",
+					StatisticalTest: "KOLMOGOROV_SMIRNOV",
+				},
+				provider:     &fakeMetricsProvider{},
+				logger:       zap.NewNop(),
+				logPersister: &fakeLogPersister{},
+			},
+			args: args{
+				experiment: []float64{0.1, 0.2, 0.3, 0.4, 0.5},
+				control:    []float64{0.1, 0.2, 0.3, 0.4, 0.5},
+				deviation:  "EITHER",
+			},
+			wantExpected: true,
+			wantErr:      false,
+		},
+		{
+			name: "kolmogorov-smirnov: deviation on high direction as unexpected",
+			metricsAnalyzer: &metricsAnalyzer{
+				id: "id",
+				cfg: config.AnalysisMetrics{
+					Provider:        "provider",
+					Query:           "query",
+					StatisticalTest: "KOLMOGOROV_SMIRNOV",
+				},
+				provider:     &fakeMetricsProvider{},
+				logger:       zap.NewNop(),
+				logPersister: &fakeLogPersister{},
+			},
+			args: args{
+				experiment: []float64{10.1, 10.2, 10.3, 10.4, 10.5},
+				control:    []float64{0.1, 0.2, 0.3, 0.4, 0.5},
+				deviation:  "HIGH",
+			},
+			wantExpected: false,
+			wantErr:      false,
+		},
+		{
+			name: "kolmogorov-smirnov: deviation on high direction as expected",
+			metricsAnalyzer: &metricsAnalyzer{
+				id: "id",
+				cfg: config.AnalysisMetrics{
+					Provider:        "provider",
+					Query:           "query",
+					StatisticalTest: "KOLMOGOROV_SMIRNOV",
+				},
+				provider:     &fakeMetricsProvider{},
+				logger:       zap.NewNop(),
+				logPersister: &fakeLogPersister{},
+			},
+			args: args{
+				experiment: []float64{10.1, 10.2, 10.3, 10.4, 10.5},
+				control:    []float64{0.1, 0.2, 0.3, 0.4, 0.5},
+				deviation:  "LOW",
+			},
+			wantExpected: true,
+			wantErr:      false,
+		},
+		{
+			name: "kolmogorov-smirnov: deviation as unexpected",
+			metricsAnalyzer: &metricsAnalyzer{
+				id: "id",
+				cfg: config.AnalysisMetrics{
+					Provider:        "provider",
+					Query:           "query",
+					StatisticalTest: "KOLMOGOROV_SMIRNOV",
+				},
+				provider:     &fakeMetricsProvider{},
+				logger:       zap.NewNop(),
+				logPersister: &fakeLogPersister{},
+			},
+			args: args{
+				experiment: []float64{10.1, 10.2, 10.3, 10.4, 10.5},
+				control:    []float64{0.1, 0.2, 0.3, 0.4, 0.5},
+				deviation:  "EITHER",
+			},
+			wantExpected: false,
+			wantErr:      false,
+		},
+		{
+			name: "bayesian: no significance",
+			metricsAnalyzer: &metricsAnalyzer{
+				id: "id",
+				cfg: config.AnalysisMetrics{
+					Provider:        "provider",
+					Query:           "query",
+					StatisticalTest: "BAYESIAN",
+				},
+				provider:     &fakeMetricsProvider{},
+				logger:       zap.NewNop(),
+				logPersister: &fakeLogPersister{},
+			},
+			args: args{
+				experiment: []float64{0.1, 0.2, 0.3, 0.4, 0.5},
+				control:    []float64{0.2, 0.1, 0.4, 0.3, 0.5},
+				deviation:  "EITHER",
+			},
+			wantExpected: true,
+			wantErr:      false,
+		},
+		{
+			name: "bayesian: deviation on low direction as unexpected",
+			metricsAnalyzer: &metricsAnalyzer{
+				id: "id",
+				cfg: config.AnalysisMetrics{
+					Provider:        "provider",
+					Query:           "query",
+					StatisticalTest: "BAYESIAN",
+				},
+				provider:     &fakeMetricsProvider{},
+				logger:       zap.NewNop(),
+				logPersister: &fakeLogPersister{},
+			},
+			args: args{
+				experiment: []float64{0.1, 0.2, 0.3, 0.4, 0.5},
+				control:    []float64{10.1, 10.2, 10.3, 10.4, 10.5},
+				deviation:  "LOW",
+			},
+			wantExpected: false,
+			wantErr:      false,
+		},
+		{
+			name: "bayesian: deviation on low direction as expected",
+			metricsAnalyzer: &metricsAnalyzer{
+				id: "id",
+				cfg: config.AnalysisMetrics{
+					Provider:        "provider",
+					Query:           "query",
+					StatisticalTest: "BAYESIAN",
+				},
+				provider:     &fakeMetricsProvider{},
+				logger:       zap.NewNop(),
+				logPersister: &fakeLogPersister{},
+			},
+			args: args{
+				experiment: []float64{0.1, 0.2, 0.3, 0.4, 0.5},
+				control:    []float64{10.1, 10.2, 10.3, 10.4, 10.5},
+				deviation:  "HIGH",
+			},
+			wantExpected: true,
+			wantErr:      false,
+		},
+		{
+			name: "bayesian: too few data points",
+			metricsAnalyzer: &metricsAnalyzer{
+				id: "id",
+				cfg: config.AnalysisMetrics{
+					Provider:        "provider",
+					Query:           "query",
+					StatisticalTest: "BAYESIAN",
+				},
+				provider:     &fakeMetricsProvider{},
+				logger:       zap.NewNop(),
+				logPersister: &fakeLogPersister{},
+			},
+			args: args{
+				experiment: []float64{0.1},
+				control:    []float64{0.1, 0.2},
+				deviation:  "EITHER",
+			},
+			wantExpected: false,
+			wantErr:      true,
+		},
+		{
+			name: "unknown statistical test",
+			metricsAnalyzer: &metricsAnalyzer{
+				id: "id",
+				cfg: config.AnalysisMetrics{
+					Provider:        "provider",
+					Query:           "query",
+					StatisticalTest: "UNKNOWN",
+				},
+				provider:     &fakeMetricsProvider{},
+				logger:       zap.NewNop(),
+				logPersister: &fakeLogPersister{},
+			},
+			args: args{
+				experiment: []float64{0.1, 0.2, 0.3, 0.4, 0.5},
+				control:    []float64{0.1, 0.2, 0.3, 0.4, 0.5},
+				deviation:  "EITHER",
+			},
+			wantExpected: false,
+			wantErr:      true,
+		},
 	}
 	for _, tc := range testcases {
 		t.Run(tc.name, func(t *testing.T) {
@@ -391,3 +581,50 @@ func Test_metricsAnalyzer_renderQuery(t *testing.T) {
 		})
 	}
 }
+
+func Test_metricsAnalyzer_report(t *testing.T) {
+	t.Parallel()
+
+	a := &metricsAnalyzer{
+		id: "metrics-0",
+		cfg: config.AnalysisMetrics{
+			Strategy: config.AnalysisStrategyThreshold,
+			Provider: "provider",
+			Query:    "query",
+			Expected: config.AnalysisExpected{Max: floatToPointer(1)},
+		},
+		provider: &fakeMetricsProvider{
+			points: []metrics.DataPoint{
+				{Timestamp: 1590000000, Value: 0.9},
+				{Timestamp: 1590000060, Value: 1.1},
+			},
+		},
+		logger:       zap.NewNop(),
+		logPersister: &fakeLogPersister{},
+	}
+	expected, _, err := a.evaluate(context.Background())
+	assert.NoError(t, err)
+	a.record(expected, err)
+	a.skip("no data found")
+
+	r := a.report()
+	assert.Equal(t, "metrics-0", r.Id)
+	assert.Equal(t, "", r.StatisticalTest)
+	assert.Equal(t, 0.0, r.Score)
+	assert.Len(t, r.Evaluations, 2)
+
+	failed := r.Evaluations[0]
+	assert.Equal(t, model.AnalysisVerdict_VERDICT_FAILED, failed.Verdict)
+	assert.Contains(t, failed.Reason, "outside the expected range")
+	assert.Len(t, failed.Queries, 1)
+	assert.Equal(t, "query", failed.Queries[0].Query)
+	assert.Equal(t, []*model.AnalysisDataPoint{
+		{Timestamp: 1590000000, Value: 0.9},
+		{Timestamp: 1590000060, Value: 1.1},
+	}, failed.Queries[0].DataPoints)
+
+	skipped := r.Evaluations[1]
+	assert.Equal(t, model.AnalysisVerdict_VERDICT_SKIPPED, skipped.Verdict)
+	assert.Equal(t, "no data found", skipped.Reason)
+	assert.Empty(t, skipped.Queries)
+}
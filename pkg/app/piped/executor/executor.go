@@ -57,6 +57,7 @@ type AppLiveResourceLister interface {
 type AnalysisResultStore interface {
 	GetLatestAnalysisResult(ctx context.Context) (*model.AnalysisResult, error)
 	PutLatestAnalysisResult(ctx context.Context, analysisResult *model.AnalysisResult) error
+	PutAnalysisReport(ctx context.Context, report *model.AnalysisReport) error
 }
 
 type Notifier interface {
@@ -226,6 +226,26 @@ func addBuiltinAnnotations(manifests []provider.Manifest, variantLabel, variant,
 	}
 }
 
+// addServerSideApplyAnnotations adds the annotations to apply the manifests
+// by server-side apply with the given options.
+func addServerSideApplyAnnotations(manifests []provider.Manifest, opts *config.K8sServerSideApplyOptions) {
+	if opts == nil || !opts.Enabled {
+		return
+	}
+	annotations := map[string]string{
+		provider.LabelServerSideApply: provider.UseServerSideApply,
+	}
+	if opts.FieldManager != "" {
+		annotations[provider.AnnotationFieldManager] = opts.FieldManager
+	}
+	if opts.ForceConflicts {
+		annotations[provider.AnnotationForceConflicts] = provider.ForceConflictsTrue
+	}
+	for i := range manifests {
+		manifests[i].AddAnnotations(annotations)
+	}
+}
+
 func applyManifests(ctx context.Context, ag applierGetter, manifests []provider.Manifest, namespace string, lp executor.LogPersister) error {
 	if namespace == "" {
 		lp.Infof("Start applying %d manifests", len(manifests))
@@ -55,21 +55,18 @@ func (e *deployExecutor) ensurePrimaryRollout(ctx context.Context) model.StageSt
 	routingMethod := config.DetermineKubernetesTrafficRoutingMethod(e.appCfg.TrafficRouting)
 
 	switch routingMethod {
-	// In case of routing by Pod selector,
+	// In case of routing by Pod selector or NGINX Ingress,
 	// all manifests can be used as primary manifests.
-	case config.KubernetesTrafficRoutingMethodPodSelector:
+	// NGINX routes the traffic to CANARY variant by a separated canary Ingress.
+	case config.KubernetesTrafficRoutingMethodPodSelector, config.KubernetesTrafficRoutingMethodNGINX:
 		primaryManifests = manifests
 
-	// In case of routing by Istio,
-	// VirtualService manifest will be used to manipulate the traffic ratio.
+	// In case of routing by Istio, Gateway API or SMI,
+	// VirtualService, HTTPRoute or TrafficSplit manifest will be used to manipulate the traffic ratio.
 	// Other manifests can be used as primary manifests.
-	case config.KubernetesTrafficRoutingMethodIstio:
-		// Firstly, find the VirtualService manifests.
-		istioCfg := e.appCfg.TrafficRouting.Istio
-		if istioCfg == nil {
-			istioCfg = &config.IstioTrafficRouting{}
-		}
-		trafficRoutingManifests, err := findIstioVirtualServiceManifests(manifests, istioCfg.VirtualService)
+	case config.KubernetesTrafficRoutingMethodIstio, config.KubernetesTrafficRoutingMethodGatewayAPI, config.KubernetesTrafficRoutingMethodSMI:
+		// Firstly, find the traffic routing manifests.
+		trafficRoutingManifests, err := findTrafficRoutingManifests(manifests, e.appCfg.Service.Name, e.appCfg.TrafficRouting)
 		if err != nil {
 			e.LogPersister.Errorf("Failed while finding traffic routing manifest: (%v)", err)
 			return model.StageStatus_STAGE_FAILURE
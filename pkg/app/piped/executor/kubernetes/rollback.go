@@ -138,6 +138,9 @@ func (e *rollbackExecutor) ensureRollback(ctx context.Context) model.StageStatus
 		e.Deployment.ApplicationId,
 	)
 
+	// Add the annotations to use server-side apply if configured.
+	addServerSideApplyAnnotations(manifests, appCfg.QuickSync.ServerSideApply)
+
 	// Add config-hash annotation to the workloads.
 	if err := annotateConfigHash(manifests); err != nil {
 		e.LogPersister.Errorf("Unable to set %q annotation into the workload manifest (%v)", provider.AnnotationConfigHash, err)
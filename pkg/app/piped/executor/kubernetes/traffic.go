@@ -18,12 +18,15 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"regexp"
+	"slices"
 	"strconv"
 	"strings"
 
 	"go.uber.org/zap"
 	istiov1alpha3 "istio.io/api/networking/v1alpha3"
 	istiov1beta1 "istio.io/api/networking/v1beta1"
+	networkingv1 "k8s.io/api/networking/v1"
 
 	provider "github.com/pipe-cd/pipecd/pkg/app/piped/platformprovider/kubernetes"
 	"github.com/pipe-cd/pipecd/pkg/config"
@@ -108,22 +111,45 @@ func (e *deployExecutor) ensureTrafficRouting(ctx context.Context) model.StageSt
 		}
 	}
 
+	// In case we are routing by NGINX Ingress, the traffic is routed to CANARY variant by the canary Ingress.
+	if method == config.KubernetesTrafficRoutingMethodNGINX {
+		if baselinePercent > 0 {
+			e.LogPersister.Errorf("Traffic routing by NGINX Ingress does not support BASELINE variant (baseline=%d)", baselinePercent)
+			return model.StageStatus_STAGE_FAILURE
+		}
+		if canaryPercent == 0 {
+			return e.removeNGINXCanaryIngress(ctx, trafficRoutingManifest)
+		}
+	}
+
 	trafficRoutingManifest, err = e.generateTrafficRoutingManifest(
 		trafficRoutingManifest,
 		primaryPercent,
 		canaryPercent,
 		baselinePercent,
+		options.CanaryMatches,
 	)
 	if err != nil {
 		e.LogPersister.Errorf("Unable generate traffic routing manifest: (%v)", err)
 		return model.StageStatus_STAGE_FAILURE
 	}
 
+	// The canary Ingress for NGINX belongs to CANARY variant
+	// so it is removed together with the other CANARY resources at CANARY_CLEAN stage or rollback.
+	trafficRoutingVariant := primaryVariant
+	if method == config.KubernetesTrafficRoutingMethodNGINX {
+		trafficRoutingVariant = e.appCfg.VariantLabel.CanaryValue
+		if err := e.addCanaryResourceToMetadata(ctx, trafficRoutingManifest.Key); err != nil {
+			e.LogPersister.Errorf("Unable to save deployment metadata (%v)", err)
+			return model.StageStatus_STAGE_FAILURE
+		}
+	}
+
 	// Add builtin annotations for tracking application live state.
 	addBuiltinAnnotations(
 		[]provider.Manifest{trafficRoutingManifest},
 		variantLabel,
-		primaryVariant,
+		trafficRoutingVariant,
 		commitHash,
 		e.PipedConfig.PipedID,
 		e.Deployment.ApplicationId,
@@ -156,12 +182,36 @@ func findTrafficRoutingManifests(manifests []provider.Manifest, serviceName stri
 		}
 		return findIstioVirtualServiceManifests(manifests, istioConfig.VirtualService)
 
+	case config.KubernetesTrafficRoutingMethodGatewayAPI:
+		gatewayConfig := cfg.GatewayAPI
+		if gatewayConfig == nil {
+			gatewayConfig = &config.GatewayAPITrafficRouting{}
+		}
+		return findGatewayAPIHTTPRouteManifests(manifests, gatewayConfig.HTTPRoute)
+
+	case config.KubernetesTrafficRoutingMethodSMI:
+		smiConfig := cfg.SMI
+		if smiConfig == nil {
+			smiConfig = &config.SMITrafficRouting{}
+		}
+		return findSMITrafficSplitManifests(manifests, smiConfig.TrafficSplit)
+
+	case config.KubernetesTrafficRoutingMethodNGINX:
+		nginxConfig := cfg.NGINX
+		if nginxConfig == nil {
+			nginxConfig = &config.NGINXTrafficRouting{}
+		}
+		if nginxConfig.Ingress.Kind != "" && nginxConfig.Ingress.Kind != provider.KindIngress {
+			return nil, fmt.Errorf("support only %q kind for Ingress reference", provider.KindIngress)
+		}
+		return findManifests(provider.KindIngress, nginxConfig.Ingress.Name, manifests), nil
+
 	default:
 		return nil, fmt.Errorf("unsupport traffic routing method %v", method)
 	}
 }
 
-func (e *deployExecutor) generateTrafficRoutingManifest(manifest provider.Manifest, primaryPercent, canaryPercent, baselinePercent int) (provider.Manifest, error) {
+func (e *deployExecutor) generateTrafficRoutingManifest(manifest provider.Manifest, primaryPercent, canaryPercent, baselinePercent int, canaryMatches []config.K8sTrafficRoutingMatch) (provider.Manifest, error) {
 	// Because the loaded manifests are read-only
 	// so we duplicate them to avoid updating the shared manifests data in cache.
 	manifest = duplicateManifest(manifest, "")
@@ -169,7 +219,7 @@ func (e *deployExecutor) generateTrafficRoutingManifest(manifest provider.Manife
 	// When all traffic should be routed to primary variant
 	// we do not need to change the traffic manifest
 	// just copy and return the one specified in the target commit.
-	if primaryPercent == 100 {
+	if primaryPercent == 100 && len(canaryMatches) == 0 {
 		return manifest, nil
 	}
 
@@ -181,9 +231,40 @@ func (e *deployExecutor) generateTrafficRoutingManifest(manifest provider.Manife
 		}
 
 		if strings.HasPrefix(manifest.Key.APIVersion, "v1alpha3") {
-			return e.generateVirtualServiceManifestV1Alpha3(manifest, istioConfig.Host, istioConfig.EditableRoutes, int32(canaryPercent), int32(baselinePercent))
+			return e.generateVirtualServiceManifestV1Alpha3(manifest, istioConfig.Host, istioConfig.EditableRoutes, canaryMatches, int32(canaryPercent), int32(baselinePercent))
+		}
+		return e.generateVirtualServiceManifest(manifest, istioConfig.Host, istioConfig.EditableRoutes, canaryMatches, int32(canaryPercent), int32(baselinePercent))
+	}
+
+	if len(canaryMatches) > 0 {
+		return manifest, fmt.Errorf("canaryMatches is supported only by istio traffic routing method")
+	}
+	if primaryPercent == 100 {
+		return manifest, nil
+	}
+
+	if cfg != nil && cfg.Method == config.KubernetesTrafficRoutingMethodGatewayAPI {
+		gatewayConfig := cfg.GatewayAPI
+		if gatewayConfig == nil {
+			gatewayConfig = &config.GatewayAPITrafficRouting{}
+		}
+		return e.generateHTTPRouteManifest(manifest, gatewayConfig, canaryPercent, baselinePercent)
+	}
+
+	if cfg != nil && cfg.Method == config.KubernetesTrafficRoutingMethodSMI {
+		smiConfig := cfg.SMI
+		if smiConfig == nil {
+			smiConfig = &config.SMITrafficRouting{}
+		}
+		return e.generateTrafficSplitManifest(manifest, smiConfig, canaryPercent, baselinePercent)
+	}
+
+	if cfg != nil && cfg.Method == config.KubernetesTrafficRoutingMethodNGINX {
+		nginxConfig := cfg.NGINX
+		if nginxConfig == nil {
+			nginxConfig = &config.NGINXTrafficRouting{}
 		}
-		return e.generateVirtualServiceManifest(manifest, istioConfig.Host, istioConfig.EditableRoutes, int32(canaryPercent), int32(baselinePercent))
+		return e.generateNGINXCanaryIngressManifest(manifest, nginxConfig, canaryPercent)
 	}
 
 	// Determine which variant will receive 100% percent of traffic.
@@ -243,7 +324,7 @@ func findIstioVirtualServiceManifests(manifests []provider.Manifest, ref config.
 	return out, nil
 }
 
-func (e *deployExecutor) generateVirtualServiceManifest(m provider.Manifest, host string, editableRoutes []string, canaryPercent, baselinePercent int32) (provider.Manifest, error) {
+func (e *deployExecutor) generateVirtualServiceManifest(m provider.Manifest, host string, editableRoutes []string, canaryMatches []config.K8sTrafficRoutingMatch, canaryPercent, baselinePercent int32) (provider.Manifest, error) {
 	// Because the loaded manifests are read-only
 	// so we duplicate them to avoid updating the shared manifests data in cache.
 	m = duplicateManifest(m, "")
@@ -267,12 +348,20 @@ func (e *deployExecutor) generateVirtualServiceManifest(m provider.Manifest, hos
 		editableMap[r] = struct{}{}
 	}
 
+	httpRoutes := make([]*istiov1beta1.HTTPRoute, 0, len(vs.Http))
 	for _, http := range vs.Http {
 		if len(editableMap) > 0 {
 			if _, ok := editableMap[http.Name]; !ok {
+				httpRoutes = append(httpRoutes, http)
 				continue
 			}
 		}
+		// The requests matching the canary conditions are routed to CANARY variant
+		// by the route placed before the original one.
+		if len(canaryMatches) > 0 {
+			httpRoutes = append(httpRoutes, e.generateCanaryMatchHTTPRoute(http, host, canaryMatches))
+		}
+		httpRoutes = append(httpRoutes, http)
 
 		var (
 			otherHostWeight int32
@@ -321,6 +410,7 @@ func (e *deployExecutor) generateVirtualServiceManifest(m provider.Manifest, hos
 		routes = append(routes, otherHostRoutes...)
 		http.Route = routes
 	}
+	vs.Http = httpRoutes
 
 	if err := m.SetStructuredSpec(vs); err != nil {
 		return m, err
@@ -329,7 +419,55 @@ func (e *deployExecutor) generateVirtualServiceManifest(m provider.Manifest, hos
 	return m, nil
 }
 
-func (e *deployExecutor) generateVirtualServiceManifestV1Alpha3(m provider.Manifest, host string, editableRoutes []string, canaryPercent, baselinePercent int32) (provider.Manifest, error) {
+// generateCanaryMatchHTTPRoute returns a copy of the given route to route the requests
+// matching any of the given conditions to CANARY variant.
+func (e *deployExecutor) generateCanaryMatchHTTPRoute(http *istiov1beta1.HTTPRoute, host string, canaryMatches []config.K8sTrafficRoutingMatch) *istiov1beta1.HTTPRoute {
+	canaryVariant := e.appCfg.VariantLabel.CanaryValue
+
+	route := http.DeepCopy()
+	if route.Name != "" {
+		route.Name = makeSuffixedName(route.Name, canaryVariant)
+	}
+
+	// All the original conditions are kept by combining them with the canary ones.
+	baseMatches := route.Match
+	if len(baseMatches) == 0 {
+		baseMatches = []*istiov1beta1.HTTPMatchRequest{{}}
+	}
+	route.Match = make([]*istiov1beta1.HTTPMatchRequest, 0, len(baseMatches)*len(canaryMatches))
+	for _, base := range baseMatches {
+		for _, cm := range canaryMatches {
+			match := base.DeepCopy()
+			if match.Headers == nil {
+				match.Headers = make(map[string]*istiov1beta1.StringMatch, len(cm.Headers)+1)
+			}
+			for name, value := range cm.Headers {
+				match.Headers[strings.ToLower(name)] = &istiov1beta1.StringMatch{
+					MatchType: &istiov1beta1.StringMatch_Exact{Exact: value},
+				}
+			}
+			if cm.Cookie != "" {
+				match.Headers["cookie"] = &istiov1beta1.StringMatch{
+					MatchType: &istiov1beta1.StringMatch_Regex{Regex: cookieMatchRegex(cm.Cookie)},
+				}
+			}
+			route.Match = append(route.Match, match)
+		}
+	}
+
+	route.Route = []*istiov1beta1.HTTPRouteDestination{
+		{
+			Destination: &istiov1beta1.Destination{
+				Host:   host,
+				Subset: canaryVariant,
+			},
+			Weight: 100,
+		},
+	}
+	return route
+}
+
+func (e *deployExecutor) generateVirtualServiceManifestV1Alpha3(m provider.Manifest, host string, editableRoutes []string, canaryMatches []config.K8sTrafficRoutingMatch, canaryPercent, baselinePercent int32) (provider.Manifest, error) {
 	// Because the loaded manifests are read-only
 	// so we duplicate them to avoid updating the shared manifests data in cache.
 	m = duplicateManifest(m, "")
@@ -353,12 +491,20 @@ func (e *deployExecutor) generateVirtualServiceManifestV1Alpha3(m provider.Manif
 		editableMap[r] = struct{}{}
 	}
 
+	httpRoutes := make([]*istiov1alpha3.HTTPRoute, 0, len(vs.Http))
 	for _, http := range vs.Http {
 		if len(editableMap) > 0 {
 			if _, ok := editableMap[http.Name]; !ok {
+				httpRoutes = append(httpRoutes, http)
 				continue
 			}
 		}
+		// The requests matching the canary conditions are routed to CANARY variant
+		// by the route placed before the original one.
+		if len(canaryMatches) > 0 {
+			httpRoutes = append(httpRoutes, e.generateCanaryMatchHTTPRouteV1Alpha3(http, host, canaryMatches))
+		}
+		httpRoutes = append(httpRoutes, http)
 
 		var (
 			otherHostWeight int32
@@ -407,6 +553,7 @@ func (e *deployExecutor) generateVirtualServiceManifestV1Alpha3(m provider.Manif
 		routes = append(routes, otherHostRoutes...)
 		http.Route = routes
 	}
+	vs.Http = httpRoutes
 
 	if err := m.SetStructuredSpec(vs); err != nil {
 		return m, err
@@ -415,6 +562,424 @@ func (e *deployExecutor) generateVirtualServiceManifestV1Alpha3(m provider.Manif
 	return m, nil
 }
 
+// generateCanaryMatchHTTPRouteV1Alpha3 returns a copy of the given route to route the requests
+// matching any of the given conditions to CANARY variant.
+func (e *deployExecutor) generateCanaryMatchHTTPRouteV1Alpha3(http *istiov1alpha3.HTTPRoute, host string, canaryMatches []config.K8sTrafficRoutingMatch) *istiov1alpha3.HTTPRoute {
+	canaryVariant := e.appCfg.VariantLabel.CanaryValue
+
+	route := http.DeepCopy()
+	if route.Name != "" {
+		route.Name = makeSuffixedName(route.Name, canaryVariant)
+	}
+
+	// All the original conditions are kept by combining them with the canary ones.
+	baseMatches := route.Match
+	if len(baseMatches) == 0 {
+		baseMatches = []*istiov1alpha3.HTTPMatchRequest{{}}
+	}
+	route.Match = make([]*istiov1alpha3.HTTPMatchRequest, 0, len(baseMatches)*len(canaryMatches))
+	for _, base := range baseMatches {
+		for _, cm := range canaryMatches {
+			match := base.DeepCopy()
+			if match.Headers == nil {
+				match.Headers = make(map[string]*istiov1alpha3.StringMatch, len(cm.Headers)+1)
+			}
+			for name, value := range cm.Headers {
+				match.Headers[strings.ToLower(name)] = &istiov1alpha3.StringMatch{
+					MatchType: &istiov1alpha3.StringMatch_Exact{Exact: value},
+				}
+			}
+			if cm.Cookie != "" {
+				match.Headers["cookie"] = &istiov1alpha3.StringMatch{
+					MatchType: &istiov1alpha3.StringMatch_Regex{Regex: cookieMatchRegex(cm.Cookie)},
+				}
+			}
+			route.Match = append(route.Match, match)
+		}
+	}
+
+	route.Route = []*istiov1alpha3.HTTPRouteDestination{
+		{
+			Destination: &istiov1alpha3.Destination{
+				Host:   host,
+				Subset: canaryVariant,
+			},
+			Weight: 100,
+		},
+	}
+	return route
+}
+
+// cookieMatchRegex returns the regular expression to match the Cookie header containing the given cookie.
+func cookieMatchRegex(cookie string) string {
+	return `^(.*?;\s*)?(` + regexp.QuoteMeta(cookie) + `)(;.*)?$`
+}
+
+func findGatewayAPIHTTPRouteManifests(manifests []provider.Manifest, ref config.K8sResourceReference) ([]provider.Manifest, error) {
+	const (
+		gatewayAPIVersionPrefix = "gateway.networking.k8s.io/"
+		httpRouteKind           = "HTTPRoute"
+	)
+
+	if ref.Kind != "" && ref.Kind != httpRouteKind {
+		return nil, fmt.Errorf("support only %q kind for HTTPRoute reference", httpRouteKind)
+	}
+
+	out := make([]provider.Manifest, 0, len(manifests))
+	for _, m := range manifests {
+		if !strings.HasPrefix(m.Key.APIVersion, gatewayAPIVersionPrefix) {
+			continue
+		}
+		if m.Key.Kind != httpRouteKind {
+			continue
+		}
+		if ref.Name != "" && m.Key.Name != ref.Name {
+			continue
+		}
+		out = append(out, m)
+	}
+
+	return out, nil
+}
+
+func (e *deployExecutor) generateHTTPRouteManifest(m provider.Manifest, cfg *config.GatewayAPITrafficRouting, canaryPercent, baselinePercent int) (provider.Manifest, error) {
+	// Because the loaded manifests are read-only
+	// so we duplicate them to avoid updating the shared manifests data in cache.
+	m = duplicateManifest(m, "")
+
+	primaryService := cfg.PrimaryService
+	if primaryService == "" {
+		primaryService = e.appCfg.Service.Name
+	}
+	if primaryService == "" {
+		return m, fmt.Errorf("unable to determine the PRIMARY service, please specify trafficRouting.gatewayAPI.primaryService")
+	}
+	canaryService := cfg.CanaryService
+	if canaryService == "" {
+		canaryService = makeSuffixedName(primaryService, e.appCfg.VariantLabel.CanaryValue)
+	}
+	baselineService := cfg.BaselineService
+	if baselineService == "" {
+		baselineService = makeSuffixedName(primaryService, e.appCfg.VariantLabel.BaselineValue)
+	}
+
+	spec, err := m.GetNestedMap("spec")
+	if err != nil {
+		return m, err
+	}
+	backends := variantBackends{
+		primary:  primaryService,
+		canary:   canaryService,
+		baseline: baselineService,
+	}
+	if err := updateHTTPRouteBackendRefs(spec, backends, int64(canaryPercent), int64(baselinePercent)); err != nil {
+		return m, err
+	}
+	if err := m.SetStructuredSpec(spec); err != nil {
+		return m, err
+	}
+
+	return m, nil
+}
+
+// variantBackends holds the names of the Services of all variants.
+type variantBackends struct {
+	primary  string
+	canary   string
+	baseline string
+}
+
+// updateHTTPRouteBackendRefs updates the backendRefs of all rules routing to the PRIMARY Service
+// in the given HTTPRoute spec to split the traffic among the variants.
+func updateHTTPRouteBackendRefs(spec map[string]interface{}, backends variantBackends, canaryPercent, baselinePercent int64) error {
+	rules, ok := spec["rules"].([]interface{})
+	if !ok {
+		return fmt.Errorf("no rules was found in the HTTPRoute")
+	}
+
+	var updated bool
+	for _, r := range rules {
+		rule, ok := r.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		refs, ok := rule["backendRefs"].([]interface{})
+		if !ok {
+			continue
+		}
+		if refs, ok = splitBackendWeights(refs, "name", backends, canaryPercent, baselinePercent); ok {
+			rule["backendRefs"] = refs
+			updated = true
+		}
+	}
+
+	if !updated {
+		return fmt.Errorf("no rule routing to service %q was found in the HTTPRoute", backends.primary)
+	}
+	return nil
+}
+
+// splitBackendWeights returns the given backends updated to split the traffic among the variants.
+// The nameKey is the field of a backend holding the name of its Service.
+// The weights of the backends are relative, so only the weight of the PRIMARY backend
+// (including the ones moved to the other variants by the previous stages) is split among the variants.
+// All weights are scaled up when needed to split it by percentage while keeping the ratio to the other backends.
+// False is returned when the backends do not contain the PRIMARY Service.
+func splitBackendWeights(refs []interface{}, nameKey string, backends variantBackends, canaryPercent, baselinePercent int64) ([]interface{}, bool) {
+	var (
+		primaryRef     map[string]interface{}
+		variantsWeight int64
+		otherRefs      = make([]interface{}, 0, len(refs))
+	)
+	for _, ref := range refs {
+		br, ok := ref.(map[string]interface{})
+		if !ok {
+			otherRefs = append(otherRefs, ref)
+			continue
+		}
+		switch br[nameKey] {
+		case backends.primary:
+			primaryRef = br
+			variantsWeight += backendRefWeight(br)
+		case backends.canary, backends.baseline:
+			// Drop the ones added by the previous stages but keep their weights for the variants.
+			variantsWeight += backendRefWeight(br)
+		default:
+			otherRefs = append(otherRefs, br)
+		}
+	}
+	if primaryRef == nil {
+		return refs, false
+	}
+
+	// Scale up all weights by the smallest factor making the weight of the variants a multiple of 100.
+	scale := 100 / gcd(variantsWeight, 100)
+	variantsWeight *= scale
+
+	var (
+		canaryWeight   = canaryPercent * variantsWeight / 100
+		baselineWeight = baselinePercent * variantsWeight / 100
+		primaryWeight  = variantsWeight - canaryWeight - baselineWeight
+		newRefs        = make([]interface{}, 0, len(otherRefs)+3)
+	)
+	newRefs = append(newRefs, makeBackendRef(primaryRef, nameKey, backends.primary, primaryWeight))
+	if canaryWeight > 0 {
+		newRefs = append(newRefs, makeBackendRef(primaryRef, nameKey, backends.canary, canaryWeight))
+	}
+	if baselineWeight > 0 {
+		newRefs = append(newRefs, makeBackendRef(primaryRef, nameKey, backends.baseline, baselineWeight))
+	}
+	for _, ref := range otherRefs {
+		if br, ok := ref.(map[string]interface{}); ok && scale > 1 {
+			ref = withBackendRefWeight(br, backendRefWeight(br)*scale)
+		}
+		newRefs = append(newRefs, ref)
+	}
+	return newRefs, true
+}
+
+// gcd returns the greatest common divisor of the given non-negative numbers.
+func gcd(a, b int64) int64 {
+	for b != 0 {
+		a, b = b, a%b
+	}
+	return a
+}
+
+// backendRefWeight returns the weight of the given backendRef.
+// The weight is 1 when it is not specified.
+func backendRefWeight(ref map[string]interface{}) int64 {
+	switch w := ref["weight"].(type) {
+	case int64:
+		return w
+	case int:
+		return int64(w)
+	case float64:
+		return int64(w)
+	default:
+		return 1
+	}
+}
+
+// makeBackendRef returns a copy of the given backend pointing to the given Service with the given weight.
+func makeBackendRef(base map[string]interface{}, nameKey, name string, weight int64) map[string]interface{} {
+	ref := withBackendRefWeight(base, weight)
+	ref[nameKey] = name
+	return ref
+}
+
+// withBackendRefWeight returns a copy of the given backend with the given weight.
+func withBackendRefWeight(base map[string]interface{}, weight int64) map[string]interface{} {
+	ref := make(map[string]interface{}, len(base)+1)
+	for k, v := range base {
+		ref[k] = v
+	}
+	ref["weight"] = weight
+	return ref
+}
+
+func findSMITrafficSplitManifests(manifests []provider.Manifest, ref config.K8sResourceReference) ([]provider.Manifest, error) {
+	const (
+		smiSplitAPIVersionPrefix = "split.smi-spec.io/"
+		trafficSplitKind         = "TrafficSplit"
+	)
+
+	if ref.Kind != "" && ref.Kind != trafficSplitKind {
+		return nil, fmt.Errorf("support only %q kind for TrafficSplit reference", trafficSplitKind)
+	}
+
+	out := make([]provider.Manifest, 0, len(manifests))
+	for _, m := range manifests {
+		if !strings.HasPrefix(m.Key.APIVersion, smiSplitAPIVersionPrefix) {
+			continue
+		}
+		if m.Key.Kind != trafficSplitKind {
+			continue
+		}
+		if ref.Name != "" && m.Key.Name != ref.Name {
+			continue
+		}
+		out = append(out, m)
+	}
+
+	return out, nil
+}
+
+func (e *deployExecutor) generateTrafficSplitManifest(m provider.Manifest, cfg *config.SMITrafficRouting, canaryPercent, baselinePercent int) (provider.Manifest, error) {
+	// Because the loaded manifests are read-only
+	// so we duplicate them to avoid updating the shared manifests data in cache.
+	m = duplicateManifest(m, "")
+
+	var (
+		serviceName = e.appCfg.Service.Name
+		backends    = variantBackends{
+			primary:  cfg.PrimaryService,
+			canary:   cfg.CanaryService,
+			baseline: cfg.BaselineService,
+		}
+	)
+	if backends.primary == "" {
+		backends.primary = makeSuffixedName(serviceName, e.appCfg.VariantLabel.PrimaryValue)
+	}
+	if backends.canary == "" {
+		backends.canary = makeSuffixedName(serviceName, e.appCfg.VariantLabel.CanaryValue)
+	}
+	if backends.baseline == "" {
+		backends.baseline = makeSuffixedName(serviceName, e.appCfg.VariantLabel.BaselineValue)
+	}
+
+	spec, err := m.GetNestedMap("spec")
+	if err != nil {
+		return m, err
+	}
+	refs, ok := spec["backends"].([]interface{})
+	if !ok {
+		return m, fmt.Errorf("no backends was found in the TrafficSplit")
+	}
+	if refs, ok = splitBackendWeights(refs, "service", backends, int64(canaryPercent), int64(baselinePercent)); !ok {
+		return m, fmt.Errorf("no backend of service %q was found in the TrafficSplit", backends.primary)
+	}
+	spec["backends"] = refs
+	if err := m.SetStructuredSpec(spec); err != nil {
+		return m, err
+	}
+
+	return m, nil
+}
+
+const (
+	nginxCanaryAnnotation       = "nginx.ingress.kubernetes.io/canary"
+	nginxCanaryWeightAnnotation = "nginx.ingress.kubernetes.io/canary-weight"
+)
+
+// generateNGINXCanaryIngressManifest generates the canary Ingress from the given Ingress of PRIMARY variant.
+// The canary Ingress routes the given percentage of traffic to the Service of CANARY variant.
+func (e *deployExecutor) generateNGINXCanaryIngressManifest(m provider.Manifest, cfg *config.NGINXTrafficRouting, canaryPercent int) (provider.Manifest, error) {
+	primaryService := cfg.PrimaryService
+	if primaryService == "" {
+		primaryService = e.appCfg.Service.Name
+	}
+	if primaryService == "" {
+		return m, fmt.Errorf("unable to determine the PRIMARY service, please specify trafficRouting.nginx.primaryService")
+	}
+	canaryService := cfg.CanaryService
+	if canaryService == "" {
+		canaryService = makeSuffixedName(primaryService, e.appCfg.VariantLabel.CanaryValue)
+	}
+
+	// The canary Ingress is generated as a new resource by suffixing the name of the given one.
+	m = duplicateManifest(m, e.appCfg.VariantLabel.CanaryValue)
+
+	spec, err := m.GetSpec()
+	if err != nil {
+		return m, err
+	}
+
+	ingressSpec := networkingv1.IngressSpec{}
+	data, err := json.Marshal(spec)
+	if err != nil {
+		return m, err
+	}
+	if err := json.Unmarshal(data, &ingressSpec); err != nil {
+		return m, err
+	}
+
+	var found bool
+	replaceBackend := func(b *networkingv1.IngressBackend) {
+		if b != nil && b.Service != nil && b.Service.Name == primaryService {
+			b.Service.Name = canaryService
+			found = true
+		}
+	}
+	replaceBackend(ingressSpec.DefaultBackend)
+	for i := range ingressSpec.Rules {
+		if ingressSpec.Rules[i].HTTP == nil {
+			continue
+		}
+		for j := range ingressSpec.Rules[i].HTTP.Paths {
+			replaceBackend(&ingressSpec.Rules[i].HTTP.Paths[j].Backend)
+		}
+	}
+	if !found {
+		return m, fmt.Errorf("no backend of service %q was found in the Ingress", primaryService)
+	}
+
+	if err := m.SetStructuredSpec(ingressSpec); err != nil {
+		return m, err
+	}
+	m.AddAnnotations(map[string]string{
+		nginxCanaryAnnotation:       "true",
+		nginxCanaryWeightAnnotation: strconv.Itoa(canaryPercent),
+	})
+
+	return m, nil
+}
+
+// addCanaryResourceToMetadata adds the given key to the list of CANARY resources stored in the metadata.
+func (e *deployExecutor) addCanaryResourceToMetadata(ctx context.Context, key provider.ResourceKey) error {
+	var resources []string
+	if value, ok := e.MetadataStore.Shared().Get(addedCanaryResourcesMetadataKey); ok && value != "" {
+		resources = strings.Split(value, ",")
+	}
+	if slices.Contains(resources, key.String()) {
+		return nil
+	}
+	resources = append(resources, key.String())
+	return e.MetadataStore.Shared().Put(ctx, addedCanaryResourcesMetadataKey, strings.Join(resources, ","))
+}
+
+// removeNGINXCanaryIngress removes the canary Ingress generated from the given Ingress of PRIMARY variant.
+func (e *deployExecutor) removeNGINXCanaryIngress(ctx context.Context, m provider.Manifest) model.StageStatus {
+	key := duplicateManifest(m, e.appCfg.VariantLabel.CanaryValue).Key
+	e.LogPersister.Infof("Removing the canary Ingress %s because no traffic should be routed to CANARY variant", key.ReadableString())
+	if err := deleteResources(ctx, e.applierGetter, []provider.ResourceKey{key}, e.LogPersister); err != nil {
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	e.LogPersister.Success("Successfully updated traffic routing")
+	return model.StageStatus_STAGE_SUCCESS
+}
+
 func checkVariantSelectorInService(m provider.Manifest, variantLabel, variant string) error {
 	selector, err := m.GetNestedStringMap("spec", "selector")
 	if err != nil {
@@ -42,6 +42,8 @@ func TestGenerateVirtualServiceManifest(t *testing.T) {
 		name           string
 		manifestFile   string
 		editableRoutes []string
+		canaryMatches  []config.K8sTrafficRoutingMatch
+		v1alpha3       bool
 		expectedFile   string
 	}{
 		{
@@ -55,6 +57,260 @@ func TestGenerateVirtualServiceManifest(t *testing.T) {
 			editableRoutes: []string{"only-primary-destination"},
 			expectedFile:   "testdata/generated-virtual-service-for-editable-routes.yaml",
 		},
+		{
+			name:           "route requests matching canary conditions to canary",
+			manifestFile:   "testdata/virtual-service.yaml",
+			editableRoutes: []string{"only-primary-destination", "include-destination-to-other-host"},
+			canaryMatches: []config.K8sTrafficRoutingMatch{
+				{
+					Headers: map[string]string{"X-Canary": "true"},
+				},
+				{
+					Cookie: "canary=always",
+				},
+			},
+			expectedFile: "testdata/generated-virtual-service-with-canary-matches.yaml",
+		},
+		{
+			name:           "route requests matching canary conditions to canary with v1alpha3",
+			manifestFile:   "testdata/virtual-service-v1alpha3.yaml",
+			editableRoutes: []string{"only-primary-destination", "include-destination-to-other-host"},
+			canaryMatches: []config.K8sTrafficRoutingMatch{
+				{
+					Headers: map[string]string{"X-Canary": "true"},
+				},
+				{
+					Cookie: "canary=always",
+				},
+			},
+			v1alpha3:     true,
+			expectedFile: "testdata/generated-virtual-service-v1alpha3-with-canary-matches.yaml",
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			manifests, err := provider.LoadManifestsFromYAMLFile(tc.manifestFile)
+			require.NoError(t, err)
+			require.Equal(t, 1, len(manifests))
+
+			generate := exec.generateVirtualServiceManifest
+			if tc.v1alpha3 {
+				generate = exec.generateVirtualServiceManifestV1Alpha3
+			}
+			generatedManifest, err := generate(manifests[0], "helloworld", tc.editableRoutes, tc.canaryMatches, 30, 20)
+			assert.NoError(t, err)
+
+			expectedManifests, err := provider.LoadManifestsFromYAMLFile(tc.expectedFile)
+			require.NoError(t, err)
+			require.Equal(t, 1, len(expectedManifests))
+
+			expected, err := expectedManifests[0].YamlBytes()
+			require.NoError(t, err)
+			got, err := generatedManifest.YamlBytes()
+			require.NoError(t, err)
+
+			assert.EqualValues(t, string(expected), string(got))
+		})
+	}
+}
+
+func TestGenerateHTTPRouteManifest(t *testing.T) {
+	t.Parallel()
+
+	exec := &deployExecutor{
+		appCfg: &config.KubernetesApplicationSpec{
+			VariantLabel: config.KubernetesVariantLabel{
+				Key:           "pipecd.dev/variant",
+				PrimaryValue:  "primary",
+				BaselineValue: "baseline",
+				CanaryValue:   "canary",
+			},
+			Service: config.K8sResourceReference{
+				Name: "helloworld",
+			},
+		},
+	}
+	testcases := []struct {
+		name         string
+		manifestFile string
+		cfg          *config.GatewayAPITrafficRouting
+		expectedFile string
+		wantErr      bool
+	}{
+		{
+			name:         "split traffic among variants",
+			manifestFile: "testdata/http-route.yaml",
+			cfg:          &config.GatewayAPITrafficRouting{},
+			expectedFile: "testdata/generated-http-route.yaml",
+		},
+		{
+			name:         "update the manifest generated by the previous stage",
+			manifestFile: "testdata/generated-http-route.yaml",
+			cfg:          &config.GatewayAPITrafficRouting{},
+			expectedFile: "testdata/generated-http-route.yaml",
+		},
+		{
+			name:         "no rule routing to the primary service",
+			manifestFile: "testdata/http-route.yaml",
+			cfg: &config.GatewayAPITrafficRouting{
+				PrimaryService: "unknown",
+			},
+			wantErr: true,
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			manifests, err := provider.LoadManifestsFromYAMLFile(tc.manifestFile)
+			require.NoError(t, err)
+			require.Equal(t, 1, len(manifests))
+
+			generatedManifest, err := exec.generateHTTPRouteManifest(manifests[0], tc.cfg, 30, 20)
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+
+			expectedManifests, err := provider.LoadManifestsFromYAMLFile(tc.expectedFile)
+			require.NoError(t, err)
+			require.Equal(t, 1, len(expectedManifests))
+
+			expected, err := expectedManifests[0].YamlBytes()
+			require.NoError(t, err)
+			got, err := generatedManifest.YamlBytes()
+			require.NoError(t, err)
+
+			assert.EqualValues(t, string(expected), string(got))
+		})
+	}
+}
+
+func TestSplitBackendWeights(t *testing.T) {
+	t.Parallel()
+
+	backends := variantBackends{
+		primary:  "helloworld",
+		canary:   "helloworld-canary",
+		baseline: "helloworld-baseline",
+	}
+	testcases := []struct {
+		name            string
+		refs            []interface{}
+		canaryPercent   int64
+		baselinePercent int64
+		expected        []interface{}
+		expectedOK      bool
+	}{
+		{
+			name: "unweighted backends",
+			refs: []interface{}{
+				map[string]interface{}{"name": "helloworld"},
+				map[string]interface{}{"name": "other"},
+			},
+			canaryPercent: 10,
+			expected: []interface{}{
+				map[string]interface{}{"name": "helloworld", "weight": int64(90)},
+				map[string]interface{}{"name": "helloworld-canary", "weight": int64(10)},
+				map[string]interface{}{"name": "other", "weight": int64(100)},
+			},
+			expectedOK: true,
+		},
+		{
+			name: "weights not summing up to 100",
+			refs: []interface{}{
+				map[string]interface{}{"name": "helloworld", "weight": int64(3)},
+				map[string]interface{}{"name": "other", "weight": int64(7)},
+			},
+			canaryPercent:   30,
+			baselinePercent: 20,
+			expected: []interface{}{
+				map[string]interface{}{"name": "helloworld", "weight": int64(150)},
+				map[string]interface{}{"name": "helloworld-canary", "weight": int64(90)},
+				map[string]interface{}{"name": "helloworld-baseline", "weight": int64(60)},
+				map[string]interface{}{"name": "other", "weight": int64(700)},
+			},
+			expectedOK: true,
+		},
+		{
+			name: "backends updated by the previous stage",
+			refs: []interface{}{
+				map[string]interface{}{"name": "helloworld", "weight": int64(150)},
+				map[string]interface{}{"name": "helloworld-canary", "weight": int64(90)},
+				map[string]interface{}{"name": "helloworld-baseline", "weight": int64(60)},
+				map[string]interface{}{"name": "other", "weight": int64(700)},
+			},
+			expected: []interface{}{
+				map[string]interface{}{"name": "helloworld", "weight": int64(300)},
+				map[string]interface{}{"name": "other", "weight": int64(700)},
+			},
+			expectedOK: true,
+		},
+		{
+			name: "no primary backend",
+			refs: []interface{}{
+				map[string]interface{}{"name": "other"},
+			},
+			canaryPercent: 10,
+			expected: []interface{}{
+				map[string]interface{}{"name": "other"},
+			},
+			expectedOK: false,
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, ok := splitBackendWeights(tc.refs, "name", backends, tc.canaryPercent, tc.baselinePercent)
+			assert.Equal(t, tc.expectedOK, ok)
+			assert.Equal(t, tc.expected, got)
+		})
+	}
+}
+
+func TestGenerateTrafficSplitManifest(t *testing.T) {
+	t.Parallel()
+
+	exec := &deployExecutor{
+		appCfg: &config.KubernetesApplicationSpec{
+			VariantLabel: config.KubernetesVariantLabel{
+				Key:           "pipecd.dev/variant",
+				PrimaryValue:  "primary",
+				BaselineValue: "baseline",
+				CanaryValue:   "canary",
+			},
+			Service: config.K8sResourceReference{
+				Name: "helloworld",
+			},
+		},
+	}
+	testcases := []struct {
+		name         string
+		manifestFile string
+		cfg          *config.SMITrafficRouting
+		expectedFile string
+		wantErr      bool
+	}{
+		{
+			name:         "split traffic among variants",
+			manifestFile: "testdata/traffic-split.yaml",
+			cfg:          &config.SMITrafficRouting{},
+			expectedFile: "testdata/generated-traffic-split.yaml",
+		},
+		{
+			name:         "update the manifest generated by the previous stage",
+			manifestFile: "testdata/generated-traffic-split.yaml",
+			cfg:          &config.SMITrafficRouting{},
+			expectedFile: "testdata/generated-traffic-split.yaml",
+		},
+		{
+			name:         "no backend of the primary service",
+			manifestFile: "testdata/traffic-split.yaml",
+			cfg: &config.SMITrafficRouting{
+				PrimaryService: "unknown",
+			},
+			wantErr: true,
+		},
 	}
 	for _, tc := range testcases {
 		t.Run(tc.name, func(t *testing.T) {
@@ -62,7 +318,73 @@ func TestGenerateVirtualServiceManifest(t *testing.T) {
 			require.NoError(t, err)
 			require.Equal(t, 1, len(manifests))
 
-			generatedManifest, err := exec.generateVirtualServiceManifest(manifests[0], "helloworld", tc.editableRoutes, 30, 20)
+			generatedManifest, err := exec.generateTrafficSplitManifest(manifests[0], tc.cfg, 30, 20)
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+
+			expectedManifests, err := provider.LoadManifestsFromYAMLFile(tc.expectedFile)
+			require.NoError(t, err)
+			require.Equal(t, 1, len(expectedManifests))
+
+			expected, err := expectedManifests[0].YamlBytes()
+			require.NoError(t, err)
+			got, err := generatedManifest.YamlBytes()
+			require.NoError(t, err)
+
+			assert.EqualValues(t, string(expected), string(got))
+		})
+	}
+}
+
+func TestGenerateNGINXCanaryIngressManifest(t *testing.T) {
+	t.Parallel()
+
+	exec := &deployExecutor{
+		appCfg: &config.KubernetesApplicationSpec{
+			VariantLabel: config.KubernetesVariantLabel{
+				Key:           "pipecd.dev/variant",
+				PrimaryValue:  "primary",
+				BaselineValue: "baseline",
+				CanaryValue:   "canary",
+			},
+			Service: config.K8sResourceReference{
+				Name: "helloworld",
+			},
+		},
+	}
+	testcases := []struct {
+		name         string
+		cfg          *config.NGINXTrafficRouting
+		expectedFile string
+		wantErr      bool
+	}{
+		{
+			name:         "route traffic to the canary service",
+			cfg:          &config.NGINXTrafficRouting{},
+			expectedFile: "testdata/generated-nginx-canary-ingress.yaml",
+		},
+		{
+			name: "no backend of the primary service",
+			cfg: &config.NGINXTrafficRouting{
+				PrimaryService: "unknown",
+			},
+			wantErr: true,
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			manifests, err := provider.LoadManifestsFromYAMLFile("testdata/nginx-ingress.yaml")
+			require.NoError(t, err)
+			require.Equal(t, 1, len(manifests))
+
+			generatedManifest, err := exec.generateNGINXCanaryIngressManifest(manifests[0], tc.cfg, 30)
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
 			assert.NoError(t, err)
 
 			expectedManifests, err := provider.LoadManifestsFromYAMLFile(tc.expectedFile)
@@ -29,7 +29,9 @@ import (
 )
 
 const (
-	approvedByKey = "ApprovedBy"
+	approvedByKey    = "ApprovedBy"
+	rejectedByKey    = "RejectedBy"
+	rejectCommentKey = "RejectComment"
 )
 
 type Executor struct {
@@ -68,6 +70,9 @@ func (e *Executor) Execute(sig executor.StopSignal) model.StageStatus {
 	for {
 		select {
 		case <-ticker.C:
+			if e.checkRejection(ctx) {
+				return model.StageStatus_STAGE_FAILURE
+			}
 			if e.checkApproval(ctx, num) {
 				return model.StageStatus_STAGE_SUCCESS
 			}
@@ -109,6 +114,65 @@ func (e *Executor) checkApproval(ctx context.Context, num int) bool {
 	return reached
 }
 
+// checkRejection returns true when this stage has been rejected by someone.
+// The rejecter and the given comment are saved into the stage metadata.
+func (e *Executor) checkRejection(ctx context.Context) bool {
+	var rejectCmd *model.ReportableCommand
+	commands := e.CommandLister.ListCommands()
+
+	for i, cmd := range commands {
+		if cmd.GetRejectStage() != nil {
+			rejectCmd = &commands[i]
+			break
+		}
+	}
+	if rejectCmd == nil {
+		return false
+	}
+
+	var (
+		rejecter = rejectCmd.Commander
+		comment  = rejectCmd.GetRejectStage().Comment
+		md       = map[string]string{
+			rejectedByKey:    rejecter,
+			rejectCommentKey: comment,
+		}
+	)
+	if err := e.MetadataStore.Stage(e.Stage.Id).PutMulti(ctx, md); err != nil {
+		e.LogPersister.Errorf("Unable to save rejecter information to deployment, %v", err)
+	}
+	if err := rejectCmd.Report(ctx, model.CommandStatus_COMMAND_SUCCEEDED, nil, nil); err != nil {
+		e.Logger.Error("failed to report handled command", zap.Error(err))
+	}
+
+	if comment != "" {
+		e.LogPersister.Errorf("This stage has been rejected by %q: %s", rejecter, comment)
+	} else {
+		e.LogPersister.Errorf("This stage has been rejected by %q", rejecter)
+	}
+	e.reportRejected(rejecter, comment)
+	return true
+}
+
+func (e *Executor) reportRejected(rejecter, comment string) {
+	users, groups, err := e.getApplicationNotificationMentions(model.NotificationEventType_EVENT_DEPLOYMENT_REJECTED)
+	if err != nil {
+		e.Logger.Error("failed to get the list of users or groups", zap.Error(err))
+	}
+
+	e.Notifier.Notify(model.NotificationEvent{
+		Type: model.NotificationEventType_EVENT_DEPLOYMENT_REJECTED,
+		Metadata: &model.NotificationEventDeploymentRejected{
+			Deployment:        e.Deployment,
+			StageId:           e.Stage.Id,
+			Rejecter:          rejecter,
+			Comment:           comment,
+			MentionedAccounts: users,
+			MentionedGroups:   groups,
+		},
+	})
+}
+
 func (e *Executor) reportApproved(approver string) {
 	users, groups, err := e.getApplicationNotificationMentions(model.NotificationEventType_EVENT_DEPLOYMENT_APPROVED)
 	if err != nil {
@@ -136,6 +200,7 @@ func (e *Executor) reportRequiringApproval() {
 		Type: model.NotificationEventType_EVENT_DEPLOYMENT_WAIT_APPROVAL,
 		Metadata: &model.NotificationEventDeploymentWaitApproval{
 			Deployment:        e.Deployment,
+			StageId:           e.Stage.Id,
 			MentionedAccounts: users,
 			MentionedGroups:   groups,
 		},
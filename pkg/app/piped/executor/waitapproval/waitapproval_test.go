@@ -19,6 +19,8 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
 	"google.golang.org/grpc"
 
 	"github.com/pipe-cd/pipecd/pkg/app/piped/executor"
@@ -69,9 +71,21 @@ func (c *fakeAPIClient) SaveStageMetadata(_ context.Context, req *pipedservice.S
 	return &pipedservice.SaveStageMetadataResponse{}, nil
 }
 
-type fakeNotifier struct{}
+type fakeNotifier struct {
+	events []model.NotificationEvent
+}
+
+func (n *fakeNotifier) Notify(event model.NotificationEvent) {
+	n.events = append(n.events, event)
+}
 
-func (n *fakeNotifier) Notify(_ model.NotificationEvent) {}
+type fakeCommandLister struct {
+	commands []model.ReportableCommand
+}
+
+func (l *fakeCommandLister) ListCommands() []model.ReportableCommand {
+	return l.commands
+}
 
 func TestValidateApproverNum(t *testing.T) {
 	t.Parallel()
@@ -217,3 +231,111 @@ func TestValidateApproverNum(t *testing.T) {
 		})
 	}
 }
+
+func TestCheckRejection(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	testcases := []struct {
+		name        string
+		commands    []*model.Command
+		want        bool
+		wantMeta    metadata
+		wantNotify  bool
+		wantHandled bool
+	}{
+		{
+			name: "no command",
+			want: false,
+		},
+		{
+			name: "only approve command",
+			commands: []*model.Command{
+				{
+					Commander:    "user-1",
+					Type:         model.Command_APPROVE_STAGE,
+					ApproveStage: &model.Command_ApproveStage{DeploymentId: "deployment-1", StageId: "stage-1"},
+				},
+			},
+			want: false,
+		},
+		{
+			name: "rejected with comment",
+			commands: []*model.Command{
+				{
+					Commander:   "user-1",
+					Type:        model.Command_REJECT_STAGE,
+					RejectStage: &model.Command_RejectStage{DeploymentId: "deployment-1", StageId: "stage-1", Comment: "broken image"},
+				},
+			},
+			want: true,
+			wantMeta: metadata{
+				rejectedByKey:    "user-1",
+				rejectCommentKey: "broken image",
+			},
+			wantNotify:  true,
+			wantHandled: true,
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			var (
+				ac = &fakeAPIClient{
+					shared: make(map[string]string, 0),
+					stages: make(map[string]metadata, 0),
+				}
+				notifier = &fakeNotifier{}
+				handled  = false
+				commands = make([]model.ReportableCommand, 0, len(tc.commands))
+			)
+			for _, cmd := range tc.commands {
+				commands = append(commands, model.ReportableCommand{
+					Command: cmd,
+					Report: func(_ context.Context, status model.CommandStatus, _ map[string]string, _ []byte) error {
+						handled = status == model.CommandStatus_COMMAND_SUCCEEDED
+						return nil
+					},
+				})
+			}
+			deployment := &model.Deployment{
+				Stages: []*model.PipelineStage{
+					{
+						Id:       "stage-1",
+						Metadata: map[string]string{},
+					},
+				},
+			}
+			e := &Executor{
+				Input: executor.Input{
+					Deployment:    deployment,
+					Stage:         deployment.Stages[0],
+					CommandLister: &fakeCommandLister{commands: commands},
+					LogPersister:  &fakeLogPersister{},
+					MetadataStore: metadatastore.NewMetadataStore(ac, deployment),
+					Notifier:      notifier,
+					Logger:        zap.NewNop(),
+				},
+			}
+
+			got := e.checkRejection(ctx)
+			assert.Equal(t, tc.want, got)
+			assert.Equal(t, tc.wantHandled, handled)
+			if tc.wantMeta != nil {
+				assert.Equal(t, tc.wantMeta, ac.stages["stage-1"])
+			}
+			if !tc.wantNotify {
+				assert.Empty(t, notifier.events)
+				return
+			}
+			require.Len(t, notifier.events, 1)
+			assert.Equal(t, model.NotificationEventType_EVENT_DEPLOYMENT_REJECTED, notifier.events[0].Type)
+			md, ok := notifier.events[0].Metadata.(*model.NotificationEventDeploymentRejected)
+			require.True(t, ok)
+			assert.Equal(t, "user-1", md.Rejecter)
+			assert.Equal(t, "broken image", md.Comment)
+		})
+	}
+}
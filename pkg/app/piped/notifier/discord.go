@@ -0,0 +1,203 @@
+// Copyright 2024 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package notifier
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/pipe-cd/pipecd/pkg/config"
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+const (
+	discordUsername     = "PipeCD"
+	discordInfoColor    = 0x222429
+	discordSuccessColor = 0x629650
+	discordErrorColor   = 0x9C3C31
+	discordWarnColor    = 0xC1A337
+
+	// Limits defined by Discord for each embed.
+	// https://discord.com/developers/docs/resources/message#embed-object-embed-limits
+	discordTitleLimit       = 256
+	discordDescriptionLimit = 4096
+	discordFieldValueLimit  = 1024
+)
+
+type discord struct {
+	name       string
+	config     config.NotificationReceiverDiscord
+	hookURL    string
+	webURL     string
+	httpClient *http.Client
+	eventCh    chan model.NotificationEvent
+	logger     *zap.Logger
+}
+
+func newDiscordSender(name string, cfg config.NotificationReceiverDiscord, webURL string, logger *zap.Logger) (*discord, error) {
+	hookURL, err := cfg.LoadHookURL()
+	if err != nil {
+		return nil, fmt.Errorf("failed to load the hook URL: %w", err)
+	}
+	return &discord{
+		name:    name,
+		config:  cfg,
+		hookURL: hookURL,
+		webURL:  strings.TrimRight(webURL, "/"),
+		httpClient: &http.Client{
+			Timeout: 5 * time.Second,
+		},
+		eventCh: make(chan model.NotificationEvent, 100),
+		logger:  logger.Named("discord").With(zap.String("name", name)),
+	}, nil
+}
+
+func (d *discord) Run(ctx context.Context) error {
+	for {
+		select {
+		case event, ok := <-d.eventCh:
+			if ok {
+				d.sendEvent(ctx, event)
+			}
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
+
+func (d *discord) Notify(event model.NotificationEvent) {
+	d.eventCh <- event
+}
+
+func (d *discord) Close(ctx context.Context) {
+	close(d.eventCh)
+
+	// Send all remaining events.
+	for {
+		select {
+		case event, ok := <-d.eventCh:
+			if !ok {
+				return
+			}
+			d.sendEvent(ctx, event)
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func (d *discord) sendEvent(ctx context.Context, event model.NotificationEvent) {
+	msg, ok := buildEventMessage(event, d.webURL, d.config.MentionedAccounts, d.config.MentionedGroups, d.logger)
+	if !ok {
+		d.logger.Info(fmt.Sprintf("ignore event %s", event.Type.String()))
+		return
+	}
+	if err := postJSON(ctx, d.httpClient, d.hookURL, makeDiscordMessage(msg)); err != nil {
+		d.logger.Error(fmt.Sprintf("unable to send notification to discord: %v", err))
+	}
+}
+
+type discordMessage struct {
+	Username        string                 `json:"username"`
+	Content         string                 `json:"content,omitempty"`
+	Embeds          []discordEmbed         `json:"embeds"`
+	AllowedMentions discordAllowedMentions `json:"allowed_mentions"`
+}
+
+type discordEmbed struct {
+	Title       string              `json:"title"`
+	URL         string              `json:"url,omitempty"`
+	Description string              `json:"description,omitempty"`
+	Color       int                 `json:"color"`
+	Fields      []discordEmbedField `json:"fields,omitempty"`
+	Timestamp   string              `json:"timestamp"`
+}
+
+type discordEmbedField struct {
+	Name   string `json:"name"`
+	Value  string `json:"value"`
+	Inline bool   `json:"inline"`
+}
+
+type discordAllowedMentions struct {
+	Parse []string `json:"parse"`
+	Users []string `json:"users,omitempty"`
+	Roles []string `json:"roles,omitempty"`
+}
+
+func makeDiscordMessage(msg eventMessage) discordMessage {
+	fields := make([]discordEmbedField, 0, len(msg.Fields))
+	for _, f := range msg.Fields {
+		value := f.Value
+		if value == "" {
+			// Discord rejects embed fields with an empty value.
+			value = "-"
+		}
+		if f.Link != "" {
+			value = fmt.Sprintf("[%s](%s)", value, f.Link)
+		}
+		fields = append(fields, discordEmbedField{
+			Name:   f.Title,
+			Value:  truncateText(value, discordFieldValueLimit-len("...")),
+			Inline: true,
+		})
+	}
+
+	// Only the explicitly listed users and roles can be pinged,
+	// so that a crafted commit message cannot mention @everyone.
+	mentions := make([]string, 0, len(msg.MentionedAccounts)+len(msg.MentionedGroups))
+	for _, a := range msg.MentionedAccounts {
+		mentions = append(mentions, fmt.Sprintf("<@%s>", a))
+	}
+	for _, g := range msg.MentionedGroups {
+		mentions = append(mentions, fmt.Sprintf("<@&%s>", g))
+	}
+
+	return discordMessage{
+		Username: discordUsername,
+		Content:  strings.Join(mentions, " "),
+		Embeds: []discordEmbed{{
+			Title:       truncateText(msg.Title, discordTitleLimit-len("...")),
+			URL:         msg.Link,
+			Description: truncateText(msg.Text, discordDescriptionLimit-len("...")),
+			Color:       discordColor(msg.Level),
+			Fields:      fields,
+			Timestamp:   msg.Timestamp.UTC().Format(time.RFC3339),
+		}},
+		AllowedMentions: discordAllowedMentions{
+			Parse: []string{},
+			Users: msg.MentionedAccounts,
+			Roles: msg.MentionedGroups,
+		},
+	}
+}
+
+func discordColor(level messageLevel) int {
+	switch level {
+	case messageLevelSuccess:
+		return discordSuccessColor
+	case messageLevelWarn:
+		return discordWarnColor
+	case messageLevelError:
+		return discordErrorColor
+	default:
+		return discordInfoColor
+	}
+}
@@ -0,0 +1,99 @@
+// Copyright 2024 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package notifier
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/pipe-cd/pipecd/pkg/config"
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+func TestMakeDiscordMessage(t *testing.T) {
+	t.Parallel()
+
+	msg := eventMessage{
+		Title: "Deployment for \"app\" was failed",
+		Link:  "https://pipecd.dev/deployments/id",
+		Text:  "reason",
+		Level: messageLevelError,
+		Fields: []eventMessageField{
+			{Title: "Application", Value: "app", Link: "https://pipecd.dev/applications/id"},
+			{Title: "Triggered By", Value: ""},
+		},
+		Timestamp:         time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+		MentionedAccounts: []string{"1001"},
+		MentionedGroups:   []string{"2001"},
+	}
+
+	got := makeDiscordMessage(msg)
+	assert.Equal(t, "<@1001> <@&2001>", got.Content)
+	require.Len(t, got.Embeds, 1)
+	assert.Equal(t, discordErrorColor, got.Embeds[0].Color)
+	assert.Equal(t, "2024-01-01T00:00:00Z", got.Embeds[0].Timestamp)
+	assert.Equal(t, []discordEmbedField{
+		{Name: "Application", Value: "[app](https://pipecd.dev/applications/id)", Inline: true},
+		{Name: "Triggered By", Value: "-", Inline: true},
+	}, got.Embeds[0].Fields)
+	assert.Equal(t, []string{}, got.AllowedMentions.Parse)
+	assert.Equal(t, []string{"1001"}, got.AllowedMentions.Users)
+	assert.Equal(t, []string{"2001"}, got.AllowedMentions.Roles)
+}
+
+func TestDiscordSendEvent(t *testing.T) {
+	t.Parallel()
+
+	received := make(chan discordMessage, 1)
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var msg discordMessage
+		if err := json.NewDecoder(r.Body).Decode(&msg); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		received <- msg
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer ts.Close()
+
+	sender, err := newDiscordSender("discord", config.NotificationReceiverDiscord{HookURL: ts.URL}, "https://pipecd.dev", zap.NewNop())
+	require.NoError(t, err)
+
+	sender.sendEvent(context.Background(), model.NotificationEvent{
+		Type: model.NotificationEventType_EVENT_PIPED_STOPPED,
+		Metadata: &model.NotificationEventPipedStopped{
+			Id:        "piped-id",
+			Name:      "piped",
+			ProjectId: "project",
+		},
+	})
+
+	select {
+	case msg := <-received:
+		require.Len(t, msg.Embeds, 1)
+		assert.Equal(t, "A piped has been stopped", msg.Embeds[0].Title)
+		assert.Equal(t, "https://pipecd.dev/settings/piped?project=project", msg.Embeds[0].URL)
+	default:
+		t.Fatal("no message was sent to discord")
+	}
+}
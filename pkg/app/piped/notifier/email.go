@@ -0,0 +1,212 @@
+// Copyright 2024 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package notifier
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"mime"
+	"net"
+	"net/smtp"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/pipe-cd/pipecd/pkg/config"
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+const emailSubjectPrefix = "[PipeCD]"
+
+type sendMailFunc func(addr string, a smtp.Auth, from string, to []string, msg []byte) error
+
+type email struct {
+	name     string
+	config   config.NotificationReceiverEmail
+	auth     smtp.Auth
+	webURL   string
+	sendMail sendMailFunc
+	eventCh  chan model.NotificationEvent
+	logger   *zap.Logger
+
+	// Buffered messages keyed by application name, only used in digest mode.
+	// Messages not related to any application are stored with an empty key.
+	pending     map[string][]eventMessage
+	pendingKeys []string
+}
+
+func newEmailSender(name string, cfg config.NotificationReceiverEmail, webURL string, logger *zap.Logger) (*email, error) {
+	var auth smtp.Auth
+	if cfg.Username != "" {
+		password, err := cfg.LoadPassword()
+		if err != nil {
+			return nil, fmt.Errorf("failed to load the smtp password: %w", err)
+		}
+		host, _, err := net.SplitHostPort(cfg.SMTPAddress)
+		if err != nil {
+			return nil, fmt.Errorf("invalid smtp address: %w", err)
+		}
+		auth = smtp.PlainAuth("", cfg.Username, password, host)
+	}
+	return &email{
+		name:     name,
+		config:   cfg,
+		auth:     auth,
+		webURL:   strings.TrimRight(webURL, "/"),
+		sendMail: smtp.SendMail,
+		eventCh:  make(chan model.NotificationEvent, eventChannelBufferSize),
+		logger:   logger.Named("email").With(zap.String("name", name)),
+		pending:  make(map[string][]eventMessage),
+	}, nil
+}
+
+func (e *email) Run(ctx context.Context) error {
+	// A nil channel blocks forever, so nothing is flushed periodically in non-digest mode.
+	var flushCh <-chan time.Time
+	if e.config.Digest != nil {
+		ticker := time.NewTicker(e.config.Digest.Interval.Duration())
+		defer ticker.Stop()
+		flushCh = ticker.C
+	}
+
+	for {
+		select {
+		case event, ok := <-e.eventCh:
+			if ok {
+				e.handleEvent(event)
+			}
+		case <-flushCh:
+			e.flush()
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
+
+func (e *email) Notify(event model.NotificationEvent) {
+	e.eventCh <- event
+}
+
+func (e *email) Close(ctx context.Context) {
+	close(e.eventCh)
+
+	// Send all remaining events and the buffered digests.
+	for {
+		select {
+		case event, ok := <-e.eventCh:
+			if !ok {
+				e.flush()
+				return
+			}
+			e.handleEvent(event)
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func (e *email) handleEvent(event model.NotificationEvent) {
+	msg, ok := buildEventMessage(event, e.webURL, nil, nil, e.logger)
+	if !ok {
+		e.logger.Info(fmt.Sprintf("ignore event %s", event.Type.String()))
+		return
+	}
+
+	if e.config.Digest == nil {
+		if err := e.send(msg.Title, formatEmailMessage(msg)); err != nil {
+			e.logger.Error(fmt.Sprintf("unable to send notification via email: %v", err))
+		}
+		return
+	}
+
+	var key string
+	if md, ok := event.Metadata.(appNameMetadata); ok {
+		key = md.GetAppName()
+	}
+	if _, ok := e.pending[key]; !ok {
+		e.pendingKeys = append(e.pendingKeys, key)
+	}
+	e.pending[key] = append(e.pending[key], msg)
+}
+
+// flush sends one email for each application that has buffered messages.
+func (e *email) flush() {
+	for _, key := range e.pendingKeys {
+		msgs := e.pending[key]
+		if len(msgs) == 0 {
+			continue
+		}
+
+		var subject string
+		switch {
+		case len(msgs) == 1:
+			subject = msgs[0].Title
+		case key == "":
+			subject = fmt.Sprintf("%d events from piped", len(msgs))
+		default:
+			subject = fmt.Sprintf("%d events for application %q", len(msgs), key)
+		}
+
+		bodies := make([]string, 0, len(msgs))
+		for _, m := range msgs {
+			bodies = append(bodies, formatEmailMessage(m))
+		}
+		if err := e.send(subject, strings.Join(bodies, "\n----------\n\n")); err != nil {
+			e.logger.Error(fmt.Sprintf("unable to send digest notification via email: %v", err))
+		}
+	}
+	e.pending = make(map[string][]eventMessage)
+	e.pendingKeys = nil
+}
+
+func (e *email) send(subject, body string) error {
+	return e.sendMail(e.config.SMTPAddress, e.auth, e.config.From, e.config.To, makeEmail(e.config.From, e.config.To, subject, body, time.Now()))
+}
+
+func makeEmail(from string, to []string, subject, body string, now time.Time) []byte {
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "From: %s\r\n", from)
+	fmt.Fprintf(&buf, "To: %s\r\n", strings.Join(to, ", "))
+	fmt.Fprintf(&buf, "Subject: %s\r\n", mime.QEncoding.Encode("utf-8", fmt.Sprintf("%s %s", emailSubjectPrefix, subject)))
+	fmt.Fprintf(&buf, "Date: %s\r\n", now.Format(time.RFC1123Z))
+	buf.WriteString("MIME-Version: 1.0\r\n")
+	buf.WriteString("Content-Type: text/plain; charset=\"utf-8\"\r\n")
+	buf.WriteString("Content-Transfer-Encoding: 8bit\r\n")
+	buf.WriteString("\r\n")
+	buf.WriteString(strings.ReplaceAll(body, "\n", "\r\n"))
+	return buf.Bytes()
+}
+
+func formatEmailMessage(msg eventMessage) string {
+	var b strings.Builder
+	b.WriteString(msg.Title + "\n")
+	if msg.Text != "" {
+		b.WriteString("\n" + msg.Text + "\n")
+	}
+	b.WriteString("\n")
+	for _, f := range msg.Fields {
+		if f.Link != "" {
+			fmt.Fprintf(&b, "%s: %s (%s)\n", f.Title, f.Value, f.Link)
+			continue
+		}
+		fmt.Fprintf(&b, "%s: %s\n", f.Title, f.Value)
+	}
+	if msg.Link != "" {
+		fmt.Fprintf(&b, "\nOpen in PipeCD: %s\n", msg.Link)
+	}
+	return b.String()
+}
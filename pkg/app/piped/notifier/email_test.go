@@ -0,0 +1,211 @@
+// Copyright 2024 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package notifier
+
+import (
+	"context"
+	"net"
+	"net/textproto"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/pipe-cd/pipecd/pkg/config"
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+// fakeSMTPServer is a minimal SMTP stand-in that records the DATA part of every received mail.
+type fakeSMTPServer struct {
+	listener net.Listener
+	mails    chan string
+}
+
+func newFakeSMTPServer(t *testing.T) *fakeSMTPServer {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	s := &fakeSMTPServer{
+		listener: l,
+		mails:    make(chan string, 10),
+	}
+	go s.serve()
+	t.Cleanup(func() { l.Close() })
+	return s
+}
+
+func (s *fakeSMTPServer) serve() {
+	for {
+		conn, err := s.listener.Accept()
+		if err != nil {
+			return
+		}
+		go s.handle(conn)
+	}
+}
+
+func (s *fakeSMTPServer) handle(conn net.Conn) {
+	defer conn.Close()
+	tp := textproto.NewConn(conn)
+	tp.PrintfLine("220 localhost fake smtp")
+	for {
+		line, err := tp.ReadLine()
+		if err != nil {
+			return
+		}
+		cmd := strings.ToUpper(strings.SplitN(line, " ", 2)[0])
+		switch cmd {
+		case "EHLO", "HELO":
+			tp.PrintfLine("250 localhost")
+		case "MAIL", "RCPT", "RSET", "NOOP":
+			tp.PrintfLine("250 OK")
+		case "DATA":
+			tp.PrintfLine("354 go ahead")
+			data, err := tp.ReadDotBytes()
+			if err != nil {
+				return
+			}
+			s.mails <- string(data)
+			tp.PrintfLine("250 OK")
+		case "QUIT":
+			tp.PrintfLine("221 bye")
+			return
+		default:
+			tp.PrintfLine("502 not implemented")
+		}
+	}
+}
+
+func (s *fakeSMTPServer) receive(t *testing.T) string {
+	select {
+	case m := <-s.mails:
+		return m
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for an email")
+		return ""
+	}
+}
+
+func makeDeploymentFailedEvent(appName string) model.NotificationEvent {
+	return model.NotificationEvent{
+		Type: model.NotificationEventType_EVENT_DEPLOYMENT_FAILED,
+		Metadata: &model.NotificationEventDeploymentFailed{
+			Deployment: &model.Deployment{
+				Id:              "deployment-" + appName,
+				ApplicationName: appName,
+				ProjectId:       "project",
+				Trigger: &model.DeploymentTrigger{
+					Commit: &model.Commit{Author: "alice"},
+				},
+			},
+			Reason: "quota exceeded",
+		},
+	}
+}
+
+func TestEmailSendEvent(t *testing.T) {
+	t.Parallel()
+
+	server := newFakeSMTPServer(t)
+	sender, err := newEmailSender("email", config.NotificationReceiverEmail{
+		SMTPAddress: server.listener.Addr().String(),
+		From:        "pipecd@example.com",
+		To:          []string{"oncall@example.com"},
+	}, "https://pipecd.dev", zap.NewNop())
+	require.NoError(t, err)
+
+	sender.handleEvent(makeDeploymentFailedEvent("app-a"))
+
+	mail := server.receive(t)
+	assert.Contains(t, mail, "From: pipecd@example.com")
+	assert.Contains(t, mail, "To: oncall@example.com")
+	assert.Contains(t, mail, `Subject: [PipeCD] Deployment for "app-a" was failed`)
+	assert.Contains(t, mail, "quota exceeded")
+	assert.Contains(t, mail, "Open in PipeCD: https://pipecd.dev/deployments/deployment-app-a?project=project")
+}
+
+func TestEmailDigest(t *testing.T) {
+	t.Parallel()
+
+	server := newFakeSMTPServer(t)
+	sender, err := newEmailSender("email", config.NotificationReceiverEmail{
+		SMTPAddress: server.listener.Addr().String(),
+		From:        "pipecd@example.com",
+		To:          []string{"oncall@example.com"},
+		Digest: &config.NotificationReceiverEmailDigest{
+			Interval: config.Duration(time.Hour),
+		},
+	}, "https://pipecd.dev", zap.NewNop())
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		sender.Run(ctx)
+		close(done)
+	}()
+
+	sender.Notify(makeDeploymentFailedEvent("app-a"))
+	sender.Notify(makeDeploymentFailedEvent("app-b"))
+	sender.Notify(makeDeploymentFailedEvent("app-a"))
+
+	// Nothing should be sent before the digest window ends.
+	select {
+	case <-server.mails:
+		t.Fatal("unexpected email was sent before flushing")
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	cancel()
+	<-done
+	sender.Close(context.Background())
+
+	mails := []string{server.receive(t), server.receive(t)}
+	var appA, appB string
+	for _, m := range mails {
+		switch {
+		case strings.Contains(m, `2 events for application "app-a"`):
+			appA = m
+		case strings.Contains(m, `Deployment for "app-b" was failed`):
+			appB = m
+		}
+	}
+	require.NotEmpty(t, appA)
+	require.NotEmpty(t, appB)
+	assert.Equal(t, 2, strings.Count(appA, "quota exceeded"))
+	assert.Equal(t, 1, strings.Count(appB, "quota exceeded"))
+}
+
+func TestFormatEmailMessage(t *testing.T) {
+	t.Parallel()
+
+	got := formatEmailMessage(eventMessage{
+		Title: "A piped has been started",
+		Link:  "https://pipecd.dev/settings/piped",
+		Fields: []eventMessageField{
+			{Title: "Name", Value: "piped"},
+			{Title: "Application", Value: "app", Link: "https://pipecd.dev/applications/app"},
+		},
+	})
+	want := "A piped has been started\n\nName: piped\nApplication: app (https://pipecd.dev/applications/app)\n\nOpen in PipeCD: https://pipecd.dev/settings/piped\n"
+	assert.Equal(t, want, got)
+
+	mail := string(makeEmail("from@example.com", []string{"a@example.com", "b@example.com"}, "subject", "line1\nline2", time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)))
+	assert.Contains(t, mail, "To: a@example.com, b@example.com\r\n")
+	assert.True(t, strings.HasSuffix(mail, "\r\n\r\nline1\r\nline2"))
+}
@@ -0,0 +1,313 @@
+// Copyright 2024 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package notifier
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/pipe-cd/pipecd/pkg/git"
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+type messageLevel int
+
+const (
+	messageLevelInfo messageLevel = iota
+	messageLevelSuccess
+	messageLevelWarn
+	messageLevelError
+)
+
+// eventMessage is a chat-tool agnostic representation of a notification event.
+// Senders other than slack render it into their own payload format.
+type eventMessage struct {
+	Title     string
+	Link      string
+	Text      string
+	Level     messageLevel
+	Fields    []eventMessageField
+	Timestamp time.Time
+	// Accounts and groups to be mentioned, including the ones
+	// configured in the application's DeploymentNotification.
+	MentionedAccounts []string
+	MentionedGroups   []string
+}
+
+type eventMessageField struct {
+	Title string
+	Value string
+	// Link is an optional URL for the value.
+	Link string
+}
+
+// buildEventMessage converts the given event into an eventMessage.
+// The given accounts and groups are appended to the ones attached to the event.
+// False is returned when the event type is not supported.
+func buildEventMessage(event model.NotificationEvent, webURL string, accounts, groups []string, logger *zap.Logger) (eventMessage, bool) {
+	msg := eventMessage{
+		Level:     messageLevelInfo,
+		Timestamp: time.Now(),
+	}
+
+	mention := func(eventAccounts, eventGroups []string) {
+		msg.MentionedAccounts = mergeMentions(eventAccounts, accounts)
+		msg.MentionedGroups = mergeMentions(eventGroups, groups)
+	}
+
+	generateDeploymentEventData := func(d *model.Deployment) {
+		msg.Link = fmt.Sprintf("%s/deployments/%s?project=%s", webURL, d.Id, d.ProjectId)
+		msg.Fields = []eventMessageField{
+			{Title: "Project", Value: truncateText(d.ProjectId, 8)},
+			{Title: "Application", Value: d.ApplicationName, Link: fmt.Sprintf("%s/applications/%s?project=%s", webURL, d.ApplicationId, d.ProjectId)},
+			{Title: "Kind", Value: strings.ToLower(d.Kind.String())},
+			{Title: "Deployment", Value: truncateText(d.Id, 8), Link: msg.Link},
+			{Title: "Triggered By", Value: d.TriggeredBy()},
+			{Title: "Started At", Value: time.Unix(d.CreatedAt, 0).UTC().Format(time.RFC1123)},
+		}
+	}
+
+	generateDeploymentEventDataForTriggerFailed := func(app *model.Application, hash string, commitMsg string) {
+		msg.Link = fmt.Sprintf("%s/applications/%s?project=%s", webURL, app.Id, app.ProjectId)
+		msg.Fields = []eventMessageField{
+			{Title: "Project", Value: truncateText(app.ProjectId, 8)},
+			{Title: "Application", Value: app.Name, Link: msg.Link},
+			{Title: "Kind", Value: strings.ToLower(app.Kind.String())},
+		}
+		commitURL, err := git.MakeCommitURL(app.GitPath.Repo.Remote, hash)
+		if err != nil {
+			logger.Error(fmt.Sprintf("failed to get the URL for the specified commit: %v", err))
+		}
+		if commitURL != "" {
+			msg.Fields = append(msg.Fields, eventMessageField{Title: "Commit", Value: truncateText(commitMsg, 8), Link: commitURL})
+		}
+	}
+
+	generatePipedEventData := func(id string, name string, version string, project string) {
+		msg.Link = fmt.Sprintf("%s/settings/piped?project=%s", webURL, project)
+		msg.Fields = []eventMessageField{
+			{Title: "Name", Value: name},
+			{Title: "Version", Value: version},
+			{Title: "Project", Value: truncateText(project, 8)},
+			{Title: "Id", Value: id},
+		}
+	}
+
+	generateStageEventData := func(d *model.Deployment, s *model.PipelineStage) {
+		msg.Link = fmt.Sprintf("%s/deployments/%s?project=%s", webURL, d.Id, d.ProjectId)
+		msg.Fields = []eventMessageField{
+			{Title: "Project", Value: truncateText(d.ProjectId, 8)},
+			{Title: "Application", Value: d.ApplicationName, Link: fmt.Sprintf("%s/applications/%s?project=%s", webURL, d.ApplicationId, d.ProjectId)},
+			{Title: "Kind", Value: strings.ToLower(d.Kind.String())},
+			{Title: "Deployment", Value: truncateText(d.Id, 8), Link: msg.Link},
+			{Title: "Stage", Value: s.Name},
+			{Title: "Triggered By", Value: d.TriggeredBy()},
+		}
+	}
+
+	switch event.Type {
+	case model.NotificationEventType_EVENT_DEPLOYMENT_TRIGGERED:
+		md := event.Metadata.(*model.NotificationEventDeploymentTriggered)
+		msg.Title = fmt.Sprintf("Triggered a new deployment for %q", md.Deployment.ApplicationName)
+		mention(md.MentionedAccounts, md.MentionedGroups)
+		generateDeploymentEventData(md.Deployment)
+
+	case model.NotificationEventType_EVENT_DEPLOYMENT_PLANNED:
+		md := event.Metadata.(*model.NotificationEventDeploymentPlanned)
+		msg.Title = fmt.Sprintf("Deployment for %q was planned", md.Deployment.ApplicationName)
+		msg.Text = md.Summary
+		mention(md.MentionedAccounts, md.MentionedGroups)
+		generateDeploymentEventData(md.Deployment)
+
+	case model.NotificationEventType_EVENT_DEPLOYMENT_STARTED:
+		md := event.Metadata.(*model.NotificationEventDeploymentStarted)
+		msg.Title = fmt.Sprintf("Deployment for %q was started", md.Deployment.ApplicationName)
+		mention(md.MentionedAccounts, md.MentionedGroups)
+		generateDeploymentEventData(md.Deployment)
+
+	case model.NotificationEventType_EVENT_DEPLOYMENT_WAIT_APPROVAL:
+		md := event.Metadata.(*model.NotificationEventDeploymentWaitApproval)
+		msg.Title = fmt.Sprintf("Deployment for %q is waiting for an approval", md.Deployment.ApplicationName)
+		mention(md.MentionedAccounts, md.MentionedGroups)
+		generateDeploymentEventData(md.Deployment)
+
+	case model.NotificationEventType_EVENT_DEPLOYMENT_APPROVED:
+		md := event.Metadata.(*model.NotificationEventDeploymentApproved)
+		msg.Title = fmt.Sprintf("Deployment for %q was approved", md.Deployment.ApplicationName)
+		msg.Text = fmt.Sprintf("Approved by %s", md.Approver)
+		mention(md.MentionedAccounts, md.MentionedGroups)
+		generateDeploymentEventData(md.Deployment)
+
+	case model.NotificationEventType_EVENT_DEPLOYMENT_BLOCKED:
+		md := event.Metadata.(*model.NotificationEventDeploymentBlocked)
+		msg.Title = fmt.Sprintf("Deployment for %q was blocked by deployment windows", md.Deployment.ApplicationName)
+		msg.Text = md.Reason
+		msg.Level = messageLevelWarn
+		mention(md.MentionedAccounts, md.MentionedGroups)
+		generateDeploymentEventData(md.Deployment)
+
+	case model.NotificationEventType_EVENT_DEPLOYMENT_REJECTED:
+		md := event.Metadata.(*model.NotificationEventDeploymentRejected)
+		msg.Title = fmt.Sprintf("Deployment for %q was rejected", md.Deployment.ApplicationName)
+		msg.Text = makeRejectedText(md.Rejecter, md.Comment)
+		msg.Level = messageLevelError
+		mention(md.MentionedAccounts, md.MentionedGroups)
+		generateDeploymentEventData(md.Deployment)
+
+	case model.NotificationEventType_EVENT_DEPLOYMENT_SUCCEEDED:
+		md := event.Metadata.(*model.NotificationEventDeploymentSucceeded)
+		msg.Title = fmt.Sprintf("Deployment for %q was completed successfully", md.Deployment.ApplicationName)
+		msg.Level = messageLevelSuccess
+		mention(md.MentionedAccounts, md.MentionedGroups)
+		generateDeploymentEventData(md.Deployment)
+
+	case model.NotificationEventType_EVENT_DEPLOYMENT_FAILED:
+		md := event.Metadata.(*model.NotificationEventDeploymentFailed)
+		msg.Title = fmt.Sprintf("Deployment for %q was failed", md.Deployment.ApplicationName)
+		msg.Text = md.Reason
+		msg.Level = messageLevelError
+		mention(md.MentionedAccounts, md.MentionedGroups)
+		generateDeploymentEventData(md.Deployment)
+
+	case model.NotificationEventType_EVENT_DEPLOYMENT_CANCELLED:
+		md := event.Metadata.(*model.NotificationEventDeploymentCancelled)
+		msg.Title = fmt.Sprintf("Deployment for %q was cancelled", md.Deployment.ApplicationName)
+		msg.Text = fmt.Sprintf("Cancelled by %s", md.Commander)
+		msg.Level = messageLevelWarn
+		mention(md.MentionedAccounts, md.MentionedGroups)
+		generateDeploymentEventData(md.Deployment)
+
+	case model.NotificationEventType_EVENT_DEPLOYMENT_TRIGGER_FAILED:
+		md := event.Metadata.(*model.NotificationEventDeploymentTriggerFailed)
+		msg.Title = fmt.Sprintf("Failed to trigger a new deployment for %s", md.Application.Name)
+		msg.Text = md.Reason
+		mention(md.MentionedAccounts, md.MentionedGroups)
+		generateDeploymentEventDataForTriggerFailed(md.Application, md.CommitHash, md.CommitMessage)
+
+	case model.NotificationEventType_EVENT_PIPED_STARTED:
+		md := event.Metadata.(*model.NotificationEventPipedStarted)
+		msg.Title = "A piped has been started"
+		mention(nil, nil)
+		generatePipedEventData(md.Id, md.Name, md.Version, md.ProjectId)
+
+	case model.NotificationEventType_EVENT_PIPED_STOPPED:
+		md := event.Metadata.(*model.NotificationEventPipedStopped)
+		msg.Title = "A piped has been stopped"
+		mention(nil, nil)
+		generatePipedEventData(md.Id, md.Name, md.Version, md.ProjectId)
+
+	case model.NotificationEventType_EVENT_STAGE_STARTED:
+		md := event.Metadata.(*model.NotificationEventStageStarted)
+		msg.Title = fmt.Sprintf("Stage %q was started", md.Stage.Name)
+		mention(nil, nil)
+		generateStageEventData(md.Deployment, md.Stage)
+
+	case model.NotificationEventType_EVENT_STAGE_SKIPPED:
+		md := event.Metadata.(*model.NotificationEventStageSkipped)
+		msg.Title = fmt.Sprintf("Stage %q was skipped", md.Stage.Name)
+		mention(nil, nil)
+		generateStageEventData(md.Deployment, md.Stage)
+
+	case model.NotificationEventType_EVENT_STAGE_SUCCEEDED:
+		md := event.Metadata.(*model.NotificationEventStageSucceeded)
+		msg.Title = fmt.Sprintf("Stage %q was completed successfully", md.Stage.Name)
+		msg.Level = messageLevelSuccess
+		mention(nil, nil)
+		generateStageEventData(md.Deployment, md.Stage)
+
+	case model.NotificationEventType_EVENT_STAGE_FAILED:
+		md := event.Metadata.(*model.NotificationEventStageFailed)
+		msg.Title = fmt.Sprintf("Stage %q was failed", md.Stage.Name)
+		msg.Text = md.Stage.StatusReason
+		msg.Level = messageLevelError
+		mention(nil, nil)
+		generateStageEventData(md.Deployment, md.Stage)
+
+	case model.NotificationEventType_EVENT_STAGE_CANCELLED:
+		md := event.Metadata.(*model.NotificationEventStageCancelled)
+		msg.Title = fmt.Sprintf("Stage %q was cancelled", md.Stage.Name)
+		msg.Level = messageLevelWarn
+		mention(nil, nil)
+		generateStageEventData(md.Deployment, md.Stage)
+
+	// TODO: Support application type of notification event.
+	default:
+		return eventMessage{}, false
+	}
+
+	return msg, true
+}
+
+// makeRejectedText returns the text describing who rejected the deployment and why.
+func makeRejectedText(rejecter, comment string) string {
+	if comment == "" {
+		return fmt.Sprintf("Rejected by %s", rejecter)
+	}
+	return fmt.Sprintf("Rejected by %s: %s", rejecter, comment)
+}
+
+// mergeMentions returns a new slice containing the unique, non-empty values of both given slices.
+func mergeMentions(a, b []string) []string {
+	if len(a)+len(b) == 0 {
+		return nil
+	}
+	out := make([]string, 0, len(a)+len(b))
+	seen := make(map[string]struct{}, len(a)+len(b))
+	for _, v := range append(append([]string{}, a...), b...) {
+		if v == "" {
+			continue
+		}
+		if _, ok := seen[v]; ok {
+			continue
+		}
+		seen[v] = struct{}{}
+		out = append(out, v)
+	}
+	return out
+}
+
+// postJSON sends the given payload as a JSON body to the given URL.
+func postJSON(ctx context.Context, client *http.Client, url string, payload interface{}) error {
+	buf := &bytes.Buffer{}
+	if err := json.NewEncoder(buf).Encode(payload); err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", url, buf)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 1024*1024))
+		return fmt.Errorf("%s: %s", resp.Status, strings.TrimSpace(string(body)))
+	}
+	return nil
+}
@@ -0,0 +1,116 @@
+// Copyright 2024 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package notifier
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+func TestBuildEventMessage(t *testing.T) {
+	t.Parallel()
+
+	deployment := &model.Deployment{
+		Id:              "deployment-id",
+		ApplicationId:   "app-id",
+		ApplicationName: "app-name",
+		ProjectId:       "project",
+		Kind:            model.ApplicationKind_KUBERNETES,
+		Trigger: &model.DeploymentTrigger{
+			Commit: &model.Commit{Author: "alice"},
+		},
+	}
+
+	testcases := []struct {
+		name     string
+		event    model.NotificationEvent
+		accounts []string
+		groups   []string
+		want     eventMessage
+		wantOK   bool
+	}{
+		{
+			name: "deployment failed with mentions from both event and receiver",
+			event: model.NotificationEvent{
+				Type: model.NotificationEventType_EVENT_DEPLOYMENT_FAILED,
+				Metadata: &model.NotificationEventDeploymentFailed{
+					Deployment:        deployment,
+					Reason:            "failed to apply",
+					MentionedAccounts: []string{"alice", "bob"},
+					MentionedGroups:   []string{"team"},
+				},
+			},
+			accounts: []string{"bob", "carol"},
+			want: eventMessage{
+				Title:             "Deployment for \"app-name\" was failed",
+				Link:              "https://pipecd.dev/deployments/deployment-id?project=project",
+				Text:              "failed to apply",
+				Level:             messageLevelError,
+				MentionedAccounts: []string{"alice", "bob", "carol"},
+				MentionedGroups:   []string{"team"},
+			},
+			wantOK: true,
+		},
+		{
+			name: "piped started",
+			event: model.NotificationEvent{
+				Type: model.NotificationEventType_EVENT_PIPED_STARTED,
+				Metadata: &model.NotificationEventPipedStarted{
+					Id:        "piped-id",
+					Name:      "piped",
+					ProjectId: "project",
+				},
+			},
+			groups: []string{"team"},
+			want: eventMessage{
+				Title:           "A piped has been started",
+				Link:            "https://pipecd.dev/settings/piped?project=project",
+				Level:           messageLevelInfo,
+				MentionedGroups: []string{"team"},
+			},
+			wantOK: true,
+		},
+		{
+			name: "unsupported event",
+			event: model.NotificationEvent{
+				Type: model.NotificationEventType_EVENT_APPLICATION_SYNCED,
+			},
+			wantOK: false,
+		},
+	}
+
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			got, ok := buildEventMessage(tc.event, "https://pipecd.dev", tc.accounts, tc.groups, zap.NewNop())
+			assert.Equal(t, tc.wantOK, ok)
+			if !ok {
+				return
+			}
+			assert.Equal(t, tc.want.Title, got.Title)
+			assert.Equal(t, tc.want.Link, got.Link)
+			assert.Equal(t, tc.want.Text, got.Text)
+			assert.Equal(t, tc.want.Level, got.Level)
+			assert.Equal(t, tc.want.MentionedAccounts, got.MentionedAccounts)
+			assert.Equal(t, tc.want.MentionedGroups, got.MentionedGroups)
+			assert.NotEmpty(t, got.Fields)
+		})
+	}
+}
@@ -73,6 +73,24 @@ func NewNotifier(cfg *config.PipedSpec, logger *zap.Logger) (*Notifier, error) {
 			sd = slacksender
 		case receiver.Webhook != nil:
 			sd = newWebhookSender(receiver.Name, *receiver.Webhook, cfg.WebAddress, logger)
+		case receiver.Teams != nil:
+			teamssender, err := newTeamsSender(receiver.Name, *receiver.Teams, cfg.WebAddress, logger)
+			if err != nil {
+				return nil, fmt.Errorf("failed to create teams sender: %w", err)
+			}
+			sd = teamssender
+		case receiver.Discord != nil:
+			discordsender, err := newDiscordSender(receiver.Name, *receiver.Discord, cfg.WebAddress, logger)
+			if err != nil {
+				return nil, fmt.Errorf("failed to create discord sender: %w", err)
+			}
+			sd = discordsender
+		case receiver.Email != nil:
+			emailsender, err := newEmailSender(receiver.Name, *receiver.Email, cfg.WebAddress, logger)
+			if err != nil {
+				return nil, fmt.Errorf("failed to create email sender: %w", err)
+			}
+			sd = emailsender
 		default:
 			continue
 		}
@@ -179,6 +179,16 @@ func (s *slack) sendMessageViaAPI(ctx context.Context, msg slackMessage) error {
 				Short: f.Short,
 			})
 		}
+		attachmentActions := make([]slackgo.AttachmentAction, 0, len(a.Actions))
+		for _, act := range a.Actions {
+			attachmentActions = append(attachmentActions, slackgo.AttachmentAction{
+				Name:  act.Name,
+				Text:  act.Text,
+				Type:  slackgo.ActionType(act.Type),
+				Value: act.Value,
+				Style: act.Style,
+			})
+		}
 		attachments = append(attachments, slackgo.Attachment{
 			Title:      a.Title,
 			TitleLink:  a.TitleLink,
@@ -187,6 +197,8 @@ func (s *slack) sendMessageViaAPI(ctx context.Context, msg slackMessage) error {
 			Color:      a.Color,
 			MarkdownIn: a.Markdown,
 			Ts:         json.Number(fmt.Sprint(a.Timestamp)),
+			CallbackID: a.CallbackID,
+			Actions:    attachmentActions,
 		})
 	}
 
@@ -203,6 +215,7 @@ func (s *slack) buildSlackMessage(event model.NotificationEvent, webURL string)
 		color             = slackInfoColor
 		timestamp         = time.Now().Unix()
 		fields            []slackField
+		actions           []slackAction
 	)
 
 	generateDeploymentEventData := func(d *model.Deployment, accounts []string, groups []string) {
@@ -300,6 +313,9 @@ func (s *slack) buildSlackMessage(event model.NotificationEvent, webURL string)
 		md.MentionedGroups = append(md.MentionedGroups, s.config.MentionedGroups...)
 		title = fmt.Sprintf("Deployment for %q is waiting for an approval", md.Deployment.ApplicationName)
 		generateDeploymentEventData(md.Deployment, md.MentionedAccounts, md.MentionedGroups)
+		if s.config.InteractiveApproval && md.StageId != "" {
+			actions = makeSlackApprovalActions(md.Deployment.Id, md.StageId)
+		}
 
 	case model.NotificationEventType_EVENT_DEPLOYMENT_APPROVED:
 		md := event.Metadata.(*model.NotificationEventDeploymentApproved)
@@ -309,6 +325,24 @@ func (s *slack) buildSlackMessage(event model.NotificationEvent, webURL string)
 		text = fmt.Sprintf("Approved by %s", md.Approver)
 		generateDeploymentEventData(md.Deployment, md.MentionedAccounts, md.MentionedGroups)
 
+	case model.NotificationEventType_EVENT_DEPLOYMENT_BLOCKED:
+		md := event.Metadata.(*model.NotificationEventDeploymentBlocked)
+		md.MentionedAccounts = append(md.MentionedAccounts, s.config.MentionedAccounts...)
+		md.MentionedGroups = append(md.MentionedGroups, s.config.MentionedGroups...)
+		title = fmt.Sprintf("Deployment for %q was blocked by deployment windows", md.Deployment.ApplicationName)
+		text = md.Reason
+		color = slackWarnColor
+		generateDeploymentEventData(md.Deployment, md.MentionedAccounts, md.MentionedGroups)
+
+	case model.NotificationEventType_EVENT_DEPLOYMENT_REJECTED:
+		md := event.Metadata.(*model.NotificationEventDeploymentRejected)
+		md.MentionedAccounts = append(md.MentionedAccounts, s.config.MentionedAccounts...)
+		md.MentionedGroups = append(md.MentionedGroups, s.config.MentionedGroups...)
+		title = fmt.Sprintf("Deployment for %q was rejected", md.Deployment.ApplicationName)
+		text = makeRejectedText(md.Rejecter, md.Comment)
+		color = slackErrorColor
+		generateDeploymentEventData(md.Deployment, md.MentionedAccounts, md.MentionedGroups)
+
 	case model.NotificationEventType_EVENT_DEPLOYMENT_SUCCEEDED:
 		md := event.Metadata.(*model.NotificationEventDeploymentSucceeded)
 		md.MentionedAccounts = append(md.MentionedAccounts, s.config.MentionedAccounts...)
@@ -387,7 +421,12 @@ func (s *slack) buildSlackMessage(event model.NotificationEvent, webURL string)
 		return slackMessage{}, false
 	}
 
-	return makeSlackMessage(title, link, text, color, timestamp, fields...), true
+	msg := makeSlackMessage(title, link, text, color, timestamp, fields...)
+	if len(actions) > 0 {
+		msg.Attachments[0].CallbackID = model.StageApprovalCallbackID
+		msg.Attachments[0].Actions = actions
+	}
+	return msg, true
 }
 
 type slackMessage struct {
@@ -403,6 +442,17 @@ type slackAttachment struct {
 	Color     string       `json:"color,omitempty"`
 	Markdown  []string     `json:"mrkdwn_in,omitempty"`
 	Timestamp int64        `json:"ts,omitempty"`
+	// CallbackID and Actions are used to make the message interactive.
+	CallbackID string        `json:"callback_id,omitempty"`
+	Actions    []slackAction `json:"actions,omitempty"`
+}
+
+type slackAction struct {
+	Name  string `json:"name"`
+	Text  string `json:"text"`
+	Type  string `json:"type"`
+	Value string `json:"value"`
+	Style string `json:"style,omitempty"`
 }
 
 type slackField struct {
@@ -411,6 +461,25 @@ type slackField struct {
 	Short bool   `json:"short"`
 }
 
+func makeSlackApprovalActions(deploymentID, stageID string) []slackAction {
+	return []slackAction{
+		{
+			Name:  model.StageApprovalActionApprove,
+			Text:  "Approve",
+			Type:  "button",
+			Value: model.MakeStageApprovalValue(deploymentID, stageID),
+			Style: "primary",
+		},
+		{
+			Name:  model.StageApprovalActionReject,
+			Text:  "Reject",
+			Type:  "button",
+			Value: model.MakeStageApprovalValue(deploymentID, stageID),
+			Style: "danger",
+		},
+	}
+}
+
 func makeSlackLink(title, url string) string {
 	return fmt.Sprintf("<%s|%s>", url, title)
 }
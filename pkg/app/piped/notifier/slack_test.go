@@ -14,7 +14,16 @@
 
 package notifier
 
-import "testing"
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/pipe-cd/pipecd/pkg/config"
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
 
 func Test_getAccountsAsString(t *testing.T) {
 	t.Parallel()
@@ -90,3 +99,61 @@ func Test_getGroupsAsString(t *testing.T) {
 		})
 	}
 }
+
+func TestBuildSlackMessageWithApprovalActions(t *testing.T) {
+	t.Parallel()
+
+	event := model.NotificationEvent{
+		Type: model.NotificationEventType_EVENT_DEPLOYMENT_WAIT_APPROVAL,
+		Metadata: &model.NotificationEventDeploymentWaitApproval{
+			Deployment: &model.Deployment{
+				Id:              "deployment-id",
+				ApplicationName: "app",
+				Trigger: &model.DeploymentTrigger{
+					Commit: &model.Commit{},
+				},
+			},
+			StageId: "stage-id",
+		},
+	}
+
+	tests := []struct {
+		name        string
+		interactive bool
+		wantActions int
+	}{
+		{
+			name:        "interactive approval is disabled",
+			interactive: false,
+			wantActions: 0,
+		},
+		{
+			name:        "interactive approval is enabled",
+			interactive: true,
+			wantActions: 2,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			s := &slack{
+				config: config.NotificationReceiverSlack{InteractiveApproval: tt.interactive},
+				logger: zap.NewNop(),
+			}
+			msg, ok := s.buildSlackMessage(event, "https://pipecd.dev")
+			require.True(t, ok)
+			require.Len(t, msg.Attachments, 1)
+			require.Len(t, msg.Attachments[0].Actions, tt.wantActions)
+			if tt.wantActions == 0 {
+				assert.Empty(t, msg.Attachments[0].CallbackID)
+				return
+			}
+			assert.Equal(t, model.StageApprovalCallbackID, msg.Attachments[0].CallbackID)
+			assert.Equal(t, model.StageApprovalActionApprove, msg.Attachments[0].Actions[0].Name)
+			assert.Equal(t, "deployment-id:stage-id", msg.Attachments[0].Actions[0].Value)
+			assert.Equal(t, model.StageApprovalActionReject, msg.Attachments[0].Actions[1].Name)
+			assert.Equal(t, "deployment-id:stage-id", msg.Attachments[0].Actions[1].Value)
+		})
+	}
+}
@@ -0,0 +1,278 @@
+// Copyright 2024 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package notifier
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/pipe-cd/pipecd/pkg/config"
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+const (
+	teamsAdaptiveCardContentType = "application/vnd.microsoft.card.adaptive"
+	teamsAdaptiveCardSchema      = "http://adaptivecards.io/schemas/adaptive-card.json"
+	teamsAdaptiveCardVersion     = "1.4"
+)
+
+type teams struct {
+	name       string
+	config     config.NotificationReceiverTeams
+	hookURL    string
+	webURL     string
+	httpClient *http.Client
+	eventCh    chan model.NotificationEvent
+	logger     *zap.Logger
+}
+
+func newTeamsSender(name string, cfg config.NotificationReceiverTeams, webURL string, logger *zap.Logger) (*teams, error) {
+	hookURL, err := cfg.LoadHookURL()
+	if err != nil {
+		return nil, fmt.Errorf("failed to load the hook URL: %w", err)
+	}
+	return &teams{
+		name:    name,
+		config:  cfg,
+		hookURL: hookURL,
+		webURL:  strings.TrimRight(webURL, "/"),
+		httpClient: &http.Client{
+			Timeout: 5 * time.Second,
+		},
+		eventCh: make(chan model.NotificationEvent, 100),
+		logger:  logger.Named("teams").With(zap.String("name", name)),
+	}, nil
+}
+
+func (t *teams) Run(ctx context.Context) error {
+	for {
+		select {
+		case event, ok := <-t.eventCh:
+			if ok {
+				t.sendEvent(ctx, event)
+			}
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
+
+func (t *teams) Notify(event model.NotificationEvent) {
+	t.eventCh <- event
+}
+
+func (t *teams) Close(ctx context.Context) {
+	close(t.eventCh)
+
+	// Send all remaining events.
+	for {
+		select {
+		case event, ok := <-t.eventCh:
+			if !ok {
+				return
+			}
+			t.sendEvent(ctx, event)
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func (t *teams) sendEvent(ctx context.Context, event model.NotificationEvent) {
+	msg, ok := buildEventMessage(event, t.webURL, t.config.MentionedAccounts, t.config.MentionedGroups, t.logger)
+	if !ok {
+		t.logger.Info(fmt.Sprintf("ignore event %s", event.Type.String()))
+		return
+	}
+	if err := postJSON(ctx, t.httpClient, t.hookURL, makeTeamsMessage(msg)); err != nil {
+		t.logger.Error(fmt.Sprintf("unable to send notification to teams: %v", err))
+	}
+}
+
+type teamsMessage struct {
+	Type        string            `json:"type"`
+	Attachments []teamsAttachment `json:"attachments"`
+}
+
+type teamsAttachment struct {
+	ContentType string            `json:"contentType"`
+	Content     teamsAdaptiveCard `json:"content"`
+}
+
+type teamsAdaptiveCard struct {
+	Schema  string               `json:"$schema"`
+	Type    string               `json:"type"`
+	Version string               `json:"version"`
+	Body    []teamsCardElement   `json:"body"`
+	Actions []teamsCardAction    `json:"actions,omitempty"`
+	MSTeams teamsCardTeamsConfig `json:"msteams"`
+}
+
+type teamsCardElement struct {
+	Type   string          `json:"type"`
+	Text   string          `json:"text,omitempty"`
+	Size   string          `json:"size,omitempty"`
+	Weight string          `json:"weight,omitempty"`
+	Color  string          `json:"color,omitempty"`
+	Wrap   bool            `json:"wrap,omitempty"`
+	Facts  []teamsCardFact `json:"facts,omitempty"`
+}
+
+type teamsCardFact struct {
+	Title string `json:"title"`
+	Value string `json:"value"`
+}
+
+type teamsCardAction struct {
+	Type  string `json:"type"`
+	Title string `json:"title"`
+	URL   string `json:"url"`
+}
+
+type teamsCardTeamsConfig struct {
+	Width    string             `json:"width"`
+	Entities []teamsCardMention `json:"entities,omitempty"`
+}
+
+type teamsCardMention struct {
+	Type      string                 `json:"type"`
+	Text      string                 `json:"text"`
+	Mentioned teamsCardMentionTarget `json:"mentioned"`
+}
+
+type teamsCardMentionTarget struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+	// Empty for a user, "tag" for a team tag.
+	Type string `json:"type,omitempty"`
+}
+
+func makeTeamsMessage(msg eventMessage) teamsMessage {
+	body := []teamsCardElement{
+		{
+			Type:   "TextBlock",
+			Text:   msg.Title,
+			Size:   "Medium",
+			Weight: "Bolder",
+			Color:  teamsColor(msg.Level),
+			Wrap:   true,
+		},
+	}
+	if msg.Text != "" {
+		body = append(body, teamsCardElement{
+			Type: "TextBlock",
+			Text: msg.Text,
+			Wrap: true,
+		})
+	}
+
+	facts := make([]teamsCardFact, 0, len(msg.Fields))
+	for _, f := range msg.Fields {
+		value := f.Value
+		if f.Link != "" {
+			value = fmt.Sprintf("[%s](%s)", f.Value, f.Link)
+		}
+		facts = append(facts, teamsCardFact{Title: f.Title, Value: value})
+	}
+	if len(facts) > 0 {
+		body = append(body, teamsCardElement{
+			Type:  "FactSet",
+			Facts: facts,
+		})
+	}
+
+	// Teams only accepts mentions via the entities field,
+	// each mention has to be referenced by an <at> tag in the card body.
+	// Groups are mentioned as team tags.
+	mentions := make([]teamsCardMention, 0, len(msg.MentionedAccounts)+len(msg.MentionedGroups))
+	tags := make([]string, 0, len(msg.MentionedAccounts)+len(msg.MentionedGroups))
+	for _, a := range msg.MentionedAccounts {
+		tag := fmt.Sprintf("<at>%s</at>", a)
+		tags = append(tags, tag)
+		mentions = append(mentions, teamsCardMention{
+			Type: "mention",
+			Text: tag,
+			Mentioned: teamsCardMentionTarget{
+				ID:   a,
+				Name: a,
+			},
+		})
+	}
+	for _, g := range msg.MentionedGroups {
+		tag := fmt.Sprintf("<at>%s</at>", g)
+		tags = append(tags, tag)
+		mentions = append(mentions, teamsCardMention{
+			Type: "mention",
+			Text: tag,
+			Mentioned: teamsCardMentionTarget{
+				ID:   g,
+				Name: g,
+				Type: "tag",
+			},
+		})
+	}
+	if len(tags) > 0 {
+		body = append(body, teamsCardElement{
+			Type: "TextBlock",
+			Text: strings.Join(tags, " "),
+			Wrap: true,
+		})
+	}
+
+	var actions []teamsCardAction
+	if msg.Link != "" {
+		actions = append(actions, teamsCardAction{
+			Type:  "Action.OpenUrl",
+			Title: "Open in PipeCD",
+			URL:   msg.Link,
+		})
+	}
+
+	return teamsMessage{
+		Type: "message",
+		Attachments: []teamsAttachment{{
+			ContentType: teamsAdaptiveCardContentType,
+			Content: teamsAdaptiveCard{
+				Schema:  teamsAdaptiveCardSchema,
+				Type:    "AdaptiveCard",
+				Version: teamsAdaptiveCardVersion,
+				Body:    body,
+				Actions: actions,
+				MSTeams: teamsCardTeamsConfig{
+					Width:    "Full",
+					Entities: mentions,
+				},
+			},
+		}},
+	}
+}
+
+func teamsColor(level messageLevel) string {
+	switch level {
+	case messageLevelSuccess:
+		return "Good"
+	case messageLevelWarn:
+		return "Warning"
+	case messageLevelError:
+		return "Attention"
+	default:
+		return "Default"
+	}
+}
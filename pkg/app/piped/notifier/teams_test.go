@@ -0,0 +1,65 @@
+// Copyright 2024 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package notifier
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMakeTeamsMessage(t *testing.T) {
+	t.Parallel()
+
+	msg := eventMessage{
+		Title: "Deployment for \"app\" is waiting for an approval",
+		Link:  "https://pipecd.dev/deployments/id",
+		Level: messageLevelInfo,
+		Fields: []eventMessageField{
+			{Title: "Project", Value: "project"},
+			{Title: "Application", Value: "app", Link: "https://pipecd.dev/applications/id"},
+		},
+		MentionedAccounts: []string{"alice@pipecd.dev"},
+		MentionedGroups:   []string{"tag-id"},
+	}
+
+	got := makeTeamsMessage(msg)
+	assert.Equal(t, "message", got.Type)
+	require.Len(t, got.Attachments, 1)
+	assert.Equal(t, teamsAdaptiveCardContentType, got.Attachments[0].ContentType)
+
+	card := got.Attachments[0].Content
+	require.Len(t, card.Body, 3)
+	assert.Equal(t, msg.Title, card.Body[0].Text)
+	assert.Equal(t, []teamsCardFact{
+		{Title: "Project", Value: "project"},
+		{Title: "Application", Value: "[app](https://pipecd.dev/applications/id)"},
+	}, card.Body[1].Facts)
+	assert.Equal(t, "<at>alice@pipecd.dev</at> <at>tag-id</at>", card.Body[2].Text)
+	assert.Equal(t, []teamsCardMention{
+		{
+			Type:      "mention",
+			Text:      "<at>alice@pipecd.dev</at>",
+			Mentioned: teamsCardMentionTarget{ID: "alice@pipecd.dev", Name: "alice@pipecd.dev"},
+		},
+		{
+			Type:      "mention",
+			Text:      "<at>tag-id</at>",
+			Mentioned: teamsCardMentionTarget{ID: "tag-id", Name: "tag-id", Type: "tag"},
+		},
+	}, card.MSTeams.Entities)
+	assert.Equal(t, []teamsCardAction{{Type: "Action.OpenUrl", Title: "Open in PipeCD", URL: msg.Link}}, card.Actions)
+}
@@ -59,6 +59,9 @@ func Diff(old, new Manifest, logger *zap.Logger, opts ...diff.Option) (*diff.Res
 		}
 	}
 
+	// Ignore the fields which are managed by other controllers in the live manifest.
+	old.u, new.u = removeFieldsManagedByOthers(old.u, new.u)
+
 	key := old.Key.String()
 
 	normalizedOld, err := remarshal(old.u)
@@ -381,6 +381,183 @@ spec:
 			diffNum:       0,
 			falsePositive: false,
 		},
+		{
+			name: "Deployment no diff on fields managed by others",
+			manifests: `apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: helloworld
+  managedFields:
+  - manager: kubectl-client-side-apply
+    operation: Update
+    apiVersion: apps/v1
+    fieldsType: FieldsV1
+    fieldsV1:
+      f:spec:
+        f:selector: {}
+        f:template:
+          f:metadata:
+            f:labels:
+              f:app: {}
+          f:spec:
+            f:containers:
+              k:{"name":"helloworld"}:
+                .: {}
+                f:image: {}
+                f:name: {}
+  - manager: kube-controller-manager
+    operation: Update
+    apiVersion: apps/v1
+    fieldsType: FieldsV1
+    fieldsV1:
+      f:spec:
+        f:replicas: {}
+  - manager: sidecar-injector
+    operation: Update
+    apiVersion: apps/v1
+    fieldsType: FieldsV1
+    fieldsV1:
+      f:spec:
+        f:template:
+          f:spec:
+            f:containers:
+              k:{"name":"helloworld"}:
+                f:env:
+                  k:{"name":"INJECTED"}:
+                    .: {}
+                    f:name: {}
+                    f:value: {}
+spec:
+  replicas: 5
+  selector:
+    matchLabels:
+      app: helloworld
+  template:
+    metadata:
+      labels:
+        app: helloworld
+    spec:
+      containers:
+      - name: helloworld
+        image: gcr.io/pipecd/helloworld:v0.1.0
+        env:
+        - name: INJECTED
+          value: "true"
+---
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: helloworld
+spec:
+  replicas: 2
+  selector:
+    matchLabels:
+      app: helloworld
+  template:
+    metadata:
+      labels:
+        app: helloworld
+    spec:
+      containers:
+      - name: helloworld
+        image: gcr.io/pipecd/helloworld:v0.1.0
+`,
+			expected: "",
+			diffNum:  0,
+		},
+		{
+			name: "Deployment has diff on fields managed by kubectl",
+			manifests: `apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: helloworld
+  managedFields:
+  - manager: kubectl-edit
+    operation: Update
+    apiVersion: apps/v1
+    fieldsType: FieldsV1
+    fieldsV1:
+      f:spec:
+        f:selector: {}
+        f:template:
+          f:metadata:
+            f:labels:
+              f:app: {}
+          f:spec:
+            f:containers:
+              k:{"name":"helloworld"}:
+                .: {}
+                f:image: {}
+                f:name: {}
+  - manager: kube-controller-manager
+    operation: Update
+    apiVersion: apps/v1
+    fieldsType: FieldsV1
+    fieldsV1:
+      f:spec:
+        f:replicas: {}
+  - manager: sidecar-injector
+    operation: Update
+    apiVersion: apps/v1
+    fieldsType: FieldsV1
+    fieldsV1:
+      f:spec:
+        f:template:
+          f:spec:
+            f:containers:
+              k:{"name":"helloworld"}:
+                f:env:
+                  k:{"name":"INJECTED"}:
+                    .: {}
+                    f:name: {}
+                    f:value: {}
+spec:
+  replicas: 5
+  selector:
+    matchLabels:
+      app: helloworld
+  template:
+    metadata:
+      labels:
+        app: helloworld
+    spec:
+      containers:
+      - name: helloworld
+        image: gcr.io/pipecd/helloworld:v0.2.0
+        env:
+        - name: INJECTED
+          value: "true"
+---
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: helloworld
+spec:
+  replicas: 2
+  selector:
+    matchLabels:
+      app: helloworld
+  template:
+    metadata:
+      labels:
+        app: helloworld
+    spec:
+      containers:
+      - name: helloworld
+        image: gcr.io/pipecd/helloworld:v0.1.0
+`,
+			expected: `  spec:
+    template:
+      spec:
+        containers:
+          -
+            #spec.template.spec.containers.0.image
+-           image: gcr.io/pipecd/helloworld:v0.2.0
++           image: gcr.io/pipecd/helloworld:v0.1.0
+
+`,
+			diffNum: 1,
+		},
 	}
 
 	for _, tc := range testcases {
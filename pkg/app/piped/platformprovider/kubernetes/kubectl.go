@@ -47,6 +47,22 @@ func NewKubectl(version, path string) *Kubectl {
 	}
 }
 
+// serverSideApplyArgs returns the kubectl apply flags configured via the annotations of the manifest.
+func serverSideApplyArgs(annotations map[string]string) []string {
+	args := make([]string, 0, 3)
+	serverSide := annotations[LabelServerSideApply] == UseServerSideApply
+	if serverSide {
+		args = append(args, "--server-side")
+	}
+	if manager := annotations[AnnotationFieldManager]; manager != "" {
+		args = append(args, "--field-manager", manager)
+	}
+	if serverSide && annotations[AnnotationForceConflicts] == ForceConflictsTrue {
+		args = append(args, "--force-conflicts")
+	}
+	return args
+}
+
 func (c *Kubectl) Apply(ctx context.Context, kubeconfig, namespace string, manifest Manifest) (err error) {
 	defer func() {
 		kubernetesmetrics.IncKubectlCallsCounter(
@@ -70,9 +86,7 @@ func (c *Kubectl) Apply(ctx context.Context, kubeconfig, namespace string, manif
 	}
 
 	args = append(args, "apply")
-	if annotation := manifest.GetAnnotations()[LabelServerSideApply]; annotation == UseServerSideApply {
-		args = append(args, "--server-side")
-	}
+	args = append(args, serverSideApplyArgs(manifest.GetAnnotations())...)
 	args = append(args, "-f", "-")
 
 	cmd := exec.CommandContext(ctx, c.execPath, args...)
@@ -35,11 +35,14 @@ const (
 	LabelServerSideApply      = "pipecd.dev/server-side-apply"      // Use server side apply instead of client side apply.
 	AnnotationConfigHash      = "pipecd.dev/config-hash"            // The hash value of all mouting config resources.
 	AnnotationOrder           = "pipecd.dev/order"                  // The order number of resource used to sort them before using.
+	AnnotationFieldManager    = "pipecd.dev/field-manager"          // The field manager name used by server side apply.
+	AnnotationForceConflicts  = "pipecd.dev/force-conflicts"        // Whether to force the conflicts with other field managers on server side apply.
 
 	ManagedByPiped           = "piped"
 	IgnoreDriftDetectionTrue = "true"
 	UseReplaceEnabled        = "enabled"
 	UseServerSideApply       = "true"
+	ForceConflictsTrue       = "true"
 
 	kustomizationFileName = "kustomization.yaml"
 )
@@ -0,0 +1,163 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kubernetes
+
+import (
+	"bytes"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/structured-merge-diff/v4/fieldpath"
+	"sigs.k8s.io/structured-merge-diff/v4/value"
+)
+
+// kubectlFieldManagerPrefix is the prefix of the field managers used by kubectl,
+// e.g. kubectl-client-side-apply, kubectl-replace or kubectl when using server-side apply.
+const kubectlFieldManagerPrefix = "kubectl"
+
+// isPipedFieldManager reports whether the given field manager is used to apply the manifests by piped.
+// The changes made by kubectl such as kubectl edit are also treated as piped's ones
+// to detect the changes made manually.
+func isPipedFieldManager(manager, configuredManager string) bool {
+	if configuredManager != "" && manager == configuredManager {
+		return true
+	}
+	return strings.HasPrefix(manager, kubectlFieldManagerPrefix)
+}
+
+// fieldsManagedByOthers returns the paths of the fields in the given live object
+// which are owned only by the field managers other than piped's ones,
+// e.g. spec.replicas updated by HorizontalPodAutoscaler.
+func fieldsManagedByOthers(live *unstructured.Unstructured) []fieldpath.Path {
+	var (
+		configuredManager = live.GetAnnotations()[AnnotationFieldManager]
+		pipedFields       = &fieldpath.Set{}
+		otherFields       = &fieldpath.Set{}
+	)
+	for _, f := range live.GetManagedFields() {
+		if f.FieldsV1 == nil {
+			continue
+		}
+		fields := &fieldpath.Set{}
+		if err := fields.FromJSON(bytes.NewReader(f.FieldsV1.Raw)); err != nil {
+			continue
+		}
+		if isPipedFieldManager(f.Manager, configuredManager) {
+			pipedFields = pipedFields.Union(fields)
+		} else {
+			otherFields = otherFields.Union(fields)
+		}
+	}
+
+	var paths []fieldpath.Path
+	otherFields.Difference(pipedFields).Iterate(func(p fieldpath.Path) {
+		// Keep the field when piped owns some of its children,
+		// e.g. a list item whose existence is recorded by both.
+		if hasFieldsUnder(pipedFields, p) {
+			return
+		}
+		paths = append(paths, p.Copy())
+	})
+	return paths
+}
+
+// hasFieldsUnder reports whether the given set contains any field under the given path.
+func hasFieldsUnder(s *fieldpath.Set, p fieldpath.Path) bool {
+	for _, pe := range p {
+		s = s.WithPrefix(pe)
+	}
+	return !s.Empty()
+}
+
+// removeFieldsManagedByOthers returns the copies of the given live and desired objects
+// removing the fields which are owned only by the field managers other than piped's ones
+// in the live object, so that the changes made by other controllers are not treated as drifts.
+// The given objects are returned as they are when there is no such field.
+func removeFieldsManagedByOthers(live, desired *unstructured.Unstructured) (*unstructured.Unstructured, *unstructured.Unstructured) {
+	paths := fieldsManagedByOthers(live)
+	if len(paths) == 0 {
+		return live, desired
+	}
+
+	live, desired = live.DeepCopy(), desired.DeepCopy()
+	for _, p := range paths {
+		removeField(live.Object, p)
+		removeField(desired.Object, p)
+	}
+	return live, desired
+}
+
+// removeField removes the field at the given path from the given object and returns the updated object.
+func removeField(obj interface{}, path fieldpath.Path) interface{} {
+	if len(path) == 0 {
+		return obj
+	}
+
+	pe := path[0]
+	if pe.FieldName != nil {
+		m, ok := obj.(map[string]interface{})
+		if !ok {
+			return obj
+		}
+		if len(path) == 1 {
+			delete(m, *pe.FieldName)
+			return m
+		}
+		if child, ok := m[*pe.FieldName]; ok {
+			m[*pe.FieldName] = removeField(child, path[1:])
+		}
+		return m
+	}
+
+	l, ok := obj.([]interface{})
+	if !ok {
+		return obj
+	}
+	for i, item := range l {
+		if !matchListItem(pe, i, item) {
+			continue
+		}
+		if len(path) == 1 {
+			return append(l[:i:i], l[i+1:]...)
+		}
+		l[i] = removeField(item, path[1:])
+		return l
+	}
+	return l
+}
+
+// matchListItem reports whether the given list item is the one selected by the given path element.
+func matchListItem(pe fieldpath.PathElement, index int, item interface{}) bool {
+	switch {
+	case pe.Key != nil:
+		m, ok := item.(map[string]interface{})
+		if !ok {
+			return false
+		}
+		for _, f := range *pe.Key {
+			v, ok := m[f.Name]
+			if !ok || !value.Equals(f.Value, value.NewValueInterface(v)) {
+				return false
+			}
+		}
+		return true
+	case pe.Value != nil:
+		return value.Equals(*pe.Value, value.NewValueInterface(item))
+	case pe.Index != nil:
+		return *pe.Index == index
+	default:
+		return false
+	}
+}
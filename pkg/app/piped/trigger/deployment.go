@@ -51,6 +51,7 @@ func buildDeployment(
 	noti *config.DeploymentNotification,
 	deploymentChainID string,
 	deploymentChainBlockIndex uint32,
+	overrideDeploymentWindows bool,
 ) (*model.Deployment, error) {
 
 	var commitURL string
@@ -87,10 +88,11 @@ func buildDeployment(
 				Url:       commitURL,
 				CreatedAt: int64(commit.CreatedAt),
 			},
-			Commander:       commander,
-			Timestamp:       now.Unix(),
-			SyncStrategy:    syncStrategy,
-			StrategySummary: strategySummary,
+			Commander:                 commander,
+			Timestamp:                 now.Unix(),
+			SyncStrategy:              syncStrategy,
+			StrategySummary:           strategySummary,
+			OverrideDeploymentWindows: overrideDeploymentWindows,
 		},
 		GitPath:                   app.GitPath,
 		CloudProvider:             app.CloudProvider,
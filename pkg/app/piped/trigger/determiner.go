@@ -38,6 +38,7 @@ type determiners struct {
 	onOutOfSync Determiner
 	onCommit    Determiner
 	onChain     Determiner
+	onSchedule  Determiner
 }
 
 func (ds *determiners) Determiner(k model.TriggerKind) Determiner {
@@ -48,6 +49,8 @@ func (ds *determiners) Determiner(k model.TriggerKind) Determiner {
 		return ds.onOutOfSync
 	case model.TriggerKind_ON_CHAIN:
 		return ds.onChain
+	case model.TriggerKind_ON_SCHEDULE:
+		return ds.onSchedule
 	default:
 		return ds.onCommit
 	}
@@ -130,6 +133,49 @@ func (d *OnOutOfSyncDeterminer) ShouldTrigger(ctx context.Context, app *model.Ap
 	return true, nil
 }
 
+type OnScheduleDeterminer struct {
+	now time.Time
+	// Map from application ID to the time when the latest deployment was triggered by this piped.
+	// This is used because the application data may be not fresh yet right after triggering.
+	triggeredTimes map[string]time.Time
+}
+
+func NewOnScheduleDeterminer(now time.Time, triggeredTimes map[string]time.Time) *OnScheduleDeterminer {
+	return &OnScheduleDeterminer{
+		now:            now,
+		triggeredTimes: triggeredTimes,
+	}
+}
+
+// ShouldTrigger decides whether a given application should be triggered or not.
+// The application is triggered when its schedule has come since the most recently triggered deployment.
+func (d *OnScheduleDeterminer) ShouldTrigger(_ context.Context, app *model.Application, appCfg *config.GenericApplicationSpec) (bool, error) {
+	s := appCfg.Trigger.OnSchedule
+	if s.Disabled || s.Schedule == "" {
+		return false, nil
+	}
+
+	// Use the creation time of the application as the baseline
+	// when no deployment was triggered yet.
+	since := time.Unix(app.CreatedAt, 0)
+	if ref := app.MostRecentlyTriggeredDeployment; ref != nil && ref.Trigger != nil {
+		since = time.Unix(ref.Trigger.Timestamp, 0)
+	}
+	if t, ok := d.triggeredTimes[app.Id]; ok && t.After(since) {
+		since = t
+	}
+
+	next, err := s.NextTime(since)
+	if err != nil {
+		return false, err
+	}
+	if next.IsZero() || next.After(d.now) {
+		return false, nil
+	}
+
+	return true, nil
+}
+
 type LastTriggeredCommitGetter interface {
 	Get(ctx context.Context, applicationID string) (string, error)
 }
@@ -15,9 +15,15 @@
 package trigger
 
 import (
+	"context"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pipe-cd/pipecd/pkg/config"
+	"github.com/pipe-cd/pipecd/pkg/model"
 )
 
 func TestIsTouchedByChangedFiles(t *testing.T) {
@@ -150,3 +156,91 @@ func TestIsTouchedByChangedFiles(t *testing.T) {
 		})
 	}
 }
+
+func TestOnScheduleDeterminer(t *testing.T) {
+	t.Parallel()
+
+	var (
+		now       = time.Date(2024, 12, 2, 3, 30, 0, 0, time.UTC)
+		createdAt = time.Date(2024, 11, 1, 0, 0, 0, 0, time.UTC)
+		nightly   = config.OnSchedule{Schedule: "0 3 * * *", Timezone: "UTC"}
+	)
+	appWithDeployment := func(triggeredAt time.Time) *model.Application {
+		return &model.Application{
+			Id:        "app-id",
+			CreatedAt: createdAt.Unix(),
+			MostRecentlyTriggeredDeployment: &model.ApplicationDeploymentReference{
+				Trigger: &model.DeploymentTrigger{
+					Timestamp: triggeredAt.Unix(),
+				},
+			},
+		}
+	}
+
+	testcases := []struct {
+		name           string
+		app            *model.Application
+		onSchedule     config.OnSchedule
+		triggeredTimes map[string]time.Time
+		expected       bool
+	}{
+		{
+			name:       "no schedule",
+			app:        appWithDeployment(now.Add(-24 * time.Hour)),
+			onSchedule: config.OnSchedule{Timezone: "UTC"},
+			expected:   false,
+		},
+		{
+			name:       "disabled",
+			app:        appWithDeployment(now.Add(-24 * time.Hour)),
+			onSchedule: config.OnSchedule{Disabled: true, Schedule: "0 3 * * *", Timezone: "UTC"},
+			expected:   false,
+		},
+		{
+			name:       "schedule has come since the last deployment",
+			app:        appWithDeployment(now.Add(-24 * time.Hour)),
+			onSchedule: nightly,
+			expected:   true,
+		},
+		{
+			name:       "triggered after the schedule",
+			app:        appWithDeployment(now.Add(-10 * time.Minute)),
+			onSchedule: nightly,
+			expected:   false,
+		},
+		{
+			name:       "schedule has not come yet in the configured timezone",
+			app:        appWithDeployment(now.Add(-2 * time.Hour)),
+			onSchedule: config.OnSchedule{Schedule: "0 3 * * *", Timezone: "Asia/Tokyo"},
+			expected:   false,
+		},
+		{
+			name:           "triggered by this piped but application data is not fresh yet",
+			app:            appWithDeployment(now.Add(-24 * time.Hour)),
+			onSchedule:     nightly,
+			triggeredTimes: map[string]time.Time{"app-id": now.Add(-time.Minute)},
+			expected:       false,
+		},
+		{
+			name:       "no deployment was triggered since the application was added",
+			app:        &model.Application{Id: "app-id", CreatedAt: createdAt.Unix()},
+			onSchedule: nightly,
+			expected:   true,
+		},
+	}
+
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			d := NewOnScheduleDeterminer(now, tc.triggeredTimes)
+			appCfg := &config.GenericApplicationSpec{
+				Trigger: config.Trigger{OnSchedule: tc.onSchedule},
+			}
+			got, err := d.ShouldTrigger(context.Background(), tc.app, appCfg)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, got)
+		})
+	}
+}
@@ -83,6 +83,9 @@ type Trigger struct {
 	commitStore       *lastTriggeredCommitStore
 	gitRepos          map[string]git.Repo
 	gracePeriod       time.Duration
+	windowRejections  map[string]string
+	triggeredTimes    map[string]time.Time
+	nowFunc           func() time.Time
 	logger            *zap.Logger
 }
 
@@ -116,6 +119,9 @@ func NewTrigger(
 		commitStore:       commitStore,
 		gitRepos:          make(map[string]git.Repo, len(cfg.Repositories)),
 		gracePeriod:       gracePeriod,
+		windowRejections:  make(map[string]string),
+		triggeredTimes:    make(map[string]time.Time),
+		nowFunc:           time.Now,
 		logger:            logger.Named("trigger"),
 	}
 
@@ -148,12 +154,14 @@ func (t *Trigger) Run(ctx context.Context) error {
 			var (
 				commitCandidates    = t.listCommitCandidates()
 				outOfSyncCandidates = t.listOutOfSyncCandidates()
-				candidates          = append(commitCandidates, outOfSyncCandidates...)
+				scheduleCandidates  = t.listScheduleCandidates()
+				candidates          = append(append(commitCandidates, outOfSyncCandidates...), scheduleCandidates...)
 			)
-			t.logger.Info(fmt.Sprintf("found %d candidates: %d commit candidates and %d out_of_sync candidates",
+			t.logger.Info(fmt.Sprintf("found %d candidates: %d commit candidates, %d out_of_sync candidates and %d schedule candidates",
 				len(candidates),
 				len(commitCandidates),
 				len(outOfSyncCandidates),
+				len(scheduleCandidates),
 			))
 			t.checkCandidates(ctx, candidates)
 
@@ -211,6 +219,7 @@ func (t *Trigger) checkRepoCandidates(ctx context.Context, repoID string, cs []c
 		onOutOfSync: NewOnOutOfSyncDeterminer(t.apiClient),
 		onCommit:    NewOnCommitDeterminer(gitRepo, headCommit.Hash, t.commitStore, t.logger),
 		onChain:     NewOnChainDeterminer(),
+		onSchedule:  NewOnScheduleDeterminer(t.nowFunc(), t.triggeredTimes),
 	}
 	triggered := make(map[string]struct{})
 
@@ -268,18 +277,24 @@ func (t *Trigger) checkRepoCandidates(ctx context.Context, repoID string, cs []c
 			strategySummary           string
 			deploymentChainID         string
 			deploymentChainBlockIndex uint32
+			overrideDeploymentWindows bool
 		)
 
 		switch c.kind {
 		case model.TriggerKind_ON_COMMAND:
 			strategy = c.command.GetSyncApplication().SyncStrategy
 			commander = c.command.Commander
+			overrideDeploymentWindows = c.command.GetSyncApplication().OverrideDeploymentWindows
 			if strategy == model.SyncStrategy_QUICK_SYNC {
 				strategySummary = "Quick sync because piped received a command from user via web console or pipectl"
 			} else {
 				strategySummary = "Sync with the specified pipeline because piped received a command from user via web console or pipectl"
 			}
 
+		case model.TriggerKind_ON_SCHEDULE:
+			strategy = model.SyncStrategy_AUTO
+			strategySummary = fmt.Sprintf("Sync application by the schedule %q", appCfg.Trigger.OnSchedule.Schedule)
+
 		case model.TriggerKind_ON_CHAIN:
 			strategy = c.command.GetChainSyncApplication().SyncStrategy
 			commander = c.command.Commander
@@ -291,6 +306,12 @@ func (t *Trigger) checkRepoCandidates(ctx context.Context, repoID string, cs []c
 			strategy = model.SyncStrategy_AUTO
 		}
 
+		// Drop the trigger when it is blocked by a deployment window configured to reject.
+		// The ones configured to queue are held by the planner until deployments are allowed.
+		if !overrideDeploymentWindows && t.rejectByDeploymentWindows(ctx, c, appCfg, headCommit) {
+			continue
+		}
+
 		// Build the deployment to trigger.
 		deployment, err := buildDeployment(
 			app,
@@ -299,10 +320,11 @@ func (t *Trigger) checkRepoCandidates(ctx context.Context, repoID string, cs []c
 			commander,
 			strategy,
 			strategySummary,
-			time.Now(),
+			t.nowFunc(),
 			appCfg.DeploymentNotification,
 			deploymentChainID,
 			deploymentChainBlockIndex,
+			overrideDeploymentWindows,
 		)
 		if err != nil {
 			msg := fmt.Sprintf("failed to build deployment for application %s: %v", app.Id, err)
@@ -339,6 +361,7 @@ func (t *Trigger) checkRepoCandidates(ctx context.Context, repoID string, cs []c
 		}
 
 		triggered[app.Id] = struct{}{}
+		t.triggeredTimes[app.Id] = time.Unix(deployment.Trigger.Timestamp, 0)
 		t.commitStore.Put(app.Id, headCommit.Hash)
 		t.notifyDeploymentTriggered(ctx, appCfg, deployment)
 
@@ -356,6 +379,41 @@ func (t *Trigger) checkRepoCandidates(ctx context.Context, repoID string, cs []c
 	return nil
 }
 
+// rejectByDeploymentWindows checks whether the given candidate should be rejected
+// because deployments are not allowed by the project or application deployment windows.
+// When it is rejected, the candidate is marked as handled and a notification is sent.
+func (t *Trigger) rejectByDeploymentWindows(ctx context.Context, c candidate, appCfg *config.GenericApplicationSpec, commit git.Commit) bool {
+	app := c.application
+
+	w, reason, err := config.FindBlockingDeploymentWindows(t.nowFunc(), t.config.ProjectDeploymentWindows, appCfg.DeploymentWindows)
+	if err != nil {
+		t.logger.Error("failed to evaluate deployment windows", zap.String("app-id", app.Id), zap.Error(err))
+		return false
+	}
+	if w == nil || w.Action != config.DeploymentWindowActionReject {
+		delete(t.windowRejections, app.Id)
+		return false
+	}
+
+	t.logger.Info(fmt.Sprintf("rejected the trigger of application %s: %s", app.Id, reason))
+	t.commitStore.Put(app.Id, commit.Hash)
+
+	if c.HasCommand() {
+		if err := c.command.Report(ctx, model.CommandStatus_COMMAND_FAILED, nil, []byte(reason)); err != nil {
+			t.logger.Error("failed to report command status", zap.Error(err))
+		}
+	}
+
+	// Out-of-sync applications are checked at every interval,
+	// so the same rejection is notified only once for them.
+	if prev, ok := t.windowRejections[app.Id]; ok && prev == reason && !c.HasCommand() {
+		return true
+	}
+	t.windowRejections[app.Id] = reason
+	t.notifyDeploymentTriggerFailed(app, appCfg, reason, commit)
+	return true
+}
+
 // listCommandCandidates finds all applications that have been commanded to sync.
 func (t *Trigger) listCommandCandidates() []candidate {
 	var (
@@ -443,6 +501,24 @@ func (t *Trigger) listCommitCandidates() []candidate {
 	return apps
 }
 
+// listScheduleCandidates finds all applications that have potentiality
+// to be candidates by their schedules.
+// They are all applications managed by this Piped
+// since the schedules can be known only after loading their application configurations.
+func (t *Trigger) listScheduleCandidates() []candidate {
+	var (
+		list = t.applicationLister.List()
+		apps = make([]candidate, 0)
+	)
+	for _, app := range list {
+		apps = append(apps, candidate{
+			application: app,
+			kind:        model.TriggerKind_ON_SCHEDULE,
+		})
+	}
+	return apps
+}
+
 // updateRepoToLatest ensures that the local data of the given Git repository should be up-to-date.
 func (t *Trigger) updateRepoToLatest(ctx context.Context, repoID string) (repo git.Repo, branch string, headCommit git.Commit, err error) {
 	var ok bool
@@ -0,0 +1,119 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trigger
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/pipe-cd/pipecd/pkg/cache/memorycache"
+	"github.com/pipe-cd/pipecd/pkg/config"
+	"github.com/pipe-cd/pipecd/pkg/git"
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+type fakeNotifier struct {
+	events []model.NotificationEvent
+}
+
+func (n *fakeNotifier) Notify(event model.NotificationEvent) {
+	n.events = append(n.events, event)
+}
+
+func TestRejectByDeploymentWindows(t *testing.T) {
+	t.Parallel()
+
+	newWindows := func(action config.DeploymentWindowAction) *config.DeploymentWindows {
+		return &config.DeploymentWindows{
+			Timezone: "UTC",
+			Action:   action,
+			Allow: []config.DeploymentWindow{
+				{Schedule: "0 9 * * *", Duration: config.Duration(9 * time.Hour)},
+			},
+		}
+	}
+	insideWindow := time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC)
+	outsideWindow := time.Date(2025, 1, 1, 20, 0, 0, 0, time.UTC)
+
+	testcases := []struct {
+		name         string
+		windows      *config.DeploymentWindows
+		now          time.Time
+		wantRejected bool
+	}{
+		{
+			name:         "deploy inside window",
+			windows:      newWindows(config.DeploymentWindowActionReject),
+			now:          insideWindow,
+			wantRejected: false,
+		},
+		{
+			name:         "queued outside window",
+			windows:      newWindows(config.DeploymentWindowActionQueue),
+			now:          outsideWindow,
+			wantRejected: false,
+		},
+		{
+			name:         "rejected outside window",
+			windows:      newWindows(config.DeploymentWindowActionReject),
+			now:          outsideWindow,
+			wantRejected: true,
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			n := &fakeNotifier{}
+			tr := &Trigger{
+				notifier:         n,
+				config:           &config.PipedSpec{},
+				commitStore:      &lastTriggeredCommitStore{cache: memorycache.NewCache()},
+				windowRejections: make(map[string]string),
+				nowFunc:          func() time.Time { return tc.now },
+				logger:           zap.NewNop(),
+			}
+			c := candidate{
+				application: &model.Application{Id: "app-id"},
+				kind:        model.TriggerKind_ON_OUT_OF_SYNC,
+			}
+			appCfg := &config.GenericApplicationSpec{DeploymentWindows: tc.windows}
+			commit := git.Commit{Hash: "commit-hash"}
+
+			// The rejection of out-of-sync applications is notified only once.
+			for i := 0; i < 2; i++ {
+				rejected := tr.rejectByDeploymentWindows(context.Background(), c, appCfg, commit)
+				assert.Equal(t, tc.wantRejected, rejected)
+			}
+
+			if !tc.wantRejected {
+				assert.Empty(t, n.events)
+				_, err := tr.commitStore.cache.Get("app-id")
+				assert.Error(t, err)
+				return
+			}
+			require.Len(t, n.events, 1)
+			assert.Equal(t, model.NotificationEventType_EVENT_DEPLOYMENT_TRIGGER_FAILED, n.events[0].Type)
+			got, err := tr.commitStore.Get(context.Background(), "app-id")
+			require.NoError(t, err)
+			assert.Equal(t, "commit-hash", got)
+		})
+	}
+}
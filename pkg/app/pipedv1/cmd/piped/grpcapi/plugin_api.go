@@ -26,6 +26,8 @@ import (
 
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 type PluginAPI struct {
@@ -43,6 +45,9 @@ type PluginAPI struct {
 type apiClient interface {
 	ReportStageLogs(ctx context.Context, req *pipedservice.ReportStageLogsRequest, opts ...grpc.CallOption) (*pipedservice.ReportStageLogsResponse, error)
 	ReportStageLogsFromLastCheckpoint(ctx context.Context, in *pipedservice.ReportStageLogsFromLastCheckpointRequest, opts ...grpc.CallOption) (*pipedservice.ReportStageLogsFromLastCheckpointResponse, error)
+	GetLatestAnalysisResult(ctx context.Context, req *pipedservice.GetLatestAnalysisResultRequest, opts ...grpc.CallOption) (*pipedservice.GetLatestAnalysisResultResponse, error)
+	PutLatestAnalysisResult(ctx context.Context, req *pipedservice.PutLatestAnalysisResultRequest, opts ...grpc.CallOption) (*pipedservice.PutLatestAnalysisResultResponse, error)
+	PutAnalysisReport(ctx context.Context, req *pipedservice.PutAnalysisReportRequest, opts ...grpc.CallOption) (*pipedservice.PutAnalysisReportResponse, error)
 }
 
 type stageCommandLister interface {
@@ -155,3 +160,53 @@ func (a *PluginAPI) ListStageCommands(ctx context.Context, req *service.ListStag
 	commands := a.stageCommandLister.ListStageCommands(req.DeploymentId, req.StageId)
 	return &service.ListStageCommandsResponse{Commands: commands}, nil
 }
+
+func (a *PluginAPI) GetLatestAnalysisResult(ctx context.Context, req *service.GetLatestAnalysisResultRequest) (*service.GetLatestAnalysisResultResponse, error) {
+	resp, err := a.apiClient.GetLatestAnalysisResult(ctx, &pipedservice.GetLatestAnalysisResultRequest{
+		ApplicationId: req.ApplicationId,
+	})
+	if status.Code(err) == codes.NotFound {
+		return &service.GetLatestAnalysisResultResponse{Found: false}, nil
+	}
+	if err != nil {
+		a.Logger.Error("failed to get the most recent analysis result",
+			zap.String("applicationID", req.ApplicationId),
+			zap.Error(err))
+		return nil, err
+	}
+
+	return &service.GetLatestAnalysisResultResponse{
+		AnalysisResult: resp.AnalysisResult,
+		Found:          true,
+	}, nil
+}
+
+func (a *PluginAPI) PutLatestAnalysisResult(ctx context.Context, req *service.PutLatestAnalysisResultRequest) (*service.PutLatestAnalysisResultResponse, error) {
+	_, err := a.apiClient.PutLatestAnalysisResult(ctx, &pipedservice.PutLatestAnalysisResultRequest{
+		ApplicationId:  req.ApplicationId,
+		AnalysisResult: req.AnalysisResult,
+	})
+	if err != nil {
+		a.Logger.Error("failed to put the most recent analysis result",
+			zap.String("applicationID", req.ApplicationId),
+			zap.Error(err))
+		return nil, err
+	}
+
+	return &service.PutLatestAnalysisResultResponse{}, nil
+}
+
+func (a *PluginAPI) PutAnalysisReport(ctx context.Context, req *service.PutAnalysisReportRequest) (*service.PutAnalysisReportResponse, error) {
+	_, err := a.apiClient.PutAnalysisReport(ctx, &pipedservice.PutAnalysisReportRequest{
+		Report: req.Report,
+	})
+	if err != nil {
+		a.Logger.Error("failed to put the analysis report",
+			zap.String("deploymentID", req.Report.GetDeploymentId()),
+			zap.String("stageID", req.Report.GetStageId()),
+			zap.Error(err))
+		return nil, err
+	}
+
+	return &service.PutAnalysisReportResponse{}, nil
+}
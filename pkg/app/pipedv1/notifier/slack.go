@@ -309,6 +309,24 @@ func (s *slack) buildSlackMessage(event model.NotificationEvent, webURL string)
 		text = fmt.Sprintf("Approved by %s", md.Approver)
 		generateDeploymentEventData(md.Deployment, md.MentionedAccounts, md.MentionedGroups)
 
+	case model.NotificationEventType_EVENT_DEPLOYMENT_BLOCKED:
+		md := event.Metadata.(*model.NotificationEventDeploymentBlocked)
+		md.MentionedAccounts = append(md.MentionedAccounts, s.config.MentionedAccounts...)
+		md.MentionedGroups = append(md.MentionedGroups, s.config.MentionedGroups...)
+		title = fmt.Sprintf("Deployment for %q was blocked by deployment windows", md.Deployment.ApplicationName)
+		text = md.Reason
+		color = slackWarnColor
+		generateDeploymentEventData(md.Deployment, md.MentionedAccounts, md.MentionedGroups)
+
+	case model.NotificationEventType_EVENT_DEPLOYMENT_REJECTED:
+		md := event.Metadata.(*model.NotificationEventDeploymentRejected)
+		md.MentionedAccounts = append(md.MentionedAccounts, s.config.MentionedAccounts...)
+		md.MentionedGroups = append(md.MentionedGroups, s.config.MentionedGroups...)
+		title = fmt.Sprintf("Deployment for %q was rejected", md.Deployment.ApplicationName)
+		text = makeRejectedText(md.Rejecter, md.Comment)
+		color = slackErrorColor
+		generateDeploymentEventData(md.Deployment, md.MentionedAccounts, md.MentionedGroups)
+
 	case model.NotificationEventType_EVENT_DEPLOYMENT_SUCCEEDED:
 		md := event.Metadata.(*model.NotificationEventDeploymentSucceeded)
 		md.MentionedAccounts = append(md.MentionedAccounts, s.config.MentionedAccounts...)
@@ -411,6 +429,14 @@ type slackField struct {
 	Short bool   `json:"short"`
 }
 
+// makeRejectedText returns the text describing who rejected the deployment and why.
+func makeRejectedText(rejecter, comment string) string {
+	if comment == "" {
+		return fmt.Sprintf("Rejected by %s", rejecter)
+	}
+	return fmt.Sprintf("Rejected by %s: %s", rejecter, comment)
+}
+
 func makeSlackLink(title, url string) string {
 	return fmt.Sprintf("<%s|%s>", url, title)
 }
@@ -0,0 +1,431 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"time"
+
+	"go.uber.org/zap"
+	"golang.org/x/sync/errgroup"
+
+	httpprovider "github.com/pipe-cd/pipecd/pkg/app/pipedv1/plugin/analysis/analysisprovider/http"
+	"github.com/pipe-cd/pipecd/pkg/app/pipedv1/plugin/analysis/analysisprovider/log"
+	logfactory "github.com/pipe-cd/pipecd/pkg/app/pipedv1/plugin/analysis/analysisprovider/log/factory"
+	"github.com/pipe-cd/pipecd/pkg/app/pipedv1/plugin/analysis/analysisprovider/metrics"
+	metricsfactory "github.com/pipe-cd/pipecd/pkg/app/pipedv1/plugin/analysis/analysisprovider/metrics/factory"
+	"github.com/pipe-cd/pipecd/pkg/app/pipedv1/plugin/analysis/config"
+	"github.com/pipe-cd/pipecd/pkg/model"
+	sdk "github.com/pipe-cd/piped-plugin-sdk-go"
+)
+
+const (
+	skippedByKey   = "SkippedBy"
+	elapsedTimeKey = "elapsedTime"
+)
+
+// analysisResultStore stores the results of the analyses via piped.
+// It is implemented by sdk.Client.
+type analysisResultStore interface {
+	GetLatestAnalysisResult(ctx context.Context) (*model.AnalysisResult, error)
+	PutLatestAnalysisResult(ctx context.Context, result *model.AnalysisResult) error
+	PutAnalysisReport(ctx context.Context, report *model.AnalysisReport) error
+}
+
+type executor struct {
+	pluginConfig *config.AnalysisPluginConfig
+	appName      string
+	client       *sdk.Client
+	logger       *zap.Logger
+	logPersister sdk.StageLogPersister
+
+	startTime           time.Time
+	previousElapsedTime time.Duration
+}
+
+// executeAnalysis spawns and runs multiple analyzer that run a query at the regular time.
+// Any of those fail then the stage ends with failure.
+func (p *plugin) executeAnalysis(ctx context.Context, cfg *config.AnalysisPluginConfig, in *sdk.ExecuteStageInput[struct{}]) sdk.StageStatus {
+	e := &executor{
+		pluginConfig: cfg,
+		appName:      in.Request.Deployment.ApplicationName,
+		client:       in.Client,
+		logger:       in.Logger,
+		logPersister: in.Client.LogPersister(),
+		startTime:    time.Now(),
+	}
+	return e.execute(ctx, in.Request.StageConfig, in.Request.TargetDeploymentSource.ApplicationDirectory)
+}
+
+func (e *executor) execute(ctx context.Context, stageConfig []byte, appDir string) sdk.StageStatus {
+	options, err := config.DecodeAnalysisStageOptions(stageConfig)
+	if err != nil {
+		e.logPersister.Errorf("Failed to decode the stage config: %v", err)
+		return sdk.StageStatusFailure
+	}
+
+	templateCfg, err := loadAnalysisTemplate(appDir)
+	if errors.Is(err, config.ErrNotFound) {
+		e.logger.Info("config file for AnalysisTemplate not found")
+		templateCfg = &config.AnalysisTemplateSpec{}
+	} else if err != nil {
+		e.logPersister.Error(err.Error())
+		return sdk.StageStatusFailure
+	}
+
+	timeout := time.Duration(options.Duration)
+	e.previousElapsedTime = e.retrievePreviousElapsedTime(ctx)
+	if e.previousElapsedTime > 0 {
+		// Restart from the middle.
+		timeout -= e.previousElapsedTime
+	}
+	defer e.saveElapsedTime(ctx)
+
+	ctxWithTimeout, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	eg, ctxWithTimeout := errgroup.WithContext(ctxWithTimeout)
+
+	// Sync the skip command.
+	var skipped atomic.Bool
+	watchCtx, stopWatching := context.WithCancel(ctx)
+	defer stopWatching()
+	go func() {
+		if !e.waitSkipCommand(watchCtx) {
+			return
+		}
+		skipped.Store(true)
+		// Stop the context to cancel all running analyses.
+		cancel()
+	}()
+
+	// Run analyses with metrics providers.
+	metricsAnalyzers := make([]*metricsAnalyzer, 0, len(options.Metrics))
+	for i := range options.Metrics {
+		cfg, err := e.getMetricsConfig(options.Metrics[i], templateCfg)
+		if err != nil {
+			e.logPersister.Errorf("Failed to get metrics config: %v", err)
+			return sdk.StageStatusFailure
+		}
+		provider, err := e.newMetricsProvider(cfg.Provider, options.Metrics[i])
+		if err != nil {
+			e.logPersister.Errorf("Failed to generate metrics provider: %v", err)
+			return sdk.StageStatusFailure
+		}
+
+		id := fmt.Sprintf("metrics-%d", i)
+		args := e.buildAppArgs(options.Metrics[i].Template.AppArgs)
+		analyzer := newMetricsAnalyzer(id, *cfg, e.startTime, provider, e.client, args, e.logger, e.logPersister)
+		metricsAnalyzers = append(metricsAnalyzers, analyzer)
+
+		// All metrics are evaluated together by the scorer when scoring is enabled.
+		if options.Scoring != nil {
+			continue
+		}
+		eg.Go(func() error {
+			e.logPersister.Infof("[%s] Start metrics analyzer every %s with query template: %q", analyzer.id, cfg.Interval.Duration(), cfg.Query)
+			return analyzer.run(ctxWithTimeout)
+		})
+	}
+	var scorer *metricsScorer
+	if options.Scoring != nil {
+		scorer = newMetricsScorer(*options.Scoring, metricsAnalyzers, e.logger, e.logPersister)
+		eg.Go(func() error {
+			e.logPersister.Infof("[scoring] Start scoring %d metrics every %s", len(metricsAnalyzers), options.Scoring.Interval.Duration())
+			return scorer.run(ctxWithTimeout)
+		})
+	}
+	// Run analyses with logging providers.
+	logAnalyzers := make([]*logAnalyzer, 0, len(options.Logs))
+	for i := range options.Logs {
+		cfg, err := e.getLogConfig(&options.Logs[i], templateCfg)
+		if err != nil {
+			e.logPersister.Errorf("Failed to get log config: %v", err)
+			return sdk.StageStatusFailure
+		}
+		provider, err := e.newLogProvider(cfg)
+		if err != nil {
+			e.logPersister.Errorf("Failed to generate log provider: %v", err)
+			return sdk.StageStatusFailure
+		}
+
+		id := fmt.Sprintf("log-%d", i)
+		args := e.buildAppArgs(options.Logs[i].Template.AppArgs)
+		analyzer := newLogAnalyzer(id, *cfg, provider, args, e.logger, e.logPersister)
+		logAnalyzers = append(logAnalyzers, analyzer)
+
+		eg.Go(func() error {
+			e.logPersister.Infof("[%s] Start log analyzer every %s with query template: %q", analyzer.id, cfg.Interval.Duration(), cfg.Query)
+			return analyzer.run(ctxWithTimeout)
+		})
+	}
+	// Run analyses with http providers.
+	httpAnalyzers := make([]*analyzer, 0, len(options.HTTPS))
+	for i := range options.HTTPS {
+		analyzer, err := e.newAnalyzerForHTTP(i, &options.HTTPS[i], templateCfg)
+		if err != nil {
+			e.logPersister.Errorf("Failed to spawn analyzer for HTTP: %v", err)
+			return sdk.StageStatusFailure
+		}
+		httpAnalyzers = append(httpAnalyzers, analyzer)
+		eg.Go(func() error {
+			e.logPersister.Infof("[%s] Start http analyzer", analyzer.id)
+			return analyzer.run(ctxWithTimeout)
+		})
+	}
+
+	if err := eg.Wait(); err != nil {
+		e.logPersister.Errorf("Analysis failed: %s", err.Error())
+		e.reportAnalysis(ctx, model.StageStatus_STAGE_FAILURE, err.Error(), metricsAnalyzers, logAnalyzers, httpAnalyzers, scorer)
+		return sdk.StageStatusFailure
+	}
+
+	switch {
+	case skipped.Load():
+		e.reportAnalysis(ctx, model.StageStatus_STAGE_SKIPPED, "", metricsAnalyzers, logAnalyzers, httpAnalyzers, scorer)
+		// The plugin can not report the skipped status, so the stage is considered as a success.
+		return sdk.StageStatusSuccess
+	case ctx.Err() != nil:
+		e.reportAnalysis(ctx, model.StageStatus_STAGE_CANCELLED, "", metricsAnalyzers, logAnalyzers, httpAnalyzers, scorer)
+		// We can return any status here because the piped handles this case as cancelled by a user,
+		// ignoring the result from a plugin.
+		return sdk.StageStatusFailure
+	}
+
+	e.reportAnalysis(ctx, model.StageStatus_STAGE_SUCCESS, "", metricsAnalyzers, logAnalyzers, httpAnalyzers, scorer)
+	e.logPersister.Success("All analyses were successful")
+	result := &model.AnalysisResult{
+		StartTime: e.startTime.Unix(),
+	}
+	if scorer != nil {
+		result.Score, result.MetricsScores = scorer.score()
+	}
+	if err := e.client.PutLatestAnalysisResult(ctx, result); err != nil {
+		e.logger.Error("failed to send the analysis result", zap.Error(err))
+	}
+	return sdk.StageStatusSuccess
+}
+
+// reportAnalysis sends the details of all analyses to the control plane
+// to explain why the stage ended with the given status.
+func (e *executor) reportAnalysis(ctx context.Context, status model.StageStatus, reason string, metricsAnalyzers []*metricsAnalyzer, logAnalyzers []*logAnalyzer, httpAnalyzers []*analyzer, scorer *metricsScorer) {
+	// The deployment, stage and application are filled by the client.
+	report := &model.AnalysisReport{
+		Status:      status,
+		Reason:      reason,
+		Metrics:     make([]*model.AnalysisMetricsReport, 0, len(metricsAnalyzers)),
+		Logs:        make([]*model.AnalysisLogReport, 0, len(logAnalyzers)),
+		Https:       make([]*model.AnalysisHTTPReport, 0, len(httpAnalyzers)),
+		StartedAt:   e.startTime.Unix(),
+		CompletedAt: time.Now().Unix(),
+	}
+	for _, a := range metricsAnalyzers {
+		report.Metrics = append(report.Metrics, a.report())
+	}
+	for _, a := range logAnalyzers {
+		report.Logs = append(report.Logs, a.report())
+	}
+	for _, a := range httpAnalyzers {
+		report.Https = append(report.Https, a.report())
+	}
+	if scorer != nil {
+		report.Score = scorer.lastScore
+		report.IntervalScores = scorer.intervalScores
+	}
+	// The report should be sent even if the stage was cancelled.
+	if err := e.client.PutAnalysisReport(context.WithoutCancel(ctx), report); err != nil {
+		e.logger.Error("failed to send the analysis report", zap.Error(err))
+	}
+}
+
+// saveElapsedTime stores the elapsed time of analysis stage into the stage metadata.
+// The analysis stage can be restarted from the middle even if it ends unexpectedly,
+// that's why count should be stored.
+func (e *executor) saveElapsedTime(ctx context.Context) {
+	elapsedTime := time.Since(e.startTime) + e.previousElapsedTime
+	metadata := map[string]string{
+		elapsedTimeKey: elapsedTime.String(),
+	}
+	if err := e.client.PutStageMetadataMulti(context.WithoutCancel(ctx), metadata); err != nil {
+		e.logger.Error("failed to store metadata", zap.Error(err))
+	}
+}
+
+// retrievePreviousElapsedTime returns the elapsed time of analysis stage by decoding the stage metadata.
+func (e *executor) retrievePreviousElapsedTime(ctx context.Context) time.Duration {
+	s, err := e.client.GetStageMetadata(ctx, elapsedTimeKey)
+	if err != nil {
+		e.logger.Error(fmt.Sprintf("failed to get stage metadata %s", elapsedTimeKey), zap.Error(err))
+		return 0
+	}
+	if s == "" {
+		return 0
+	}
+	et, err := time.ParseDuration(s)
+	if err != nil {
+		e.logger.Error("unexpected elapsed time is stored", zap.String("stored-value", s), zap.Error(err))
+		return 0
+	}
+	return et
+}
+
+// waitSkipCommand blocks until the stage is skipped by a user or the given context is done.
+// It returns true if the stage was skipped.
+func (e *executor) waitSkipCommand(ctx context.Context) bool {
+	for cmd, err := range e.client.ListStageCommands(ctx, model.Command_SKIP_STAGE) {
+		if ctx.Err() != nil {
+			return false
+		}
+		if err != nil {
+			e.logger.Error("failed to list stage commands", zap.Error(err))
+			continue
+		}
+
+		if err := e.client.PutStageMetadata(ctx, skippedByKey, cmd.Commander); err != nil {
+			e.logPersister.Errorf("Unable to save the commander who skipped the stage information to deployment, %v", err)
+		}
+		e.logPersister.Infof("Got the skip command from %q", cmd.Commander)
+		e.logPersister.Infof("This stage has been skipped by user (%s)", cmd.Commander)
+		return true
+	}
+	return false
+}
+
+func (e *executor) newAnalyzerForHTTP(i int, templatable *config.TemplatableAnalysisHTTP, templateCfg *config.AnalysisTemplateSpec) (*analyzer, error) {
+	cfg, err := e.getHTTPConfig(templatable, templateCfg)
+	if err != nil {
+		return nil, err
+	}
+	provider := httpprovider.NewProvider(time.Duration(cfg.Timeout))
+	id := fmt.Sprintf("http-%d", i)
+	runner := func(ctx context.Context, query string) (bool, string, error) {
+		return provider.Run(ctx, cfg)
+	}
+	return newAnalyzer(id, provider.Type(), "", runner, time.Duration(cfg.Interval), cfg.FailureLimit, cfg.SkipOnNoData, e.logger, e.logPersister), nil
+}
+
+func (e *executor) newMetricsProvider(providerName string, templatable config.TemplatableAnalysisMetrics) (metrics.Provider, error) {
+	cfg, ok := e.pluginConfig.GetAnalysisProvider(providerName)
+	if !ok {
+		return nil, fmt.Errorf("unknown provider name %s", providerName)
+	}
+	provider, err := metricsfactory.NewProvider(&templatable, &cfg, e.logger)
+	if err != nil {
+		return nil, err
+	}
+	return provider, nil
+}
+
+func (e *executor) newLogProvider(analysisCfg *config.AnalysisLog) (log.Provider, error) {
+	cfg, ok := e.pluginConfig.GetAnalysisProvider(analysisCfg.Provider)
+	if !ok {
+		return nil, fmt.Errorf("unknown provider name %s", analysisCfg.Provider)
+	}
+	provider, err := logfactory.NewProvider(analysisCfg, &cfg, e.logger)
+	if err != nil {
+		return nil, err
+	}
+	return provider, nil
+}
+
+// getMetricsConfig renders the given template and returns the metrics config.
+// Just returns metrics config if no template specified.
+func (e *executor) getMetricsConfig(templatableCfg config.TemplatableAnalysisMetrics, templateCfg *config.AnalysisTemplateSpec) (*config.AnalysisMetrics, error) {
+	name := templatableCfg.Template.Name
+	if name == "" {
+		return &templatableCfg.AnalysisMetrics, nil
+	}
+
+	cfg, ok := templateCfg.Metrics[name]
+	if !ok {
+		return nil, fmt.Errorf("analysis template %s not found despite template specified", name)
+	}
+	return &cfg, nil
+}
+
+// getLogConfig renders the given template and returns the log config.
+// Just returns log config if no template specified.
+func (e *executor) getLogConfig(templatableCfg *config.TemplatableAnalysisLog, templateCfg *config.AnalysisTemplateSpec) (*config.AnalysisLog, error) {
+	name := templatableCfg.Template.Name
+	if name == "" {
+		return &templatableCfg.AnalysisLog, nil
+	}
+
+	cfg, ok := templateCfg.Logs[name]
+	if !ok {
+		return nil, fmt.Errorf("analysis template %s not found despite template specified", name)
+	}
+	return &cfg, nil
+}
+
+// getHTTPConfig renders the given template and returns the http config.
+// Just returns http config if no template specified.
+func (e *executor) getHTTPConfig(templatableCfg *config.TemplatableAnalysisHTTP, templateCfg *config.AnalysisTemplateSpec) (*config.AnalysisHTTP, error) {
+	name := templatableCfg.Template.Name
+	if name == "" {
+		return &templatableCfg.AnalysisHTTP, nil
+	}
+
+	cfg, ok := templateCfg.HTTPS[name]
+	if !ok {
+		return nil, fmt.Errorf("analysis template %s not found despite template specified", name)
+	}
+	return &cfg, nil
+}
+
+func (e *executor) buildAppArgs(customArgs map[string]string) argsTemplate {
+	return argsTemplate{
+		App: appArgs{
+			Name: e.appName,
+			// TODO: Populate Env
+			Env: "",
+		},
+		AppCustomArgs: customArgs,
+	}
+}
+
+// loadAnalysisTemplate loads the analysis template from the root of the repository
+// which contains the given application directory.
+func loadAnalysisTemplate(appDir string) (*config.AnalysisTemplateSpec, error) {
+	repoRoot, err := findRepositoryRoot(appDir)
+	if err != nil {
+		return nil, err
+	}
+	return config.LoadAnalysisTemplate(repoRoot)
+}
+
+// findRepositoryRoot returns the nearest directory containing .git
+// from the given directory to the filesystem root.
+func findRepositoryRoot(dir string) (string, error) {
+	dir, err := filepath.Abs(dir)
+	if err != nil {
+		return "", err
+	}
+	for {
+		if _, err := os.Stat(filepath.Join(dir, ".git")); err == nil {
+			return dir, nil
+		}
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return "", fmt.Errorf("no git repository found for the application directory")
+		}
+		dir = parent
+	}
+}
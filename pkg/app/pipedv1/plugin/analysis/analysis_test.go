@@ -0,0 +1,63 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFindRepositoryRoot(t *testing.T) {
+	t.Parallel()
+
+	repoDir := t.TempDir()
+	require.NoError(t, os.Mkdir(filepath.Join(repoDir, ".git"), 0755))
+	appDir := filepath.Join(repoDir, "apps", "app-1")
+	require.NoError(t, os.MkdirAll(appDir, 0755))
+
+	testcases := []struct {
+		name     string
+		dir      string
+		expected string
+		wantErr  bool
+	}{
+		{
+			name:     "application directory in the repository",
+			dir:      appDir,
+			expected: repoDir,
+		},
+		{
+			name:     "repository root",
+			dir:      repoDir,
+			expected: repoDir,
+		},
+		{
+			name:    "no repository",
+			dir:     t.TempDir(),
+			wantErr: true,
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := findRepositoryRoot(tc.dir)
+			assert.Equal(t, tc.wantErr, err != nil)
+			assert.Equal(t, tc.expected, got)
+		})
+	}
+}
@@ -0,0 +1,138 @@
+// Copyright 2024 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package factory
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"go.uber.org/zap"
+
+	"github.com/pipe-cd/pipecd/pkg/app/pipedv1/plugin/analysis/analysisprovider/log"
+	"github.com/pipe-cd/pipecd/pkg/app/pipedv1/plugin/analysis/analysisprovider/log/elasticsearch"
+	"github.com/pipe-cd/pipecd/pkg/app/pipedv1/plugin/analysis/analysisprovider/log/loki"
+	"github.com/pipe-cd/pipecd/pkg/app/pipedv1/plugin/analysis/analysisprovider/log/stackdriver"
+	"github.com/pipe-cd/pipecd/pkg/app/pipedv1/plugin/analysis/config"
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+// NewProvider generates an appropriate provider according to analysis provider config.
+func NewProvider(analysisCfg *config.AnalysisLog, providerCfg *config.AnalysisProvider, logger *zap.Logger) (provider log.Provider, err error) {
+	switch providerCfg.Type {
+	case model.AnalysisProviderStackdriver:
+		cfg := providerCfg.StackdriverConfig
+		sa, err := os.ReadFile(cfg.ServiceAccountFile)
+		if err != nil {
+			return nil, err
+		}
+		options := []stackdriver.Option{
+			stackdriver.WithLogger(logger),
+		}
+		if analysisCfg.Timeout > 0 {
+			options = append(options, stackdriver.WithTimeout(analysisCfg.Timeout.Duration()))
+		}
+		if cfg.ProjectID != "" {
+			options = append(options, stackdriver.WithProjectID(cfg.ProjectID))
+		}
+		provider, err = stackdriver.NewProvider(sa, options...)
+		if err != nil {
+			return nil, err
+		}
+
+	case model.AnalysisProviderLoki:
+		cfg := providerCfg.LokiConfig
+		options := []loki.Option{
+			loki.WithLogger(logger),
+		}
+		if analysisCfg.Timeout > 0 {
+			options = append(options, loki.WithTimeout(analysisCfg.Timeout.Duration()))
+		}
+		if cfg.TenantID != "" {
+			options = append(options, loki.WithTenantID(cfg.TenantID))
+		}
+		if cfg.UsernameFile != "" && cfg.PasswordFile != "" {
+			username, password, err := readBasicAuth(cfg.UsernameFile, cfg.PasswordFile)
+			if err != nil {
+				return nil, err
+			}
+			options = append(options, loki.WithBasicAuth(username, password))
+		}
+		if cfg.TokenFile != "" {
+			token, err := readFile(cfg.TokenFile)
+			if err != nil {
+				return nil, fmt.Errorf("failed to read the token file: %w", err)
+			}
+			options = append(options, loki.WithBearerToken(token))
+		}
+		provider, err = loki.NewProvider(cfg.Address, options...)
+		if err != nil {
+			return nil, err
+		}
+
+	case model.AnalysisProviderElasticsearch:
+		cfg := providerCfg.ElasticsearchConfig
+		options := []elasticsearch.Option{
+			elasticsearch.WithLogger(logger),
+			elasticsearch.WithIndex(cfg.Index),
+			elasticsearch.WithFields(cfg.TimestampField, cfg.MessageField, cfg.SeverityField),
+		}
+		if analysisCfg.Timeout > 0 {
+			options = append(options, elasticsearch.WithTimeout(analysisCfg.Timeout.Duration()))
+		}
+		if cfg.UsernameFile != "" && cfg.PasswordFile != "" {
+			username, password, err := readBasicAuth(cfg.UsernameFile, cfg.PasswordFile)
+			if err != nil {
+				return nil, err
+			}
+			options = append(options, elasticsearch.WithBasicAuth(username, password))
+		}
+		if cfg.APIKeyFile != "" {
+			apiKey, err := readFile(cfg.APIKeyFile)
+			if err != nil {
+				return nil, fmt.Errorf("failed to read the api-key file: %w", err)
+			}
+			options = append(options, elasticsearch.WithAPIKey(apiKey))
+		}
+		provider, err = elasticsearch.NewProvider(cfg.Address, options...)
+		if err != nil {
+			return nil, err
+		}
+
+	default:
+		return nil, fmt.Errorf("any of providers config not found")
+	}
+	return provider, nil
+}
+
+func readBasicAuth(usernameFile, passwordFile string) (username, password string, err error) {
+	username, err = readFile(usernameFile)
+	if err != nil {
+		return "", "", fmt.Errorf("failed to read the username file: %w", err)
+	}
+	password, err = readFile(passwordFile)
+	if err != nil {
+		return "", "", fmt.Errorf("failed to read the password file: %w", err)
+	}
+	return username, password, nil
+}
+
+func readFile(path string) (string, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(string(data)), nil
+}
@@ -0,0 +1,45 @@
+// Copyright 2024 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package loki
+
+import (
+	"context"
+
+	"github.com/pipe-cd/pipecd/pkg/app/pipedv1/plugin/analysis/analysisprovider/log"
+)
+
+// fakeClient returns the given count and streams.
+type fakeClient struct {
+	count   int
+	streams []stream
+	err     error
+	// The limits given to Streams.
+	limits []int
+}
+
+func (f *fakeClient) Count(_ context.Context, _ string, _ log.QueryRange) (int, error) {
+	if f.err != nil {
+		return 0, f.err
+	}
+	return f.count, nil
+}
+
+func (f *fakeClient) Streams(_ context.Context, _ string, _ log.QueryRange, limit int) ([]stream, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	f.limits = append(f.limits, limit)
+	return f.streams, nil
+}
@@ -0,0 +1,311 @@
+// Copyright 2024 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package loki
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/pipe-cd/pipecd/pkg/app/pipedv1/plugin/analysis/analysisprovider/log"
+)
+
+const (
+	ProviderType   = "Loki"
+	defaultTimeout = 30 * time.Second
+)
+
+// The stream labels used to find the severity of log lines.
+var severityLabels = []string{"level", "detected_level", "severity"}
+
+type client interface {
+	// Count returns the number of log lines matched with the given LogQL log query within the given range.
+	Count(ctx context.Context, query string, queryRange log.QueryRange) (int, error)
+	// Streams returns at most limit log lines matched with the given LogQL log query within the given range.
+	Streams(ctx context.Context, query string, queryRange log.QueryRange, limit int) ([]stream, error)
+}
+
+// stream represents a set of log lines sharing the same labels.
+type stream struct {
+	Labels map[string]string `json:"stream"`
+	// Pairs of the timestamp in nanoseconds and the log line.
+	Values [][2]string `json:"values"`
+}
+
+// Provider is a client for Grafana Loki.
+type Provider struct {
+	client client
+
+	address     string
+	tenantID    string
+	username    string
+	password    string
+	bearerToken string
+
+	timeout time.Duration
+	logger  *zap.Logger
+}
+
+func NewProvider(address string, opts ...Option) (*Provider, error) {
+	if address == "" {
+		return nil, fmt.Errorf("address is required")
+	}
+
+	p := &Provider{
+		address: strings.TrimSuffix(address, "/"),
+		timeout: defaultTimeout,
+		logger:  zap.NewNop(),
+	}
+	for _, opt := range opts {
+		opt(p)
+	}
+	p.client = &httpClient{
+		client:      &http.Client{},
+		address:     p.address,
+		tenantID:    p.tenantID,
+		username:    p.username,
+		password:    p.password,
+		bearerToken: p.bearerToken,
+	}
+	return p, nil
+}
+
+type Option func(*Provider)
+
+func WithTimeout(timeout time.Duration) Option {
+	return func(p *Provider) {
+		p.timeout = timeout
+	}
+}
+
+func WithLogger(logger *zap.Logger) Option {
+	return func(p *Provider) {
+		p.logger = logger.Named("loki-provider")
+	}
+}
+
+func WithBasicAuth(username, password string) Option {
+	return func(p *Provider) {
+		p.username = username
+		p.password = password
+	}
+}
+
+func WithBearerToken(token string) Option {
+	return func(p *Provider) {
+		p.bearerToken = token
+	}
+}
+
+// WithTenantID sets the tenant sent as X-Scope-OrgID header to a multi-tenant Loki.
+func WithTenantID(tenantID string) Option {
+	return func(p *Provider) {
+		p.tenantID = tenantID
+	}
+}
+
+func (p *Provider) Type() string {
+	return ProviderType
+}
+
+// QueryEntries counts the log lines matched with the given LogQL log query like
+// `{app="foo"} |= "error"` within the given range.
+// The samples are fetched only when some log lines are matched.
+func (p *Provider) QueryEntries(ctx context.Context, query string, queryRange log.QueryRange, sampleSize int) (*log.QueryResult, error) {
+	if query == "" {
+		return nil, fmt.Errorf("query is required")
+	}
+	if err := queryRange.Validate(); err != nil {
+		return nil, err
+	}
+	ctx, cancel := context.WithTimeout(ctx, p.timeout)
+	defer cancel()
+
+	count, err := p.client.Count(ctx, query, queryRange)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count log lines for %s: %w", ProviderType, err)
+	}
+	result := &log.QueryResult{Count: count}
+	p.logger.Debug("counted log lines",
+		zap.String("query", query),
+		zap.Int("count", count),
+	)
+	if count == 0 || sampleSize <= 0 {
+		return result, nil
+	}
+
+	streams, err := p.client.Streams(ctx, query, queryRange, sampleSize)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query log lines for %s: %w", ProviderType, err)
+	}
+	result.Samples = convertStreams(streams, sampleSize)
+	return result, nil
+}
+
+// convertStreams merges the log lines of the given streams and returns the newest limit ones.
+func convertStreams(streams []stream, limit int) []log.Entry {
+	var entries []log.Entry
+	for _, s := range streams {
+		var severity string
+		for _, l := range severityLabels {
+			if v, ok := s.Labels[l]; ok {
+				severity = strings.ToUpper(v)
+				break
+			}
+		}
+		for _, v := range s.Values {
+			ns, err := strconv.ParseInt(v[0], 10, 64)
+			if err != nil {
+				continue
+			}
+			entries = append(entries, log.Entry{
+				Timestamp: time.Unix(0, ns).UTC(),
+				Severity:  severity,
+				Message:   v[1],
+			})
+		}
+	}
+	sort.SliceStable(entries, func(i, j int) bool {
+		return entries[i].Timestamp.After(entries[j].Timestamp)
+	})
+	if len(entries) > limit {
+		entries = entries[:limit]
+	}
+	return entries
+}
+
+// makeCountQuery builds the LogQL metric query to count the log lines matched with the given log query.
+func makeCountQuery(query string, queryRange log.QueryRange) string {
+	seconds := int64(queryRange.To.Sub(queryRange.From).Seconds())
+	if seconds < 1 {
+		seconds = 1
+	}
+	return fmt.Sprintf("sum(count_over_time(%s [%ds]))", query, seconds)
+}
+
+type httpClient struct {
+	client      *http.Client
+	address     string
+	tenantID    string
+	username    string
+	password    string
+	bearerToken string
+}
+
+type queryResponse struct {
+	Status string `json:"status"`
+	Data   struct {
+		ResultType string          `json:"resultType"`
+		Result     json.RawMessage `json:"result"`
+	} `json:"data"`
+}
+
+func (c *httpClient) Count(ctx context.Context, query string, queryRange log.QueryRange) (int, error) {
+	params := url.Values{}
+	params.Set("query", makeCountQuery(query, queryRange))
+	params.Set("time", strconv.FormatInt(queryRange.To.UnixNano(), 10))
+	resp, err := c.get(ctx, "/loki/api/v1/query", params)
+	if err != nil {
+		return 0, err
+	}
+	if resp.Data.ResultType != "vector" {
+		return 0, fmt.Errorf("unexpected result type %q returned", resp.Data.ResultType)
+	}
+	var samples []struct {
+		// Pair of the timestamp in seconds and the value.
+		Value [2]interface{} `json:"value"`
+	}
+	if err := json.Unmarshal(resp.Data.Result, &samples); err != nil {
+		return 0, fmt.Errorf("failed to decode the result: %w", err)
+	}
+	// No sample is returned when nothing matched.
+	if len(samples) == 0 {
+		return 0, nil
+	}
+	v, ok := samples[0].Value[1].(string)
+	if !ok {
+		return 0, fmt.Errorf("unexpected value %v returned", samples[0].Value[1])
+	}
+	count, err := strconv.ParseFloat(v, 64)
+	if err != nil {
+		return 0, fmt.Errorf("unexpected value %q returned: %w", v, err)
+	}
+	return int(count), nil
+}
+
+func (c *httpClient) Streams(ctx context.Context, query string, queryRange log.QueryRange, limit int) ([]stream, error) {
+	params := url.Values{}
+	params.Set("query", query)
+	params.Set("start", strconv.FormatInt(queryRange.From.UnixNano(), 10))
+	params.Set("end", strconv.FormatInt(queryRange.To.UnixNano(), 10))
+	params.Set("limit", strconv.Itoa(limit))
+	params.Set("direction", "backward")
+	resp, err := c.get(ctx, "/loki/api/v1/query_range", params)
+	if err != nil {
+		return nil, err
+	}
+	if resp.Data.ResultType != "streams" {
+		return nil, fmt.Errorf("unexpected result type %q returned, the query must be a log query", resp.Data.ResultType)
+	}
+	var streams []stream
+	if err := json.Unmarshal(resp.Data.Result, &streams); err != nil {
+		return nil, fmt.Errorf("failed to decode the result: %w", err)
+	}
+	return streams, nil
+}
+
+func (c *httpClient) get(ctx context.Context, path string, params url.Values) (*queryResponse, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.address+path+"?"+params.Encode(), nil)
+	if err != nil {
+		return nil, err
+	}
+	if c.tenantID != "" {
+		req.Header.Set("X-Scope-OrgID", c.tenantID)
+	}
+	if c.username != "" && c.password != "" {
+		req.SetBasicAuth(c.username, c.password)
+	}
+	if c.bearerToken != "" {
+		req.Header.Set("Authorization", "Bearer "+c.bearerToken)
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return nil, fmt.Errorf("loki returned %d: %s", resp.StatusCode, strings.TrimSpace(string(msg)))
+	}
+	var out queryResponse
+	if err := json.NewDecoder(resp.Body).Decode(&out); err != nil {
+		return nil, fmt.Errorf("failed to decode the response: %w", err)
+	}
+	if out.Status != "success" {
+		return nil, fmt.Errorf("loki returned status %q", out.Status)
+	}
+	return &out, nil
+}
@@ -0,0 +1,168 @@
+// Copyright 2024 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package loki
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/pipe-cd/pipecd/pkg/app/pipedv1/plugin/analysis/analysisprovider/log"
+)
+
+var testQueryRange = log.QueryRange{
+	From: time.Date(2009, time.January, 1, 0, 0, 0, 0, time.UTC),
+	To:   time.Date(2009, time.January, 1, 0, 5, 0, 0, time.UTC),
+}
+
+func TestType(t *testing.T) {
+	t.Parallel()
+
+	p := Provider{}
+	assert.Equal(t, ProviderType, p.Type())
+}
+
+func TestProviderQueryEntries(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name       string
+		client     *fakeClient
+		sampleSize int
+		want       *log.QueryResult
+		wantLimits []int
+		wantErr    bool
+	}{
+		{
+			name: "query failed",
+			client: &fakeClient{
+				err: fmt.Errorf("query error"),
+			},
+			wantErr: true,
+		},
+		{
+			name:       "no log lines",
+			client:     &fakeClient{},
+			sampleSize: 2,
+			want:       &log.QueryResult{},
+		},
+		{
+			name: "samples are not needed",
+			client: &fakeClient{
+				count: 3,
+			},
+			want: &log.QueryResult{Count: 3},
+		},
+		{
+			name: "merge streams",
+			client: &fakeClient{
+				count: 3,
+				streams: []stream{
+					{
+						Labels: map[string]string{"app": "foo", "level": "error"},
+						Values: [][2]string{
+							{"1230768180000000000", "error 1"},
+							{"1230768000000000000", "error 3"},
+						},
+					},
+					{
+						Labels: map[string]string{"app": "bar"},
+						Values: [][2]string{
+							{"1230768240000000000", "error 0"},
+							{"1230768120000000000", "error 2"},
+						},
+					},
+				},
+			},
+			sampleSize: 3,
+			want: &log.QueryResult{
+				Count: 3,
+				Samples: []log.Entry{
+					{Timestamp: time.Date(2009, time.January, 1, 0, 4, 0, 0, time.UTC), Message: "error 0"},
+					{Timestamp: time.Date(2009, time.January, 1, 0, 3, 0, 0, time.UTC), Severity: "ERROR", Message: "error 1"},
+					{Timestamp: time.Date(2009, time.January, 1, 0, 2, 0, 0, time.UTC), Message: "error 2"},
+				},
+			},
+			wantLimits: []int{3},
+		},
+	}
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			p := Provider{
+				client:  tc.client,
+				timeout: defaultTimeout,
+				logger:  zap.NewNop(),
+			}
+			got, err := p.QueryEntries(context.Background(), `{app=~"foo|bar"} |= "error"`, testQueryRange, tc.sampleSize)
+			assert.Equal(t, tc.wantErr, err != nil)
+			assert.Equal(t, tc.want, got)
+			assert.Equal(t, tc.wantLimits, tc.client.limits)
+		})
+	}
+}
+
+func TestHTTPClient(t *testing.T) {
+	t.Parallel()
+
+	var requests []*http.Request
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r)
+		switch r.URL.Path {
+		case "/loki/api/v1/query":
+			fmt.Fprint(w, `{"status":"success","data":{"resultType":"vector","result":[{"metric":{},"value":[1230768300,"12"]}]}}`)
+		case "/loki/api/v1/query_range":
+			fmt.Fprint(w, `{"status":"success","data":{"resultType":"streams","result":[{"stream":{"app":"foo"},"values":[["1230768240000000000","error 0"]]}]}}`)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	p, err := NewProvider(server.URL+"/", WithTenantID("tenant"), WithBasicAuth("user", "pass"))
+	require.NoError(t, err)
+
+	got, err := p.QueryEntries(context.Background(), `{app="foo"} |= "error"`, testQueryRange, 5)
+	require.NoError(t, err)
+	assert.Equal(t, &log.QueryResult{
+		Count: 12,
+		Samples: []log.Entry{
+			{Timestamp: time.Date(2009, time.January, 1, 0, 4, 0, 0, time.UTC), Message: "error 0"},
+		},
+	}, got)
+
+	require.Len(t, requests, 2)
+	for _, r := range requests {
+		assert.Equal(t, "tenant", r.Header.Get("X-Scope-OrgID"))
+		username, password, ok := r.BasicAuth()
+		assert.True(t, ok)
+		assert.Equal(t, "user", username)
+		assert.Equal(t, "pass", password)
+	}
+	assert.Equal(t, `sum(count_over_time({app="foo"} |= "error" [300s]))`, requests[0].URL.Query().Get("query"))
+	assert.Equal(t, "1230768300000000000", requests[0].URL.Query().Get("time"))
+	assert.Equal(t, `{app="foo"} |= "error"`, requests[1].URL.Query().Get("query"))
+	assert.Equal(t, "1230768000000000000", requests[1].URL.Query().Get("start"))
+	assert.Equal(t, "5", requests[1].URL.Query().Get("limit"))
+	assert.Equal(t, "backward", requests[1].URL.Query().Get("direction"))
+}
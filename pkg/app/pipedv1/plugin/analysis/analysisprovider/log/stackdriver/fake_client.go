@@ -0,0 +1,52 @@
+// Copyright 2024 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package stackdriver
+
+import (
+	"context"
+	"fmt"
+
+	logging "google.golang.org/api/logging/v2"
+)
+
+// fakeClient returns the given pages of entries in order.
+type fakeClient struct {
+	pages    [][]*logging.LogEntry
+	err      error
+	requests []*logging.ListLogEntriesRequest
+}
+
+func (f *fakeClient) ListEntries(_ context.Context, req *logging.ListLogEntriesRequest) (*logging.ListLogEntriesResponse, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	r := *req
+	f.requests = append(f.requests, &r)
+
+	var page int
+	if req.PageToken != "" {
+		if _, err := fmt.Sscanf(req.PageToken, "page-%d", &page); err != nil {
+			return nil, err
+		}
+	}
+	if page >= len(f.pages) {
+		return &logging.ListLogEntriesResponse{}, nil
+	}
+	resp := &logging.ListLogEntriesResponse{Entries: f.pages[page]}
+	if page+1 < len(f.pages) {
+		resp.NextPageToken = fmt.Sprintf("page-%d", page+1)
+	}
+	return resp, nil
+}
@@ -0,0 +1,160 @@
+// Copyright 2024 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package stackdriver
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	logging "google.golang.org/api/logging/v2"
+
+	"github.com/pipe-cd/pipecd/pkg/app/pipedv1/plugin/analysis/analysisprovider/log"
+)
+
+func TestType(t *testing.T) {
+	t.Parallel()
+
+	p := Provider{}
+	assert.Equal(t, ProviderType, p.Type())
+}
+
+func TestProviderQueryEntries(t *testing.T) {
+	t.Parallel()
+
+	queryRange := log.QueryRange{
+		From: time.Date(2009, time.January, 1, 0, 0, 0, 0, time.UTC),
+		To:   time.Date(2009, time.January, 1, 0, 5, 0, 0, time.UTC),
+	}
+	makeEntries := func(n int) []*logging.LogEntry {
+		entries := make([]*logging.LogEntry, n)
+		for i := range entries {
+			entries[i] = &logging.LogEntry{
+				Timestamp:   "2009-01-01T00:04:00Z",
+				Severity:    "ERROR",
+				TextPayload: fmt.Sprintf("error %d", i),
+			}
+		}
+		return entries
+	}
+	testcases := []struct {
+		name       string
+		client     *fakeClient
+		sampleSize int
+		want       *log.QueryResult
+		wantErr    bool
+	}{
+		{
+			name: "query failed",
+			client: &fakeClient{
+				err: fmt.Errorf("query error"),
+			},
+			wantErr: true,
+		},
+		{
+			name:       "no entries",
+			client:     &fakeClient{},
+			sampleSize: 3,
+			want:       &log.QueryResult{},
+		},
+		{
+			name: "structured entries",
+			client: &fakeClient{
+				pages: [][]*logging.LogEntry{
+					{
+						{
+							Timestamp:   "2009-01-01T00:04:00.123Z",
+							Severity:    "ERROR",
+							JsonPayload: []byte(`{"message":"failed to connect","code":14}`),
+						},
+						{
+							Timestamp:   "2009-01-01T00:03:00Z",
+							JsonPayload: []byte(`{"code":13}`),
+						},
+					},
+				},
+			},
+			sampleSize: 3,
+			want: &log.QueryResult{
+				Count: 2,
+				Samples: []log.Entry{
+					{
+						Timestamp: time.Date(2009, time.January, 1, 0, 4, 0, 123000000, time.UTC),
+						Severity:  "ERROR",
+						Message:   "failed to connect",
+					},
+					{
+						Timestamp: time.Date(2009, time.January, 1, 0, 3, 0, 0, time.UTC),
+						Message:   `{"code":13}`,
+					},
+				},
+			},
+		},
+		{
+			name: "count entries across pages",
+			client: &fakeClient{
+				pages: [][]*logging.LogEntry{makeEntries(2), makeEntries(3)},
+			},
+			sampleSize: 3,
+			want: &log.QueryResult{
+				Count: 5,
+				Samples: []log.Entry{
+					{Timestamp: time.Date(2009, time.January, 1, 0, 4, 0, 0, time.UTC), Severity: "ERROR", Message: "error 0"},
+					{Timestamp: time.Date(2009, time.January, 1, 0, 4, 0, 0, time.UTC), Severity: "ERROR", Message: "error 1"},
+					{Timestamp: time.Date(2009, time.January, 1, 0, 4, 0, 0, time.UTC), Severity: "ERROR", Message: "error 0"},
+				},
+			},
+		},
+		{
+			name: "truncated",
+			client: func() *fakeClient {
+				pages := make([][]*logging.LogEntry, maxPages+1)
+				for i := range pages {
+					pages[i] = makeEntries(1)
+				}
+				return &fakeClient{pages: pages}
+			}(),
+			want: &log.QueryResult{
+				Count:     maxPages,
+				Truncated: true,
+			},
+		},
+	}
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			p := Provider{
+				client:    tc.client,
+				projectID: "project",
+				timeout:   defaultTimeout,
+				logger:    zap.NewNop(),
+			}
+			got, err := p.QueryEntries(context.Background(), `severity>=ERROR`, queryRange, tc.sampleSize)
+			assert.Equal(t, tc.wantErr, err != nil)
+			assert.Equal(t, tc.want, got)
+			if tc.wantErr {
+				return
+			}
+			require.NotEmpty(t, tc.client.requests)
+			assert.Equal(t, []string{"projects/project"}, tc.client.requests[0].ResourceNames)
+			assert.Equal(t, `(severity>=ERROR) AND timestamp>="2009-01-01T00:00:00Z" AND timestamp<="2009-01-01T00:05:00Z"`, tc.client.requests[0].Filter)
+		})
+	}
+}
@@ -0,0 +1,167 @@
+// Copyright 2024 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cloudmonitoring
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/prometheus/client_golang/api"
+	v1 "github.com/prometheus/client_golang/api/prometheus/v1"
+	"github.com/prometheus/common/model"
+	"go.uber.org/zap"
+	"google.golang.org/api/option"
+	htransport "google.golang.org/api/transport/http"
+
+	"github.com/pipe-cd/pipecd/pkg/app/pipedv1/plugin/analysis/analysisprovider/metrics"
+)
+
+const (
+	ProviderType   = "CloudMonitoring"
+	defaultTimeout = 30 * time.Second
+	// The endpoint of the Prometheus HTTP API offered by Cloud Monitoring.
+	prometheusEndpoint = "https://monitoring.googleapis.com/v1/projects/%s/location/global/prometheus"
+	readScope          = "https://www.googleapis.com/auth/monitoring.read"
+)
+
+type client interface {
+	QueryRange(ctx context.Context, query string, r v1.Range) (model.Value, v1.Warnings, error)
+}
+
+// Provider is a client for Google Cloud Monitoring.
+// The metrics are queried in PromQL, including the Google Cloud metrics
+// like `rate(run_googleapis_com:request_count{monitored_resource="cloud_run_revision"}[1m])`.
+type Provider struct {
+	client    client
+	projectID string
+
+	timeout time.Duration
+	logger  *zap.Logger
+}
+
+func NewProvider(ctx context.Context, serviceAccount []byte, opts ...Option) (*Provider, error) {
+	p := &Provider{
+		timeout: defaultTimeout,
+		logger:  zap.NewNop(),
+	}
+	for _, opt := range opts {
+		opt(p)
+	}
+
+	if p.projectID == "" {
+		var sa struct {
+			ProjectID string `json:"project_id"`
+		}
+		if err := json.Unmarshal(serviceAccount, &sa); err != nil {
+			return nil, fmt.Errorf("failed to parse service account: %w", err)
+		}
+		if sa.ProjectID == "" {
+			return nil, fmt.Errorf("project id is required because the service account does not contain it")
+		}
+		p.projectID = sa.ProjectID
+	}
+
+	httpClient, _, err := htransport.NewClient(ctx, option.WithCredentialsJSON(serviceAccount), option.WithScopes(readScope))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create cloud monitoring client: %w", err)
+	}
+	c, err := api.NewClient(api.Config{
+		Address:      fmt.Sprintf(prometheusEndpoint, p.projectID),
+		RoundTripper: httpClient.Transport,
+	})
+	if err != nil {
+		return nil, err
+	}
+	p.client = v1.NewAPI(c)
+	return p, nil
+}
+
+type Option func(*Provider)
+
+func WithTimeout(timeout time.Duration) Option {
+	return func(p *Provider) {
+		p.timeout = timeout
+	}
+}
+
+func WithLogger(logger *zap.Logger) Option {
+	return func(p *Provider) {
+		p.logger = logger.Named("cloudmonitoring-provider")
+	}
+}
+
+// WithProjectID sets the scoping project where the metrics are read.
+// The project of the service account is used by default.
+func WithProjectID(projectID string) Option {
+	return func(p *Provider) {
+		p.projectID = projectID
+	}
+}
+
+func (p *Provider) Type() string {
+	return ProviderType
+}
+
+func (p *Provider) QueryPoints(ctx context.Context, query string, queryRange metrics.QueryRange) ([]metrics.DataPoint, error) {
+	ctx, cancel := context.WithTimeout(ctx, p.timeout)
+	defer cancel()
+
+	if err := queryRange.Validate(); err != nil {
+		return nil, err
+	}
+	// NOTE: Use 1m as a step but make sure the "step" is smaller than the query range.
+	step := time.Minute
+	if diff := queryRange.To.Sub(queryRange.From); diff < step {
+		step = diff
+	}
+
+	p.logger.Info("run query", zap.String("query", query))
+	response, warnings, err := p.client.QueryRange(ctx, query, v1.Range{
+		Start: queryRange.From,
+		End:   queryRange.To,
+		Step:  step,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to run query for %s: %w", ProviderType, err)
+	}
+	for _, w := range warnings {
+		p.logger.Warn("non critical error occurred", zap.String("warning", w))
+	}
+
+	// The range queries endpoint always gives back a range vector.
+	matrix, ok := response.(model.Matrix)
+	if !ok {
+		return nil, fmt.Errorf("unexpected data type returned")
+	}
+	var points []metrics.DataPoint
+	for _, r := range matrix {
+		for _, point := range r.Values {
+			if math.IsNaN(float64(point.Value)) {
+				return nil, fmt.Errorf("the value is not a number: %w", metrics.ErrNoDataFound)
+			}
+			points = append(points, metrics.DataPoint{
+				Timestamp: point.Timestamp.Unix(),
+				Value:     float64(point.Value),
+			})
+		}
+	}
+	if len(points) == 0 {
+		return nil, fmt.Errorf("no data points found within the queried range: %w", metrics.ErrNoDataFound)
+	}
+	return points, nil
+}
@@ -0,0 +1,130 @@
+// Copyright 2024 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cloudmonitoring
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"testing"
+	"time"
+
+	v1 "github.com/prometheus/client_golang/api/prometheus/v1"
+	"github.com/prometheus/common/model"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+
+	"github.com/pipe-cd/pipecd/pkg/app/pipedv1/plugin/analysis/analysisprovider/metrics"
+)
+
+func TestType(t *testing.T) {
+	t.Parallel()
+
+	p := Provider{}
+	assert.Equal(t, ProviderType, p.Type())
+}
+
+func TestProviderQueryPoints(t *testing.T) {
+	t.Parallel()
+
+	queryRange := metrics.QueryRange{
+		From: time.Date(2009, time.January, 1, 0, 0, 0, 0, time.UTC),
+		To:   time.Date(2009, time.January, 1, 0, 5, 0, 0, time.UTC),
+	}
+	testcases := []struct {
+		name       string
+		client     *fakeClient
+		want       []metrics.DataPoint
+		wantErr    bool
+		wantNoData bool
+	}{
+		{
+			name: "query failed",
+			client: &fakeClient{
+				err: fmt.Errorf("query error"),
+			},
+			wantErr: true,
+		},
+		{
+			name: "unexpected data type",
+			client: &fakeClient{
+				value: &model.Scalar{Value: 0.1},
+			},
+			wantErr: true,
+		},
+		{
+			name: "no data points",
+			client: &fakeClient{
+				value: model.Matrix{},
+			},
+			wantErr:    true,
+			wantNoData: true,
+		},
+		{
+			name: "not a number",
+			client: &fakeClient{
+				value: model.Matrix{
+					{Values: []model.SamplePair{{Timestamp: model.TimeFromUnix(1230768060), Value: model.SampleValue(math.NaN())}}},
+				},
+			},
+			wantErr:    true,
+			wantNoData: true,
+		},
+		{
+			name: "multiple series",
+			client: &fakeClient{
+				value: model.Matrix{
+					{
+						Metric: model.Metric{"service_name": "foo"},
+						Values: []model.SamplePair{
+							{Timestamp: model.TimeFromUnix(1230768060), Value: 0.1},
+							{Timestamp: model.TimeFromUnix(1230768120), Value: 0.2},
+						},
+					},
+					{
+						Metric: model.Metric{"service_name": "bar"},
+						Values: []model.SamplePair{
+							{Timestamp: model.TimeFromUnix(1230768060), Value: 0.3},
+						},
+					},
+				},
+			},
+			want: []metrics.DataPoint{
+				{Timestamp: 1230768060, Value: 0.1},
+				{Timestamp: 1230768120, Value: 0.2},
+				{Timestamp: 1230768060, Value: 0.3},
+			},
+		},
+	}
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			p := Provider{
+				client:  tc.client,
+				timeout: defaultTimeout,
+				logger:  zap.NewNop(),
+			}
+			got, err := p.QueryPoints(context.Background(), `rate(run_googleapis_com:request_count[1m])`, queryRange)
+			assert.Equal(t, tc.wantErr, err != nil)
+			assert.Equal(t, tc.wantNoData, errors.Is(err, metrics.ErrNoDataFound))
+			assert.Equal(t, tc.want, got)
+			if tc.client.err == nil {
+				assert.Equal(t, []v1.Range{{Start: queryRange.From, End: queryRange.To, Step: time.Minute}}, tc.client.ranges)
+			}
+		})
+	}
+}
@@ -0,0 +1,262 @@
+// Copyright 2024 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cloudwatch
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	v4 "github.com/aws/aws-sdk-go-v2/aws/signer/v4"
+	"github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/credentials/stscreds"
+	"go.uber.org/zap"
+
+	"github.com/pipe-cd/pipecd/pkg/app/pipedv1/plugin/analysis/analysisprovider/metrics"
+)
+
+const (
+	ProviderType   = "CloudWatch"
+	defaultTimeout = 30 * time.Second
+	// The granularity of the returned data points.
+	defaultPeriod = time.Minute
+	apiVersion    = "2010-08-01"
+	serviceName   = "monitoring"
+	queryID       = "q"
+)
+
+type client interface {
+	GetMetricData(ctx context.Context, in *getMetricDataInput) (*getMetricDataOutput, error)
+}
+
+type getMetricDataInput struct {
+	// A Metrics Insights query or a metric math expression.
+	Expression string
+	StartTime  time.Time
+	EndTime    time.Time
+	// The granularity in seconds.
+	Period    int
+	NextToken string
+}
+
+type getMetricDataOutput struct {
+	Results   []metricDataResult `xml:"GetMetricDataResult>MetricDataResults>member"`
+	NextToken string             `xml:"GetMetricDataResult>NextToken"`
+}
+
+type metricDataResult struct {
+	ID         string      `xml:"Id"`
+	Label      string      `xml:"Label"`
+	StatusCode string      `xml:"StatusCode"`
+	Timestamps []time.Time `xml:"Timestamps>member"`
+	Values     []float64   `xml:"Values>member"`
+}
+
+// Provider is a client for Amazon CloudWatch.
+type Provider struct {
+	client client
+
+	period  time.Duration
+	timeout time.Duration
+	logger  *zap.Logger
+}
+
+// Options to load the AWS credentials.
+type Credentials struct {
+	// Path to the shared credentials file.
+	CredentialsFile string
+	// AWS profile to extract credentials from the shared credentials file.
+	Profile string
+	// The IAM role arn to use when assuming a role.
+	RoleARN string
+	// Path to the WebIdentity token the SDK should use to assume a role with.
+	TokenFile string
+}
+
+func NewProvider(ctx context.Context, region string, creds Credentials, opts ...Option) (*Provider, error) {
+	if region == "" {
+		return nil, fmt.Errorf("region is required")
+	}
+
+	p := &Provider{
+		period:  defaultPeriod,
+		timeout: defaultTimeout,
+		logger:  zap.NewNop(),
+	}
+	for _, opt := range opts {
+		opt(p)
+	}
+
+	optFns := []func(*config.LoadOptions) error{config.WithRegion(region)}
+	if creds.CredentialsFile != "" {
+		optFns = append(optFns, config.WithSharedCredentialsFiles([]string{creds.CredentialsFile}))
+	}
+	if creds.Profile != "" {
+		optFns = append(optFns, config.WithSharedConfigProfile(creds.Profile))
+	}
+	if creds.TokenFile != "" && creds.RoleARN != "" {
+		optFns = append(optFns, config.WithWebIdentityRoleCredentialOptions(func(v *stscreds.WebIdentityRoleOptions) {
+			v.RoleARN = creds.RoleARN
+			v.TokenRetriever = stscreds.IdentityTokenFile(creds.TokenFile)
+		}))
+	}
+	cfg, err := config.LoadDefaultConfig(ctx, optFns...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load config to create cloudwatch client: %w", err)
+	}
+	p.client = &httpClient{
+		client:      &http.Client{},
+		endpoint:    fmt.Sprintf("https://monitoring.%s.amazonaws.com/", region),
+		region:      region,
+		credentials: cfg.Credentials,
+		signer:      v4.NewSigner(),
+	}
+	return p, nil
+}
+
+type Option func(*Provider)
+
+func WithTimeout(timeout time.Duration) Option {
+	return func(p *Provider) {
+		p.timeout = timeout
+	}
+}
+
+func WithLogger(logger *zap.Logger) Option {
+	return func(p *Provider) {
+		p.logger = logger.Named("cloudwatch-provider")
+	}
+}
+
+func (p *Provider) Type() string {
+	return ProviderType
+}
+
+// QueryPoints runs the given Metrics Insights query like
+// `SELECT AVG(CPUUtilization) FROM SCHEMA("AWS/ECS", ClusterName, ServiceName) WHERE ServiceName = 'foo'`
+// or metric math expression, and gives back the data points of all returned time series within the given range.
+func (p *Provider) QueryPoints(ctx context.Context, query string, queryRange metrics.QueryRange) ([]metrics.DataPoint, error) {
+	ctx, cancel := context.WithTimeout(ctx, p.timeout)
+	defer cancel()
+
+	if err := queryRange.Validate(); err != nil {
+		return nil, err
+	}
+	in := &getMetricDataInput{
+		Expression: query,
+		StartTime:  queryRange.From,
+		EndTime:    queryRange.To,
+		Period:     int(p.period.Seconds()),
+	}
+	var points []metrics.DataPoint
+	for {
+		out, err := p.client.GetMetricData(ctx, in)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get metric data from %s: %w", ProviderType, err)
+		}
+		for _, r := range out.Results {
+			if len(r.Timestamps) != len(r.Values) {
+				return nil, fmt.Errorf("invalid response: the number of timestamps and values of %q are different", r.Label)
+			}
+			for i := range r.Values {
+				points = append(points, metrics.DataPoint{
+					Timestamp: r.Timestamps[i].Unix(),
+					Value:     r.Values[i],
+				})
+			}
+		}
+		if out.NextToken == "" {
+			break
+		}
+		in.NextToken = out.NextToken
+	}
+	if len(points) == 0 {
+		return nil, fmt.Errorf("no data points found within the queried range: %w", metrics.ErrNoDataFound)
+	}
+	return points, nil
+}
+
+// httpClient calls the GetMetricData action of CloudWatch Query API.
+type httpClient struct {
+	client      *http.Client
+	endpoint    string
+	region      string
+	credentials aws.CredentialsProvider
+	signer      *v4.Signer
+}
+
+func (c *httpClient) GetMetricData(ctx context.Context, in *getMetricDataInput) (*getMetricDataOutput, error) {
+	form := url.Values{}
+	form.Set("Action", "GetMetricData")
+	form.Set("Version", apiVersion)
+	form.Set("StartTime", in.StartTime.UTC().Format(time.RFC3339))
+	form.Set("EndTime", in.EndTime.UTC().Format(time.RFC3339))
+	form.Set("ScanBy", "TimestampAscending")
+	form.Set("MetricDataQueries.member.1.Id", queryID)
+	form.Set("MetricDataQueries.member.1.Expression", in.Expression)
+	form.Set("MetricDataQueries.member.1.Period", strconv.Itoa(in.Period))
+	if in.NextToken != "" {
+		form.Set("NextToken", in.NextToken)
+	}
+	body := form.Encode()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.endpoint, strings.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded; charset=utf-8")
+	if c.credentials != nil {
+		creds, err := c.credentials.Retrieve(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to retrieve aws credentials: %w", err)
+		}
+		hash := sha256.Sum256([]byte(body))
+		if err := c.signer.SignHTTP(ctx, creds, req, hex.EncodeToString(hash[:]), serviceName, c.region, time.Now()); err != nil {
+			return nil, fmt.Errorf("failed to sign the request: %w", err)
+		}
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		var e struct {
+			Code    string `xml:"Error>Code"`
+			Message string `xml:"Error>Message"`
+		}
+		data, _ := io.ReadAll(io.LimitReader(resp.Body, 4096))
+		if err := xml.Unmarshal(data, &e); err == nil && e.Code != "" {
+			return nil, fmt.Errorf("cloudwatch returned %d: %s: %s", resp.StatusCode, e.Code, e.Message)
+		}
+		return nil, fmt.Errorf("cloudwatch returned %d: %s", resp.StatusCode, strings.TrimSpace(string(data)))
+	}
+	var out getMetricDataOutput
+	if err := xml.NewDecoder(resp.Body).Decode(&out); err != nil {
+		return nil, fmt.Errorf("failed to decode the response: %w", err)
+	}
+	return &out, nil
+}
@@ -0,0 +1,49 @@
+// Copyright 2024 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cloudwatch
+
+import (
+	"context"
+	"fmt"
+)
+
+// fakeClient returns the given pages of results in order.
+type fakeClient struct {
+	pages  [][]metricDataResult
+	err    error
+	inputs []getMetricDataInput
+}
+
+func (f *fakeClient) GetMetricData(_ context.Context, in *getMetricDataInput) (*getMetricDataOutput, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	f.inputs = append(f.inputs, *in)
+
+	var page int
+	if in.NextToken != "" {
+		if _, err := fmt.Sscanf(in.NextToken, "page-%d", &page); err != nil {
+			return nil, err
+		}
+	}
+	if page >= len(f.pages) {
+		return &getMetricDataOutput{}, nil
+	}
+	out := &getMetricDataOutput{Results: f.pages[page]}
+	if page+1 < len(f.pages) {
+		out.NextToken = fmt.Sprintf("page-%d", page+1)
+	}
+	return out, nil
+}
@@ -0,0 +1,255 @@
+// Copyright 2024 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package newrelic
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/pipe-cd/pipecd/pkg/app/pipedv1/plugin/analysis/analysisprovider/metrics"
+)
+
+const (
+	ProviderType   = "NewRelic"
+	defaultAddress = "https://api.newrelic.com/graphql"
+	defaultTimeout = 30 * time.Second
+)
+
+const nrqlGraphQLQuery = `query($accountId: Int!, $nrql: Nrql!) {
+  actor {
+    account(id: $accountId) {
+      nrql(query: $nrql) {
+        results
+      }
+    }
+  }
+}`
+
+// The fields of NRQL results which are not the queried values.
+var nonValueFields = map[string]struct{}{
+	"beginTimeSeconds": {},
+	"endTimeSeconds":   {},
+	"facet":            {},
+	"timestamp":        {},
+}
+
+type client interface {
+	// QueryNRQL runs the given NRQL query on the given account through NerdGraph API.
+	QueryNRQL(ctx context.Context, accountID int64, nrql string) ([]map[string]interface{}, error)
+}
+
+// Provider is a client for New Relic.
+type Provider struct {
+	client    client
+	accountID int64
+
+	address string
+	apiKey  string
+	timeout time.Duration
+	logger  *zap.Logger
+}
+
+func NewProvider(accountID int64, apiKey string, opts ...Option) (*Provider, error) {
+	if accountID == 0 {
+		return nil, fmt.Errorf("account id is required")
+	}
+	if apiKey == "" {
+		return nil, fmt.Errorf("api-key is required")
+	}
+
+	p := &Provider{
+		accountID: accountID,
+		address:   defaultAddress,
+		apiKey:    apiKey,
+		timeout:   defaultTimeout,
+		logger:    zap.NewNop(),
+	}
+	for _, opt := range opts {
+		opt(p)
+	}
+	p.client = &httpClient{
+		client:  &http.Client{},
+		address: p.address,
+		apiKey:  p.apiKey,
+	}
+	return p, nil
+}
+
+type Option func(*Provider)
+
+// WithAddress sets the address of NerdGraph API.
+// Use "https://api.eu.newrelic.com/graphql" for the accounts in the EU region.
+func WithAddress(address string) Option {
+	return func(p *Provider) {
+		p.address = address
+	}
+}
+
+func WithTimeout(timeout time.Duration) Option {
+	return func(p *Provider) {
+		p.timeout = timeout
+	}
+}
+
+func WithLogger(logger *zap.Logger) Option {
+	return func(p *Provider) {
+		p.logger = logger.Named("newrelic-provider")
+	}
+}
+
+func (p *Provider) Type() string {
+	return ProviderType
+}
+
+// QueryPoints runs the given NRQL query like
+// `SELECT average(duration) FROM Transaction WHERE appName = 'foo' TIMESERIES 1 minute`
+// within the given range. The query must not contain SINCE and UNTIL clauses and must select a single value.
+// Without TIMESERIES clause, the single aggregated value is given back as a data point at the end of the range.
+func (p *Provider) QueryPoints(ctx context.Context, query string, queryRange metrics.QueryRange) ([]metrics.DataPoint, error) {
+	ctx, cancel := context.WithTimeout(ctx, p.timeout)
+	defer cancel()
+
+	if err := queryRange.Validate(); err != nil {
+		return nil, err
+	}
+	nrql := fmt.Sprintf("%s SINCE %d UNTIL %d", strings.TrimSpace(query), queryRange.From.UnixMilli(), queryRange.To.UnixMilli())
+	p.logger.Info("run query", zap.String("query", nrql))
+	results, err := p.client.QueryNRQL(ctx, p.accountID, nrql)
+	if err != nil {
+		return nil, fmt.Errorf("failed to run query for %s: %w", ProviderType, err)
+	}
+
+	points := make([]metrics.DataPoint, 0, len(results))
+	for _, r := range results {
+		value, ok, err := extractValue(r)
+		if err != nil {
+			return nil, err
+		}
+		// The value is null when no event is found in the time bucket.
+		if !ok {
+			continue
+		}
+		timestamp := queryRange.To.Unix()
+		if t, ok := r["beginTimeSeconds"].(float64); ok {
+			timestamp = int64(t)
+		}
+		points = append(points, metrics.DataPoint{
+			Timestamp: timestamp,
+			Value:     value,
+		})
+	}
+	if len(points) == 0 {
+		return nil, fmt.Errorf("no data points found within the queried range: %w", metrics.ErrNoDataFound)
+	}
+	return points, nil
+}
+
+// extractValue returns the single queried value of the given NRQL result.
+// The value can be nested in an object like {"percentile.duration": {"95": 0.3}}.
+func extractValue(result map[string]interface{}) (float64, bool, error) {
+	keys := make([]string, 0, len(result))
+	for k := range result {
+		if _, ok := nonValueFields[k]; !ok {
+			keys = append(keys, k)
+		}
+	}
+	if len(keys) != 1 {
+		sort.Strings(keys)
+		return 0, false, fmt.Errorf("the query must select a single value but got %d: %v", len(keys), keys)
+	}
+	switch v := result[keys[0]].(type) {
+	case nil:
+		return 0, false, nil
+	case float64:
+		return v, true, nil
+	case map[string]interface{}:
+		return extractValue(v)
+	default:
+		return 0, false, fmt.Errorf("the value of %s is not a number: %v", keys[0], v)
+	}
+}
+
+type httpClient struct {
+	client  *http.Client
+	address string
+	apiKey  string
+}
+
+func (c *httpClient) QueryNRQL(ctx context.Context, accountID int64, nrql string) ([]map[string]interface{}, error) {
+	body, err := json.Marshal(map[string]interface{}{
+		"query": nrqlGraphQLQuery,
+		"variables": map[string]interface{}{
+			"accountId": accountID,
+			"nrql":      nrql,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.address, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("API-Key", c.apiKey)
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return nil, fmt.Errorf("newrelic returned %d: %s", resp.StatusCode, strings.TrimSpace(string(msg)))
+	}
+	var out struct {
+		Data struct {
+			Actor struct {
+				Account struct {
+					NRQL *struct {
+						Results []map[string]interface{} `json:"results"`
+					} `json:"nrql"`
+				} `json:"account"`
+			} `json:"actor"`
+		} `json:"data"`
+		Errors []struct {
+			Message string `json:"message"`
+		} `json:"errors"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&out); err != nil {
+		return nil, fmt.Errorf("failed to decode the response: %w", err)
+	}
+	if len(out.Errors) > 0 {
+		msgs := make([]string, 0, len(out.Errors))
+		for _, e := range out.Errors {
+			msgs = append(msgs, e.Message)
+		}
+		return nil, fmt.Errorf("newrelic returned errors: %s", strings.Join(msgs, "; "))
+	}
+	if out.Data.Actor.Account.NRQL == nil {
+		return nil, fmt.Errorf("invalid response: no nrql result found")
+	}
+	return out.Data.Actor.Account.NRQL.Results, nil
+}
@@ -0,0 +1,176 @@
+// Copyright 2024 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/pipe-cd/pipecd/pkg/app/pipedv1/plugin/analysis/analysisprovider/metrics"
+	"github.com/pipe-cd/pipecd/pkg/model"
+	sdk "github.com/pipe-cd/piped-plugin-sdk-go"
+)
+
+// analyzer contains a query for an analysis provider.
+type analyzer struct {
+	id           string
+	providerType string
+	evaluate     evaluator
+	query        string
+	interval     time.Duration
+	// The analysis will fail, if this value is exceeded,
+	failureLimit int
+	skipOnNoData bool
+
+	evaluationRecorder
+
+	logger       *zap.Logger
+	logPersister sdk.StageLogPersister
+}
+
+type evaluator func(ctx context.Context, query string) (expected bool, reason string, err error)
+
+func newAnalyzer(
+	id string,
+	providerType string,
+	query string,
+	evaluate evaluator,
+	interval time.Duration,
+	failureLimit int,
+	skipOnNodata bool,
+	logger *zap.Logger,
+	logPersister sdk.StageLogPersister,
+) *analyzer {
+	return &analyzer{
+		id:           id,
+		providerType: providerType,
+		evaluate:     evaluate,
+		query:        query,
+		interval:     interval,
+		failureLimit: failureLimit,
+		skipOnNoData: skipOnNodata,
+		logPersister: logPersister,
+		logger: logger.With(
+			zap.String("analyzer-id", id),
+			zap.String("provider-type", providerType),
+		),
+	}
+}
+
+// run starts an analysis which runs the query at the given interval, until the context is done.
+// It returns an error when the number of failures exceeds the the failureLimit.
+func (a *analyzer) run(ctx context.Context) error {
+	ticker := time.NewTicker(a.interval)
+	defer ticker.Stop()
+
+	failureCount := 0
+	for {
+		select {
+		case <-ticker.C:
+			expected, reason, err := a.evaluate(ctx, a.query)
+			// Ignore parent's context deadline exceeded error, and return immediately.
+			if errors.Is(err, context.DeadlineExceeded) && ctx.Err() == context.DeadlineExceeded {
+				return nil
+			}
+			if errors.Is(err, metrics.ErrNoDataFound) && a.skipOnNoData {
+				a.logPersister.Infof("[%s] The query result evaluation was skipped because \"skipOnNoData\" is true even though no data returned. Reason: %v. Performed query: %q", a.id, err, a.query)
+				a.skip(err.Error())
+				continue
+			}
+			if err != nil {
+				reason = fmt.Sprintf("failed to run query: %s", err.Error())
+			}
+			a.reason = reason
+			a.record(expected, nil)
+
+			if expected {
+				a.logPersister.Successf("[%s] The query result is expected one. Reason: %s. Performed query: %q", a.id, reason, a.query)
+				continue
+			}
+
+			a.logPersister.Errorf("[%s] The query result is unexpected. Reason: %s. Performed query: %q", a.id, reason, a.query)
+			failureCount++
+			if failureCount > a.failureLimit {
+				return fmt.Errorf("analysis '%s' failed because the failure number exceeded the failure limit (%d)", a.id, a.failureLimit)
+			}
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
+
+// report returns the details of all evaluations of the HTTP analysis.
+func (a *analyzer) report() *model.AnalysisHTTPReport {
+	return &model.AnalysisHTTPReport{
+		Id:          a.id,
+		Provider:    a.providerType,
+		Score:       a.passRate(),
+		Evaluations: a.reports,
+	}
+}
+
+// evaluationRecorder keeps the results of the evaluations to be reported.
+type evaluationRecorder struct {
+	// The number of evaluations and the ones with the expected result.
+	evaluations int
+	passes      int
+	// The details of each evaluation to be reported.
+	reports []*model.AnalysisEvaluation
+	// The queries performed and the failure reason in the current evaluation.
+	queries []*model.AnalysisQuery
+	reason  string
+}
+
+// record counts the given result of an evaluation and adds it to the report.
+func (r *evaluationRecorder) record(expected bool, err error) {
+	r.evaluations++
+	verdict := model.AnalysisVerdict_VERDICT_FAILED
+	if expected {
+		r.passes++
+		verdict = model.AnalysisVerdict_VERDICT_PASSED
+	}
+	reason := r.reason
+	if err != nil {
+		reason = err.Error()
+	}
+	r.addReport(verdict, reason)
+}
+
+// skip adds the evaluation which was not counted because of the given reason to the report.
+func (r *evaluationRecorder) skip(reason string) {
+	r.addReport(model.AnalysisVerdict_VERDICT_SKIPPED, reason)
+}
+
+func (r *evaluationRecorder) addReport(verdict model.AnalysisVerdict, reason string) {
+	r.reports = append(r.reports, &model.AnalysisEvaluation{
+		EvaluatedAt: time.Now().Unix(),
+		Verdict:     verdict,
+		Reason:      reason,
+		Queries:     r.queries,
+	})
+	r.queries, r.reason = nil, ""
+}
+
+// passRate returns the percentage of the evaluations with the expected result.
+func (r *evaluationRecorder) passRate() float64 {
+	if r.evaluations == 0 {
+		return 0
+	}
+	return 100 * float64(r.passes) / float64(r.evaluations)
+}
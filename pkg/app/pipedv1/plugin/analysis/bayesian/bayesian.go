@@ -0,0 +1,95 @@
+// Copyright 2024 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package bayesian provides a Bayesian comparison of the means of two samples.
+// Unlike the hypothesis tests, it gives back the probability that one mean
+// is greater than the other and the credible interval of their difference,
+// which stay meaningful even with a few data points of low-traffic variants.
+package bayesian
+
+import (
+	"errors"
+	"math"
+)
+
+var ErrSampleSize = errors.New("sample is too small")
+
+// Result is the posterior distribution of the difference between the means of two samples.
+// With the non-informative prior, the posterior of each mean is approximated by
+// a normal distribution centered on the sample mean with the variance of s^2/n.
+type Result struct {
+	// N1 and N2 are the sizes of the input samples.
+	N1, N2 int
+	// Difference is the posterior mean of mean(x1) - mean(x2).
+	Difference float64
+	// StdDev is the posterior standard deviation of mean(x1) - mean(x2).
+	StdDev float64
+	// ProbabilityGreater is the posterior probability that mean(x1) is greater than mean(x2).
+	ProbabilityGreater float64
+}
+
+// CompareMeans compares the means of the samples x1 and x2.
+// Each sample requires at least 2 values to estimate its variance.
+func CompareMeans(x1, x2 []float64) (*Result, error) {
+	if len(x1) < 2 || len(x2) < 2 {
+		return nil, ErrSampleSize
+	}
+	m1, v1 := meanAndVariance(x1)
+	m2, v2 := meanAndVariance(x2)
+	res := &Result{
+		N1:         len(x1),
+		N2:         len(x2),
+		Difference: m1 - m2,
+		StdDev:     math.Sqrt(v1/float64(len(x1)) + v2/float64(len(x2))),
+	}
+	switch {
+	case res.StdDev > 0:
+		res.ProbabilityGreater = normalCDF(res.Difference / res.StdDev)
+	case res.Difference > 0:
+		res.ProbabilityGreater = 1
+	case res.Difference < 0:
+		res.ProbabilityGreater = 0
+	default:
+		res.ProbabilityGreater = 0.5
+	}
+	return res, nil
+}
+
+// CredibleInterval returns the equal-tailed interval which contains
+// the difference of the means with the given probability like 0.95.
+func (r *Result) CredibleInterval(level float64) (lower, upper float64) {
+	z := normalQuantile(0.5 + level/2)
+	return r.Difference - z*r.StdDev, r.Difference + z*r.StdDev
+}
+
+// meanAndVariance returns the mean and the unbiased variance of the given sample.
+func meanAndVariance(xs []float64) (mean, variance float64) {
+	for _, x := range xs {
+		mean += x
+	}
+	mean /= float64(len(xs))
+	for _, x := range xs {
+		variance += (x - mean) * (x - mean)
+	}
+	variance /= float64(len(xs) - 1)
+	return mean, variance
+}
+
+func normalCDF(x float64) float64 {
+	return 0.5 * math.Erfc(-x/math.Sqrt2)
+}
+
+func normalQuantile(p float64) float64 {
+	return math.Sqrt2 * math.Erfinv(2*p-1)
+}
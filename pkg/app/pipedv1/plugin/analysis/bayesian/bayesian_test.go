@@ -0,0 +1,92 @@
+// Copyright 2024 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bayesian
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCompareMeans(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name      string
+		x1        []float64
+		x2        []float64
+		want      *Result
+		wantLower float64
+		wantUpper float64
+		wantErr   bool
+	}{
+		{
+			name:    "too small sample",
+			x1:      []float64{1},
+			x2:      []float64{1, 2},
+			wantErr: true,
+		},
+		{
+			name: "same constant samples",
+			x1:   []float64{1, 1, 1},
+			x2:   []float64{1, 1},
+			want: &Result{N1: 3, N2: 2, ProbabilityGreater: 0.5},
+		},
+		{
+			name:      "greater constant sample",
+			x1:        []float64{2, 2},
+			x2:        []float64{1, 1},
+			want:      &Result{N1: 2, N2: 2, Difference: 1, ProbabilityGreater: 1},
+			wantLower: 1,
+			wantUpper: 1,
+		},
+		{
+			name: "greater sample",
+			// mean 3, variance 2.5 / mean 2, variance 2.5 so the standard deviation is 1.
+			x1: []float64{1, 2, 3, 4, 5},
+			x2: []float64{0, 1, 2, 3, 4},
+			want: &Result{
+				N1:                 5,
+				N2:                 5,
+				Difference:         1,
+				StdDev:             1,
+				ProbabilityGreater: 0.8413,
+			},
+			wantLower: 1 - 1.96,
+			wantUpper: 1 + 1.96,
+		},
+	}
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := CompareMeans(tc.x1, tc.x2)
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.want.N1, got.N1)
+			assert.Equal(t, tc.want.N2, got.N2)
+			assert.InDelta(t, tc.want.Difference, got.Difference, 1e-9)
+			assert.InDelta(t, tc.want.StdDev, got.StdDev, 1e-9)
+			assert.InDelta(t, tc.want.ProbabilityGreater, got.ProbabilityGreater, 1e-4)
+			lower, upper := got.CredibleInterval(0.95)
+			assert.InDelta(t, tc.wantLower, lower, 1e-3)
+			assert.InDelta(t, tc.wantUpper, upper, 1e-3)
+		})
+	}
+}
@@ -0,0 +1,271 @@
+// Copyright 2024 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	config "github.com/pipe-cd/pipecd/pkg/configv1"
+)
+
+const (
+	AnalysisStrategyThreshold      = "THRESHOLD"
+	AnalysisStrategyPrevious       = "PREVIOUS"
+	AnalysisStrategyCanaryBaseline = "CANARY_BASELINE"
+	AnalysisStrategyCanaryPrimary  = "CANARY_PRIMARY"
+
+	AnalysisDeviationEither = "EITHER"
+	AnalysisDeviationHigh   = "HIGH"
+	AnalysisDeviationLow    = "LOW"
+
+	AnalysisStatisticalTestMannWhitney       = "MANN_WHITNEY"
+	AnalysisStatisticalTestKolmogorovSmirnov = "KOLMOGOROV_SMIRNOV"
+	AnalysisStatisticalTestBayesian          = "BAYESIAN"
+)
+
+// AnalysisMetrics contains common configurable values for deployment analysis with metrics.
+type AnalysisMetrics struct {
+	// The strategy name. One of THRESHOLD or PREVIOUS or CANARY_BASELINE or CANARY_PRIMARY is available.
+	// Defaults to THRESHOLD.
+	Strategy string `json:"strategy" default:"THRESHOLD"`
+	// The unique name of provider defined in the Piped Configuration.
+	// Required field.
+	Provider string `json:"provider"`
+	// A query performed against the Analysis Provider.
+	// Required field.
+	Query string `json:"query"`
+	// The expected query result.
+	// Required field for the THRESHOLD strategy.
+	Expected AnalysisExpected `json:"expected"`
+	// Run a query at this intervals.
+	// Required field.
+	Interval config.Duration `json:"interval"`
+	// Acceptable number of failures. For instance, If 1 is set,
+	// the analysis will be considered a failure after 2 failures.
+	// Default is 0.
+	FailureLimit int `json:"failureLimit"`
+	// If true, it considers as a success when no data returned from the analysis provider.
+	// Default is false.
+	SkipOnNoData bool `json:"skipOnNoData"`
+	// How long after which the query times out.
+	// Default is 30s.
+	Timeout config.Duration `json:"timeout" default:"30s"`
+
+	// The stage fails on deviation in the specified direction. One of LOW or HIGH or EITHER is available.
+	// This can be used only for PREVIOUS, CANARY_BASELINE or CANARY_PRIMARY. Defaults to EITHER.
+	Deviation string `json:"deviation" default:"EITHER"`
+	// The custom arguments to be populated for the Canary query.
+	// They can be referred as {{ .VariantArgs.xxx }}.
+	CanaryArgs map[string]string `json:"canaryArgs"`
+	// The custom arguments to be populated for the Baseline query.
+	// They can be referred as {{ .VariantArgs.xxx }}.
+	BaselineArgs map[string]string `json:"baselineArgs"`
+	// The custom arguments to be populated for the Primary query.
+	// They can be referred as {{ .VariantArgs.xxx }}.
+	PrimaryArgs map[string]string `json:"primaryArgs"`
+	// The statistical method to compare the data points of two variants.
+	// One of MANN_WHITNEY or KOLMOGOROV_SMIRNOV or BAYESIAN is available.
+	// This can be used only for PREVIOUS, CANARY_BASELINE or CANARY_PRIMARY. Defaults to MANN_WHITNEY.
+	StatisticalTest string `json:"statisticalTest,omitempty"`
+	// The weight of this metrics in the overall score.
+	// This is used only when the scoring is enabled. Defaults to 1.
+	Weight float64 `json:"weight,omitempty"`
+	// Whether the analysis fails immediately when this metrics fails regardless of the overall score.
+	// This is used only when the scoring is enabled.
+	Critical bool `json:"critical,omitempty"`
+}
+
+func (m *AnalysisMetrics) Validate() error {
+	if m.Provider == "" {
+		return fmt.Errorf("missing \"provider\" field")
+	}
+	if m.Query == "" {
+		return fmt.Errorf("missing \"query\" field")
+	}
+	if m.Interval == 0 {
+		return fmt.Errorf("missing \"interval\" field")
+	}
+	if m.Deviation != AnalysisDeviationEither && m.Deviation != AnalysisDeviationHigh && m.Deviation != AnalysisDeviationLow {
+		return fmt.Errorf("\"deviation\" have to be one of %s, %s or %s", AnalysisDeviationEither, AnalysisDeviationHigh, AnalysisDeviationLow)
+	}
+	switch m.StatisticalTest {
+	case "", AnalysisStatisticalTestMannWhitney, AnalysisStatisticalTestKolmogorovSmirnov, AnalysisStatisticalTestBayesian:
+	default:
+		return fmt.Errorf("\"statisticalTest\" have to be one of %s, %s or %s", AnalysisStatisticalTestMannWhitney, AnalysisStatisticalTestKolmogorovSmirnov, AnalysisStatisticalTestBayesian)
+	}
+	if m.Weight < 0 {
+		return fmt.Errorf("\"weight\" must not be negative")
+	}
+	return nil
+}
+
+// AnalysisScoring configures the scoring which evaluates all metrics together.
+// At every interval, each metrics is judged as passed or failed and
+// the overall score is the percentage of the total weight of the passed metrics.
+type AnalysisScoring struct {
+	// Evaluate all metrics at this intervals.
+	// Required field.
+	Interval config.Duration `json:"interval"`
+	// The minimum score to consider the analysis as a success at the end.
+	// Default is 95.
+	PassThreshold float64 `json:"passThreshold" default:"95"`
+	// The analysis fails immediately when the score of an interval falls below this.
+	// The scores between this and the passThreshold are considered as marginal.
+	// Default is 75.
+	MarginalThreshold float64 `json:"marginalThreshold" default:"75"`
+}
+
+func (s *AnalysisScoring) Validate() error {
+	if s.Interval == 0 {
+		return fmt.Errorf("missing \"interval\" field")
+	}
+	if s.PassThreshold < 0 || s.PassThreshold > 100 {
+		return fmt.Errorf("\"passThreshold\" must be between 0 and 100")
+	}
+	if s.MarginalThreshold < 0 || s.MarginalThreshold > s.PassThreshold {
+		return fmt.Errorf("\"marginalThreshold\" must be between 0 and \"passThreshold\"")
+	}
+	return nil
+}
+
+// AnalysisExpected defines the range used for metrics analysis.
+type AnalysisExpected struct {
+	Min *float64 `json:"min"`
+	Max *float64 `json:"max"`
+}
+
+func (e *AnalysisExpected) Validate() error {
+	if e.Min == nil && e.Max == nil {
+		return fmt.Errorf("expected range is undefined")
+	}
+	return nil
+}
+
+// InRange returns true if the given value is within the range.
+func (e *AnalysisExpected) InRange(value float64) bool {
+	if e.Min != nil && *e.Min > value {
+		return false
+	}
+	if e.Max != nil && *e.Max < value {
+		return false
+	}
+	return true
+}
+
+func (e *AnalysisExpected) String() string {
+	if e.Min == nil && e.Max == nil {
+		return ""
+	}
+
+	var b strings.Builder
+	if e.Min != nil {
+		min := strconv.FormatFloat(*e.Min, 'f', -1, 64)
+		b.WriteString(min + " ")
+	}
+
+	b.WriteString("<=")
+
+	if e.Max != nil {
+		max := strconv.FormatFloat(*e.Max, 'f', -1, 64)
+		b.WriteString(" " + max)
+	}
+	return b.String()
+}
+
+// AnalysisLog contains common configurable values for deployment analysis with log.
+type AnalysisLog struct {
+	// The strategy name. One of THRESHOLD or CANARY_BASELINE or CANARY_PRIMARY is available.
+	// Defaults to THRESHOLD.
+	Strategy string `json:"strategy,omitempty"`
+	// A query to find the log entries considered as failures, e.g. error logs.
+	Query    string          `json:"query"`
+	Interval config.Duration `json:"interval"`
+	// Maximum number of failed checks before the query result is considered as failure.
+	FailureLimit int `json:"failureLimit"`
+	// If true, the evaluation is skipped instead of being considered as success
+	// when no log entries are found for the analyzed variant.
+	// Default is false.
+	SkipOnNoData bool `json:"skipOnNoData"`
+	// How long after which the query times out.
+	Timeout  config.Duration `json:"timeout"`
+	Provider string          `json:"provider"`
+	// Maximum number of log entries matched within an interval.
+	// For THRESHOLD, the check fails if more log entries than this are found.
+	// For CANARY_BASELINE and CANARY_PRIMARY, the check fails if the number of entries for Canary
+	// exceeds both of this and maxRatio times the number of entries for Baseline or Primary.
+	// Default is 0.
+	MaxCount int `json:"maxCount,omitempty"`
+	// Maximum ratio of the number of log entries for Canary to the one for Baseline or Primary.
+	// This can be used only for CANARY_BASELINE or CANARY_PRIMARY. Defaults to 1.
+	MaxRatio float64 `json:"maxRatio,omitempty"`
+	// Number of matched log entries shown in the stage log when the check fails.
+	// Defaults to 5.
+	SampleSize int `json:"sampleSize,omitempty"`
+	// The custom arguments to be populated for the Canary query.
+	// They can be referred as {{ .VariantArgs.xxx }}.
+	CanaryArgs map[string]string `json:"canaryArgs,omitempty"`
+	// The custom arguments to be populated for the Baseline query.
+	// They can be referred as {{ .VariantArgs.xxx }}.
+	BaselineArgs map[string]string `json:"baselineArgs,omitempty"`
+	// The custom arguments to be populated for the Primary query.
+	// They can be referred as {{ .VariantArgs.xxx }}.
+	PrimaryArgs map[string]string `json:"primaryArgs,omitempty"`
+}
+
+func (a *AnalysisLog) Validate() error {
+	switch a.Strategy {
+	case "", AnalysisStrategyThreshold, AnalysisStrategyCanaryBaseline, AnalysisStrategyCanaryPrimary:
+	default:
+		return fmt.Errorf("\"strategy\" have to be one of %s, %s or %s", AnalysisStrategyThreshold, AnalysisStrategyCanaryBaseline, AnalysisStrategyCanaryPrimary)
+	}
+	if a.MaxCount < 0 {
+		return fmt.Errorf("\"maxCount\" must not be negative")
+	}
+	if a.MaxRatio < 0 {
+		return fmt.Errorf("\"maxRatio\" must not be negative")
+	}
+	if a.SampleSize < 0 {
+		return fmt.Errorf("\"sampleSize\" must not be negative")
+	}
+	return nil
+}
+
+// AnalysisHTTP contains common configurable values for deployment analysis with http.
+type AnalysisHTTP struct {
+	URL    string `json:"url"`
+	Method string `json:"method"`
+	// Custom headers to set in the request. HTTP allows repeated headers.
+	Headers          []AnalysisHTTPHeader `json:"headers"`
+	ExpectedCode     int                  `json:"expectedCode"`
+	ExpectedResponse string               `json:"expectedResponse"`
+	Interval         config.Duration      `json:"interval"`
+	// Maximum number of failed checks before the response is considered as failure.
+	FailureLimit int `json:"failureLimit"`
+	// If true, it considers as success when no data returned from the analysis provider.
+	// Default is false.
+	SkipOnNoData bool            `json:"skipOnNoData"`
+	Timeout      config.Duration `json:"timeout"`
+}
+
+func (a *AnalysisHTTP) Validate() error {
+	return nil
+}
+
+type AnalysisHTTPHeader struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
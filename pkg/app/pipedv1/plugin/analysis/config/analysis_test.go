@@ -0,0 +1,183 @@
+// Copyright 2024 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	config "github.com/pipe-cd/pipecd/pkg/configv1"
+)
+
+func floatPointer(v float64) *float64 {
+	return &v
+}
+
+func TestAnalysisExpectedString(t *testing.T) {
+	testcases := []struct {
+		name string
+		Min  *float64
+		Max  *float64
+		want string
+	}{
+		{
+			name: "only min given",
+			Min:  floatPointer(1.5),
+			want: "1.5 <=",
+		},
+		{
+			name: "only max given",
+			Max:  floatPointer(1.5),
+			want: "<= 1.5",
+		},
+		{
+			name: "both min and max given",
+			Min:  floatPointer(1.5),
+			Max:  floatPointer(2.5),
+			want: "1.5 <= 2.5",
+		},
+		{
+			name: "invalid range",
+			want: "",
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			e := &AnalysisExpected{
+				Min: tc.Min,
+				Max: tc.Max,
+			}
+			got := e.String()
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestAnalysisLogValidate(t *testing.T) {
+	testcases := []struct {
+		name    string
+		log     AnalysisLog
+		wantErr bool
+	}{
+		{
+			name: "default strategy",
+			log:  AnalysisLog{},
+		},
+		{
+			name: "canary baseline strategy",
+			log:  AnalysisLog{Strategy: AnalysisStrategyCanaryBaseline, MaxCount: 1, MaxRatio: 1.5},
+		},
+		{
+			name:    "previous strategy is not supported",
+			log:     AnalysisLog{Strategy: AnalysisStrategyPrevious},
+			wantErr: true,
+		},
+		{
+			name:    "negative max count",
+			log:     AnalysisLog{MaxCount: -1},
+			wantErr: true,
+		},
+		{
+			name:    "negative max ratio",
+			log:     AnalysisLog{Strategy: AnalysisStrategyCanaryPrimary, MaxRatio: -1},
+			wantErr: true,
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.log.Validate()
+			assert.Equal(t, tc.wantErr, err != nil)
+		})
+	}
+}
+
+func TestAnalysisMetricsValidate(t *testing.T) {
+	valid := func(f func(m *AnalysisMetrics)) AnalysisMetrics {
+		m := AnalysisMetrics{
+			Provider:  "prometheus-dev",
+			Query:     "query",
+			Interval:  config.Duration(time.Minute),
+			Deviation: AnalysisDeviationEither,
+		}
+		f(&m)
+		return m
+	}
+	testcases := []struct {
+		name    string
+		metrics AnalysisMetrics
+		wantErr bool
+	}{
+		{
+			name:    "default statistical test",
+			metrics: valid(func(m *AnalysisMetrics) {}),
+		},
+		{
+			name:    "bayesian with weight",
+			metrics: valid(func(m *AnalysisMetrics) { m.StatisticalTest = AnalysisStatisticalTestBayesian; m.Weight = 2 }),
+		},
+		{
+			name:    "unknown statistical test",
+			metrics: valid(func(m *AnalysisMetrics) { m.StatisticalTest = "T_TEST" }),
+			wantErr: true,
+		},
+		{
+			name:    "negative weight",
+			metrics: valid(func(m *AnalysisMetrics) { m.Weight = -1 }),
+			wantErr: true,
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.metrics.Validate()
+			assert.Equal(t, tc.wantErr, err != nil)
+		})
+	}
+}
+
+func TestAnalysisScoringValidate(t *testing.T) {
+	testcases := []struct {
+		name    string
+		scoring AnalysisScoring
+		wantErr bool
+	}{
+		{
+			name:    "valid",
+			scoring: AnalysisScoring{Interval: config.Duration(time.Minute), PassThreshold: 95, MarginalThreshold: 75},
+		},
+		{
+			name:    "missing interval",
+			scoring: AnalysisScoring{PassThreshold: 95, MarginalThreshold: 75},
+			wantErr: true,
+		},
+		{
+			name:    "pass threshold over 100",
+			scoring: AnalysisScoring{Interval: config.Duration(time.Minute), PassThreshold: 101, MarginalThreshold: 75},
+			wantErr: true,
+		},
+		{
+			name:    "marginal threshold over pass threshold",
+			scoring: AnalysisScoring{Interval: config.Duration(time.Minute), PassThreshold: 70, MarginalThreshold: 75},
+			wantErr: true,
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.scoring.Validate()
+			assert.Equal(t, tc.wantErr, err != nil)
+		})
+	}
+}
@@ -0,0 +1,40 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+// AnalysisPluginConfig is the plugin config set in the plugins section of the piped spec.
+type AnalysisPluginConfig struct {
+	// List of analysis providers can be used by the ANALYSIS stage.
+	AnalysisProviders []AnalysisProvider `json:"analysisProviders,omitempty"`
+}
+
+func (c *AnalysisPluginConfig) Validate() error {
+	for _, p := range c.AnalysisProviders {
+		if err := p.Validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GetAnalysisProvider finds and returns an Analysis Provider config whose name is the given string.
+func (c *AnalysisPluginConfig) GetAnalysisProvider(name string) (AnalysisProvider, bool) {
+	for _, p := range c.AnalysisProviders {
+		if p.Name == name {
+			return p, true
+		}
+	}
+	return AnalysisProvider{}, false
+}
@@ -0,0 +1,88 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+func TestAnalysisPluginConfig(t *testing.T) {
+	data := `{
+		"analysisProviders": [
+			{
+				"name": "prometheus-dev",
+				"type": "PROMETHEUS",
+				"config": {
+					"address": "https://your-prometheus.dev"
+				}
+			},
+			{
+				"name": "loki-dev",
+				"type": "LOKI",
+				"config": {
+					"address": "https://your-loki.dev",
+					"tenantID": "tenant-1"
+				}
+			}
+		]
+	}`
+
+	var cfg AnalysisPluginConfig
+	require.NoError(t, json.Unmarshal([]byte(data), &cfg))
+	require.NoError(t, cfg.Validate())
+
+	p, ok := cfg.GetAnalysisProvider("prometheus-dev")
+	require.True(t, ok)
+	assert.Equal(t, AnalysisProvider{
+		Name: "prometheus-dev",
+		Type: model.AnalysisProviderPrometheus,
+		PrometheusConfig: &AnalysisProviderPrometheusConfig{
+			Address: "https://your-prometheus.dev",
+		},
+	}, p)
+
+	p, ok = cfg.GetAnalysisProvider("loki-dev")
+	require.True(t, ok)
+	assert.Equal(t, AnalysisProvider{
+		Name: "loki-dev",
+		Type: model.AnalysisProviderLoki,
+		LokiConfig: &AnalysisProviderLokiConfig{
+			Address:  "https://your-loki.dev",
+			TenantID: "tenant-1",
+		},
+	}, p)
+
+	_, ok = cfg.GetAnalysisProvider("unknown")
+	assert.False(t, ok)
+}
+
+func TestAnalysisPluginConfigValidate(t *testing.T) {
+	cfg := AnalysisPluginConfig{
+		AnalysisProviders: []AnalysisProvider{
+			{
+				Name:             "prometheus-dev",
+				Type:             model.AnalysisProviderPrometheus,
+				PrometheusConfig: &AnalysisProviderPrometheusConfig{},
+			},
+		},
+	}
+	assert.Error(t, cfg.Validate())
+}
@@ -0,0 +1,339 @@
+// Copyright 2024 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+// AnalysisProvider defines an analysis provider which can be used by the ANALYSIS stage.
+type AnalysisProvider struct {
+	Name string                     `json:"name"`
+	Type model.AnalysisProviderType `json:"type"`
+
+	PrometheusConfig      *AnalysisProviderPrometheusConfig
+	DatadogConfig         *AnalysisProviderDatadogConfig
+	StackdriverConfig     *AnalysisProviderStackdriverConfig
+	LokiConfig            *AnalysisProviderLokiConfig
+	ElasticsearchConfig   *AnalysisProviderElasticsearchConfig
+	CloudWatchConfig      *AnalysisProviderCloudWatchConfig
+	NewRelicConfig        *AnalysisProviderNewRelicConfig
+	CloudMonitoringConfig *AnalysisProviderCloudMonitoringConfig
+}
+
+type genericAnalysisProvider struct {
+	Name   string                     `json:"name"`
+	Type   model.AnalysisProviderType `json:"type"`
+	Config json.RawMessage            `json:"config"`
+}
+
+func (p *AnalysisProvider) MarshalJSON() ([]byte, error) {
+	var (
+		err    error
+		config json.RawMessage
+	)
+
+	switch p.Type {
+	case model.AnalysisProviderDatadog:
+		config, err = json.Marshal(p.DatadogConfig)
+	case model.AnalysisProviderPrometheus:
+		config, err = json.Marshal(p.PrometheusConfig)
+	case model.AnalysisProviderStackdriver:
+		config, err = json.Marshal(p.StackdriverConfig)
+	case model.AnalysisProviderLoki:
+		config, err = json.Marshal(p.LokiConfig)
+	case model.AnalysisProviderElasticsearch:
+		config, err = json.Marshal(p.ElasticsearchConfig)
+	case model.AnalysisProviderCloudWatch:
+		config, err = json.Marshal(p.CloudWatchConfig)
+	case model.AnalysisProviderNewRelic:
+		config, err = json.Marshal(p.NewRelicConfig)
+	case model.AnalysisProviderCloudMonitoring:
+		config, err = json.Marshal(p.CloudMonitoringConfig)
+	default:
+		err = fmt.Errorf("unsupported analysis provider type: %s", p.Name)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(&genericAnalysisProvider{
+		Name:   p.Name,
+		Type:   p.Type,
+		Config: config,
+	})
+}
+
+func (p *AnalysisProvider) UnmarshalJSON(data []byte) error {
+	var err error
+	gp := genericAnalysisProvider{}
+	if err = json.Unmarshal(data, &gp); err != nil {
+		return err
+	}
+	p.Name = gp.Name
+	p.Type = gp.Type
+
+	switch p.Type {
+	case model.AnalysisProviderPrometheus:
+		p.PrometheusConfig = &AnalysisProviderPrometheusConfig{}
+		if len(gp.Config) > 0 {
+			err = json.Unmarshal(gp.Config, p.PrometheusConfig)
+		}
+	case model.AnalysisProviderDatadog:
+		p.DatadogConfig = &AnalysisProviderDatadogConfig{}
+		if len(gp.Config) > 0 {
+			err = json.Unmarshal(gp.Config, p.DatadogConfig)
+		}
+	case model.AnalysisProviderStackdriver:
+		p.StackdriverConfig = &AnalysisProviderStackdriverConfig{}
+		if len(gp.Config) > 0 {
+			err = json.Unmarshal(gp.Config, p.StackdriverConfig)
+		}
+	case model.AnalysisProviderLoki:
+		p.LokiConfig = &AnalysisProviderLokiConfig{}
+		if len(gp.Config) > 0 {
+			err = json.Unmarshal(gp.Config, p.LokiConfig)
+		}
+	case model.AnalysisProviderElasticsearch:
+		p.ElasticsearchConfig = &AnalysisProviderElasticsearchConfig{}
+		if len(gp.Config) > 0 {
+			err = json.Unmarshal(gp.Config, p.ElasticsearchConfig)
+		}
+	case model.AnalysisProviderCloudWatch:
+		p.CloudWatchConfig = &AnalysisProviderCloudWatchConfig{}
+		if len(gp.Config) > 0 {
+			err = json.Unmarshal(gp.Config, p.CloudWatchConfig)
+		}
+	case model.AnalysisProviderNewRelic:
+		p.NewRelicConfig = &AnalysisProviderNewRelicConfig{}
+		if len(gp.Config) > 0 {
+			err = json.Unmarshal(gp.Config, p.NewRelicConfig)
+		}
+	case model.AnalysisProviderCloudMonitoring:
+		p.CloudMonitoringConfig = &AnalysisProviderCloudMonitoringConfig{}
+		if len(gp.Config) > 0 {
+			err = json.Unmarshal(gp.Config, p.CloudMonitoringConfig)
+		}
+	default:
+		err = fmt.Errorf("unsupported analysis provider type: %s", p.Name)
+	}
+	return err
+}
+
+func (p *AnalysisProvider) Validate() error {
+	switch p.Type {
+	case model.AnalysisProviderPrometheus:
+		return p.PrometheusConfig.Validate()
+	case model.AnalysisProviderDatadog:
+		return p.DatadogConfig.Validate()
+	case model.AnalysisProviderStackdriver:
+		return p.StackdriverConfig.Validate()
+	case model.AnalysisProviderLoki:
+		return p.LokiConfig.Validate()
+	case model.AnalysisProviderElasticsearch:
+		return p.ElasticsearchConfig.Validate()
+	case model.AnalysisProviderCloudWatch:
+		return p.CloudWatchConfig.Validate()
+	case model.AnalysisProviderNewRelic:
+		return p.NewRelicConfig.Validate()
+	case model.AnalysisProviderCloudMonitoring:
+		return p.CloudMonitoringConfig.Validate()
+	default:
+		return fmt.Errorf("unknow provider type: %s", p.Type)
+	}
+}
+
+type AnalysisProviderPrometheusConfig struct {
+	Address string `json:"address"`
+	// The path to the username file.
+	UsernameFile string `json:"usernameFile,omitempty"`
+	// The path to the password file.
+	PasswordFile string `json:"passwordFile,omitempty"`
+}
+
+func (a *AnalysisProviderPrometheusConfig) Validate() error {
+	if a.Address == "" {
+		return fmt.Errorf("prometheus analysis provider requires the address")
+	}
+	return nil
+}
+
+type AnalysisProviderDatadogConfig struct {
+	// The address of Datadog API server.
+	// Only "datadoghq.com", "us3.datadoghq.com", "datadoghq.eu", "ddog-gov.com" are available.
+	// Defaults to "datadoghq.com"
+	Address string `json:"address,omitempty"`
+	// Required: The path to the api key file.
+	APIKeyFile string `json:"apiKeyFile"`
+	// Required: The path to the application key file.
+	ApplicationKeyFile string `json:"applicationKeyFile"`
+	// Base64 API Key for Datadog API server.
+	APIKeyData string `json:"apiKeyData,omitempty"`
+	// Base64 Application Key for Datadog API server.
+	ApplicationKeyData string `json:"applicationKeyData,omitempty"`
+}
+
+func (a *AnalysisProviderDatadogConfig) Validate() error {
+	if a.APIKeyFile == "" && a.APIKeyData == "" {
+		return fmt.Errorf("either datadog APIKeyFile or APIKeyData must be set")
+	}
+	if a.ApplicationKeyFile == "" && a.ApplicationKeyData == "" {
+		return fmt.Errorf("either datadog ApplicationKeyFile or ApplicationKeyData must be set")
+	}
+	if a.APIKeyData != "" && a.APIKeyFile != "" {
+		return fmt.Errorf("only datadog APIKeyFile or APIKeyData can be set")
+	}
+	if a.ApplicationKeyData != "" && a.ApplicationKeyFile != "" {
+		return fmt.Errorf("only datadog ApplicationKeyFile or ApplicationKeyData can be set")
+	}
+	return nil
+}
+
+type AnalysisProviderStackdriverConfig struct {
+	// The path to the service account file.
+	ServiceAccountFile string `json:"serviceAccountFile"`
+	// The ID of the project where the logs are read.
+	// Default is the project of the service account.
+	ProjectID string `json:"projectID,omitempty"`
+}
+
+func (a *AnalysisProviderStackdriverConfig) Validate() error {
+	return nil
+}
+
+type AnalysisProviderLokiConfig struct {
+	// The address of Loki server.
+	Address string `json:"address"`
+	// The tenant ID sent as X-Scope-OrgID header to a multi-tenant Loki.
+	TenantID string `json:"tenantID,omitempty"`
+	// The path to the username file.
+	UsernameFile string `json:"usernameFile,omitempty"`
+	// The path to the password file.
+	PasswordFile string `json:"passwordFile,omitempty"`
+	// The path to the bearer token file.
+	TokenFile string `json:"tokenFile,omitempty"`
+}
+
+func (a *AnalysisProviderLokiConfig) Validate() error {
+	if a.Address == "" {
+		return fmt.Errorf("loki analysis provider requires the address")
+	}
+	if (a.UsernameFile == "") != (a.PasswordFile == "") {
+		return fmt.Errorf("both usernameFile and passwordFile must be set for loki analysis provider")
+	}
+	if a.UsernameFile != "" && a.TokenFile != "" {
+		return fmt.Errorf("only basic auth or tokenFile can be set for loki analysis provider")
+	}
+	return nil
+}
+
+type AnalysisProviderElasticsearchConfig struct {
+	// The address of Elasticsearch or OpenSearch server.
+	Address string `json:"address"`
+	// The index pattern where the logs are searched.
+	// Default is "*".
+	Index string `json:"index,omitempty"`
+	// The field holding the timestamp of the log entries.
+	// Default is "@timestamp".
+	TimestampField string `json:"timestampField,omitempty"`
+	// The field holding the message of the log entries.
+	// Default is "message".
+	MessageField string `json:"messageField,omitempty"`
+	// The field holding the severity of the log entries.
+	// Default is "log.level".
+	SeverityField string `json:"severityField,omitempty"`
+	// The path to the username file.
+	UsernameFile string `json:"usernameFile,omitempty"`
+	// The path to the password file.
+	PasswordFile string `json:"passwordFile,omitempty"`
+	// The path to the base64 encoded API key file.
+	APIKeyFile string `json:"apiKeyFile,omitempty"`
+}
+
+func (a *AnalysisProviderElasticsearchConfig) Validate() error {
+	if a.Address == "" {
+		return fmt.Errorf("elasticsearch analysis provider requires the address")
+	}
+	if (a.UsernameFile == "") != (a.PasswordFile == "") {
+		return fmt.Errorf("both usernameFile and passwordFile must be set for elasticsearch analysis provider")
+	}
+	if a.UsernameFile != "" && a.APIKeyFile != "" {
+		return fmt.Errorf("only basic auth or apiKeyFile can be set for elasticsearch analysis provider")
+	}
+	return nil
+}
+
+type AnalysisProviderCloudWatchConfig struct {
+	// The region to send requests to. This parameter is required.
+	// e.g. "us-west-2"
+	Region string `json:"region"`
+	// Path to the shared credentials file.
+	CredentialsFile string `json:"credentialsFile,omitempty"`
+	// The IAM role arn to use when assuming an role.
+	RoleARN string `json:"roleARN,omitempty"`
+	// Path to the WebIdentity token the SDK should use to assume a role with.
+	TokenFile string `json:"tokenFile,omitempty"`
+	// AWS Profile to extract credentials from the shared credentials file.
+	// If empty, the environment variable "AWS_PROFILE" is used.
+	// "default" is populated if the environment variable is also not set.
+	Profile string `json:"profile,omitempty"`
+}
+
+func (a *AnalysisProviderCloudWatchConfig) Validate() error {
+	if a.Region == "" {
+		return fmt.Errorf("cloudwatch analysis provider requires the region")
+	}
+	return nil
+}
+
+type AnalysisProviderNewRelicConfig struct {
+	// The address of NerdGraph API.
+	// Default is "https://api.newrelic.com/graphql".
+	// Use "https://api.eu.newrelic.com/graphql" for the accounts in the EU region.
+	Address string `json:"address,omitempty"`
+	// The ID of the account where the NRQL queries are run.
+	AccountID int64 `json:"accountID"`
+	// The path to the user API key file.
+	APIKeyFile string `json:"apiKeyFile"`
+}
+
+func (a *AnalysisProviderNewRelicConfig) Validate() error {
+	if a.AccountID == 0 {
+		return fmt.Errorf("newrelic analysis provider requires the accountID")
+	}
+	if a.APIKeyFile == "" {
+		return fmt.Errorf("newrelic analysis provider requires the apiKeyFile")
+	}
+	return nil
+}
+
+type AnalysisProviderCloudMonitoringConfig struct {
+	// The path to the service account file.
+	ServiceAccountFile string `json:"serviceAccountFile"`
+	// The ID of the scoping project where the metrics are read.
+	// Default is the project of the service account.
+	ProjectID string `json:"projectID,omitempty"`
+}
+
+func (a *AnalysisProviderCloudMonitoringConfig) Validate() error {
+	if a.ServiceAccountFile == "" {
+		return fmt.Errorf("cloud monitoring analysis provider requires the serviceAccountFile")
+	}
+	return nil
+}
@@ -0,0 +1,130 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	config "github.com/pipe-cd/pipecd/pkg/configv1"
+)
+
+func TestDecodeAnalysisStageOptions(t *testing.T) {
+	testcases := []struct {
+		name     string
+		data     string
+		expected *AnalysisStageOptions
+		wantErr  bool
+	}{
+		{
+			name: "set default values",
+			data: `{
+				"duration": "10m",
+				"metrics": [
+					{
+						"provider": "prometheus-dev",
+						"query": "up",
+						"interval": "1m",
+						"expected": {"min": 1}
+					}
+				],
+				"scoring": {
+					"interval": "1m"
+				}
+			}`,
+			expected: &AnalysisStageOptions{
+				Duration: config.Duration(10 * time.Minute),
+				Metrics: []TemplatableAnalysisMetrics{
+					{
+						AnalysisMetrics: AnalysisMetrics{
+							Strategy:  AnalysisStrategyThreshold,
+							Provider:  "prometheus-dev",
+							Query:     "up",
+							Expected:  AnalysisExpected{Min: floatPointer(1)},
+							Interval:  config.Duration(time.Minute),
+							Timeout:   config.Duration(30 * time.Second),
+							Deviation: AnalysisDeviationEither,
+						},
+					},
+				},
+				Scoring: &AnalysisScoring{
+					Interval:          config.Duration(time.Minute),
+					PassThreshold:     95,
+					MarginalThreshold: 75,
+				},
+			},
+		},
+		{
+			name: "use template",
+			data: `{
+				"duration": "10m",
+				"metrics": [
+					{
+						"template": {
+							"name": "http_error_rate",
+							"appArgs": {"namespace": "default"}
+						}
+					}
+				]
+			}`,
+			expected: &AnalysisStageOptions{
+				Duration: config.Duration(10 * time.Minute),
+				Metrics: []TemplatableAnalysisMetrics{
+					{
+						AnalysisMetrics: AnalysisMetrics{
+							Strategy:  AnalysisStrategyThreshold,
+							Timeout:   config.Duration(30 * time.Second),
+							Deviation: AnalysisDeviationEither,
+						},
+						Template: AnalysisTemplateRef{
+							Name:    "http_error_rate",
+							AppArgs: map[string]string{"namespace": "default"},
+						},
+					},
+				},
+			},
+		},
+		{
+			name:    "missing duration",
+			data:    `{"metrics": []}`,
+			wantErr: true,
+		},
+		{
+			name: "scoring without metrics",
+			data: `{
+				"duration": "10m",
+				"scoring": {
+					"interval": "1m"
+				}
+			}`,
+			wantErr: true,
+		},
+		{
+			name:    "invalid json",
+			data:    `{"duration": 10m}`,
+			wantErr: true,
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := DecodeAnalysisStageOptions([]byte(tc.data))
+			require.Equal(t, tc.wantErr, err != nil, err)
+			assert.Equal(t, tc.expected, got)
+		})
+	}
+}
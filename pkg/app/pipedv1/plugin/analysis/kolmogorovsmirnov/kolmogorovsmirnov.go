@@ -0,0 +1,121 @@
+// Copyright 2024 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package kolmogorovsmirnov provides the two-sample Kolmogorov-Smirnov test
+// which compares the whole distributions of two samples, so that it can detect
+// differences in the shape as well as in the location.
+package kolmogorovsmirnov
+
+import (
+	"errors"
+	"math"
+	"sort"
+)
+
+// A Hypothesis specifies the alternative hypothesis of the test.
+// The default (zero) value is to test against the alternative hypothesis
+// that the distributions differ.
+type Hypothesis int
+
+const (
+	// Less specifies the alternative hypothesis that the values of
+	// the first sample tend to be less than the second.
+	// This is a one-tailed test.
+	Less Hypothesis = -1
+
+	// Differs specifies the alternative hypothesis that the distributions
+	// of the two samples are not equal. This is a two-tailed test.
+	Differs Hypothesis = 0
+
+	// Greater specifies the alternative hypothesis that the values of
+	// the first sample tend to be greater than the second.
+	// This is a one-tailed test.
+	Greater Hypothesis = 1
+)
+
+var ErrSampleSize = errors.New("sample is too small")
+
+// Result is the result of a two-sample Kolmogorov-Smirnov test.
+type Result struct {
+	// N1 and N2 are the sizes of the input samples.
+	N1, N2 int
+	// D is the statistic of the test, the maximum distance between
+	// the empirical distribution functions of the two samples in
+	// the direction of the alternative hypothesis.
+	D float64
+	// AltHypothesis specifies the alternative hypothesis tested.
+	AltHypothesis Hypothesis
+	// P is the p-value of the test, which is asymptotically approximated.
+	P float64
+}
+
+// Test performs a two-sample Kolmogorov-Smirnov test on the samples x1 and x2.
+func Test(x1, x2 []float64, alt Hypothesis) (*Result, error) {
+	n1, n2 := len(x1), len(x2)
+	if n1 == 0 || n2 == 0 {
+		return nil, ErrSampleSize
+	}
+	s1 := append([]float64(nil), x1...)
+	s2 := append([]float64(nil), x2...)
+	sort.Float64s(s1)
+	sort.Float64s(s2)
+
+	// dPlus is the maximum of F1(x)-F2(x) and dMinus is the maximum of F2(x)-F1(x)
+	// where F1 and F2 are the empirical distribution functions.
+	var dPlus, dMinus float64
+	for i, j := 0, 0; i < n1 && j < n2; {
+		v := math.Min(s1[i], s2[j])
+		for i < n1 && s1[i] == v {
+			i++
+		}
+		for j < n2 && s2[j] == v {
+			j++
+		}
+		diff := float64(i)/float64(n1) - float64(j)/float64(n2)
+		dPlus = math.Max(dPlus, diff)
+		dMinus = math.Max(dMinus, -diff)
+	}
+
+	ne := float64(n1) * float64(n2) / float64(n1+n2)
+	res := &Result{N1: n1, N2: n2, AltHypothesis: alt}
+	switch alt {
+	case Less:
+		// The values of x1 are less when its distribution function is above the one of x2.
+		res.D = dPlus
+		res.P = math.Exp(-2 * ne * res.D * res.D)
+	case Greater:
+		res.D = dMinus
+		res.P = math.Exp(-2 * ne * res.D * res.D)
+	default:
+		res.D = math.Max(dPlus, dMinus)
+		sqrtNe := math.Sqrt(ne)
+		res.P = qks((sqrtNe + 0.12 + 0.11/sqrtNe) * res.D)
+	}
+	res.P = math.Min(math.Max(res.P, 0), 1)
+	return res, nil
+}
+
+// qks returns the complementary cumulative distribution function of the Kolmogorov distribution.
+// See Numerical Recipes 3rd edition, section 6.14.12.
+func qks(z float64) float64 {
+	if z <= 0 {
+		return 1
+	}
+	if z < 1.18 {
+		y := math.Exp(-1.23370055013616983 / (z * z))
+		return 1 - 2.50662827463100050242/z*(y+math.Pow(y, 9)+math.Pow(y, 25)+math.Pow(y, 49))
+	}
+	x := math.Exp(-2 * z * z)
+	return 2 * (x - math.Pow(x, 4) + math.Pow(x, 9))
+}
@@ -0,0 +1,86 @@
+// Copyright 2024 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kolmogorovsmirnov
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTest(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name    string
+		x1      []float64
+		x2      []float64
+		alt     Hypothesis
+		wantD   float64
+		wantP   float64
+		wantErr bool
+	}{
+		{
+			name:    "empty sample",
+			x1:      []float64{1, 2},
+			wantErr: true,
+		},
+		{
+			name:  "same samples",
+			x1:    []float64{1, 2, 3, 4, 5},
+			x2:    []float64{5, 4, 3, 2, 1},
+			wantD: 0,
+			wantP: 1,
+		},
+		{
+			name:  "different samples",
+			x1:    []float64{0.61, 0.29, 0.06, 0.59, -1.73, -0.74, 0.51, -0.56, 0.39, 1.64, 0.05, -0.06, 0.64, -0.82, 0.37, 1.77, 1.09, -1.28, 2.36, 1.31, 1.05, -0.32, -0.4, 1.06, -2.47},
+			x2:    []float64{2.2, 1.66, 1.38, 0.2, 0.36, 0, 0.96, 1.56, 0.44, 1.5, -0.3, 0.66, 2.31, 3.29, -0.27, -0.37, 0.38, 0.7, 0.52, -0.18, 2.32, 1.96, 2.28, 2.16, 1.23},
+			wantD: 0.32,
+			wantP: 0.1236,
+		},
+		{
+			name:  "greater sample",
+			x1:    []float64{5, 6, 7, 8, 9, 10, 11, 12},
+			x2:    []float64{1, 2, 3, 4, 5, 6, 7, 8},
+			alt:   Greater,
+			wantD: 0.5,
+			wantP: 0.1353,
+		},
+		{
+			name:  "greater sample tested as less",
+			x1:    []float64{5, 6, 7, 8, 9, 10, 11, 12},
+			x2:    []float64{1, 2, 3, 4, 5, 6, 7, 8},
+			alt:   Less,
+			wantD: 0,
+			wantP: 1,
+		},
+	}
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := Test(tc.x1, tc.x2, tc.alt)
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.InDelta(t, tc.wantD, got.D, 1e-9)
+			assert.InDelta(t, tc.wantP, got.P, 0.01)
+		})
+	}
+}
@@ -0,0 +1,602 @@
+// Copyright 2024 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"text/template"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/pipe-cd/pipecd/pkg/app/pipedv1/plugin/analysis/analysisprovider/metrics"
+	"github.com/pipe-cd/pipecd/pkg/app/pipedv1/plugin/analysis/bayesian"
+	"github.com/pipe-cd/pipecd/pkg/app/pipedv1/plugin/analysis/config"
+	"github.com/pipe-cd/pipecd/pkg/app/pipedv1/plugin/analysis/kolmogorovsmirnov"
+	"github.com/pipe-cd/pipecd/pkg/app/pipedv1/plugin/analysis/mannwhitney"
+	"github.com/pipe-cd/pipecd/pkg/model"
+	sdk "github.com/pipe-cd/piped-plugin-sdk-go"
+)
+
+const (
+	canaryVariantName   = "canary"
+	baselineVariantName = "baseline"
+	primaryVariantName  = "primary"
+	// The primary variant of the previous deployment.
+	previousVariantName = "previous"
+)
+
+type metricsAnalyzer struct {
+	id                  string
+	cfg                 config.AnalysisMetrics
+	stageStartTime      time.Time
+	provider            metrics.Provider
+	analysisResultStore analysisResultStore
+	// Application-specific arguments using when rendering the query.
+	argsTemplate argsTemplate
+	logger       *zap.Logger
+	logPersister sdk.StageLogPersister
+
+	evaluationRecorder
+}
+
+func newMetricsAnalyzer(id string, cfg config.AnalysisMetrics, stageStartTime time.Time, provider metrics.Provider, analysisResultStore analysisResultStore, argsTemplate argsTemplate, logger *zap.Logger, logPersister sdk.StageLogPersister) *metricsAnalyzer {
+	return &metricsAnalyzer{
+		id:                  id,
+		cfg:                 cfg,
+		stageStartTime:      stageStartTime,
+		provider:            provider,
+		analysisResultStore: analysisResultStore,
+		argsTemplate:        argsTemplate,
+		logPersister:        logPersister,
+		logger: logger.With(
+			zap.String("analyzer-id", id),
+		),
+	}
+}
+
+// run starts an analysis which runs the query at the given interval, until the context is done.
+// It returns an error when the number of failures exceeds the the failureLimit.
+func (a *metricsAnalyzer) run(ctx context.Context) error {
+	ticker := time.NewTicker(a.cfg.Interval.Duration())
+	defer ticker.Stop()
+
+	failureCount := 0
+	for {
+		select {
+		case <-ticker.C:
+			expected, firstDeploy, err := a.evaluate(ctx)
+			if firstDeploy {
+				a.logPersister.Infof("[%s] PreviousAnalysis cannot be executed because this seems to be the first deployment, so it is considered as a success", a.id)
+				a.skip("no previous deployment to compare with")
+				return nil
+			}
+			// Ignore parent's context deadline exceeded error, and return immediately.
+			if errors.Is(err, context.DeadlineExceeded) && ctx.Err() == context.DeadlineExceeded {
+				return nil
+			}
+			if errors.Is(err, metrics.ErrNoDataFound) && a.cfg.SkipOnNoData {
+				a.logPersister.Infof("[%s] The query result evaluation was skipped because \"skipOnNoData\" is true though no data returned. Reason: %v", a.id, err)
+				a.skip(err.Error())
+				continue
+			}
+			if err != nil {
+				a.logPersister.Errorf("[%s] Unexpected error: %v", a.id, err)
+			}
+			a.record(expected, err)
+			if expected {
+				a.logPersister.Successf("[%s] The query result is expected one", a.id)
+				continue
+			}
+			failureCount++
+			if failureCount > a.cfg.FailureLimit {
+				return fmt.Errorf("analysis '%s' failed because the failure number exceeded the failure limit (%d)", a.id, a.cfg.FailureLimit)
+			}
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
+
+// evaluate runs the query once and judges the result with the configured strategy.
+// firstDeploy is true when the PREVIOUS strategy has no previous deployment to compare with.
+func (a *metricsAnalyzer) evaluate(ctx context.Context) (expected, firstDeploy bool, err error) {
+	a.queries, a.reason = nil, ""
+	switch a.cfg.Strategy {
+	case config.AnalysisStrategyThreshold:
+		expected, err = a.analyzeWithThreshold(ctx)
+	case config.AnalysisStrategyPrevious:
+		expected, firstDeploy, err = a.analyzeWithPrevious(ctx)
+	case config.AnalysisStrategyCanaryBaseline:
+		expected, err = a.analyzeWithCanaryBaseline(ctx)
+	case config.AnalysisStrategyCanaryPrimary:
+		expected, err = a.analyzeWithCanaryPrimary(ctx)
+	default:
+		err = fmt.Errorf("unknown strategy %q given", a.cfg.Strategy)
+	}
+	return
+}
+
+// queryPoints runs the given query and keeps it with the returned data points for the report.
+func (a *metricsAnalyzer) queryPoints(ctx context.Context, variant, query string, queryRange metrics.QueryRange) ([]metrics.DataPoint, error) {
+	points, err := a.provider.QueryPoints(ctx, query, queryRange)
+	q := &model.AnalysisQuery{
+		Variant:    variant,
+		Query:      query,
+		From:       queryRange.From.Unix(),
+		To:         queryRange.To.Unix(),
+		DataPoints: make([]*model.AnalysisDataPoint, 0, len(points)),
+	}
+	for _, p := range points {
+		q.DataPoints = append(q.DataPoints, &model.AnalysisDataPoint{
+			Timestamp: p.Timestamp,
+			Value:     p.Value,
+		})
+	}
+	a.queries = append(a.queries, q)
+	return points, err
+}
+
+// score returns the percentage of the evaluations with the expected result.
+func (a *metricsAnalyzer) score() *model.AnalysisMetricsScore {
+	s := &model.AnalysisMetricsScore{
+		Id:              a.id,
+		Provider:        a.cfg.Provider,
+		Query:           a.cfg.Query,
+		StatisticalTest: a.statisticalTest(),
+		Weight:          a.weight(),
+		Score:           a.passRate(),
+		Evaluations:     int32(a.evaluations),
+		Passes:          int32(a.passes),
+	}
+	return s
+}
+
+// report returns the details of all evaluations.
+func (a *metricsAnalyzer) report() *model.AnalysisMetricsReport {
+	r := &model.AnalysisMetricsReport{
+		Id:          a.id,
+		Provider:    a.cfg.Provider,
+		Strategy:    a.cfg.Strategy,
+		Score:       a.passRate(),
+		Evaluations: a.reports,
+	}
+	if a.cfg.Strategy == config.AnalysisStrategyThreshold {
+		r.Expected = a.cfg.Expected.String()
+	} else {
+		r.StatisticalTest = a.statisticalTest()
+		r.Deviation = a.cfg.Deviation
+	}
+	return r
+}
+
+func (a *metricsAnalyzer) statisticalTest() string {
+	if a.cfg.StatisticalTest == "" {
+		return config.AnalysisStatisticalTestMannWhitney
+	}
+	return a.cfg.StatisticalTest
+}
+
+func (a *metricsAnalyzer) weight() float64 {
+	if a.cfg.Weight == 0 {
+		return 1
+	}
+	return a.cfg.Weight
+}
+
+// analyzeWithThreshold returns false if any data point is out of the prediction range.
+// Return an error if the evaluation could not be executed normally.
+func (a *metricsAnalyzer) analyzeWithThreshold(ctx context.Context) (bool, error) {
+	if err := a.cfg.Expected.Validate(); err != nil {
+		return false, fmt.Errorf("\"expected\" is required to analyze with the THRESHOLD strategy")
+	}
+
+	now := time.Now()
+	queryRange := metrics.QueryRange{
+		From: now.Add(-a.cfg.Interval.Duration()),
+		To:   now,
+	}
+
+	a.logPersister.Infof("[%s] Run query: %q, in range: %v", a.id, a.cfg.Query, queryRange)
+	points, err := a.queryPoints(ctx, "", a.cfg.Query, queryRange)
+	if err != nil {
+		return false, fmt.Errorf("failed to run query: %w", err)
+	}
+	if len(points) == 0 {
+		a.logPersister.Infof("[%s] This analysis stage will be skipped since there was no data point to compare", a.id)
+		return true, nil
+	}
+
+	var outiler metrics.DataPoint
+	expected := true
+	for i := range points {
+		if a.cfg.Expected.InRange(points[i].Value) {
+			continue
+		}
+		expected = false
+		outiler = points[i]
+		break
+	}
+	if !expected {
+		a.logPersister.Errorf("[%s] Failed because it found a data point (%s) that is outside the expected range (%s). Performed query: %q", a.id, &outiler, &a.cfg.Expected, a.cfg.Query)
+		a.reason = fmt.Sprintf("found a data point (%s) that is outside the expected range (%s)", &outiler, &a.cfg.Expected)
+		return false, nil
+	}
+
+	return true, nil
+}
+
+// analyzeWithPrevious returns false if primary deviates in the specified direction compared to the previous deployment.
+// Return an error if the evaluation could not be executed normally.
+// elapsedTime is used to compare metrics at the same point in time after the analysis has started.
+func (a *metricsAnalyzer) analyzeWithPrevious(ctx context.Context) (expected, firstDeploy bool, err error) {
+	now := time.Now()
+	queryRange := metrics.QueryRange{
+		From: now.Add(-a.cfg.Interval.Duration()),
+		To:   now,
+	}
+
+	a.logPersister.Infof("[%s] Run query: %q, in range: %v", a.id, a.cfg.Query, queryRange)
+	points, err := a.queryPoints(ctx, primaryVariantName, a.cfg.Query, queryRange)
+	if err != nil {
+		return false, false, fmt.Errorf("failed to run query: %w: performed query: %q", err, a.cfg.Query)
+	}
+	pointsCount := len(points)
+	a.logPersister.Infof("[%s] Got %d data points for current Primary from the query: %q", a.id, pointsCount, a.cfg.Query)
+	values := make([]float64, 0, pointsCount)
+	for i := range points {
+		values = append(values, points[i].Value)
+	}
+
+	prevMetadata, err := a.analysisResultStore.GetLatestAnalysisResult(ctx)
+	if err != nil {
+		return false, false, fmt.Errorf("failed to fetch the most recent successful analysis metadata: %w", err)
+	}
+	if prevMetadata == nil {
+		return false, true, nil
+	}
+	// Compare it with the previous metrics when the same amount of time as now has passed since the start of the stage.
+	elapsedTime := now.Sub(a.stageStartTime)
+	prevTo := time.Unix(prevMetadata.StartTime, 0).Add(elapsedTime)
+	prevFrom := prevTo.Add(-a.cfg.Interval.Duration())
+	prevQueryRange := metrics.QueryRange{
+		From: prevFrom,
+		To:   prevTo,
+	}
+
+	a.logPersister.Infof("[%s] Run query: %q, in range: %v", a.id, a.cfg.Query, prevQueryRange)
+	prevPoints, err := a.queryPoints(ctx, previousVariantName, a.cfg.Query, prevQueryRange)
+	if err != nil {
+		return false, false, fmt.Errorf("failed to run query to fetch metrics for the previous deployment: %w: performed query: %q", err, a.cfg.Query)
+	}
+	prevPointsCount := len(prevPoints)
+	a.logPersister.Infof("[%s] Got %d data points for previous Primary from the query: %q", a.id, prevPointsCount, a.cfg.Query)
+	prevValues := make([]float64, 0, prevPointsCount)
+	for i := range prevPoints {
+		prevValues = append(prevValues, prevPoints[i].Value)
+	}
+	expected, err = a.compare(values, prevValues, a.cfg.Deviation)
+	if err != nil {
+		a.logPersister.Errorf("[%s] Failed to compare data points: %v", a.id, err)
+		a.logPersister.Infof("[%s] Performed query: %q", a.id, a.cfg.Query)
+		return false, false, err
+	}
+	if !expected {
+		a.logPersister.Errorf("[%s] The difference between Current Primary and Previous one is statistically significant", a.id)
+		a.reason = "the difference between current Primary and previous one is statistically significant"
+		a.logPersister.Infof("[%s] Performed query range for current Primary: %q", a.id, &queryRange)
+		a.logPersister.Infof("[%s] Performed query range for previous Primary: %q", a.id, &prevQueryRange)
+		a.logPersister.Infof("[%s] Performed query: %q", a.id, a.cfg.Query)
+		a.logPersister.Infof("[%s] Current data points acquired:", a.id)
+		for i := range points {
+			a.logPersister.Infof("[%s] %s", a.id, &points[i])
+		}
+		a.logPersister.Infof("[%s] Previous data points acquired:", a.id)
+		for i := range prevPoints {
+			a.logPersister.Infof("[%s] %s", a.id, &prevPoints[i])
+		}
+		return false, false, nil
+	}
+	return true, false, nil
+}
+
+// analyzeWithCanaryBaseline returns false if canary deviates in the specified direction compared to baseline.
+// Return an error if the evaluation could not be executed normally.
+func (a *metricsAnalyzer) analyzeWithCanaryBaseline(ctx context.Context) (bool, error) {
+	now := time.Now()
+	queryRange := metrics.QueryRange{
+		From: now.Add(-a.cfg.Interval.Duration()),
+		To:   now,
+	}
+	canaryQuery, err := a.renderQuery(a.cfg.Query, a.cfg.CanaryArgs, canaryVariantName)
+	if err != nil {
+		return false, fmt.Errorf("failed to render query template for Canary: %w", err)
+	}
+	baselineQuery, err := a.renderQuery(a.cfg.Query, a.cfg.BaselineArgs, baselineVariantName)
+	if err != nil {
+		return false, fmt.Errorf("failed to render query template for Baseline: %w", err)
+	}
+
+	// Fetch data points from Canary.
+	a.logPersister.Infof("[%s] Run query: %q, in range: %v", a.id, canaryQuery, queryRange)
+	canaryPoints, err := a.queryPoints(ctx, canaryVariantName, canaryQuery, queryRange)
+	if err != nil {
+		return false, fmt.Errorf("failed to run query to fetch metrics for the Canary variant: %w: query range: %s: performed query: %q", err, &queryRange, canaryQuery)
+	}
+	canaryPointsCount := len(canaryPoints)
+	a.logPersister.Infof("[%s] Got %d data points for Canary from the query: %q", a.id, canaryPointsCount, canaryQuery)
+	canaryValues := make([]float64, 0, canaryPointsCount)
+	for i := range canaryPoints {
+		canaryValues = append(canaryValues, canaryPoints[i].Value)
+	}
+
+	// Fetch data points from Baseline.
+	a.logPersister.Infof("[%s] Run query: %q, in range: %v", a.id, baselineQuery, queryRange)
+	baselinePoints, err := a.queryPoints(ctx, baselineVariantName, baselineQuery, queryRange)
+	if err != nil {
+		return false, fmt.Errorf("failed to run query to fetch metrics for the Baseline variant: %w: query range: %s: performed query: %q", err, &queryRange, baselineQuery)
+	}
+	baselinePointsCount := len(baselinePoints)
+	a.logPersister.Infof("[%s] Got %d data points for Baseline from the query: %q", a.id, baselinePointsCount, baselineQuery)
+	baselineValues := make([]float64, 0, baselinePointsCount)
+	for i := range baselinePoints {
+		baselineValues = append(baselineValues, baselinePoints[i].Value)
+	}
+
+	expected, err := a.compare(canaryValues, baselineValues, a.cfg.Deviation)
+	if err != nil {
+		a.logPersister.Errorf("[%s] Failed to compare data points: %v", a.id, err)
+		a.logPersister.Infof("[%s] Performed query for Canary: %q", a.id, canaryQuery)
+		a.logPersister.Infof("[%s] Performed query for Baseline: %q", a.id, baselineQuery)
+		return false, err
+	}
+	if !expected {
+		a.logPersister.Errorf("[%s] The difference between Canary and Baseline is statistically significant", a.id)
+		a.reason = "the difference between Canary and Baseline is statistically significant"
+		a.logPersister.Infof("[%s] Performed query range: %q", a.id, &queryRange)
+		a.logPersister.Infof("[%s] Performed query for Canary: %q", a.id, canaryQuery)
+		a.logPersister.Infof("[%s] Performed query for Baseline: %q", a.id, baselineQuery)
+		a.logPersister.Infof("[%s] Canary data points acquired:", a.id)
+		for i := range canaryPoints {
+			a.logPersister.Infof("[%s] %s", a.id, &canaryPoints[i])
+		}
+		a.logPersister.Infof("[%s] Baseline data points acquired:", a.id)
+		for i := range baselinePoints {
+			a.logPersister.Infof("[%s] %s", a.id, &baselinePoints[i])
+		}
+		return false, nil
+	}
+	return true, nil
+}
+
+// analyzeWithCanaryPrimary returns false if canary deviates in the specified direction compared to primary.
+// Return an error if the evaluation could not be executed normally.
+func (a *metricsAnalyzer) analyzeWithCanaryPrimary(ctx context.Context) (bool, error) {
+	now := time.Now()
+	queryRange := metrics.QueryRange{
+		From: now.Add(-a.cfg.Interval.Duration()),
+		To:   now,
+	}
+	canaryQuery, err := a.renderQuery(a.cfg.Query, a.cfg.CanaryArgs, canaryVariantName)
+	if err != nil {
+		return false, fmt.Errorf("failed to render query template for Canary: %w", err)
+	}
+	primaryQuery, err := a.renderQuery(a.cfg.Query, a.cfg.PrimaryArgs, primaryVariantName)
+	if err != nil {
+		return false, fmt.Errorf("failed to render query template for Primary: %w", err)
+	}
+
+	a.logPersister.Infof("[%s] Run query: %q, in range: %v", a.id, canaryQuery, queryRange)
+	canaryPoints, err := a.queryPoints(ctx, canaryVariantName, canaryQuery, queryRange)
+	if err != nil {
+		return false, fmt.Errorf("failed to run query to fetch metrics for the Canary variant: %w: performed query: %q", err, canaryQuery)
+	}
+	canaryPointsCount := len(canaryPoints)
+	a.logPersister.Infof("[%s] Got %d data points for Canary from the query: %q", a.id, canaryPointsCount, canaryQuery)
+	canaryValues := make([]float64, 0, canaryPointsCount)
+	for i := range canaryPoints {
+		canaryValues = append(canaryValues, canaryPoints[i].Value)
+	}
+
+	a.logPersister.Infof("[%s] Run query: %q, in range: %v", a.id, primaryQuery, queryRange)
+	primaryPoints, err := a.queryPoints(ctx, primaryVariantName, primaryQuery, queryRange)
+	if err != nil {
+		return false, fmt.Errorf("failed to run query to fetch metrics for the Primary variant: %w: performed query: %q", err, primaryQuery)
+	}
+	primaryPointsCount := len(primaryPoints)
+	a.logPersister.Infof("[%s] Got %d data points for Primary from the query: %q", a.id, primaryPointsCount, primaryQuery)
+	primaryValues := make([]float64, 0, primaryPointsCount)
+	for i := range primaryPoints {
+		primaryValues = append(primaryValues, primaryPoints[i].Value)
+	}
+	expected, err := a.compare(canaryValues, primaryValues, a.cfg.Deviation)
+	if err != nil {
+		a.logPersister.Errorf("[%s] Failed to compare data points: %v", a.id, err)
+		a.logPersister.Infof("[%s] Performed query for Canary: %q", a.id, canaryQuery)
+		a.logPersister.Infof("[%s] Performed query for Primary: %q", a.id, primaryQuery)
+		return false, err
+	}
+	if !expected {
+		a.logPersister.Errorf("[%s] The difference between Canary and Primary is statistically significant", a.id)
+		a.reason = "the difference between Canary and Primary is statistically significant"
+		a.logPersister.Infof("[%s] Performed query range: %q", a.id, &queryRange)
+		a.logPersister.Infof("[%s] Performed query for Canary: %q", a.id, canaryQuery)
+		a.logPersister.Infof("[%s] Performed query for Primary: %q", a.id, primaryQuery)
+		a.logPersister.Infof("[%s] Canary data points acquired:", a.id)
+		for i := range canaryPoints {
+			a.logPersister.Infof("[%s] %s", a.id, &canaryPoints[i])
+		}
+		a.logPersister.Infof("[%s] Primary data points acquired:", a.id)
+		for i := range primaryPoints {
+			a.logPersister.Infof("[%s] %s", a.id, &primaryPoints[i])
+		}
+		return false, nil
+	}
+	return true, nil
+}
+
+// compare compares the given two samples using the configured statistical test.
+// Considered as failure if it deviates in the specified direction as the third argument.
+// If both of the point values is empty, this returns true.
+func (a *metricsAnalyzer) compare(experiment, control []float64, deviation string) (acceptable bool, err error) {
+	if len(experiment) == 0 && len(control) == 0 {
+		a.logPersister.Infof("[%s] The analysis stage will be skipped since there was no data point to compare", a.id)
+		return true, nil
+	}
+	if len(experiment) == 0 {
+		return false, fmt.Errorf("no data points of Experiment found")
+	}
+	if len(control) == 0 {
+		return false, fmt.Errorf("no data points of Control found")
+	}
+	switch deviation {
+	case config.AnalysisDeviationEither, config.AnalysisDeviationLow, config.AnalysisDeviationHigh:
+	default:
+		return false, fmt.Errorf("unknown deviation %q given", deviation)
+	}
+	switch a.statisticalTest() {
+	case config.AnalysisStatisticalTestMannWhitney:
+		return compareWithMannWhitney(experiment, control, deviation)
+	case config.AnalysisStatisticalTestKolmogorovSmirnov:
+		return compareWithKolmogorovSmirnov(experiment, control, deviation)
+	case config.AnalysisStatisticalTestBayesian:
+		return compareWithBayesian(experiment, control, deviation)
+	default:
+		return false, fmt.Errorf("unknown statistical test %q given", a.cfg.StatisticalTest)
+	}
+}
+
+// alpha is the significance level of the hypothesis tests. Typically 5% is used.
+const alpha = 0.05
+
+// compareWithMannWhitney compares the given two samples using Mann-Whitney U test.
+func compareWithMannWhitney(experiment, control []float64, deviation string) (bool, error) {
+	var alternativeHypothesis mannwhitney.LocationHypothesis
+	switch deviation {
+	case config.AnalysisDeviationEither:
+		alternativeHypothesis = mannwhitney.LocationDiffers
+	case config.AnalysisDeviationLow:
+		alternativeHypothesis = mannwhitney.LocationLess
+	case config.AnalysisDeviationHigh:
+		alternativeHypothesis = mannwhitney.LocationGreater
+	}
+	res, err := mannwhitney.MannWhitneyUTest(experiment, control, alternativeHypothesis)
+	if errors.Is(err, mannwhitney.ErrSamplesEqual) {
+		// All samples are exact the same.
+		return true, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("failed to perform the Mann-Whitney U test: %w", err)
+	}
+
+	// If the p-value is greater than the significance level,
+	// we cannot say that the distributions in the two groups differed significantly.
+	// See: https://support.minitab.com/en-us/minitab-express/1/help-and-how-to/basic-statistics/inference/how-to/two-samples/mann-whitney-test/interpret-the-results/key-results/
+	return res.P > alpha, nil
+}
+
+// compareWithKolmogorovSmirnov compares the distributions of the given two samples
+// using the two-sample Kolmogorov-Smirnov test.
+func compareWithKolmogorovSmirnov(experiment, control []float64, deviation string) (bool, error) {
+	var alternativeHypothesis kolmogorovsmirnov.Hypothesis
+	switch deviation {
+	case config.AnalysisDeviationEither:
+		alternativeHypothesis = kolmogorovsmirnov.Differs
+	case config.AnalysisDeviationLow:
+		alternativeHypothesis = kolmogorovsmirnov.Less
+	case config.AnalysisDeviationHigh:
+		alternativeHypothesis = kolmogorovsmirnov.Greater
+	}
+	res, err := kolmogorovsmirnov.Test(experiment, control, alternativeHypothesis)
+	if err != nil {
+		return false, fmt.Errorf("failed to perform the Kolmogorov-Smirnov test: %w", err)
+	}
+	return res.P > alpha, nil
+}
+
+// credibility is the probability used to decide that the means of two samples differ.
+const credibility = 0.95
+
+// compareWithBayesian compares the means of the given two samples
+// by the posterior probability that the experiment is greater than the control.
+// For EITHER deviation, it fails when the credible interval of the difference excludes zero.
+func compareWithBayesian(experiment, control []float64, deviation string) (bool, error) {
+	res, err := bayesian.CompareMeans(experiment, control)
+	if err != nil {
+		return false, fmt.Errorf("failed to compare the means: %w", err)
+	}
+	switch deviation {
+	case config.AnalysisDeviationLow:
+		return res.ProbabilityGreater >= 1-credibility, nil
+	case config.AnalysisDeviationHigh:
+		return res.ProbabilityGreater <= credibility, nil
+	default:
+		lower, upper := res.CredibleInterval(credibility)
+		return lower <= 0 && 0 <= upper, nil
+	}
+}
+
+// argsTemplate is a collection of available template arguments.
+// NOTE: Changing its fields will force users to change the template definition.
+type argsTemplate struct {
+	// The args that are automatically populated.
+	App     appArgs
+	Variant variantArgs
+
+	// User-defined custom args.
+	VariantCustomArgs map[string]string
+	AppCustomArgs     map[string]string
+}
+
+// appArgs allows application-specific data to be embedded in the query.
+type appArgs struct {
+	Name string
+	Env  string
+}
+
+// variantArgs allows variant-specific data to be embedded in the query.
+type variantArgs struct {
+	// One of "primary", "canary", or "baseline" will be populated.
+	Name string
+}
+
+// renderQuery applies the given variant args to the query template.
+func (a *metricsAnalyzer) renderQuery(queryTemplate string, variantCustomArgs map[string]string, variant string) (string, error) {
+	return renderVariantQuery(queryTemplate, a.argsTemplate, variantCustomArgs, variant)
+}
+
+// renderVariantQuery applies the given application args and variant args to the query template.
+func renderVariantQuery(queryTemplate string, appArgs argsTemplate, variantCustomArgs map[string]string, variant string) (string, error) {
+	args := argsTemplate{
+		Variant:           variantArgs{Name: variant},
+		VariantCustomArgs: variantCustomArgs,
+		App:               appArgs.App,
+		AppCustomArgs:     appArgs.AppCustomArgs,
+	}
+
+	t, err := template.New("AnalysisTemplate").Parse(queryTemplate)
+	if err != nil {
+		return "", fmt.Errorf("failed to parse query template: %w", err)
+	}
+
+	b := new(bytes.Buffer)
+	if err := t.Execute(b, args); err != nil {
+		return "", fmt.Errorf("failed to apply template: %w", err)
+	}
+	return b.String(), err
+}
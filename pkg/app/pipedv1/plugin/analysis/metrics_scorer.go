@@ -0,0 +1,158 @@
+// Copyright 2024 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/pipe-cd/pipecd/pkg/app/pipedv1/plugin/analysis/analysisprovider/metrics"
+	"github.com/pipe-cd/pipecd/pkg/app/pipedv1/plugin/analysis/config"
+	"github.com/pipe-cd/pipecd/pkg/model"
+	sdk "github.com/pipe-cd/piped-plugin-sdk-go"
+)
+
+// metricsScorer evaluates all metrics analyzers at the same interval
+// and aggregates their results into a weighted score.
+type metricsScorer struct {
+	cfg       config.AnalysisScoring
+	analyzers []*metricsAnalyzer
+	// The score computed at the last interval.
+	lastScore float64
+	scored    bool
+	// The scores computed at each interval.
+	intervalScores []*model.AnalysisIntervalScore
+
+	logger       *zap.Logger
+	logPersister sdk.StageLogPersister
+}
+
+func newMetricsScorer(cfg config.AnalysisScoring, analyzers []*metricsAnalyzer, logger *zap.Logger, logPersister sdk.StageLogPersister) *metricsScorer {
+	return &metricsScorer{
+		cfg:          cfg,
+		analyzers:    analyzers,
+		logger:       logger.With(zap.String("analyzer-id", "scoring")),
+		logPersister: logPersister,
+	}
+}
+
+// run evaluates all metrics every interval until the context is done.
+// It returns an error immediately when a critical metric fails or the score falls below the marginal threshold,
+// and when the analysis ends with the last score below the pass threshold.
+func (s *metricsScorer) run(ctx context.Context) error {
+	ticker := time.NewTicker(s.cfg.Interval.Duration())
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			score, skipped, err := s.evaluate(ctx)
+			// The analysis ended while evaluating, so the last score is used.
+			if ctx.Err() != nil {
+				return s.done(ctx)
+			}
+			if err != nil {
+				return err
+			}
+			if skipped {
+				s.logPersister.Infof("[scoring] The score was not computed because no metrics returned data")
+				continue
+			}
+			s.lastScore, s.scored = score, true
+			s.intervalScores = append(s.intervalScores, &model.AnalysisIntervalScore{
+				EvaluatedAt: time.Now().Unix(),
+				Score:       score,
+			})
+			switch {
+			case score >= s.cfg.PassThreshold:
+				s.logPersister.Successf("[scoring] The score %.2f passed the threshold %.2f", score, s.cfg.PassThreshold)
+			case score >= s.cfg.MarginalThreshold:
+				s.logPersister.Infof("[scoring] The score %.2f is marginal, it is below the pass threshold %.2f", score, s.cfg.PassThreshold)
+			default:
+				return fmt.Errorf("analysis failed because the score %.2f fell below the marginal threshold %.2f", score, s.cfg.MarginalThreshold)
+			}
+		case <-ctx.Done():
+			return s.done(ctx)
+		}
+	}
+}
+
+// evaluate evaluates every metric once and returns the percentage of the weight of the passed ones.
+// skipped is true when all metrics were excluded because of no data.
+func (s *metricsScorer) evaluate(ctx context.Context) (score float64, skipped bool, err error) {
+	var total, passed float64
+	for _, a := range s.analyzers {
+		expected, firstDeploy, err := a.evaluate(ctx)
+		if ctx.Err() != nil {
+			return 0, false, nil
+		}
+		if firstDeploy {
+			a.logPersister.Infof("[%s] PreviousAnalysis cannot be executed because this seems to be the first deployment, so it is considered as a success", a.id)
+			expected, err = true, nil
+		}
+		if errors.Is(err, metrics.ErrNoDataFound) && a.cfg.SkipOnNoData {
+			a.logPersister.Infof("[%s] The query result was excluded from the score because \"skipOnNoData\" is true though no data returned. Reason: %v", a.id, err)
+			a.skip(err.Error())
+			continue
+		}
+		if err != nil {
+			a.logPersister.Errorf("[%s] Unexpected error: %v", a.id, err)
+		}
+		a.record(expected, err)
+		total += a.weight()
+		if expected {
+			a.logPersister.Successf("[%s] The query result is expected one", a.id)
+			passed += a.weight()
+			continue
+		}
+		if a.cfg.Critical {
+			return 0, false, fmt.Errorf("analysis '%s' failed because it is marked as critical", a.id)
+		}
+		a.logPersister.Infof("[%s] The query result is not expected one", a.id)
+	}
+	if total == 0 {
+		return 0, true, nil
+	}
+	return 100 * passed / total, false, nil
+}
+
+// done returns an error if the analysis reached its duration with the last score lower than the pass threshold.
+// Nothing is checked when the analysis was cancelled, e.g. skipped by a command or failed by another analyzer.
+func (s *metricsScorer) done(ctx context.Context) error {
+	if ctx.Err() != context.DeadlineExceeded {
+		return nil
+	}
+	if !s.scored {
+		s.logPersister.Infof("[scoring] No score was computed during the analysis, so it is considered as a success")
+		return nil
+	}
+	if s.lastScore < s.cfg.PassThreshold {
+		return fmt.Errorf("analysis failed because the final score %.2f is lower than the pass threshold %.2f", s.lastScore, s.cfg.PassThreshold)
+	}
+	return nil
+}
+
+// score returns the final score and the scores of each metric.
+func (s *metricsScorer) score() (float64, []*model.AnalysisMetricsScore) {
+	scores := make([]*model.AnalysisMetricsScore, 0, len(s.analyzers))
+	for _, a := range s.analyzers {
+		scores = append(scores, a.score())
+	}
+	return s.lastScore, scores
+}
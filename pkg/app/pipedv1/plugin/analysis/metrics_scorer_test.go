@@ -0,0 +1,190 @@
+// Copyright 2024 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+
+	"github.com/pipe-cd/pipecd/pkg/app/pipedv1/plugin/analysis/analysisprovider/metrics"
+	"github.com/pipe-cd/pipecd/pkg/app/pipedv1/plugin/analysis/config"
+)
+
+func newThresholdAnalyzer(id string, weight float64, critical, skipOnNoData bool, provider *fakeMetricsProvider) *metricsAnalyzer {
+	return &metricsAnalyzer{
+		id: id,
+		cfg: config.AnalysisMetrics{
+			Strategy:     config.AnalysisStrategyThreshold,
+			Provider:     "provider",
+			Query:        "query",
+			Expected:     config.AnalysisExpected{Max: floatToPointer(1)},
+			Weight:       weight,
+			Critical:     critical,
+			SkipOnNoData: skipOnNoData,
+		},
+		provider:     provider,
+		logger:       zap.NewNop(),
+		logPersister: &fakeLogPersister{},
+	}
+}
+
+func Test_metricsScorer_evaluate(t *testing.T) {
+	t.Parallel()
+
+	var (
+		passing = &fakeMetricsProvider{points: []metrics.DataPoint{{Value: 0.5}}}
+		failing = &fakeMetricsProvider{points: []metrics.DataPoint{{Value: 1.5}}}
+		noData  = &fakeMetricsProvider{err: metrics.ErrNoDataFound}
+	)
+	testcases := []struct {
+		name        string
+		analyzers   []*metricsAnalyzer
+		wantScore   float64
+		wantSkipped bool
+		wantErr     bool
+	}{
+		{
+			name: "all metrics passed",
+			analyzers: []*metricsAnalyzer{
+				newThresholdAnalyzer("metrics-0", 0, false, false, passing),
+				newThresholdAnalyzer("metrics-1", 0, false, false, passing),
+			},
+			wantScore: 100,
+		},
+		{
+			name: "weighted score",
+			analyzers: []*metricsAnalyzer{
+				newThresholdAnalyzer("metrics-0", 3, false, false, passing),
+				newThresholdAnalyzer("metrics-1", 1, false, false, failing),
+			},
+			wantScore: 75,
+		},
+		{
+			name: "query error is counted as failure",
+			analyzers: []*metricsAnalyzer{
+				newThresholdAnalyzer("metrics-0", 0, false, false, passing),
+				newThresholdAnalyzer("metrics-1", 0, false, false, noData),
+			},
+			wantScore: 50,
+		},
+		{
+			name: "metrics without data are excluded",
+			analyzers: []*metricsAnalyzer{
+				newThresholdAnalyzer("metrics-0", 0, false, false, passing),
+				newThresholdAnalyzer("metrics-1", 0, false, true, noData),
+			},
+			wantScore: 100,
+		},
+		{
+			name: "all metrics are excluded",
+			analyzers: []*metricsAnalyzer{
+				newThresholdAnalyzer("metrics-0", 0, false, true, noData),
+			},
+			wantSkipped: true,
+		},
+		{
+			name: "critical metric failed",
+			analyzers: []*metricsAnalyzer{
+				newThresholdAnalyzer("metrics-0", 0, false, false, passing),
+				newThresholdAnalyzer("metrics-1", 0, true, false, failing),
+			},
+			wantErr: true,
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			s := newMetricsScorer(config.AnalysisScoring{}, tc.analyzers, zap.NewNop(), &fakeLogPersister{})
+			score, skipped, err := s.evaluate(context.Background())
+			assert.Equal(t, tc.wantErr, err != nil)
+			assert.Equal(t, tc.wantSkipped, skipped)
+			assert.Equal(t, tc.wantScore, score)
+		})
+	}
+}
+
+func Test_metricsScorer_score(t *testing.T) {
+	t.Parallel()
+
+	analyzer := newThresholdAnalyzer("metrics-0", 2, false, false, &fakeMetricsProvider{})
+	s := newMetricsScorer(config.AnalysisScoring{}, []*metricsAnalyzer{analyzer}, zap.NewNop(), &fakeLogPersister{})
+	analyzer.record(true, nil)
+	analyzer.record(true, nil)
+	analyzer.record(false, nil)
+	analyzer.record(true, nil)
+	s.lastScore = 80
+
+	score, metricsScores := s.score()
+	assert.Equal(t, 80.0, score)
+	assert.Len(t, metricsScores, 1)
+	assert.Equal(t, "metrics-0", metricsScores[0].Id)
+	assert.Equal(t, config.AnalysisStatisticalTestMannWhitney, metricsScores[0].StatisticalTest)
+	assert.Equal(t, 2.0, metricsScores[0].Weight)
+	assert.Equal(t, int32(4), metricsScores[0].Evaluations)
+	assert.Equal(t, int32(3), metricsScores[0].Passes)
+	assert.Equal(t, 75.0, metricsScores[0].Score)
+}
+
+func Test_metricsScorer_done(t *testing.T) {
+	t.Parallel()
+
+	expired, cancel := context.WithTimeout(context.Background(), 0)
+	defer cancel()
+	<-expired.Done()
+	cancelled, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	testcases := []struct {
+		name      string
+		ctx       context.Context
+		scored    bool
+		lastScore float64
+		wantErr   bool
+	}{
+		{
+			name:      "last score passed",
+			ctx:       expired,
+			scored:    true,
+			lastScore: 95,
+		},
+		{
+			name:      "last score is marginal",
+			ctx:       expired,
+			scored:    true,
+			lastScore: 80,
+			wantErr:   true,
+		},
+		{
+			name: "no score computed",
+			ctx:  expired,
+		},
+		{
+			name:      "cancelled",
+			ctx:       cancelled,
+			scored:    true,
+			lastScore: 80,
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			s := newMetricsScorer(config.AnalysisScoring{PassThreshold: 95, MarginalThreshold: 75}, nil, zap.NewNop(), &fakeLogPersister{})
+			s.scored, s.lastScore = tc.scored, tc.lastScore
+			err := s.done(tc.ctx)
+			assert.Equal(t, tc.wantErr, err != nil)
+		})
+	}
+}
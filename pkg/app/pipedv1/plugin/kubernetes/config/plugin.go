@@ -0,0 +1,151 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/google/cel-go/cel"
+	"k8s.io/client-go/util/jsonpath"
+)
+
+// KubernetesPluginConfig represents the plugin-wide configuration set in the plugins section of the piped spec.
+type KubernetesPluginConfig struct {
+	// List of rules used to evaluate the health status of the resources
+	// whose kinds are not supported by the plugin, such as custom resources.
+	// A rule takes precedence over the built-in evaluation of the same kind.
+	HealthRules []K8sHealthRule `json:"healthRules,omitempty"`
+}
+
+func (c *KubernetesPluginConfig) Validate() error {
+	for _, r := range c.HealthRules {
+		if err := r.Validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// K8sHealthRule represents a rule to evaluate the health status of the resources of a kind.
+// e.g.
+//
+//	group: cert-manager.io
+//	kind: Certificate
+//	healthy:
+//	- jsonPath: '{.status.conditions[?(@.type=="Ready")].status}'
+//	  value: "True"
+//	unhealthy:
+//	- jsonPath: '{.status.conditions[?(@.type=="Ready")].reason}'
+//	  value: Failed
+//	- cel: 'object.status.conditions.exists(c, c.type == "Issuing" && c.status == "False")'
+//	message: '{.status.conditions[?(@.type=="Ready")].message}'
+type K8sHealthRule struct {
+	// The API group of the target resources.
+	// Empty means the core group.
+	Group string `json:"group"`
+	// The kind of the target resources.
+	Kind string `json:"kind"`
+	// The conditions all of which must be satisfied to treat the resource as healthy.
+	Healthy []K8sHealthCondition `json:"healthy"`
+	// The conditions any of which makes the resource unhealthy.
+	// They are evaluated before the healthy conditions.
+	Unhealthy []K8sHealthCondition `json:"unhealthy,omitempty"`
+	// The JSONPath template used to build the description of the health status.
+	Message string `json:"message,omitempty"`
+}
+
+func (r K8sHealthRule) Validate() error {
+	if r.Kind == "" {
+		return errors.New("kind must be set for the health rule")
+	}
+	if len(r.Healthy) == 0 {
+		return fmt.Errorf("at least one healthy condition must be set for the health rule of %s", r.Kind)
+	}
+	for _, conds := range [][]K8sHealthCondition{r.Healthy, r.Unhealthy} {
+		for _, c := range conds {
+			if err := c.Validate(); err != nil {
+				return fmt.Errorf("invalid health condition for %s: %w", r.Kind, err)
+			}
+		}
+	}
+	if r.Message != "" {
+		if err := validateJSONPath(r.Message); err != nil {
+			return fmt.Errorf("invalid message for the health rule of %s: %w", r.Kind, err)
+		}
+	}
+	return nil
+}
+
+// K8sHealthCondition represents a condition checked against a resource.
+// Either the pair of jsonPath and value or cel must be set.
+type K8sHealthCondition struct {
+	// The JSONPath template to extract the field of the resource.
+	// The condition is satisfied when the extracted text equals to the value.
+	// e.g. {.status.phase}
+	JSONPath string `json:"jsonPath,omitempty"`
+	// The expected value of the extracted field.
+	Value string `json:"value,omitempty"`
+	// The CEL expression returning a bool, in which the resource is available as object.
+	// The condition is satisfied when the expression evaluates to true.
+	// e.g. object.status.availableReplicas >= object.spec.replicas
+	CEL string `json:"cel,omitempty"`
+}
+
+func (c K8sHealthCondition) Validate() error {
+	if c.CEL != "" {
+		if c.JSONPath != "" || c.Value != "" {
+			return errors.New("jsonPath and value must not be set together with cel")
+		}
+		_, err := CompileCELHealthCondition(c.CEL)
+		return err
+	}
+	return validateJSONPath(c.JSONPath)
+}
+
+// String returns the expression of the condition used in the health messages.
+func (c K8sHealthCondition) String() string {
+	if c.CEL != "" {
+		return c.CEL
+	}
+	return c.JSONPath
+}
+
+// CompileCELHealthCondition compiles the given CEL expression of a health condition.
+// The resource is passed to the returned program as the variable named object.
+func CompileCELHealthCondition(expr string) (cel.Program, error) {
+	env, err := cel.NewEnv(cel.Variable("object", cel.DynType))
+	if err != nil {
+		return nil, err
+	}
+	ast, iss := env.Compile(expr)
+	if iss.Err() != nil {
+		return nil, fmt.Errorf("failed to compile cel %q: %w", expr, iss.Err())
+	}
+	if ast.OutputType() != cel.BoolType && ast.OutputType() != cel.DynType {
+		return nil, fmt.Errorf("cel %q must return bool but returns %s", expr, ast.OutputType())
+	}
+	return env.Program(ast)
+}
+
+func validateJSONPath(tmpl string) error {
+	if tmpl == "" {
+		return errors.New("jsonPath must be set")
+	}
+	if err := jsonpath.New("").Parse(tmpl); err != nil {
+		return fmt.Errorf("failed to parse jsonPath %q: %w", tmpl, err)
+	}
+	return nil
+}
@@ -0,0 +1,162 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestKubernetesPluginConfig(t *testing.T) {
+	t.Parallel()
+
+	data := `{
+  "healthRules": [
+    {
+      "group": "cert-manager.io",
+      "kind": "Certificate",
+      "healthy": [
+        {"jsonPath": "{.status.conditions[?(@.type==\"Ready\")].status}", "value": "True"}
+      ],
+      "unhealthy": [
+        {"jsonPath": "{.status.conditions[?(@.type==\"Ready\")].reason}", "value": "Failed"}
+      ],
+      "message": "{.status.conditions[?(@.type==\"Ready\")].message}"
+    }
+  ]
+}`
+	var cfg KubernetesPluginConfig
+	require.NoError(t, json.Unmarshal([]byte(data), &cfg))
+	require.NoError(t, cfg.Validate())
+
+	expected := KubernetesPluginConfig{
+		HealthRules: []K8sHealthRule{
+			{
+				Group: "cert-manager.io",
+				Kind:  "Certificate",
+				Healthy: []K8sHealthCondition{
+					{JSONPath: `{.status.conditions[?(@.type=="Ready")].status}`, Value: "True"},
+				},
+				Unhealthy: []K8sHealthCondition{
+					{JSONPath: `{.status.conditions[?(@.type=="Ready")].reason}`, Value: "Failed"},
+				},
+				Message: `{.status.conditions[?(@.type=="Ready")].message}`,
+			},
+		},
+	}
+	assert.Equal(t, expected, cfg)
+}
+
+func TestK8sHealthRuleValidate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		rule    K8sHealthRule
+		wantErr bool
+	}{
+		{
+			name: "valid",
+			rule: K8sHealthRule{
+				Group:   "argoproj.io",
+				Kind:    "Rollout",
+				Healthy: []K8sHealthCondition{{JSONPath: "{.status.phase}", Value: "Healthy"}},
+			},
+		},
+		{
+			name: "missing kind",
+			rule: K8sHealthRule{
+				Healthy: []K8sHealthCondition{{JSONPath: "{.status.phase}", Value: "Healthy"}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "no healthy condition",
+			rule: K8sHealthRule{
+				Kind:      "Rollout",
+				Unhealthy: []K8sHealthCondition{{JSONPath: "{.status.phase}", Value: "Degraded"}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "empty jsonPath",
+			rule: K8sHealthRule{
+				Kind:    "Rollout",
+				Healthy: []K8sHealthCondition{{Value: "Healthy"}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid jsonPath of unhealthy condition",
+			rule: K8sHealthRule{
+				Kind:      "Rollout",
+				Healthy:   []K8sHealthCondition{{JSONPath: "{.status.phase}", Value: "Healthy"}},
+				Unhealthy: []K8sHealthCondition{{JSONPath: "{.status.phase", Value: "Degraded"}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "valid cel",
+			rule: K8sHealthRule{
+				Kind:    "Rollout",
+				Healthy: []K8sHealthCondition{{CEL: "object.status.availableReplicas >= object.spec.replicas"}},
+			},
+		},
+		{
+			name: "invalid cel",
+			rule: K8sHealthRule{
+				Kind:    "Rollout",
+				Healthy: []K8sHealthCondition{{CEL: "object.status.phase =="}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "cel not returning bool",
+			rule: K8sHealthRule{
+				Kind:    "Rollout",
+				Healthy: []K8sHealthCondition{{CEL: "1 + 2"}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "cel with jsonPath",
+			rule: K8sHealthRule{
+				Kind:    "Rollout",
+				Healthy: []K8sHealthCondition{{CEL: "true", JSONPath: "{.status.phase}", Value: "Healthy"}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid message",
+			rule: K8sHealthRule{
+				Kind:    "Rollout",
+				Healthy: []K8sHealthCondition{{JSONPath: "{.status.phase}", Value: "Healthy"}},
+				Message: "{.status.message",
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			err := tt.rule.Validate()
+			assert.Equal(t, tt.wantErr, err != nil)
+		})
+	}
+}
@@ -20,4 +20,18 @@ type K8sSyncStageOptions struct {
 	AddVariantLabelToSelector bool `json:"addVariantLabelToSelector"`
 	// Whether the resources that are no longer defined in Git should be removed or not.
 	Prune bool `json:"prune"`
+	// Configuration for applying the manifests by server-side apply.
+	ServerSideApply *K8sServerSideApplyOptions `json:"serverSideApply,omitempty"`
+}
+
+// K8sServerSideApplyOptions contains the configurable values for applying the manifests by server-side apply.
+type K8sServerSideApplyOptions struct {
+	// Whether the manifests should be applied by server-side apply.
+	Enabled bool `json:"enabled"`
+	// The name of the field manager used to apply the manifests.
+	// Default is "kubectl".
+	FieldManager string `json:"fieldManager,omitempty"`
+	// Whether the conflicts with the fields owned by other field managers should be forced.
+	// Their ownership is taken over if true, otherwise the sync fails on conflict.
+	ForceConflicts bool `json:"forceConflicts"`
 }
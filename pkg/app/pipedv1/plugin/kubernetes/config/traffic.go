@@ -23,6 +23,12 @@ const (
 	KubernetesTrafficRoutingMethodPodSelector KubernetesTrafficRoutingMethod = "podselector"
 	// KubernetesTrafficRoutingMethodIstio is the way by updating the VirtualService to update traffic routing.
 	KubernetesTrafficRoutingMethodIstio KubernetesTrafficRoutingMethod = "istio"
+	// KubernetesTrafficRoutingMethodGatewayAPI is the way by updating the backendRefs of the HTTPRoute to update traffic routing.
+	KubernetesTrafficRoutingMethodGatewayAPI KubernetesTrafficRoutingMethod = "gatewayapi"
+	// KubernetesTrafficRoutingMethodSMI is the way by updating the backends of the SMI TrafficSplit to update traffic routing.
+	KubernetesTrafficRoutingMethodSMI KubernetesTrafficRoutingMethod = "smi"
+	// KubernetesTrafficRoutingMethodNGINX is the way by applying a canary Ingress of NGINX Ingress Controller to update traffic routing.
+	KubernetesTrafficRoutingMethodNGINX KubernetesTrafficRoutingMethod = "nginx"
 )
 
 // KubernetesTrafficRouting represents the traffic routing configuration for a Kubernetes application.
@@ -32,6 +38,12 @@ type KubernetesTrafficRouting struct {
 	Method KubernetesTrafficRoutingMethod `json:"method"`
 	// The Istio-specific configuration for traffic routing.
 	Istio *IstioTrafficRouting `json:"istio"`
+	// The Gateway API-specific configuration for traffic routing.
+	GatewayAPI *GatewayAPITrafficRouting `json:"gatewayAPI"`
+	// The SMI-specific configuration for traffic routing.
+	SMI *SMITrafficRouting `json:"smi"`
+	// The NGINX Ingress-specific configuration for traffic routing.
+	NGINX *NGINXTrafficRouting `json:"nginx"`
 }
 
 // DetermineKubernetesTrafficRoutingMethod determines the routing method should be used based on the TrafficRouting config.
@@ -56,6 +68,52 @@ type IstioTrafficRouting struct {
 	VirtualService K8sResourceReference `json:"virtualService"`
 }
 
+// GatewayAPITrafficRouting represents the Gateway API-specific configuration for traffic routing.
+type GatewayAPITrafficRouting struct {
+	// The reference to HTTPRoute manifest.
+	// Empty means the first HTTPRoute resource will be used.
+	HTTPRoute K8sResourceReference `json:"httpRoute"`
+	// The name of the Service of PRIMARY variant referenced by the backendRefs of the HTTPRoute.
+	// Only the rules routing to this Service will be updated.
+	// Empty means the name of the application Service will be used.
+	PrimaryService string `json:"primaryService"`
+	// The name of the Service of CANARY variant.
+	// Empty means the name of the PRIMARY Service suffixed by the CANARY variant label value, e.g. helloworld-canary.
+	CanaryService string `json:"canaryService"`
+	// The name of the Service of BASELINE variant.
+	// Empty means the name of the PRIMARY Service suffixed by the BASELINE variant label value, e.g. helloworld-baseline.
+	BaselineService string `json:"baselineService"`
+}
+
+// SMITrafficRouting represents the SMI-specific configuration for traffic routing.
+type SMITrafficRouting struct {
+	// The reference to TrafficSplit manifest.
+	// Empty means the first TrafficSplit resource will be used.
+	TrafficSplit K8sResourceReference `json:"trafficSplit"`
+	// The name of the Service of PRIMARY variant in the backends of the TrafficSplit.
+	// Empty means the name of the application Service suffixed by the PRIMARY variant label value, e.g. helloworld-primary.
+	PrimaryService string `json:"primaryService"`
+	// The name of the Service of CANARY variant.
+	// Empty means the name of the application Service suffixed by the CANARY variant label value, e.g. helloworld-canary.
+	CanaryService string `json:"canaryService"`
+	// The name of the Service of BASELINE variant.
+	// Empty means the name of the application Service suffixed by the BASELINE variant label value, e.g. helloworld-baseline.
+	BaselineService string `json:"baselineService"`
+}
+
+// NGINXTrafficRouting represents the NGINX Ingress-specific configuration for traffic routing.
+type NGINXTrafficRouting struct {
+	// The reference to Ingress manifest routing traffic to PRIMARY variant.
+	// Empty means the first Ingress resource will be used.
+	Ingress K8sResourceReference `json:"ingress"`
+	// The name of the Service of PRIMARY variant referenced by the Ingress.
+	// Empty means the name of the application Service will be used.
+	PrimaryService string `json:"primaryService"`
+	// The name of the Service of CANARY variant.
+	// Empty means the name of the PRIMARY Service suffixed by the CANARY variant label value, e.g. helloworld-canary.
+	CanaryService string `json:"canaryService"`
+}
+
 // K8sTrafficRoutingStageOptions contains all configurable values for a K8S_TRAFFIC_ROUTING stage.
 type K8sTrafficRoutingStageOptions struct {
 	// Which variant should receive all traffic.
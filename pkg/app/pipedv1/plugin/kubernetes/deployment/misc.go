@@ -24,6 +24,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
+	kubeconfig "github.com/pipe-cd/pipecd/pkg/app/pipedv1/plugin/kubernetes/config"
 	"github.com/pipe-cd/pipecd/pkg/app/pipedv1/plugin/kubernetes/provider"
 	sdk "github.com/pipe-cd/piped-plugin-sdk-go"
 )
@@ -229,6 +230,26 @@ func addVariantLabelsAndAnnotations(m []provider.Manifest, variantLabel, variant
 	}
 }
 
+// addServerSideApplyAnnotations adds the annotations to apply the given manifests
+// by server-side apply with the given options.
+func addServerSideApplyAnnotations(m []provider.Manifest, opts *kubeconfig.K8sServerSideApplyOptions) {
+	if opts == nil || !opts.Enabled {
+		return
+	}
+	annotations := map[string]string{
+		provider.LabelServerSideApply: provider.UseServerSideApply,
+	}
+	if opts.FieldManager != "" {
+		annotations[provider.AnnotationFieldManager] = opts.FieldManager
+	}
+	if opts.ForceConflicts {
+		annotations[provider.AnnotationForceConflicts] = provider.ForceConflictsTrue
+	}
+	for _, m := range m {
+		m.AddAnnotations(annotations)
+	}
+}
+
 // duplicateManifests duplicates the given manifests and appends a name suffix to each manifest.
 func duplicateManifests(manifests []provider.Manifest, nameSuffix string) []provider.Manifest {
 	copied := make([]provider.Manifest, len(manifests))
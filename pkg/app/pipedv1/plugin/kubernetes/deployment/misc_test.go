@@ -21,6 +21,7 @@ import (
 	"github.com/stretchr/testify/require"
 	corev1 "k8s.io/api/core/v1"
 
+	kubeconfig "github.com/pipe-cd/pipecd/pkg/app/pipedv1/plugin/kubernetes/config"
 	"github.com/pipe-cd/pipecd/pkg/app/pipedv1/plugin/kubernetes/provider"
 )
 
@@ -414,6 +415,67 @@ metadata:
 	}
 }
 
+func TestAddServerSideApplyAnnotations(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name       string
+		opts       *kubeconfig.K8sServerSideApplyOptions
+		wantAnnots map[string]string
+	}{
+		{
+			name:       "not configured",
+			wantAnnots: nil,
+		},
+		{
+			name:       "disabled",
+			opts:       &kubeconfig.K8sServerSideApplyOptions{Enabled: false, FieldManager: "piped"},
+			wantAnnots: nil,
+		},
+		{
+			name: "enabled with default field manager",
+			opts: &kubeconfig.K8sServerSideApplyOptions{Enabled: true},
+			wantAnnots: map[string]string{
+				provider.LabelServerSideApply: provider.UseServerSideApply,
+			},
+		},
+		{
+			name: "enabled with field manager and force conflicts",
+			opts: &kubeconfig.K8sServerSideApplyOptions{Enabled: true, FieldManager: "piped", ForceConflicts: true},
+			wantAnnots: map[string]string{
+				provider.LabelServerSideApply:     provider.UseServerSideApply,
+				provider.AnnotationFieldManager:   "piped",
+				provider.AnnotationForceConflicts: provider.ForceConflictsTrue,
+			},
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			manifests, err := provider.ParseManifests(`
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: config1
+---
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: config2
+`)
+			require.NoError(t, err)
+
+			addServerSideApplyAnnotations(manifests, tc.opts)
+
+			for _, m := range manifests {
+				assert.Equal(t, tc.wantAnnots, m.GetAnnotations())
+			}
+		})
+	}
+}
+
 func TestDuplicateManifests(t *testing.T) {
 	yaml := `
 apiVersion: v1
@@ -70,12 +70,32 @@ func (p *Plugin) executeK8sPrimaryRolloutStage(ctx context.Context, input *sdk.E
 	var primaryManifests []provider.Manifest
 	routingMethod := kubeconfig.DetermineKubernetesTrafficRoutingMethod(appCfg.TrafficRouting)
 	switch routingMethod {
-	case kubeconfig.KubernetesTrafficRoutingMethodPodSelector:
+	case kubeconfig.KubernetesTrafficRoutingMethodPodSelector, kubeconfig.KubernetesTrafficRoutingMethodNGINX:
+		// NGINX routes the traffic to CANARY variant by a separated canary Ingress,
+		// so all manifests can be used as primary manifests.
 		primaryManifests = manifests
 	case kubeconfig.KubernetesTrafficRoutingMethodIstio:
 		// TODO: support routing by Istio
 		lp.Errorf("Traffic routing method %v is not yet implemented", routingMethod)
 		return sdk.StageStatusFailure
+	case kubeconfig.KubernetesTrafficRoutingMethodGatewayAPI, kubeconfig.KubernetesTrafficRoutingMethodSMI:
+		// The HTTPRoute or TrafficSplit manifest will be used to manipulate the traffic ratio,
+		// so it is excluded from the primary manifests.
+		trafficRoutingManifests, err := findTrafficRoutingManifests(manifests, appCfg.Service.Name, appCfg.TrafficRouting)
+		if err != nil {
+			lp.Errorf("Failed while finding traffic routing manifest: (%v)", err)
+			return sdk.StageStatusFailure
+		}
+		primaryManifests = manifests
+		if len(trafficRoutingManifests) > 0 {
+			primaryManifests = make([]provider.Manifest, 0, len(manifests)-1)
+			for _, m := range manifests {
+				if m.Key() == trafficRoutingManifests[0].Key() {
+					continue
+				}
+				primaryManifests = append(primaryManifests, m)
+			}
+		}
 	default:
 		lp.Errorf("Traffic routing method %v is not supported", routingMethod)
 		return sdk.StageStatusFailure
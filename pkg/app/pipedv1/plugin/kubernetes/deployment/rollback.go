@@ -72,6 +72,7 @@ func (p *Plugin) executeK8sRollbackStage(ctx context.Context, input *sdk.Execute
 	}
 
 	addVariantLabelsAndAnnotations(manifests, variantLabel, primaryVariant)
+	addServerSideApplyAnnotations(manifests, cfg.Spec.QuickSync.ServerSideApply)
 
 	if err := annotateConfigHash(manifests); err != nil {
 		lp.Errorf("Unable to set %q annotation into the workload manifest (%v)", provider.AnnotationConfigHash, err)
@@ -101,6 +102,22 @@ func (p *Plugin) executeK8sRollbackStage(ctx context.Context, input *sdk.Execute
 		return sdk.StageStatusFailure
 	}
 
+	// The canary Ingress for NGINX keeps routing traffic to CANARY variant until it is removed.
+	if kubeconfig.DetermineKubernetesTrafficRoutingMethod(cfg.Spec.TrafficRouting) == kubeconfig.KubernetesTrafficRoutingMethodNGINX {
+		ingresses, err := findTrafficRoutingManifests(manifests, cfg.Spec.Service.Name, cfg.Spec.TrafficRouting)
+		if err != nil {
+			lp.Errorf("Failed while finding the Ingress for traffic routing (%v)", err)
+			return sdk.StageStatusFailure
+		}
+		if len(ingresses) > 0 {
+			lp.Info("Removing the canary Ingress")
+			if err := deleteNGINXCanaryIngress(ctx, lp, applier, ingresses[0], cfg.Spec.VariantLabel.CanaryValue); err != nil {
+				lp.Errorf("Failed while removing the canary Ingress (%v)", err)
+				return sdk.StageStatusFailure
+			}
+		}
+	}
+
 	// TODO: implement prune resources
 	// TODO: delete all resources of CANARY variant
 	// TODO: delete all resources of BASELINE variant
@@ -83,6 +83,7 @@ func (p *Plugin) executeK8sSyncStage(ctx context.Context, input *sdk.ExecuteStag
 	}
 
 	addVariantLabelsAndAnnotations(manifests, variantLabel, primaryVariant)
+	addServerSideApplyAnnotations(manifests, stageCfg.ServerSideApply)
 
 	if err := annotateConfigHash(manifests); err != nil {
 		lp.Errorf("Unable to set %q annotation into the workload manifest (%v)", provider.AnnotationConfigHash, err)
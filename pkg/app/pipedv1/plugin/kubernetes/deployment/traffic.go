@@ -15,13 +15,602 @@
 package deployment
 
 import (
+	"cmp"
 	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"strconv"
+	"strings"
+
+	"go.uber.org/zap"
+	networkingv1 "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 
 	kubeconfig "github.com/pipe-cd/pipecd/pkg/app/pipedv1/plugin/kubernetes/config"
+	"github.com/pipe-cd/pipecd/pkg/app/pipedv1/plugin/kubernetes/provider"
+	"github.com/pipe-cd/pipecd/pkg/app/pipedv1/plugin/kubernetes/toolregistry"
 	sdk "github.com/pipe-cd/piped-plugin-sdk-go"
 )
 
-func (p *Plugin) executeK8sTrafficRoutingStage(_ context.Context, input *sdk.ExecuteStageInput[kubeconfig.KubernetesApplicationSpec], _ []*sdk.DeployTarget[kubeconfig.KubernetesDeployTargetConfig]) sdk.StageStatus {
-	input.Client.LogPersister().Error("Traffic routing is not yet implemented")
-	return sdk.StageStatusFailure
+const (
+	primaryMetadataKey  = "primary-percentage"
+	canaryMetadataKey   = "canary-percentage"
+	baselineMetadataKey = "baseline-percentage"
+)
+
+func (p *Plugin) executeK8sTrafficRoutingStage(ctx context.Context, input *sdk.ExecuteStageInput[kubeconfig.KubernetesApplicationSpec], dts []*sdk.DeployTarget[kubeconfig.KubernetesDeployTargetConfig]) sdk.StageStatus {
+	lp := input.Client.LogPersister()
+
+	// Get the deploy target config.
+	if len(dts) == 0 {
+		lp.Error("No deploy target was found")
+		return sdk.StageStatusFailure
+	}
+	deployTargetConfig := dts[0].Config
+
+	cfg, err := input.Request.TargetDeploymentSource.AppConfig()
+	if err != nil {
+		lp.Errorf("Failed while loading application config (%v)", err)
+		return sdk.StageStatusFailure
+	}
+
+	var (
+		appCfg         = cfg.Spec
+		variantLabel   = appCfg.VariantLabel.Key
+		primaryVariant = appCfg.VariantLabel.PrimaryValue
+	)
+
+	var stageCfg kubeconfig.K8sTrafficRoutingStageOptions
+	if err := json.Unmarshal(input.Request.StageConfig, &stageCfg); err != nil {
+		lp.Errorf("Failed while unmarshalling stage config (%v)", err)
+		return sdk.StageStatusFailure
+	}
+
+	method := kubeconfig.DetermineKubernetesTrafficRoutingMethod(appCfg.TrafficRouting)
+	if method == kubeconfig.KubernetesTrafficRoutingMethodIstio {
+		// TODO: support routing by Istio
+		lp.Errorf("Traffic routing method %v is not yet implemented", method)
+		return sdk.StageStatusFailure
+	}
+
+	toolRegistry := toolregistry.NewRegistry(input.Client.ToolRegistry())
+	loader := provider.NewLoader(toolRegistry)
+
+	lp.Infof("Loading manifests at commit %s for handling", input.Request.TargetDeploymentSource.CommitHash)
+	manifests, err := p.loadManifests(ctx, &input.Request.Deployment, appCfg, &input.Request.TargetDeploymentSource, loader)
+	if err != nil {
+		lp.Errorf("Failed while loading manifests (%v)", err)
+		return sdk.StageStatusFailure
+	}
+	lp.Successf("Successfully loaded %d manifests", len(manifests))
+
+	if len(manifests) == 0 {
+		lp.Error("There are no kubernetes manifests to handle")
+		return sdk.StageStatusFailure
+	}
+
+	// Decide traffic routing percentage for all variants.
+	primaryPercent, canaryPercent, baselinePercent := stageCfg.Percentages()
+	saveTrafficRoutingMetadata(ctx, input, primaryPercent, canaryPercent, baselinePercent)
+
+	// Find traffic routing manifests.
+	trafficRoutingManifests, err := findTrafficRoutingManifests(manifests, appCfg.Service.Name, appCfg.TrafficRouting)
+	if err != nil {
+		lp.Errorf("Failed while finding traffic routing manifest: (%v)", err)
+		return sdk.StageStatusFailure
+	}
+
+	switch len(trafficRoutingManifests) {
+	case 1:
+		break
+	case 0:
+		lp.Errorf("Unable to find any traffic routing manifests")
+		return sdk.StageStatusFailure
+	default:
+		lp.Infof(
+			"Detected %d traffic routing manifests but only the first one (%s) will be used",
+			len(trafficRoutingManifests),
+			trafficRoutingManifests[0].Key().ReadableString(),
+		)
+	}
+	trafficRoutingManifest := trafficRoutingManifests[0]
+
+	// In case we are routing by PodSelector, the service manifest must contain variantLabel inside its selector.
+	if method == kubeconfig.KubernetesTrafficRoutingMethodPodSelector {
+		if err := checkVariantSelectorInService(trafficRoutingManifest, variantLabel, primaryVariant); err != nil {
+			lp.Errorf("Traffic routing by PodSelector requires %q inside the selector of Service manifest but it was unable to check that field in manifest %s (%v)",
+				variantLabel+": "+primaryVariant,
+				trafficRoutingManifest.Key().ReadableString(),
+				err,
+			)
+			return sdk.StageStatusFailure
+		}
+	}
+
+	// In case we are routing by NGINX Ingress, BASELINE variant is not supported.
+	if method == kubeconfig.KubernetesTrafficRoutingMethodNGINX && baselinePercent > 0 {
+		lp.Errorf("Traffic routing by NGINX Ingress does not support BASELINE variant (baseline=%d)", baselinePercent)
+		return sdk.StageStatusFailure
+	}
+
+	// Get the kubectl tool path.
+	kubectlPath, err := toolRegistry.Kubectl(ctx, cmp.Or(appCfg.Input.KubectlVersion, deployTargetConfig.KubectlVersion))
+	if err != nil {
+		lp.Errorf("Failed while getting kubectl tool (%v)", err)
+		return sdk.StageStatusFailure
+	}
+
+	// Create the applier for the target cluster.
+	applier := provider.NewApplier(provider.NewKubectl(kubectlPath), appCfg.Input, deployTargetConfig, input.Logger)
+
+	// In case we are routing by NGINX Ingress, the canary Ingress is removed when no traffic should be routed to CANARY variant.
+	if method == kubeconfig.KubernetesTrafficRoutingMethodNGINX && canaryPercent == 0 {
+		lp.Info("Removing the canary Ingress because no traffic should be routed to CANARY variant")
+		if err := deleteNGINXCanaryIngress(ctx, lp, applier, trafficRoutingManifest, appCfg.VariantLabel.CanaryValue); err != nil {
+			lp.Errorf("Failed while removing the canary Ingress (%v)", err)
+			return sdk.StageStatusFailure
+		}
+		lp.Success("Successfully updated traffic routing")
+		return sdk.StageStatusSuccess
+	}
+
+	trafficRoutingManifest, err = generateTrafficRoutingManifest(appCfg, trafficRoutingManifest, primaryPercent, canaryPercent, baselinePercent)
+	if err != nil {
+		lp.Errorf("Unable generate traffic routing manifest: (%v)", err)
+		return sdk.StageStatusFailure
+	}
+
+	// The canary Ingress for NGINX belongs to CANARY variant
+	// so it is removed together with the other CANARY resources.
+	trafficRoutingVariant := primaryVariant
+	if method == kubeconfig.KubernetesTrafficRoutingMethodNGINX {
+		trafficRoutingVariant = appCfg.VariantLabel.CanaryValue
+	}
+	addVariantLabelsAndAnnotations([]provider.Manifest{trafficRoutingManifest}, variantLabel, trafficRoutingVariant)
+
+	lp.Infof("Start updating traffic routing to be percentages: primary=%d, canary=%d, baseline=%d",
+		primaryPercent,
+		canaryPercent,
+		baselinePercent,
+	)
+	if err := applyManifests(ctx, applier, []provider.Manifest{trafficRoutingManifest}, appCfg.Input.Namespace, lp); err != nil {
+		lp.Errorf("Failed while applying traffic routing manifest (%v)", err)
+		return sdk.StageStatusFailure
+	}
+
+	lp.Success("Successfully updated traffic routing")
+	return sdk.StageStatusSuccess
+}
+
+// deleteNGINXCanaryIngress deletes the canary Ingress generated from the given Ingress of PRIMARY variant.
+// It is not treated as an error when the canary Ingress does not exist.
+func deleteNGINXCanaryIngress(ctx context.Context, lp sdk.StageLogPersister, applier *provider.Applier, ingress provider.Manifest, canaryVariant string) error {
+	key := ingress.DeepCopyWithName(makeSuffixedName(ingress.Name(), canaryVariant)).Key()
+	if err := applier.Delete(ctx, key); err != nil {
+		if errors.Is(err, provider.ErrNotFound) {
+			lp.Infof("The canary Ingress does not exist, so skip deleting it: %s", key.ReadableString())
+			return nil
+		}
+		return err
+	}
+	lp.Successf("- deleted resource: %s", key.ReadableString())
+	return nil
+}
+
+func saveTrafficRoutingMetadata(ctx context.Context, input *sdk.ExecuteStageInput[kubeconfig.KubernetesApplicationSpec], primary, canary, baseline int) {
+	metadata := map[string]string{
+		primaryMetadataKey:  strconv.FormatInt(int64(primary), 10),
+		canaryMetadataKey:   strconv.FormatInt(int64(canary), 10),
+		baselineMetadataKey: strconv.FormatInt(int64(baseline), 10),
+	}
+	if err := input.Client.PutStageMetadataMulti(ctx, metadata); err != nil {
+		input.Logger.Error("failed to save traffic routing percentages to metadata", zap.Error(err))
+	}
+}
+
+func findTrafficRoutingManifests(manifests []provider.Manifest, serviceName string, cfg *kubeconfig.KubernetesTrafficRouting) ([]provider.Manifest, error) {
+	method := kubeconfig.DetermineKubernetesTrafficRoutingMethod(cfg)
+
+	switch method {
+	case kubeconfig.KubernetesTrafficRoutingMethodPodSelector:
+		return findManifests(provider.KindService, serviceName, manifests), nil
+
+	case kubeconfig.KubernetesTrafficRoutingMethodGatewayAPI:
+		gatewayConfig := cfg.GatewayAPI
+		if gatewayConfig == nil {
+			gatewayConfig = &kubeconfig.GatewayAPITrafficRouting{}
+		}
+		return findGatewayAPIHTTPRouteManifests(manifests, gatewayConfig.HTTPRoute)
+
+	case kubeconfig.KubernetesTrafficRoutingMethodSMI:
+		smiConfig := cfg.SMI
+		if smiConfig == nil {
+			smiConfig = &kubeconfig.SMITrafficRouting{}
+		}
+		return findSMITrafficSplitManifests(manifests, smiConfig.TrafficSplit)
+
+	case kubeconfig.KubernetesTrafficRoutingMethodNGINX:
+		nginxConfig := cfg.NGINX
+		if nginxConfig == nil {
+			nginxConfig = &kubeconfig.NGINXTrafficRouting{}
+		}
+		if nginxConfig.Ingress.Kind != "" && nginxConfig.Ingress.Kind != provider.KindIngress {
+			return nil, fmt.Errorf("support only %q kind for Ingress reference", provider.KindIngress)
+		}
+		return findManifests(provider.KindIngress, nginxConfig.Ingress.Name, manifests), nil
+
+	default:
+		return nil, fmt.Errorf("unsupport traffic routing method %v", method)
+	}
+}
+
+func generateTrafficRoutingManifest(appCfg *kubeconfig.KubernetesApplicationSpec, manifest provider.Manifest, primaryPercent, canaryPercent, baselinePercent int) (provider.Manifest, error) {
+	// Duplicate the manifest to avoid updating the loaded one.
+	manifest = manifest.DeepCopy()
+
+	// When all traffic should be routed to primary variant
+	// we do not need to change the traffic manifest
+	// just copy and return the one specified in the target commit.
+	if primaryPercent == 100 {
+		return manifest, nil
+	}
+
+	cfg := appCfg.TrafficRouting
+	if cfg != nil && cfg.Method == kubeconfig.KubernetesTrafficRoutingMethodGatewayAPI {
+		gatewayConfig := cfg.GatewayAPI
+		if gatewayConfig == nil {
+			gatewayConfig = &kubeconfig.GatewayAPITrafficRouting{}
+		}
+		return generateHTTPRouteManifest(appCfg, manifest, gatewayConfig, canaryPercent, baselinePercent)
+	}
+
+	if cfg != nil && cfg.Method == kubeconfig.KubernetesTrafficRoutingMethodSMI {
+		smiConfig := cfg.SMI
+		if smiConfig == nil {
+			smiConfig = &kubeconfig.SMITrafficRouting{}
+		}
+		return generateTrafficSplitManifest(appCfg, manifest, smiConfig, canaryPercent, baselinePercent)
+	}
+
+	if cfg != nil && cfg.Method == kubeconfig.KubernetesTrafficRoutingMethodNGINX {
+		nginxConfig := cfg.NGINX
+		if nginxConfig == nil {
+			nginxConfig = &kubeconfig.NGINXTrafficRouting{}
+		}
+		return generateNGINXCanaryIngressManifest(appCfg, manifest, nginxConfig, canaryPercent)
+	}
+
+	// Determine which variant will receive 100% percent of traffic.
+	var variant string
+	switch {
+	case canaryPercent == 100:
+		variant = appCfg.VariantLabel.CanaryValue
+	default:
+		return manifest, fmt.Errorf("traffic routing by pod requires either PRIMARY or CANARY must be 100 (primary=%d, canary=%d)", primaryPercent, canaryPercent)
+	}
+
+	variantLabel := appCfg.VariantLabel.Key
+	if err := manifest.AddStringMapValues(map[string]string{variantLabel: variant}, "spec", "selector"); err != nil {
+		return manifest, fmt.Errorf("unable to update selector for service %q because of: %w", manifest.Name(), err)
+	}
+
+	return manifest, nil
+}
+
+func findGatewayAPIHTTPRouteManifests(manifests []provider.Manifest, ref kubeconfig.K8sResourceReference) ([]provider.Manifest, error) {
+	const (
+		gatewayAPIVersionPrefix = "gateway.networking.k8s.io/"
+		httpRouteKind           = "HTTPRoute"
+	)
+
+	if ref.Kind != "" && ref.Kind != httpRouteKind {
+		return nil, fmt.Errorf("support only %q kind for HTTPRoute reference", httpRouteKind)
+	}
+
+	out := make([]provider.Manifest, 0, len(manifests))
+	for _, m := range manifests {
+		if !strings.HasPrefix(m.APIVersion(), gatewayAPIVersionPrefix) {
+			continue
+		}
+		if m.Kind() != httpRouteKind {
+			continue
+		}
+		if ref.Name != "" && m.Name() != ref.Name {
+			continue
+		}
+		out = append(out, m)
+	}
+
+	return out, nil
+}
+
+func generateHTTPRouteManifest(appCfg *kubeconfig.KubernetesApplicationSpec, m provider.Manifest, cfg *kubeconfig.GatewayAPITrafficRouting, canaryPercent, baselinePercent int) (provider.Manifest, error) {
+	m = m.DeepCopy()
+
+	primaryService := cmp.Or(cfg.PrimaryService, appCfg.Service.Name)
+	if primaryService == "" {
+		return m, fmt.Errorf("unable to determine the PRIMARY service, please specify trafficRouting.gatewayAPI.primaryService")
+	}
+	backends := variantBackends{
+		primary:  primaryService,
+		canary:   cmp.Or(cfg.CanaryService, makeSuffixedName(primaryService, appCfg.VariantLabel.CanaryValue)),
+		baseline: cmp.Or(cfg.BaselineService, makeSuffixedName(primaryService, appCfg.VariantLabel.BaselineValue)),
+	}
+
+	spec, _, err := m.NestedMap("spec")
+	if err != nil {
+		return m, err
+	}
+	if err := updateHTTPRouteBackendRefs(spec, backends, int64(canaryPercent), int64(baselinePercent)); err != nil {
+		return m, err
+	}
+	if err := m.SetNestedMap(spec, "spec"); err != nil {
+		return m, err
+	}
+
+	return m, nil
+}
+
+// variantBackends holds the names of the Services of all variants.
+type variantBackends struct {
+	primary  string
+	canary   string
+	baseline string
+}
+
+// updateHTTPRouteBackendRefs updates the backendRefs of all rules routing to the PRIMARY Service
+// in the given HTTPRoute spec to split the traffic among the variants.
+func updateHTTPRouteBackendRefs(spec map[string]any, backends variantBackends, canaryPercent, baselinePercent int64) error {
+	rules, ok := spec["rules"].([]any)
+	if !ok {
+		return fmt.Errorf("no rules was found in the HTTPRoute")
+	}
+
+	var updated bool
+	for _, r := range rules {
+		rule, ok := r.(map[string]any)
+		if !ok {
+			continue
+		}
+		refs, ok := rule["backendRefs"].([]any)
+		if !ok {
+			continue
+		}
+		if refs, ok = splitBackendWeights(refs, "name", backends, canaryPercent, baselinePercent); ok {
+			rule["backendRefs"] = refs
+			updated = true
+		}
+	}
+
+	if !updated {
+		return fmt.Errorf("no rule routing to service %q was found in the HTTPRoute", backends.primary)
+	}
+	return nil
+}
+
+// splitBackendWeights returns the given backends updated to split the traffic among the variants.
+// The nameKey is the field of a backend holding the name of its Service.
+// The weights of the backends are relative, so only the weight of the PRIMARY backend
+// (including the ones moved to the other variants by the previous stages) is split among the variants.
+// All weights are scaled up when needed to split it by percentage while keeping the ratio to the other backends.
+// False is returned when the backends do not contain the PRIMARY Service.
+func splitBackendWeights(refs []any, nameKey string, backends variantBackends, canaryPercent, baselinePercent int64) ([]any, bool) {
+	var (
+		primaryRef     map[string]any
+		variantsWeight int64
+		otherRefs      = make([]any, 0, len(refs))
+	)
+	for _, ref := range refs {
+		br, ok := ref.(map[string]any)
+		if !ok {
+			otherRefs = append(otherRefs, ref)
+			continue
+		}
+		switch br[nameKey] {
+		case backends.primary:
+			primaryRef = br
+			variantsWeight += backendRefWeight(br)
+		case backends.canary, backends.baseline:
+			// Drop the ones added by the previous stages but keep their weights for the variants.
+			variantsWeight += backendRefWeight(br)
+		default:
+			otherRefs = append(otherRefs, br)
+		}
+	}
+	if primaryRef == nil {
+		return refs, false
+	}
+
+	// Scale up all weights by the smallest factor making the weight of the variants a multiple of 100.
+	scale := 100 / gcd(variantsWeight, 100)
+	variantsWeight *= scale
+
+	var (
+		canaryWeight   = canaryPercent * variantsWeight / 100
+		baselineWeight = baselinePercent * variantsWeight / 100
+		primaryWeight  = variantsWeight - canaryWeight - baselineWeight
+		newRefs        = make([]any, 0, len(otherRefs)+3)
+	)
+	newRefs = append(newRefs, makeBackendRef(primaryRef, nameKey, backends.primary, primaryWeight))
+	if canaryWeight > 0 {
+		newRefs = append(newRefs, makeBackendRef(primaryRef, nameKey, backends.canary, canaryWeight))
+	}
+	if baselineWeight > 0 {
+		newRefs = append(newRefs, makeBackendRef(primaryRef, nameKey, backends.baseline, baselineWeight))
+	}
+	for _, ref := range otherRefs {
+		if br, ok := ref.(map[string]any); ok && scale > 1 {
+			ref = withBackendRefWeight(br, backendRefWeight(br)*scale)
+		}
+		newRefs = append(newRefs, ref)
+	}
+	return newRefs, true
+}
+
+// gcd returns the greatest common divisor of the given non-negative numbers.
+func gcd(a, b int64) int64 {
+	for b != 0 {
+		a, b = b, a%b
+	}
+	return a
+}
+
+// backendRefWeight returns the weight of the given backendRef.
+// The weight is 1 when it is not specified.
+func backendRefWeight(ref map[string]any) int64 {
+	switch w := ref["weight"].(type) {
+	case int64:
+		return w
+	case float64:
+		return int64(w)
+	default:
+		return 1
+	}
+}
+
+// makeBackendRef returns a copy of the given backend pointing to the given Service with the given weight.
+func makeBackendRef(base map[string]any, nameKey, name string, weight int64) map[string]any {
+	ref := withBackendRefWeight(base, weight)
+	ref[nameKey] = name
+	return ref
+}
+
+// withBackendRefWeight returns a copy of the given backend with the given weight.
+func withBackendRefWeight(base map[string]any, weight int64) map[string]any {
+	ref := maps.Clone(base)
+	ref["weight"] = weight
+	return ref
+}
+
+func findSMITrafficSplitManifests(manifests []provider.Manifest, ref kubeconfig.K8sResourceReference) ([]provider.Manifest, error) {
+	const (
+		smiSplitAPIVersionPrefix = "split.smi-spec.io/"
+		trafficSplitKind         = "TrafficSplit"
+	)
+
+	if ref.Kind != "" && ref.Kind != trafficSplitKind {
+		return nil, fmt.Errorf("support only %q kind for TrafficSplit reference", trafficSplitKind)
+	}
+
+	out := make([]provider.Manifest, 0, len(manifests))
+	for _, m := range manifests {
+		if !strings.HasPrefix(m.APIVersion(), smiSplitAPIVersionPrefix) {
+			continue
+		}
+		if m.Kind() != trafficSplitKind {
+			continue
+		}
+		if ref.Name != "" && m.Name() != ref.Name {
+			continue
+		}
+		out = append(out, m)
+	}
+
+	return out, nil
+}
+
+func generateTrafficSplitManifest(appCfg *kubeconfig.KubernetesApplicationSpec, m provider.Manifest, cfg *kubeconfig.SMITrafficRouting, canaryPercent, baselinePercent int) (provider.Manifest, error) {
+	m = m.DeepCopy()
+
+	serviceName := appCfg.Service.Name
+	backends := variantBackends{
+		primary:  cmp.Or(cfg.PrimaryService, makeSuffixedName(serviceName, appCfg.VariantLabel.PrimaryValue)),
+		canary:   cmp.Or(cfg.CanaryService, makeSuffixedName(serviceName, appCfg.VariantLabel.CanaryValue)),
+		baseline: cmp.Or(cfg.BaselineService, makeSuffixedName(serviceName, appCfg.VariantLabel.BaselineValue)),
+	}
+
+	spec, _, err := m.NestedMap("spec")
+	if err != nil {
+		return m, err
+	}
+	refs, ok := spec["backends"].([]any)
+	if !ok {
+		return m, fmt.Errorf("no backends was found in the TrafficSplit")
+	}
+	if refs, ok = splitBackendWeights(refs, "service", backends, int64(canaryPercent), int64(baselinePercent)); !ok {
+		return m, fmt.Errorf("no backend of service %q was found in the TrafficSplit", backends.primary)
+	}
+	spec["backends"] = refs
+	if err := m.SetNestedMap(spec, "spec"); err != nil {
+		return m, err
+	}
+
+	return m, nil
+}
+
+const (
+	nginxCanaryAnnotation       = "nginx.ingress.kubernetes.io/canary"
+	nginxCanaryWeightAnnotation = "nginx.ingress.kubernetes.io/canary-weight"
+)
+
+// generateNGINXCanaryIngressManifest generates the canary Ingress from the given Ingress of PRIMARY variant.
+// The canary Ingress routes the given percentage of traffic to the Service of CANARY variant.
+func generateNGINXCanaryIngressManifest(appCfg *kubeconfig.KubernetesApplicationSpec, m provider.Manifest, cfg *kubeconfig.NGINXTrafficRouting, canaryPercent int) (provider.Manifest, error) {
+	primaryService := cmp.Or(cfg.PrimaryService, appCfg.Service.Name)
+	if primaryService == "" {
+		return m, fmt.Errorf("unable to determine the PRIMARY service, please specify trafficRouting.nginx.primaryService")
+	}
+	canaryService := cmp.Or(cfg.CanaryService, makeSuffixedName(primaryService, appCfg.VariantLabel.CanaryValue))
+
+	// The canary Ingress is generated as a new resource by suffixing the name of the given one.
+	m = m.DeepCopyWithName(makeSuffixedName(m.Name(), appCfg.VariantLabel.CanaryValue))
+
+	spec, _, err := m.NestedMap("spec")
+	if err != nil {
+		return m, err
+	}
+	ingressSpec := networkingv1.IngressSpec{}
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(spec, &ingressSpec); err != nil {
+		return m, err
+	}
+
+	var found bool
+	replaceBackend := func(b *networkingv1.IngressBackend) {
+		if b != nil && b.Service != nil && b.Service.Name == primaryService {
+			b.Service.Name = canaryService
+			found = true
+		}
+	}
+	replaceBackend(ingressSpec.DefaultBackend)
+	for i := range ingressSpec.Rules {
+		if ingressSpec.Rules[i].HTTP == nil {
+			continue
+		}
+		for j := range ingressSpec.Rules[i].HTTP.Paths {
+			replaceBackend(&ingressSpec.Rules[i].HTTP.Paths[j].Backend)
+		}
+	}
+	if !found {
+		return m, fmt.Errorf("no backend of service %q was found in the Ingress", primaryService)
+	}
+
+	spec, err = runtime.DefaultUnstructuredConverter.ToUnstructured(&ingressSpec)
+	if err != nil {
+		return m, err
+	}
+	if err := m.SetNestedMap(spec, "spec"); err != nil {
+		return m, err
+	}
+	m.AddAnnotations(map[string]string{
+		nginxCanaryAnnotation:       "true",
+		nginxCanaryWeightAnnotation: strconv.Itoa(canaryPercent),
+	})
+
+	return m, nil
+}
+
+func checkVariantSelectorInService(m provider.Manifest, variantLabel, variant string) error {
+	value, ok, err := m.NestedString("spec", "selector", variantLabel)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return fmt.Errorf("missing %s key in spec.selector", variantLabel)
+	}
+	if value != variant {
+		return fmt.Errorf("require %s but got %s for %s key in spec.selector", variant, value, variantLabel)
+	}
+	return nil
 }
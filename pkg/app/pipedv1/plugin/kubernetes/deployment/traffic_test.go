@@ -0,0 +1,639 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deployment
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	kubeconfig "github.com/pipe-cd/pipecd/pkg/app/pipedv1/plugin/kubernetes/config"
+	"github.com/pipe-cd/pipecd/pkg/app/pipedv1/plugin/kubernetes/provider"
+)
+
+const testHTTPRoute = `
+apiVersion: gateway.networking.k8s.io/v1
+kind: HTTPRoute
+metadata:
+  name: helloworld
+spec:
+  parentRefs:
+  - name: gateway
+  rules:
+  - backendRefs:
+    - name: helloworld
+      port: 9085
+  - backendRefs:
+    - name: helloworld
+      port: 9085
+      weight: 50
+    - name: another-service
+      port: 8080
+      weight: 50
+  - backendRefs:
+    - name: another-service
+      port: 8080
+`
+
+func TestGenerateHTTPRouteManifest(t *testing.T) {
+	t.Parallel()
+
+	appCfg := &kubeconfig.KubernetesApplicationSpec{
+		Service: kubeconfig.K8sResourceReference{
+			Name: "helloworld",
+		},
+		VariantLabel: kubeconfig.KubernetesVariantLabel{
+			Key:           "pipecd.dev/variant",
+			PrimaryValue:  "primary",
+			CanaryValue:   "canary",
+			BaselineValue: "baseline",
+		},
+	}
+
+	testcases := []struct {
+		name            string
+		manifest        string
+		cfg             *kubeconfig.GatewayAPITrafficRouting
+		canaryPercent   int
+		baselinePercent int
+		expected        string
+		wantErr         bool
+	}{
+		{
+			name:            "split traffic among variants",
+			manifest:        testHTTPRoute,
+			cfg:             &kubeconfig.GatewayAPITrafficRouting{},
+			canaryPercent:   30,
+			baselinePercent: 20,
+			expected: `
+apiVersion: gateway.networking.k8s.io/v1
+kind: HTTPRoute
+metadata:
+  name: helloworld
+spec:
+  parentRefs:
+  - name: gateway
+  rules:
+  - backendRefs:
+    - name: helloworld
+      port: 9085
+      weight: 50
+    - name: helloworld-canary
+      port: 9085
+      weight: 30
+    - name: helloworld-baseline
+      port: 9085
+      weight: 20
+  - backendRefs:
+    - name: helloworld
+      port: 9085
+      weight: 50
+    - name: helloworld-canary
+      port: 9085
+      weight: 30
+    - name: helloworld-baseline
+      port: 9085
+      weight: 20
+    - name: another-service
+      port: 8080
+      weight: 100
+  - backendRefs:
+    - name: another-service
+      port: 8080
+`,
+		},
+		{
+			name: "remove the variants receiving no traffic",
+			manifest: `
+apiVersion: gateway.networking.k8s.io/v1
+kind: HTTPRoute
+metadata:
+  name: helloworld
+spec:
+  rules:
+  - backendRefs:
+    - name: primary
+      weight: 50
+    - name: new
+      weight: 50
+`,
+			cfg: &kubeconfig.GatewayAPITrafficRouting{
+				PrimaryService: "primary",
+				CanaryService:  "new",
+			},
+			canaryPercent: 0,
+			expected: `
+apiVersion: gateway.networking.k8s.io/v1
+kind: HTTPRoute
+metadata:
+  name: helloworld
+spec:
+  rules:
+  - backendRefs:
+    - name: primary
+      weight: 100
+`,
+		},
+		{
+			name:     "no rule routing to the primary service",
+			manifest: testHTTPRoute,
+			cfg: &kubeconfig.GatewayAPITrafficRouting{
+				PrimaryService: "unknown",
+			},
+			canaryPercent: 50,
+			wantErr:       true,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			manifests, err := provider.ParseManifests(tc.manifest)
+			require.NoError(t, err)
+			require.Len(t, manifests, 1)
+
+			got, err := generateHTTPRouteManifest(appCfg, manifests[0], tc.cfg, tc.canaryPercent, tc.baselinePercent)
+			if tc.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			expected, err := provider.ParseManifests(tc.expected)
+			require.NoError(t, err)
+			require.Len(t, expected, 1)
+
+			wantSpec, _, err := expected[0].NestedMap("spec")
+			require.NoError(t, err)
+			gotSpec, _, err := got.NestedMap("spec")
+			require.NoError(t, err)
+			assert.Equal(t, wantSpec, gotSpec)
+		})
+	}
+}
+
+func TestSplitBackendWeights(t *testing.T) {
+	t.Parallel()
+
+	backends := variantBackends{
+		primary:  "helloworld",
+		canary:   "helloworld-canary",
+		baseline: "helloworld-baseline",
+	}
+	testcases := []struct {
+		name            string
+		refs            []any
+		canaryPercent   int64
+		baselinePercent int64
+		expected        []any
+		expectedOK      bool
+	}{
+		{
+			name: "unweighted backends",
+			refs: []any{
+				map[string]any{"name": "helloworld"},
+				map[string]any{"name": "other"},
+			},
+			canaryPercent: 10,
+			expected: []any{
+				map[string]any{"name": "helloworld", "weight": int64(90)},
+				map[string]any{"name": "helloworld-canary", "weight": int64(10)},
+				map[string]any{"name": "other", "weight": int64(100)},
+			},
+			expectedOK: true,
+		},
+		{
+			name: "weights not summing up to 100",
+			refs: []any{
+				map[string]any{"name": "helloworld", "weight": int64(3)},
+				map[string]any{"name": "other", "weight": int64(7)},
+			},
+			canaryPercent:   30,
+			baselinePercent: 20,
+			expected: []any{
+				map[string]any{"name": "helloworld", "weight": int64(150)},
+				map[string]any{"name": "helloworld-canary", "weight": int64(90)},
+				map[string]any{"name": "helloworld-baseline", "weight": int64(60)},
+				map[string]any{"name": "other", "weight": int64(700)},
+			},
+			expectedOK: true,
+		},
+		{
+			name: "backends updated by the previous stage",
+			refs: []any{
+				map[string]any{"name": "helloworld", "weight": int64(150)},
+				map[string]any{"name": "helloworld-canary", "weight": int64(90)},
+				map[string]any{"name": "helloworld-baseline", "weight": int64(60)},
+				map[string]any{"name": "other", "weight": int64(700)},
+			},
+			expected: []any{
+				map[string]any{"name": "helloworld", "weight": int64(300)},
+				map[string]any{"name": "other", "weight": int64(700)},
+			},
+			expectedOK: true,
+		},
+		{
+			name: "no primary backend",
+			refs: []any{
+				map[string]any{"name": "other"},
+			},
+			canaryPercent: 10,
+			expected: []any{
+				map[string]any{"name": "other"},
+			},
+			expectedOK: false,
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, ok := splitBackendWeights(tc.refs, "name", backends, tc.canaryPercent, tc.baselinePercent)
+			assert.Equal(t, tc.expectedOK, ok)
+			assert.Equal(t, tc.expected, got)
+		})
+	}
+}
+
+func TestGenerateTrafficSplitManifest(t *testing.T) {
+	t.Parallel()
+
+	appCfg := &kubeconfig.KubernetesApplicationSpec{
+		Service: kubeconfig.K8sResourceReference{
+			Name: "helloworld",
+		},
+		VariantLabel: kubeconfig.KubernetesVariantLabel{
+			Key:           "pipecd.dev/variant",
+			PrimaryValue:  "primary",
+			CanaryValue:   "canary",
+			BaselineValue: "baseline",
+		},
+	}
+
+	testcases := []struct {
+		name            string
+		manifest        string
+		cfg             *kubeconfig.SMITrafficRouting
+		canaryPercent   int
+		baselinePercent int
+		expected        string
+		wantErr         bool
+	}{
+		{
+			name: "split traffic among variants",
+			manifest: `
+apiVersion: split.smi-spec.io/v1alpha2
+kind: TrafficSplit
+metadata:
+  name: helloworld
+spec:
+  service: helloworld
+  backends:
+  - service: helloworld-primary
+    weight: 100
+`,
+			cfg:             &kubeconfig.SMITrafficRouting{},
+			canaryPercent:   30,
+			baselinePercent: 20,
+			expected: `
+apiVersion: split.smi-spec.io/v1alpha2
+kind: TrafficSplit
+metadata:
+  name: helloworld
+spec:
+  service: helloworld
+  backends:
+  - service: helloworld-primary
+    weight: 50
+  - service: helloworld-canary
+    weight: 30
+  - service: helloworld-baseline
+    weight: 20
+`,
+		},
+		{
+			name: "remove the variants receiving no traffic",
+			manifest: `
+apiVersion: split.smi-spec.io/v1alpha2
+kind: TrafficSplit
+metadata:
+  name: helloworld
+spec:
+  service: helloworld
+  backends:
+  - service: helloworld-primary
+    weight: 50
+  - service: helloworld-canary
+    weight: 50
+`,
+			cfg:           &kubeconfig.SMITrafficRouting{},
+			canaryPercent: 0,
+			expected: `
+apiVersion: split.smi-spec.io/v1alpha2
+kind: TrafficSplit
+metadata:
+  name: helloworld
+spec:
+  service: helloworld
+  backends:
+  - service: helloworld-primary
+    weight: 100
+`,
+		},
+		{
+			name: "no backend of the primary service",
+			manifest: `
+apiVersion: split.smi-spec.io/v1alpha2
+kind: TrafficSplit
+metadata:
+  name: helloworld
+spec:
+  service: helloworld
+  backends:
+  - service: helloworld-primary
+    weight: 100
+`,
+			cfg: &kubeconfig.SMITrafficRouting{
+				PrimaryService: "unknown",
+			},
+			canaryPercent: 50,
+			wantErr:       true,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			manifests, err := provider.ParseManifests(tc.manifest)
+			require.NoError(t, err)
+			require.Len(t, manifests, 1)
+
+			got, err := generateTrafficSplitManifest(appCfg, manifests[0], tc.cfg, tc.canaryPercent, tc.baselinePercent)
+			if tc.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			expected, err := provider.ParseManifests(tc.expected)
+			require.NoError(t, err)
+			require.Len(t, expected, 1)
+
+			wantSpec, _, err := expected[0].NestedMap("spec")
+			require.NoError(t, err)
+			gotSpec, _, err := got.NestedMap("spec")
+			require.NoError(t, err)
+			assert.Equal(t, wantSpec, gotSpec)
+		})
+	}
+}
+
+func TestGenerateNGINXCanaryIngressManifest(t *testing.T) {
+	t.Parallel()
+
+	appCfg := &kubeconfig.KubernetesApplicationSpec{
+		Service: kubeconfig.K8sResourceReference{
+			Name: "helloworld",
+		},
+		VariantLabel: kubeconfig.KubernetesVariantLabel{
+			Key:           "pipecd.dev/variant",
+			PrimaryValue:  "primary",
+			CanaryValue:   "canary",
+			BaselineValue: "baseline",
+		},
+	}
+
+	manifests, err := provider.ParseManifests(`
+apiVersion: networking.k8s.io/v1
+kind: Ingress
+metadata:
+  name: helloworld
+  annotations:
+    kubernetes.io/ingress.class: nginx
+spec:
+  rules:
+  - host: helloworld.example.com
+    http:
+      paths:
+      - path: /
+        pathType: Prefix
+        backend:
+          service:
+            name: helloworld
+            port:
+              number: 9085
+      - path: /others
+        pathType: Prefix
+        backend:
+          service:
+            name: another-service
+            port:
+              number: 8080
+`)
+	require.NoError(t, err)
+	require.Len(t, manifests, 1)
+
+	testcases := []struct {
+		name     string
+		cfg      *kubeconfig.NGINXTrafficRouting
+		expected string
+		wantErr  bool
+	}{
+		{
+			name: "route traffic to the canary service",
+			cfg:  &kubeconfig.NGINXTrafficRouting{},
+			expected: `
+apiVersion: networking.k8s.io/v1
+kind: Ingress
+metadata:
+  name: helloworld-canary
+  annotations:
+    kubernetes.io/ingress.class: nginx
+    nginx.ingress.kubernetes.io/canary: "true"
+    nginx.ingress.kubernetes.io/canary-weight: "30"
+spec:
+  rules:
+  - host: helloworld.example.com
+    http:
+      paths:
+      - path: /
+        pathType: Prefix
+        backend:
+          service:
+            name: helloworld-canary
+            port:
+              number: 9085
+      - path: /others
+        pathType: Prefix
+        backend:
+          service:
+            name: another-service
+            port:
+              number: 8080
+`,
+		},
+		{
+			name: "no backend of the primary service",
+			cfg: &kubeconfig.NGINXTrafficRouting{
+				PrimaryService: "unknown",
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := generateNGINXCanaryIngressManifest(appCfg, manifests[0], tc.cfg, 30)
+			if tc.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			expected, err := provider.ParseManifests(tc.expected)
+			require.NoError(t, err)
+			require.Len(t, expected, 1)
+
+			assert.Equal(t, expected[0].Key(), got.Key())
+			assert.Equal(t, expected[0].GetAnnotations(), got.GetAnnotations())
+
+			wantSpec, _, err := expected[0].NestedMap("spec")
+			require.NoError(t, err)
+			gotSpec, _, err := got.NestedMap("spec")
+			require.NoError(t, err)
+			assert.Equal(t, wantSpec, gotSpec)
+		})
+	}
+}
+
+func TestFindTrafficRoutingManifests(t *testing.T) {
+	t.Parallel()
+
+	manifests, err := provider.ParseManifests(`
+apiVersion: v1
+kind: Service
+metadata:
+  name: helloworld
+---
+apiVersion: gateway.networking.k8s.io/v1
+kind: HTTPRoute
+metadata:
+  name: first
+---
+apiVersion: gateway.networking.k8s.io/v1
+kind: HTTPRoute
+metadata:
+  name: second
+---
+apiVersion: split.smi-spec.io/v1alpha2
+kind: TrafficSplit
+metadata:
+  name: helloworld
+---
+apiVersion: networking.k8s.io/v1
+kind: Ingress
+metadata:
+  name: helloworld
+`)
+	require.NoError(t, err)
+
+	testcases := []struct {
+		name     string
+		cfg      *kubeconfig.KubernetesTrafficRouting
+		expected []string
+		wantErr  bool
+	}{
+		{
+			name:     "pod selector",
+			cfg:      nil,
+			expected: []string{"helloworld"},
+		},
+		{
+			name: "all HTTPRoutes",
+			cfg: &kubeconfig.KubernetesTrafficRouting{
+				Method: kubeconfig.KubernetesTrafficRoutingMethodGatewayAPI,
+			},
+			expected: []string{"first", "second"},
+		},
+		{
+			name: "specified HTTPRoute",
+			cfg: &kubeconfig.KubernetesTrafficRouting{
+				Method: kubeconfig.KubernetesTrafficRoutingMethodGatewayAPI,
+				GatewayAPI: &kubeconfig.GatewayAPITrafficRouting{
+					HTTPRoute: kubeconfig.K8sResourceReference{Name: "second"},
+				},
+			},
+			expected: []string{"second"},
+		},
+		{
+			name: "wrong kind of HTTPRoute reference",
+			cfg: &kubeconfig.KubernetesTrafficRouting{
+				Method: kubeconfig.KubernetesTrafficRoutingMethodGatewayAPI,
+				GatewayAPI: &kubeconfig.GatewayAPITrafficRouting{
+					HTTPRoute: kubeconfig.K8sResourceReference{Kind: "GRPCRoute"},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "TrafficSplit",
+			cfg: &kubeconfig.KubernetesTrafficRouting{
+				Method: kubeconfig.KubernetesTrafficRoutingMethodSMI,
+			},
+			expected: []string{"helloworld"},
+		},
+		{
+			name: "Ingress",
+			cfg: &kubeconfig.KubernetesTrafficRouting{
+				Method: kubeconfig.KubernetesTrafficRoutingMethodNGINX,
+			},
+			expected: []string{"helloworld"},
+		},
+		{
+			name: "wrong kind of Ingress reference",
+			cfg: &kubeconfig.KubernetesTrafficRouting{
+				Method: kubeconfig.KubernetesTrafficRoutingMethodNGINX,
+				NGINX: &kubeconfig.NGINXTrafficRouting{
+					Ingress: kubeconfig.K8sResourceReference{Kind: "Service"},
+				},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := findTrafficRoutingManifests(manifests, "helloworld", tc.cfg)
+			if tc.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			names := make([]string, 0, len(got))
+			for _, m := range got {
+				names = append(names, m.Name())
+			}
+			assert.Equal(t, tc.expected, names)
+		})
+	}
+}
@@ -32,7 +32,7 @@ import (
 type Plugin struct{}
 
 // GetLivestate implements sdk.LivestatePlugin.
-func (p Plugin) GetLivestate(ctx context.Context, _ *sdk.ConfigNone, deployTargets []*sdk.DeployTarget[kubeconfig.KubernetesDeployTargetConfig], input *sdk.GetLivestateInput[kubeconfig.KubernetesApplicationSpec]) (*sdk.GetLivestateResponse, error) {
+func (p Plugin) GetLivestate(ctx context.Context, pluginConfig *kubeconfig.KubernetesPluginConfig, deployTargets []*sdk.DeployTarget[kubeconfig.KubernetesDeployTargetConfig], input *sdk.GetLivestateInput[kubeconfig.KubernetesApplicationSpec]) (*sdk.GetLivestateResponse, error) {
 	if len(deployTargets) != 1 {
 		return nil, fmt.Errorf("only 1 deploy target is allowed but got %d", len(deployTargets))
 	}
@@ -70,10 +70,10 @@ func (p Plugin) GetLivestate(ctx context.Context, _ *sdk.ConfigNone, deployTarge
 
 	resourceStates := make([]sdk.ResourceState, 0, len(namespacedLiveResources)+len(clusterScopedLiveResources))
 	for _, m := range namespacedLiveResources {
-		resourceStates = append(resourceStates, m.ToResourceState(deployTarget.Name))
+		resourceStates = append(resourceStates, m.ToResourceState(deployTarget.Name, pluginConfig.HealthRules))
 	}
 	for _, m := range clusterScopedLiveResources {
-		resourceStates = append(resourceStates, m.ToResourceState(deployTarget.Name))
+		resourceStates = append(resourceStates, m.ToResourceState(deployTarget.Name, pluginConfig.HealthRules))
 	}
 
 	manifests, err := p.loadManifests(ctx, input, cfg.Spec, provider.NewLoader(toolRegistry))
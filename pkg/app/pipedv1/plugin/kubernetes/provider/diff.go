@@ -36,6 +36,9 @@ func Diff(old, new Manifest, logger *zap.Logger, opts ...diff.Option) (*diff.Res
 		}
 	}
 
+	// Ignore the fields which are managed by other controllers in the live manifest.
+	old.body, new.body = removeFieldsManagedByOthers(old.body, new.body)
+
 	key := old.Key().String()
 
 	normalizedOld, err := remarshal(old.body)
@@ -15,25 +15,221 @@
 package provider
 
 import (
+	"bytes"
 	"fmt"
+	"strings"
+	"sync"
 
+	"github.com/google/cel-go/cel"
 	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	"k8s.io/client-go/util/jsonpath"
 
+	"github.com/pipe-cd/pipecd/pkg/app/pipedv1/plugin/kubernetes/config"
 	sdk "github.com/pipe-cd/piped-plugin-sdk-go"
 )
 
-func (m Manifest) calculateHealthStatus() (sdk.ResourceHealthStatus, string) {
-	switch {
-	case m.IsDeployment():
+func (m Manifest) calculateHealthStatus(rules []config.K8sHealthRule) (sdk.ResourceHealthStatus, string) {
+	gvk := m.body.GroupVersionKind()
+	for _, r := range rules {
+		if r.Group == gvk.Group && r.Kind == gvk.Kind {
+			return m.evaluateHealthRule(r)
+		}
+	}
+
+	if !isBuiltinAPIGroup(gvk.Group) {
+		return sdk.ResourceHealthStateUnknown, fmt.Sprintf("%q was applied successfully but its health status couldn't be determined exactly. (Because no health rule is configured for this kind of resource.)", gvk)
+	}
+
+	switch m.body.GetKind() {
+	case KindDeployment:
 		obj := &appsv1.Deployment{}
 		if err := m.ConvertToStructuredObject(obj); err != nil {
-			return sdk.ResourceHealthStateUnknown, ""
+			return conversionErrorHealthStatus(obj, err)
 		}
 		return deploymentHealthStatus(obj)
+	case KindStatefulSet:
+		obj := &appsv1.StatefulSet{}
+		if err := m.ConvertToStructuredObject(obj); err != nil {
+			return conversionErrorHealthStatus(obj, err)
+		}
+		return statefulSetHealthStatus(obj)
+	case KindDaemonSet:
+		obj := &appsv1.DaemonSet{}
+		if err := m.ConvertToStructuredObject(obj); err != nil {
+			return conversionErrorHealthStatus(obj, err)
+		}
+		return daemonSetHealthStatus(obj)
+	case KindReplicaSet:
+		obj := &appsv1.ReplicaSet{}
+		if err := m.ConvertToStructuredObject(obj); err != nil {
+			return conversionErrorHealthStatus(obj, err)
+		}
+		return replicaSetHealthStatus(obj)
+	case KindJob:
+		obj := &batchv1.Job{}
+		if err := m.ConvertToStructuredObject(obj); err != nil {
+			return conversionErrorHealthStatus(obj, err)
+		}
+		return jobHealthStatus(obj)
+	case KindPod:
+		obj := &corev1.Pod{}
+		if err := m.ConvertToStructuredObject(obj); err != nil {
+			return conversionErrorHealthStatus(obj, err)
+		}
+		return podHealthStatus(obj)
+	case KindService:
+		obj := &corev1.Service{}
+		if err := m.ConvertToStructuredObject(obj); err != nil {
+			return conversionErrorHealthStatus(obj, err)
+		}
+		return serviceHealthStatus(obj)
+	case KindIngress:
+		obj := &networkingv1.Ingress{}
+		if err := m.ConvertToStructuredObject(obj); err != nil {
+			return conversionErrorHealthStatus(obj, err)
+		}
+		return ingressHealthStatus(obj)
+	case KindPersistentVolume:
+		obj := &corev1.PersistentVolume{}
+		if err := m.ConvertToStructuredObject(obj); err != nil {
+			return conversionErrorHealthStatus(obj, err)
+		}
+		return persistentVolumeHealthStatus(obj)
+	case KindPersistentVolumeClaim:
+		obj := &corev1.PersistentVolumeClaim{}
+		if err := m.ConvertToStructuredObject(obj); err != nil {
+			return conversionErrorHealthStatus(obj, err)
+		}
+		return persistentVolumeClaimHealthStatus(obj)
+	case KindNamespace:
+		obj := &corev1.Namespace{}
+		if err := m.ConvertToStructuredObject(obj); err != nil {
+			return conversionErrorHealthStatus(obj, err)
+		}
+		return namespaceHealthStatus(obj)
+	case KindConfigMap, KindSecret, KindServiceAccount, KindCronJob, KindPodDisruptionBudget,
+		KindRole, KindRoleBinding, KindClusterRole, KindClusterRoleBinding:
+		// These resources have no status to be checked, so they are healthy once applied.
+		return sdk.ResourceHealthStateHealthy, fmt.Sprintf("%q was applied successfully", m.body.GetName())
 	default:
-		// TODO: Implement health status calculation for other resource types.
-		return sdk.ResourceHealthStateUnknown, fmt.Sprintf("Unimplemented or unknown resource: %s", m.body.GroupVersionKind())
+		return sdk.ResourceHealthStateUnknown, fmt.Sprintf("Unimplemented or unknown resource: %s", gvk)
+	}
+}
+
+func conversionErrorHealthStatus(obj any, err error) (sdk.ResourceHealthStatus, string) {
+	return sdk.ResourceHealthStateUnknown, fmt.Sprintf("Unexpected error while calculating: unable to convert to %T: %v", obj, err)
+}
+
+// evaluateHealthRule evaluates the health status of the manifest by using the given rule.
+// The resource is unhealthy if any of the unhealthy conditions is satisfied,
+// and is healthy if all of the healthy conditions are satisfied.
+// Otherwise, the health status is unknown because the resource is probably still in progress.
+func (m Manifest) evaluateHealthRule(rule config.K8sHealthRule) (sdk.ResourceHealthStatus, string) {
+	message, err := m.renderJSONPath(rule.Message)
+	if err != nil {
+		return sdk.ResourceHealthStateUnknown, fmt.Sprintf("Failed to render the health message: %v", err)
+	}
+
+	for _, c := range rule.Unhealthy {
+		ok, err := m.satisfyHealthCondition(c)
+		if err != nil {
+			return sdk.ResourceHealthStateUnknown, fmt.Sprintf("Failed to evaluate the unhealthy condition %s: %v", c, err)
+		}
+		if ok {
+			if message == "" {
+				message = unhealthyConditionMessage(c)
+			}
+			return sdk.ResourceHealthStateUnhealthy, message
+		}
 	}
+
+	for _, c := range rule.Healthy {
+		ok, err := m.satisfyHealthCondition(c)
+		if err != nil {
+			return sdk.ResourceHealthStateUnknown, fmt.Sprintf("Failed to evaluate the healthy condition %s: %v", c, err)
+		}
+		if !ok {
+			if message == "" {
+				message = healthyConditionMessage(c)
+			}
+			return sdk.ResourceHealthStateUnknown, message
+		}
+	}
+	return sdk.ResourceHealthStateHealthy, message
+}
+
+func unhealthyConditionMessage(c config.K8sHealthCondition) string {
+	if c.CEL != "" {
+		return fmt.Sprintf("The condition %s is satisfied", c.CEL)
+	}
+	return fmt.Sprintf("The value of %s is %q", c.JSONPath, c.Value)
+}
+
+func healthyConditionMessage(c config.K8sHealthCondition) string {
+	if c.CEL != "" {
+		return fmt.Sprintf("Waiting for the condition %s to be satisfied", c.CEL)
+	}
+	return fmt.Sprintf("Waiting for the value of %s to be %q", c.JSONPath, c.Value)
+}
+
+func (m Manifest) satisfyHealthCondition(c config.K8sHealthCondition) (bool, error) {
+	if c.CEL != "" {
+		return m.evaluateCEL(c.CEL)
+	}
+	v, err := m.renderJSONPath(c.JSONPath)
+	if err != nil {
+		return false, err
+	}
+	return v == c.Value, nil
+}
+
+// celPrograms caches the compiled programs of the CEL health conditions
+// because the health status of the resources is evaluated repeatedly.
+var celPrograms sync.Map
+
+// evaluateCEL evaluates the given CEL expression with the manifest.
+func (m Manifest) evaluateCEL(expr string) (bool, error) {
+	var prg cel.Program
+	if v, ok := celPrograms.Load(expr); ok {
+		prg = v.(cel.Program)
+	} else {
+		p, err := config.CompileCELHealthCondition(expr)
+		if err != nil {
+			return false, err
+		}
+		celPrograms.Store(expr, p)
+		prg = p
+	}
+
+	out, _, err := prg.Eval(map[string]any{"object": m.body.Object})
+	if err != nil {
+		return false, err
+	}
+	v, ok := out.Value().(bool)
+	if !ok {
+		return false, fmt.Errorf("cel %q returned %v which is not bool", expr, out.Value())
+	}
+	return v, nil
+}
+
+// renderJSONPath renders the given JSONPath template with the manifest.
+// Missing fields are rendered as empty.
+func (m Manifest) renderJSONPath(tmpl string) (string, error) {
+	if tmpl == "" {
+		return "", nil
+	}
+	jp := jsonpath.New("").AllowMissingKeys(true)
+	if err := jp.Parse(tmpl); err != nil {
+		return "", err
+	}
+	var buf bytes.Buffer
+	if err := jp.Execute(&buf, m.body.Object); err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(buf.String()), nil
 }
 
 func deploymentHealthStatus(obj *appsv1.Deployment) (sdk.ResourceHealthStatus, string) {
@@ -66,3 +262,188 @@ func deploymentHealthStatus(obj *appsv1.Deployment) (sdk.ResourceHealthStatus, s
 	}
 	return sdk.ResourceHealthStateHealthy, ""
 }
+
+func statefulSetHealthStatus(obj *appsv1.StatefulSet) (sdk.ResourceHealthStatus, string) {
+	// Referred to:
+	//   https://github.com/kubernetes/kubernetes/blob/7942dca975b7be9386540df3c17e309c3cb2de60/staging/src/k8s.io/kubectl/pkg/polymorphichelpers/rollout_status.go#L130-L149
+	if obj.Status.ObservedGeneration == 0 || obj.Generation > obj.Status.ObservedGeneration {
+		return sdk.ResourceHealthStateUnknown, "Waiting for statefulset spec update to be observed"
+	}
+	if obj.Spec.Replicas == nil {
+		return sdk.ResourceHealthStateUnknown, "The number of desired replicas is unspecified"
+	}
+	if *obj.Spec.Replicas != obj.Status.ReadyReplicas {
+		return sdk.ResourceHealthStateUnhealthy, fmt.Sprintf("The number of ready replicas (%d) is different from the desired number (%d)", obj.Status.ReadyReplicas, *obj.Spec.Replicas)
+	}
+
+	// Check if the partitioned roll out is in progress.
+	if obj.Spec.UpdateStrategy.Type == appsv1.RollingUpdateStatefulSetStrategyType && obj.Spec.UpdateStrategy.RollingUpdate != nil {
+		if partition := obj.Spec.UpdateStrategy.RollingUpdate.Partition; partition != nil {
+			if obj.Status.UpdatedReplicas < *obj.Spec.Replicas-*partition {
+				return sdk.ResourceHealthStateUnhealthy, fmt.Sprintf("Waiting for partitioned roll out to finish because %d out of %d new pods have been updated", obj.Status.UpdatedReplicas, *obj.Spec.Replicas-*partition)
+			}
+		}
+		return sdk.ResourceHealthStateHealthy, ""
+	}
+
+	if obj.Status.UpdateRevision != obj.Status.CurrentRevision {
+		return sdk.ResourceHealthStateUnhealthy, fmt.Sprintf("Waiting for statefulset rolling update to complete %d pods at revision %s", obj.Status.UpdatedReplicas, obj.Status.UpdateRevision)
+	}
+	return sdk.ResourceHealthStateHealthy, ""
+}
+
+func daemonSetHealthStatus(obj *appsv1.DaemonSet) (sdk.ResourceHealthStatus, string) {
+	// Referred to:
+	//   https://github.com/kubernetes/kubernetes/blob/7942dca975b7be9386540df3c17e309c3cb2de60/staging/src/k8s.io/kubectl/pkg/polymorphichelpers/rollout_status.go#L107-L115
+	if obj.Status.ObservedGeneration == 0 || obj.Generation > obj.Status.ObservedGeneration {
+		return sdk.ResourceHealthStateUnknown, "Waiting for rollout to finish because observed daemon set generation less than desired generation"
+	}
+	if obj.Status.UpdatedNumberScheduled < obj.Status.DesiredNumberScheduled {
+		return sdk.ResourceHealthStateUnhealthy, fmt.Sprintf("Waiting for daemon set %q rollout to finish because %d out of %d new pods have been updated", obj.Name, obj.Status.UpdatedNumberScheduled, obj.Status.DesiredNumberScheduled)
+	}
+	if obj.Status.NumberAvailable < obj.Status.DesiredNumberScheduled {
+		return sdk.ResourceHealthStateUnhealthy, fmt.Sprintf("Waiting for daemon set %q rollout to finish because %d of %d updated pods are available", obj.Name, obj.Status.NumberAvailable, obj.Status.DesiredNumberScheduled)
+	}
+	if obj.Status.NumberMisscheduled > 0 {
+		return sdk.ResourceHealthStateUnhealthy, fmt.Sprintf("%d nodes that are running the daemon pod, but are not supposed to run the daemon pod", obj.Status.NumberMisscheduled)
+	}
+	if obj.Status.NumberUnavailable > 0 {
+		return sdk.ResourceHealthStateUnhealthy, fmt.Sprintf("%d nodes that should be running the daemon pod and have none of the daemon pod running and available", obj.Status.NumberUnavailable)
+	}
+	return sdk.ResourceHealthStateHealthy, ""
+}
+
+func replicaSetHealthStatus(obj *appsv1.ReplicaSet) (sdk.ResourceHealthStatus, string) {
+	if obj.Status.ObservedGeneration == 0 || obj.Generation > obj.Status.ObservedGeneration {
+		return sdk.ResourceHealthStateUnknown, "Waiting for rollout to finish because observed replica set generation less than desired generation"
+	}
+	for _, cond := range obj.Status.Conditions {
+		if cond.Type == appsv1.ReplicaSetReplicaFailure && cond.Status == corev1.ConditionTrue {
+			return sdk.ResourceHealthStateUnhealthy, cond.Message
+		}
+	}
+	if obj.Spec.Replicas == nil {
+		return sdk.ResourceHealthStateUnknown, "The number of desired replicas is unspecified"
+	}
+	if obj.Status.AvailableReplicas < *obj.Spec.Replicas {
+		return sdk.ResourceHealthStateUnhealthy, fmt.Sprintf("Waiting for rollout to finish because only %d/%d replicas are available", obj.Status.AvailableReplicas, *obj.Spec.Replicas)
+	}
+	if *obj.Spec.Replicas != obj.Status.ReadyReplicas {
+		return sdk.ResourceHealthStateUnhealthy, fmt.Sprintf("The number of ready replicas (%d) is different from the desired number (%d)", obj.Status.ReadyReplicas, *obj.Spec.Replicas)
+	}
+	return sdk.ResourceHealthStateHealthy, ""
+}
+
+func jobHealthStatus(obj *batchv1.Job) (sdk.ResourceHealthStatus, string) {
+	var (
+		completed bool
+		message   string
+	)
+	for _, cond := range obj.Status.Conditions {
+		if cond.Status != corev1.ConditionTrue {
+			continue
+		}
+		switch cond.Type {
+		case batchv1.JobFailed:
+			return sdk.ResourceHealthStateUnhealthy, cond.Message
+		case batchv1.JobComplete:
+			completed = true
+			message = cond.Message
+		}
+	}
+	if !completed {
+		return sdk.ResourceHealthStateHealthy, "Job is in progress"
+	}
+	return sdk.ResourceHealthStateHealthy, message
+}
+
+func podHealthStatus(obj *corev1.Pod) (sdk.ResourceHealthStatus, string) {
+	// Determine based on its container statuses.
+	if obj.Spec.RestartPolicy == corev1.RestartPolicyAlways {
+		var messages []string
+		for _, s := range obj.Status.ContainerStatuses {
+			waiting := s.State.Waiting
+			if waiting == nil {
+				continue
+			}
+			if strings.HasPrefix(waiting.Reason, "Err") || strings.HasSuffix(waiting.Reason, "Error") || strings.HasSuffix(waiting.Reason, "BackOff") {
+				messages = append(messages, waiting.Message)
+			}
+		}
+		if len(messages) > 0 {
+			return sdk.ResourceHealthStateUnhealthy, strings.Join(messages, ", ")
+		}
+	}
+
+	// Determine based on its phase.
+	switch obj.Status.Phase {
+	case corev1.PodRunning, corev1.PodSucceeded:
+		return sdk.ResourceHealthStateHealthy, obj.Status.Message
+	case corev1.PodPending:
+		return sdk.ResourceHealthStateUnknown, obj.Status.Message
+	default:
+		return sdk.ResourceHealthStateUnhealthy, obj.Status.Message
+	}
+}
+
+func serviceHealthStatus(obj *corev1.Service) (sdk.ResourceHealthStatus, string) {
+	if obj.Spec.Type != corev1.ServiceTypeLoadBalancer {
+		return sdk.ResourceHealthStateHealthy, ""
+	}
+	if len(obj.Status.LoadBalancer.Ingress) == 0 {
+		return sdk.ResourceHealthStateUnknown, "Ingress points for the load-balancer are in progress"
+	}
+	return sdk.ResourceHealthStateHealthy, ""
+}
+
+func ingressHealthStatus(obj *networkingv1.Ingress) (sdk.ResourceHealthStatus, string) {
+	if len(obj.Status.LoadBalancer.Ingress) == 0 {
+		return sdk.ResourceHealthStateUnknown, "Ingress points for the load-balancer are in progress"
+	}
+	return sdk.ResourceHealthStateHealthy, ""
+}
+
+func persistentVolumeHealthStatus(obj *corev1.PersistentVolume) (sdk.ResourceHealthStatus, string) {
+	switch obj.Status.Phase {
+	case corev1.VolumeBound, corev1.VolumeAvailable:
+		return sdk.ResourceHealthStateHealthy, obj.Status.Message
+	case corev1.VolumePending:
+		return sdk.ResourceHealthStateUnknown, obj.Status.Message
+	default:
+		return sdk.ResourceHealthStateUnhealthy, obj.Status.Message
+	}
+}
+
+func persistentVolumeClaimHealthStatus(obj *corev1.PersistentVolumeClaim) (sdk.ResourceHealthStatus, string) {
+	switch obj.Status.Phase {
+	case corev1.ClaimBound:
+		return sdk.ResourceHealthStateHealthy, ""
+	case corev1.ClaimPending:
+		return sdk.ResourceHealthStateUnknown, "Being not yet bound"
+	case corev1.ClaimLost:
+		return sdk.ResourceHealthStateUnhealthy, "Lost its underlying PersistentVolume"
+	default:
+		return sdk.ResourceHealthStateUnknown, "The current phase of PersistentVolumeClaim is unexpected"
+	}
+}
+
+func namespaceHealthStatus(obj *corev1.Namespace) (sdk.ResourceHealthStatus, string) {
+	switch obj.Status.Phase {
+	case corev1.NamespaceActive:
+		// Go to determine based on the status' conditions.
+	case corev1.NamespaceTerminating:
+		return sdk.ResourceHealthStateUnknown, "Namespace is gracefully terminated"
+	default:
+		return sdk.ResourceHealthStateUnknown, fmt.Sprintf("The Namespace is at an unexpected phase: %s", obj.Status.Phase)
+	}
+
+	for _, cond := range obj.Status.Conditions {
+		switch cond.Type {
+		case corev1.NamespaceDeletionDiscoveryFailure, corev1.NamespaceDeletionContentFailure, corev1.NamespaceDeletionGVParsingFailure:
+			if cond.Status == corev1.ConditionTrue {
+				return sdk.ResourceHealthStateUnhealthy, cond.Message
+			}
+		}
+	}
+	return sdk.ResourceHealthStateHealthy, ""
+}
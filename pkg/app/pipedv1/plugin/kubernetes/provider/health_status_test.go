@@ -22,6 +22,7 @@ import (
 	appsv1 "k8s.io/api/apps/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
+	"github.com/pipe-cd/pipecd/pkg/app/pipedv1/plugin/kubernetes/config"
 	sdk "github.com/pipe-cd/piped-plugin-sdk-go"
 )
 
@@ -125,3 +126,394 @@ func TestDeploymentHealthStatus(t *testing.T) {
 		})
 	}
 }
+
+func TestManifest_calculateHealthStatus(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		manifest string
+		health   sdk.ResourceHealthStatus
+		msg      string
+	}{
+		{
+			name: "statefulset with ready replicas",
+			manifest: `
+apiVersion: apps/v1
+kind: StatefulSet
+metadata:
+  name: web
+  generation: 1
+spec:
+  replicas: 2
+status:
+  observedGeneration: 1
+  readyReplicas: 2
+  currentRevision: web-1
+  updateRevision: web-1
+`,
+			health: sdk.ResourceHealthStateHealthy,
+		},
+		{
+			name: "statefulset waiting for ready replicas",
+			manifest: `
+apiVersion: apps/v1
+kind: StatefulSet
+metadata:
+  name: web
+  generation: 1
+spec:
+  replicas: 2
+status:
+  observedGeneration: 1
+  readyReplicas: 1
+`,
+			health: sdk.ResourceHealthStateUnhealthy,
+			msg:    "The number of ready replicas (1) is different from the desired number (2)",
+		},
+		{
+			name: "daemonset not observed yet",
+			manifest: `
+apiVersion: apps/v1
+kind: DaemonSet
+metadata:
+  name: agent
+  generation: 2
+status:
+  observedGeneration: 1
+`,
+			health: sdk.ResourceHealthStateUnknown,
+			msg:    "Waiting for rollout to finish",
+		},
+		{
+			name: "replicaset with replica failure",
+			manifest: `
+apiVersion: apps/v1
+kind: ReplicaSet
+metadata:
+  name: web
+  generation: 1
+spec:
+  replicas: 1
+status:
+  observedGeneration: 1
+  conditions:
+  - type: ReplicaFailure
+    status: "True"
+    message: exceeded quota
+`,
+			health: sdk.ResourceHealthStateUnhealthy,
+			msg:    "exceeded quota",
+		},
+		{
+			name: "failed job",
+			manifest: `
+apiVersion: batch/v1
+kind: Job
+metadata:
+  name: migrate
+status:
+  conditions:
+  - type: Failed
+    status: "True"
+    message: backoff limit exceeded
+`,
+			health: sdk.ResourceHealthStateUnhealthy,
+			msg:    "backoff limit exceeded",
+		},
+		{
+			name: "pod in crash loop",
+			manifest: `
+apiVersion: v1
+kind: Pod
+metadata:
+  name: web
+spec:
+  restartPolicy: Always
+status:
+  phase: Running
+  containerStatuses:
+  - name: web
+    state:
+      waiting:
+        reason: CrashLoopBackOff
+        message: back-off restarting failed container
+`,
+			health: sdk.ResourceHealthStateUnhealthy,
+			msg:    "back-off restarting failed container",
+		},
+		{
+			name: "running pod",
+			manifest: `
+apiVersion: v1
+kind: Pod
+metadata:
+  name: web
+status:
+  phase: Running
+`,
+			health: sdk.ResourceHealthStateHealthy,
+		},
+		{
+			name: "load balancer service in progress",
+			manifest: `
+apiVersion: v1
+kind: Service
+metadata:
+  name: web
+spec:
+  type: LoadBalancer
+`,
+			health: sdk.ResourceHealthStateUnknown,
+			msg:    "Ingress points for the load-balancer are in progress",
+		},
+		{
+			name: "ingress with load balancer",
+			manifest: `
+apiVersion: networking.k8s.io/v1
+kind: Ingress
+metadata:
+  name: web
+status:
+  loadBalancer:
+    ingress:
+    - ip: 10.0.0.1
+`,
+			health: sdk.ResourceHealthStateHealthy,
+		},
+		{
+			name: "lost pvc",
+			manifest: `
+apiVersion: v1
+kind: PersistentVolumeClaim
+metadata:
+  name: data
+status:
+  phase: Lost
+`,
+			health: sdk.ResourceHealthStateUnhealthy,
+			msg:    "Lost its underlying PersistentVolume",
+		},
+		{
+			name: "role",
+			manifest: `
+apiVersion: rbac.authorization.k8s.io/v1
+kind: Role
+metadata:
+  name: reader
+`,
+			health: sdk.ResourceHealthStateHealthy,
+			msg:    "\"reader\" was applied successfully",
+		},
+		{
+			name: "custom resource without health rule",
+			manifest: `
+apiVersion: serving.knative.dev/v1
+kind: Service
+metadata:
+  name: web
+`,
+			health: sdk.ResourceHealthStateUnknown,
+			msg:    "no health rule is configured",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			manifests := mustParseManifests(t, tt.manifest)
+			require.Len(t, manifests, 1)
+			h, msg := manifests[0].calculateHealthStatus(nil)
+			assert.Equal(t, tt.health, h)
+			if tt.msg != "" {
+				assert.Contains(t, msg, tt.msg)
+			}
+		})
+	}
+}
+
+func TestManifest_calculateHealthStatusWithHealthRules(t *testing.T) {
+	t.Parallel()
+
+	rules := []config.K8sHealthRule{
+		{
+			Group: "argoproj.io",
+			Kind:  "Rollout",
+			Healthy: []config.K8sHealthCondition{
+				{JSONPath: "{.status.phase}", Value: "Healthy"},
+			},
+			Unhealthy: []config.K8sHealthCondition{
+				{JSONPath: "{.status.phase}", Value: "Degraded"},
+			},
+			Message: "{.status.message}",
+		},
+		{
+			Group: "cert-manager.io",
+			Kind:  "Certificate",
+			Healthy: []config.K8sHealthCondition{
+				{JSONPath: `{.status.conditions[?(@.type=="Ready")].status}`, Value: "True"},
+			},
+		},
+		{
+			Group: "example.com",
+			Kind:  "Database",
+			Healthy: []config.K8sHealthCondition{
+				{CEL: "has(object.status.readyReplicas) && object.status.readyReplicas >= object.spec.replicas"},
+			},
+			Unhealthy: []config.K8sHealthCondition{
+				{CEL: `has(object.status.phase) && object.status.phase != "Running" && object.status.restarts > 3`},
+			},
+		},
+	}
+
+	tests := []struct {
+		name     string
+		manifest string
+		health   sdk.ResourceHealthStatus
+		msg      string
+	}{
+		{
+			name: "healthy rollout",
+			manifest: `
+apiVersion: argoproj.io/v1alpha1
+kind: Rollout
+metadata:
+  name: web
+status:
+  phase: Healthy
+`,
+			health: sdk.ResourceHealthStateHealthy,
+		},
+		{
+			name: "degraded rollout",
+			manifest: `
+apiVersion: argoproj.io/v1alpha1
+kind: Rollout
+metadata:
+  name: web
+status:
+  phase: Degraded
+  message: ProgressDeadlineExceeded
+`,
+			health: sdk.ResourceHealthStateUnhealthy,
+			msg:    "ProgressDeadlineExceeded",
+		},
+		{
+			name: "progressing rollout",
+			manifest: `
+apiVersion: argoproj.io/v1alpha1
+kind: Rollout
+metadata:
+  name: web
+status:
+  phase: Progressing
+  message: more replicas need to be updated
+`,
+			health: sdk.ResourceHealthStateUnknown,
+			msg:    "more replicas need to be updated",
+		},
+		{
+			name: "ready certificate",
+			manifest: `
+apiVersion: cert-manager.io/v1
+kind: Certificate
+metadata:
+  name: tls
+status:
+  conditions:
+  - type: Issuing
+    status: "False"
+  - type: Ready
+    status: "True"
+`,
+			health: sdk.ResourceHealthStateHealthy,
+		},
+		{
+			name: "certificate without status",
+			manifest: `
+apiVersion: cert-manager.io/v1
+kind: Certificate
+metadata:
+  name: tls
+`,
+			health: sdk.ResourceHealthStateUnknown,
+			msg:    "Waiting for the value of",
+		},
+		{
+			name: "database with enough ready replicas",
+			manifest: `
+apiVersion: example.com/v1
+kind: Database
+metadata:
+  name: db
+spec:
+  replicas: 3
+status:
+  phase: Running
+  readyReplicas: 3
+`,
+			health: sdk.ResourceHealthStateHealthy,
+		},
+		{
+			name: "database restarting repeatedly",
+			manifest: `
+apiVersion: example.com/v1
+kind: Database
+metadata:
+  name: db
+spec:
+  replicas: 3
+status:
+  phase: Pending
+  readyReplicas: 1
+  restarts: 5
+`,
+			health: sdk.ResourceHealthStateUnhealthy,
+			msg:    "is satisfied",
+		},
+		{
+			name: "database waiting for ready replicas",
+			manifest: `
+apiVersion: example.com/v1
+kind: Database
+metadata:
+  name: db
+spec:
+  replicas: 3
+status:
+  phase: Running
+  readyReplicas: 1
+`,
+			health: sdk.ResourceHealthStateUnknown,
+			msg:    "Waiting for the condition",
+		},
+		{
+			name: "database with the field of unexpected type",
+			manifest: `
+apiVersion: example.com/v1
+kind: Database
+metadata:
+  name: db
+spec:
+  replicas: three
+status:
+  readyReplicas: 1
+`,
+			health: sdk.ResourceHealthStateUnknown,
+			msg:    "Failed to evaluate the healthy condition",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			manifests := mustParseManifests(t, tt.manifest)
+			require.Len(t, manifests, 1)
+			h, msg := manifests[0].calculateHealthStatus(rules)
+			assert.Equal(t, tt.health, h)
+			if tt.msg != "" {
+				assert.Contains(t, msg, tt.msg)
+			}
+		})
+	}
+}
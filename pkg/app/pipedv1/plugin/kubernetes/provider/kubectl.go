@@ -45,6 +45,22 @@ func NewKubectl(path string) *Kubectl {
 	}
 }
 
+// serverSideApplyArgs returns the kubectl apply flags configured via the annotations of the manifest.
+func serverSideApplyArgs(annotations map[string]string) []string {
+	args := make([]string, 0, 3)
+	serverSide := annotations[LabelServerSideApply] == UseServerSideApply
+	if serverSide {
+		args = append(args, "--server-side")
+	}
+	if manager := annotations[AnnotationFieldManager]; manager != "" {
+		args = append(args, "--field-manager", manager)
+	}
+	if serverSide && annotations[AnnotationForceConflicts] == ForceConflictsTrue {
+		args = append(args, "--force-conflicts")
+	}
+	return args
+}
+
 // Apply runs kubectl apply command with the given manifest.
 func (c *Kubectl) Apply(ctx context.Context, kubeconfig, namespace string, manifest Manifest) (err error) {
 	// TODO: record the metrics for the kubectl apply command.
@@ -63,9 +79,7 @@ func (c *Kubectl) Apply(ctx context.Context, kubeconfig, namespace string, manif
 	}
 
 	args = append(args, "apply")
-	if annotation := manifest.body.GetAnnotations()[LabelServerSideApply]; annotation == UseServerSideApply {
-		args = append(args, "--server-side")
-	}
+	args = append(args, serverSideApplyArgs(manifest.body.GetAnnotations())...)
 	args = append(args, "-f", "-")
 
 	cmd := exec.CommandContext(ctx, c.execPath, args...)
@@ -270,7 +284,7 @@ func (c *Kubectl) GetAllClusterScoped(ctx context.Context, kubeconfig string, se
 	if kubeconfig != "" {
 		args = append(args, "--kubeconfig", kubeconfig)
 	}
-	args = append(args, "get", strings.Join(resources, ","), "-o", "yaml", "--selector", strings.Join(selector, ","))
+	args = append(args, "get", strings.Join(resources, ","), "-o", "yaml", "--selector", strings.Join(selector, ","), "--show-managed-fields")
 	cmd := exec.CommandContext(ctx, c.execPath, args...)
 	out, err := cmd.CombinedOutput()
 	if err != nil {
@@ -300,7 +314,7 @@ func (c *Kubectl) GetAllClusterScoped(ctx context.Context, kubeconfig string, se
 // If no resources are found, it returns nil without an error.
 func (c *Kubectl) GetAll(ctx context.Context, kubeconfig, namespace string, selector ...string) (ms []Manifest, err error) {
 	args := make([]string, 0, 7)
-	args = append(args, "get", "all", "-o", "yaml", "--selector", strings.Join(selector, ","))
+	args = append(args, "get", "all", "-o", "yaml", "--selector", strings.Join(selector, ","), "--show-managed-fields")
 	if kubeconfig != "" {
 		args = append(args, "--kubeconfig", kubeconfig)
 	}
@@ -33,11 +33,14 @@ const (
 	LabelOriginalAPIVersion = "pipecd.dev/original-api-version"  // The api version defined in git configuration. e.g. apps/v1
 
 	// annotations
-	AnnotationOrder      = "pipecd.dev/order"       // The order number of resource used to sort them before using.
-	AnnotationConfigHash = "pipecd.dev/config-hash" // The hash value of all mouting config resources.
+	AnnotationOrder          = "pipecd.dev/order"           // The order number of resource used to sort them before using.
+	AnnotationConfigHash     = "pipecd.dev/config-hash"     // The hash value of all mouting config resources.
+	AnnotationFieldManager   = "pipecd.dev/field-manager"   // The field manager name used by server side apply.
+	AnnotationForceConflicts = "pipecd.dev/force-conflicts" // Whether to force the conflicts with other field managers on server side apply.
 
 	// label/annotation values
 	ManagedByPiped     = "piped"
 	UseReplaceEnabled  = "enabled"
 	UseServerSideApply = "true"
+	ForceConflictsTrue = "true"
 )
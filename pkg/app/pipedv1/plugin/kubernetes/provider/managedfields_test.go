@@ -0,0 +1,228 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRemoveFieldsManagedByOthers(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name            string
+		manifests       string
+		expectedLive    string
+		expectedDesired string
+	}{
+		{
+			name: "no managed fields",
+			manifests: `apiVersion: v1
+kind: Service
+metadata:
+  name: helloworld
+spec:
+  type: ClusterIP
+---
+apiVersion: v1
+kind: Service
+metadata:
+  name: helloworld
+spec:
+  type: NodePort
+`,
+			expectedLive: `apiVersion: v1
+kind: Service
+metadata:
+  name: helloworld
+spec:
+  type: ClusterIP
+`,
+			expectedDesired: `apiVersion: v1
+kind: Service
+metadata:
+  name: helloworld
+spec:
+  type: NodePort
+`,
+		},
+		{
+			name: "remove fields owned only by others",
+			manifests: `apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: helloworld
+  annotations:
+    pipecd.dev/field-manager: piped
+  managedFields:
+  - manager: piped
+    operation: Apply
+    apiVersion: apps/v1
+    fieldsType: FieldsV1
+    fieldsV1:
+      f:spec:
+        f:replicas: {}
+        f:template:
+          f:spec:
+            f:containers:
+              k:{"name":"helloworld"}:
+                .: {}
+                f:args: {}
+                f:image: {}
+                f:name: {}
+  - manager: hpa-controller
+    operation: Update
+    apiVersion: apps/v1
+    fieldsType: FieldsV1
+    fieldsV1:
+      f:spec:
+        f:replicas: {}
+  - manager: sidecar-injector
+    operation: Update
+    apiVersion: apps/v1
+    fieldsType: FieldsV1
+    fieldsV1:
+      f:spec:
+        f:template:
+          f:spec:
+            f:containers:
+              k:{"name":"helloworld"}:
+                f:args:
+                  v:"--injected": {}
+              k:{"name":"sidecar"}:
+                .: {}
+                f:image: {}
+                f:name: {}
+spec:
+  replicas: 5
+  template:
+    spec:
+      containers:
+      - name: helloworld
+        image: helloworld:v0.1.0
+        args:
+        - --port=9085
+        - --injected
+      - name: sidecar
+        image: sidecar:v0.1.0
+---
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: helloworld
+spec:
+  replicas: 2
+  template:
+    spec:
+      containers:
+      - name: helloworld
+        image: helloworld:v0.2.0
+        args:
+        - --port=9085
+`,
+			expectedLive: `apiVersion: apps/v1
+kind: Deployment
+metadata:
+  annotations:
+    pipecd.dev/field-manager: piped
+  managedFields:
+  - apiVersion: apps/v1
+    fieldsType: FieldsV1
+    fieldsV1:
+      f:spec:
+        f:replicas: {}
+        f:template:
+          f:spec:
+            f:containers:
+              k:{"name":"helloworld"}:
+                .: {}
+                f:args: {}
+                f:image: {}
+                f:name: {}
+    manager: piped
+    operation: Apply
+  - apiVersion: apps/v1
+    fieldsType: FieldsV1
+    fieldsV1:
+      f:spec:
+        f:replicas: {}
+    manager: hpa-controller
+    operation: Update
+  - apiVersion: apps/v1
+    fieldsType: FieldsV1
+    fieldsV1:
+      f:spec:
+        f:template:
+          f:spec:
+            f:containers:
+              k:{"name":"helloworld"}:
+                f:args:
+                  v:"--injected": {}
+              k:{"name":"sidecar"}:
+                .: {}
+                f:image: {}
+                f:name: {}
+    manager: sidecar-injector
+    operation: Update
+  name: helloworld
+spec:
+  replicas: 5
+  template:
+    spec:
+      containers:
+      - args:
+        - --port=9085
+        image: helloworld:v0.1.0
+        name: helloworld
+`,
+			expectedDesired: `apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: helloworld
+spec:
+  replicas: 2
+  template:
+    spec:
+      containers:
+      - args:
+        - --port=9085
+        image: helloworld:v0.2.0
+        name: helloworld
+`,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			manifests, err := ParseManifests(tc.manifests)
+			require.NoError(t, err)
+			require.Equal(t, 2, len(manifests))
+			live, desired := manifests[0], manifests[1]
+
+			live.body, desired.body = removeFieldsManagedByOthers(live.body, desired.body)
+
+			data, err := live.YamlBytes()
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedLive, string(data))
+
+			data, err = desired.YamlBytes()
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedDesired, string(data))
+		})
+	}
+}
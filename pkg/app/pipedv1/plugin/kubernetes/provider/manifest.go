@@ -21,6 +21,7 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 	"sigs.k8s.io/yaml"
 
+	"github.com/pipe-cd/pipecd/pkg/app/pipedv1/plugin/kubernetes/config"
 	sdk "github.com/pipe-cd/piped-plugin-sdk-go"
 )
 
@@ -159,6 +160,11 @@ func (m Manifest) NestedString(fields ...string) (string, bool, error) {
 	return unstructured.NestedString(m.body.Object, fields...)
 }
 
+// SetNestedMap sets the given map to the field at the specified path.
+func (m Manifest) SetNestedMap(value map[string]any, fields ...string) error {
+	return unstructured.SetNestedMap(m.body.Object, value, fields...)
+}
+
 func (m Manifest) AddLabels(labels map[string]string) {
 	if len(labels) == 0 {
 		return
@@ -212,7 +218,8 @@ func (m Manifest) AddStringMapValues(values map[string]string, fields ...string)
 }
 
 // ToResourceState converts the manifest into a sdk.ResourceState.
-func (m Manifest) ToResourceState(deployTarget string) sdk.ResourceState {
+// The given health rules are used to evaluate the health status of the kinds not supported by the plugin.
+func (m Manifest) ToResourceState(deployTarget string, healthRules []config.K8sHealthRule) sdk.ResourceState {
 	var parents []string // default as nil
 	if len(m.body.GetOwnerReferences()) > 0 {
 		parents = make([]string, 0, len(m.body.GetOwnerReferences()))
@@ -221,7 +228,7 @@ func (m Manifest) ToResourceState(deployTarget string) sdk.ResourceState {
 		}
 	}
 
-	status, desc := m.calculateHealthStatus()
+	status, desc := m.calculateHealthStatus(healthRules)
 
 	return sdk.ResourceState{
 		ID:                string(m.body.GetUID()),
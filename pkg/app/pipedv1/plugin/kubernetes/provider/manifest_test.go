@@ -878,7 +878,7 @@ func TestManifest_ToResourceState(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			got := tt.manifest.ToResourceState(tt.deployTarget)
+			got := tt.manifest.ToResourceState(tt.deployTarget, nil)
 			assert.Equal(t, tt.want, got)
 		})
 	}
@@ -23,10 +23,26 @@ import (
 )
 
 const (
-	KindService    = "Service"
-	KindDeployment = "Deployment"
-	KindSecret     = "Secret"
-	KindConfigMap  = "ConfigMap"
+	KindService               = "Service"
+	KindDeployment            = "Deployment"
+	KindSecret                = "Secret"
+	KindConfigMap             = "ConfigMap"
+	KindStatefulSet           = "StatefulSet"
+	KindDaemonSet             = "DaemonSet"
+	KindReplicaSet            = "ReplicaSet"
+	KindPod                   = "Pod"
+	KindJob                   = "Job"
+	KindCronJob               = "CronJob"
+	KindIngress               = "Ingress"
+	KindPersistentVolume      = "PersistentVolume"
+	KindPersistentVolumeClaim = "PersistentVolumeClaim"
+	KindServiceAccount        = "ServiceAccount"
+	KindRole                  = "Role"
+	KindRoleBinding           = "RoleBinding"
+	KindClusterRole           = "ClusterRole"
+	KindClusterRoleBinding    = "ClusterRoleBinding"
+	KindNamespace             = "Namespace"
+	KindPodDisruptionBudget   = "PodDisruptionBudget"
 
 	DefaultNamespace = "default"
 )
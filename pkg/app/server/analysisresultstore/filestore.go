@@ -50,6 +50,33 @@ func (f *analysisFileStore) Put(ctx context.Context, applicationID string, analy
 	return f.backend.Put(ctx, path, data)
 }
 
+func (f *analysisFileStore) GetReport(ctx context.Context, deploymentID, stageID string) (*model.AnalysisReport, error) {
+	path := buildReportPath(deploymentID, stageID)
+
+	content, err := f.backend.Get(ctx, path)
+	if err != nil {
+		return nil, err
+	}
+	var r model.AnalysisReport
+	if err := json.Unmarshal(content, &r); err != nil {
+		return nil, err
+	}
+	return &r, nil
+}
+
+func (f *analysisFileStore) PutReport(ctx context.Context, report *model.AnalysisReport) error {
+	path := buildReportPath(report.DeploymentId, report.StageId)
+	data, err := json.Marshal(report)
+	if err != nil {
+		return err
+	}
+	return f.backend.Put(ctx, path, data)
+}
+
 func buildPath(applicationID string) string {
 	return fmt.Sprintf("latest-analysis-result/%s.json", applicationID)
 }
+
+func buildReportPath(deploymentID, stageID string) string {
+	return fmt.Sprintf("analysis-report/%s/%s.json", deploymentID, stageID)
+}
@@ -77,3 +77,96 @@ func TestFileStoreGet(t *testing.T) {
 		})
 	}
 }
+
+func TestFileStoreGetReport(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	store := filestoretest.NewMockStore(ctrl)
+
+	testcases := []struct {
+		name         string
+		deploymentID string
+		stageID      string
+		content      string
+		readerErr    error
+
+		expected    *model.AnalysisReport
+		expectedErr error
+	}{
+		{
+			name:         "file not found in filestore",
+			deploymentID: "deployment-id",
+			stageID:      "stage-id",
+			readerErr:    filestore.ErrNotFound,
+			expectedErr:  filestore.ErrNotFound,
+		},
+		{
+			name:         "found file in filestore",
+			deploymentID: "deployment-id",
+			stageID:      "stage-id",
+
+			content: `{
+				"deployment_id": "deployment-id",
+				"stage_id": "stage-id",
+				"status": 3,
+				"score": 80,
+				"metrics": [
+					{
+						"id": "metrics-0",
+						"evaluations": [
+							{
+								"evaluated_at": 1590000060,
+								"verdict": 2,
+								"queries": [
+									{
+										"variant": "canary",
+										"data_points": [{"timestamp": 1590000000, "value": 0.5}]
+									}
+								]
+							}
+						]
+					}
+				]
+			}`,
+
+			expected: &model.AnalysisReport{
+				DeploymentId: "deployment-id",
+				StageId:      "stage-id",
+				Status:       model.StageStatus_STAGE_FAILURE,
+				Score:        80,
+				Metrics: []*model.AnalysisMetricsReport{
+					{
+						Id: "metrics-0",
+						Evaluations: []*model.AnalysisEvaluation{
+							{
+								EvaluatedAt: 1590000060,
+								Verdict:     model.AnalysisVerdict_VERDICT_FAILED,
+								Queries: []*model.AnalysisQuery{
+									{
+										Variant:    "canary",
+										DataPoints: []*model.AnalysisDataPoint{{Timestamp: 1590000000, Value: 0.5}},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	fs := analysisFileStore{
+		backend: store,
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			path := buildReportPath(tc.deploymentID, tc.stageID)
+			content := []byte(tc.content)
+
+			store.EXPECT().Get(context.TODO(), path).Return(content, tc.readerErr)
+			report, err := fs.GetReport(context.TODO(), tc.deploymentID, tc.stageID)
+			require.Equal(t, tc.expectedErr, err)
+			assert.Equal(t, tc.expected, report)
+		})
+	}
+}
@@ -16,6 +16,7 @@ package analysisresultstore
 
 import (
 	"context"
+	"errors"
 
 	"go.uber.org/zap"
 
@@ -28,6 +29,10 @@ type Store interface {
 	GetLatestAnalysisResult(ctx context.Context, applicationID string) (*model.AnalysisResult, error)
 	// PutStateSnapshot updates the most recent successful analysis result of the specified application.
 	PutLatestAnalysisResult(ctx context.Context, applicationID string, snapshot *model.AnalysisResult) error
+	// GetAnalysisReport gives back the report of the specified ANALYSIS stage.
+	GetAnalysisReport(ctx context.Context, deploymentID, stageID string) (*model.AnalysisReport, error)
+	// PutAnalysisReport saves the report of an ANALYSIS stage.
+	PutAnalysisReport(ctx context.Context, report *model.AnalysisReport) error
 }
 
 type store struct {
@@ -61,3 +66,22 @@ func (s *store) PutLatestAnalysisResult(ctx context.Context, applicationID strin
 	}
 	return nil
 }
+
+func (s *store) GetAnalysisReport(ctx context.Context, deploymentID, stageID string) (*model.AnalysisReport, error) {
+	resp, err := s.backend.GetReport(ctx, deploymentID, stageID)
+	if err != nil {
+		if !errors.Is(err, filestore.ErrNotFound) {
+			s.logger.Error("failed to get the analysis report from filestore", zap.Error(err))
+		}
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (s *store) PutAnalysisReport(ctx context.Context, report *model.AnalysisReport) error {
+	if err := s.backend.PutReport(ctx, report); err != nil {
+		s.logger.Error("failed to put the analysis report to filestore", zap.Error(err))
+		return err
+	}
+	return nil
+}
@@ -27,6 +27,7 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"github.com/pipe-cd/pipecd/pkg/app/server/analysisresultstore"
 	"github.com/pipe-cd/pipecd/pkg/app/server/commandstore"
 	"github.com/pipe-cd/pipecd/pkg/app/server/service/apiservice"
 	"github.com/pipe-cd/pipecd/pkg/app/server/stagelogstore"
@@ -87,6 +88,7 @@ type API struct {
 	deploymentTraceStore apiDeploymentTraceStore
 	commandStore         commandstore.Store
 	stageLogStore        stagelogstore.Store
+	analysisResultStore  analysisresultstore.Store
 	commandOutputGetter  commandOutputGetter
 
 	encryptionKeyCache cache.Cache
@@ -116,6 +118,7 @@ func NewAPI(
 		deploymentTraceStore: datastore.NewDeploymentTraceStore(ds, w),
 		commandStore:         commandstore.NewStore(w, ds, sc, logger),
 		stageLogStore:        stagelogstore.NewStore(fs, sc, logger),
+		analysisResultStore:  analysisresultstore.NewStore(fs, logger),
 		commandOutputGetter:  cog,
 		// Public key is variable but likely to be accessed multiple times in a short period.
 		encryptionKeyCache: memorycache.NewTTLCache(ctx, 5*time.Minute, 5*time.Minute),
@@ -200,8 +203,9 @@ func (a *API) SyncApplication(ctx context.Context, req *apiservice.SyncApplicati
 		Type:          model.Command_SYNC_APPLICATION,
 		Commander:     key.Id,
 		SyncApplication: &model.Command_SyncApplication{
-			ApplicationId: app.Id,
-			SyncStrategy:  model.SyncStrategy_AUTO,
+			ApplicationId:             app.Id,
+			SyncStrategy:              model.SyncStrategy_AUTO,
+			OverrideDeploymentWindows: req.OverrideDeploymentWindows,
 		},
 	}
 	if err := addCommand(ctx, a.commandStore, &cmd, a.logger); err != nil {
@@ -648,6 +652,58 @@ func (a *API) ListDeployments(ctx context.Context, req *apiservice.ListDeploymen
 	}, nil
 }
 
+// RejectStage rejects the given stage of a running deployment.
+// The deployment will be failed and then rolled back if auto rollback is enabled.
+func (a *API) RejectStage(ctx context.Context, req *apiservice.RejectStageRequest) (*apiservice.RejectStageResponse, error) {
+	key, err := requireAPIKey(ctx, model.APIKey_READ_WRITE, a.logger)
+	if err != nil {
+		return nil, err
+	}
+
+	deployment, err := getDeployment(ctx, a.deploymentStore, req.DeploymentId, a.logger)
+	if err != nil {
+		return nil, err
+	}
+
+	if key.ProjectId != deployment.ProjectId {
+		return nil, status.Error(codes.InvalidArgument, "Requested deployment does not belong to your project")
+	}
+
+	stage, ok := deployment.StageMap()[req.StageId]
+	if !ok {
+		return nil, status.Error(codes.FailedPrecondition, "The stage was not found in the deployment")
+	}
+	if stage.Name != model.StageWaitApproval.String() {
+		return nil, status.Error(codes.FailedPrecondition, "Only WAIT_APPROVAL stages can be rejected")
+	}
+	if stage.Status.IsCompleted() {
+		return nil, status.Error(codes.FailedPrecondition, "Could not reject the stage because it was already completed")
+	}
+
+	cmd := model.Command{
+		Id:            uuid.New().String(),
+		PipedId:       deployment.PipedId,
+		ApplicationId: deployment.ApplicationId,
+		ProjectId:     deployment.ProjectId,
+		DeploymentId:  req.DeploymentId,
+		StageId:       req.StageId,
+		Type:          model.Command_REJECT_STAGE,
+		Commander:     key.Id,
+		RejectStage: &model.Command_RejectStage{
+			DeploymentId: req.DeploymentId,
+			StageId:      req.StageId,
+			Comment:      req.Comment,
+		},
+	}
+	if err := addCommand(ctx, a.commandStore, &cmd, a.logger); err != nil {
+		return nil, err
+	}
+
+	return &apiservice.RejectStageResponse{
+		CommandId: cmd.Id,
+	}, nil
+}
+
 func (a *API) ListStageLogs(ctx context.Context, req *apiservice.ListStageLogsRequest) (*apiservice.ListStageLogsResponse, error) {
 	key, err := requireAPIKey(ctx, model.APIKey_READ_ONLY, a.logger)
 	if err != nil {
@@ -692,6 +748,49 @@ func (a *API) ListStageLogs(ctx context.Context, req *apiservice.ListStageLogsRe
 	}, nil
 }
 
+func (a *API) GetAnalysisReport(ctx context.Context, req *apiservice.GetAnalysisReportRequest) (*apiservice.GetAnalysisReportResponse, error) {
+	key, err := requireAPIKey(ctx, model.APIKey_READ_ONLY, a.logger)
+	if err != nil {
+		return nil, err
+	}
+
+	deployment, err := getDeployment(ctx, a.deploymentStore, req.DeploymentId, a.logger)
+	if err != nil {
+		return nil, err
+	}
+
+	if key.ProjectId != deployment.ProjectId {
+		return nil, status.Error(codes.InvalidArgument, "Requested deployment does not belong to your project")
+	}
+
+	reports := make([]*model.AnalysisReport, 0)
+	for _, stage := range deployment.Stages {
+		if stage.Name != model.StageAnalysis.String() {
+			continue
+		}
+		if req.StageId != "" && stage.Id != req.StageId {
+			continue
+		}
+		report, err := a.analysisResultStore.GetAnalysisReport(ctx, deployment.Id, stage.Id)
+		// The report does not exist until the stage ends.
+		if errors.Is(err, filestore.ErrNotFound) {
+			continue
+		}
+		if err != nil {
+			return nil, status.Error(codes.Internal, "failed to get the analysis report")
+		}
+		reports = append(reports, report)
+	}
+
+	if req.StageId != "" && len(reports) == 0 {
+		return nil, status.Error(codes.NotFound, "the analysis report is not found")
+	}
+
+	return &apiservice.GetAnalysisReportResponse{
+		Reports: reports,
+	}, nil
+}
+
 func (a *API) GetCommand(ctx context.Context, req *apiservice.GetCommandRequest) (*apiservice.GetCommandResponse, error) {
 	_, err := requireAPIKey(ctx, model.APIKey_READ_ONLY, a.logger)
 	if err != nil {
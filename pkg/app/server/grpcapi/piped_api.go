@@ -36,6 +36,7 @@ import (
 	"github.com/pipe-cd/pipecd/pkg/app/server/unregisteredappstore"
 	"github.com/pipe-cd/pipecd/pkg/cache"
 	"github.com/pipe-cd/pipecd/pkg/cache/memorycache"
+	"github.com/pipe-cd/pipecd/pkg/config"
 	"github.com/pipe-cd/pipecd/pkg/datastore"
 	"github.com/pipe-cd/pipecd/pkg/filestore"
 	"github.com/pipe-cd/pipecd/pkg/model"
@@ -74,8 +75,9 @@ type pipedAPIPipedStore interface {
 }
 
 type pipedAPIEventStore interface {
+	Add(ctx context.Context, e model.Event) error
 	List(ctx context.Context, opts datastore.ListOptions) ([]*model.Event, string, error)
-	UpdateStatus(ctx context.Context, eventID string, status model.EventStatus, statusDescription string) error
+	UpdateStatus(ctx context.Context, eventID string, status model.EventStatus, statusDescription, pullRequestURL string) error
 }
 
 type commandOutputPutter interface {
@@ -102,12 +104,15 @@ type PipedAPI struct {
 	deploymentPipedCache cache.Cache
 	pipedStatCache       cache.Cache
 
+	// JSON encoded project-wide deployment windows keyed by project ID.
+	projectDeploymentWindows map[string]string
+
 	webBaseURL string
 	logger     *zap.Logger
 }
 
 // NewPipedAPI creates a new PipedAPI instance.
-func NewPipedAPI(ctx context.Context, ds datastore.DataStore, sc cache.Cache, sls stagelogstore.Store, alss applicationlivestatestore.Store, las analysisresultstore.Store, hc cache.Cache, cop commandOutputPutter, uas unregisteredappstore.Store, webBaseURL string, logger *zap.Logger) *PipedAPI {
+func NewPipedAPI(ctx context.Context, ds datastore.DataStore, sc cache.Cache, sls stagelogstore.Store, alss applicationlivestatestore.Store, las analysisresultstore.Store, hc cache.Cache, cop commandOutputPutter, uas unregisteredappstore.Store, pdws []config.ProjectDeploymentWindows, webBaseURL string, logger *zap.Logger) *PipedAPI {
 	w := datastore.PipedCommander
 	a := &PipedAPI{
 		applicationStore:          datastore.NewApplicationStore(ds, w),
@@ -124,9 +129,18 @@ func NewPipedAPI(ctx context.Context, ds datastore.DataStore, sc cache.Cache, sl
 		appPipedCache:             memorycache.NewTTLCache(ctx, 24*time.Hour, 3*time.Hour),
 		deploymentPipedCache:      memorycache.NewTTLCache(ctx, 24*time.Hour, 3*time.Hour),
 		pipedStatCache:            hc,
+		projectDeploymentWindows:  make(map[string]string, len(pdws)),
 		webBaseURL:                webBaseURL,
 		logger:                    logger.Named("piped-api"),
 	}
+	for _, w := range pdws {
+		data, err := json.Marshal(w.DeploymentWindows)
+		if err != nil {
+			a.logger.Error("failed to encode deployment windows", zap.String("project-id", w.ProjectID), zap.Error(err))
+			continue
+		}
+		a.projectDeploymentWindows[w.ProjectID] = string(data)
+	}
 	return a
 }
 
@@ -166,7 +180,7 @@ func (a *PipedAPI) ReportStat(ctx context.Context, req *pipedservice.ReportStatR
 // ReportPipedMeta is sent by piped while starting up to report its metadata
 // such as configured cloud providers.
 func (a *PipedAPI) ReportPipedMeta(ctx context.Context, req *pipedservice.ReportPipedMetaRequest) (*pipedservice.ReportPipedMetaResponse, error) {
-	_, pipedID, _, err := rpcauth.ExtractPipedToken(ctx)
+	projectID, pipedID, _, err := rpcauth.ExtractPipedToken(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -200,8 +214,9 @@ func (a *PipedAPI) ReportPipedMeta(ctx context.Context, req *pipedservice.Report
 		return nil, err
 	}
 	return &pipedservice.ReportPipedMetaResponse{
-		Name:       piped.Name,
-		WebBaseUrl: a.webBaseURL,
+		Name:                     piped.Name,
+		WebBaseUrl:               a.webBaseURL,
+		ProjectDeploymentWindows: a.projectDeploymentWindows[projectID],
 	}, nil
 }
 
@@ -429,7 +444,7 @@ func (a *PipedAPI) ReportDeploymentPlanned(ctx context.Context, req *pipedservic
 }
 
 // ReportDeploymentStatusChanged is used to update the status
-// of a specific deployment to RUNNING or ROLLING_BACK.
+// of a specific deployment to PENDING, RUNNING or ROLLING_BACK.
 func (a *PipedAPI) ReportDeploymentStatusChanged(ctx context.Context, req *pipedservice.ReportDeploymentStatusChangedRequest) (*pipedservice.ReportDeploymentStatusChangedResponse, error) {
 	_, pipedID, _, err := rpcauth.ExtractPipedToken(ctx)
 	if err != nil {
@@ -814,13 +829,78 @@ func (a *PipedAPI) ReportEventStatuses(ctx context.Context, req *pipedservice.Re
 	}
 	for _, e := range req.Events {
 		// TODO: For success status, change all previous events with the same event key to OUTDATED
-		if err := a.eventStore.UpdateStatus(ctx, e.Id, e.Status, e.StatusDescription); err != nil {
+		if err := a.eventStore.UpdateStatus(ctx, e.Id, e.Status, e.StatusDescription, e.PullRequestUrl); err != nil {
 			return nil, gRPCStoreError(err, fmt.Sprintf("update status of event %s", e.Id))
 		}
 	}
 	return &pipedservice.ReportEventStatusesResponse{}, nil
 }
 
+// RegisterEvent registers a new event found by piped itself.
+func (a *PipedAPI) RegisterEvent(ctx context.Context, req *pipedservice.RegisterEventRequest) (*pipedservice.RegisterEventResponse, error) {
+	projectID, pipedID, _, err := rpcauth.ExtractPipedToken(ctx)
+	if err != nil {
+		return nil, err
+	}
+	id := uuid.New().String()
+
+	if err := a.eventStore.Add(ctx, model.Event{
+		Id:                id,
+		Name:              req.Name,
+		Data:              req.Data,
+		Labels:            req.Labels,
+		EventKey:          model.MakeEventKey(req.Name, req.Labels),
+		ProjectId:         projectID,
+		Status:            model.EventStatus_EVENT_NOT_HANDLED,
+		StatusDescription: fmt.Sprintf("It is going to be replaced by %s", req.Data),
+	}); err != nil {
+		a.logger.Error("failed to register event",
+			zap.String("piped-id", pipedID),
+			zap.String("event-name", req.Name),
+			zap.Error(err),
+		)
+		return nil, gRPCStoreError(err, fmt.Sprintf("add event %s", id))
+	}
+	return &pipedservice.RegisterEventResponse{EventId: id}, nil
+}
+
+// SyncApplication creates a command to sync the given application.
+// The application must belong to the piped.
+func (a *PipedAPI) SyncApplication(ctx context.Context, req *pipedservice.SyncApplicationRequest) (*pipedservice.SyncApplicationResponse, error) {
+	_, pipedID, _, err := rpcauth.ExtractPipedToken(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	app, err := a.applicationStore.Get(ctx, req.ApplicationId)
+	if err != nil {
+		return nil, gRPCStoreError(err, fmt.Sprintf("get application %s", req.ApplicationId))
+	}
+	if app.PipedId != pipedID {
+		return nil, status.Error(codes.PermissionDenied, "requested application doesn't belong to the piped")
+	}
+
+	cmd := model.Command{
+		Id:            uuid.New().String(),
+		PipedId:       app.PipedId,
+		ApplicationId: app.Id,
+		ProjectId:     app.ProjectId,
+		Type:          model.Command_SYNC_APPLICATION,
+		Commander:     pipedID,
+		SyncApplication: &model.Command_SyncApplication{
+			ApplicationId: app.Id,
+			SyncStrategy:  req.SyncStrategy,
+		},
+	}
+	if err := addCommand(ctx, a.commandStore, &cmd, a.logger); err != nil {
+		return nil, err
+	}
+
+	return &pipedservice.SyncApplicationResponse{
+		CommandId: cmd.Id,
+	}, nil
+}
+
 func (a *PipedAPI) GetLatestAnalysisResult(ctx context.Context, req *pipedservice.GetLatestAnalysisResultRequest) (*pipedservice.GetLatestAnalysisResultResponse, error) {
 	_, pipedID, _, err := rpcauth.ExtractPipedToken(ctx)
 	if err != nil {
@@ -862,6 +942,23 @@ func (a *PipedAPI) PutLatestAnalysisResult(ctx context.Context, req *pipedservic
 	return &pipedservice.PutLatestAnalysisResultResponse{}, nil
 }
 
+func (a *PipedAPI) PutAnalysisReport(ctx context.Context, req *pipedservice.PutAnalysisReportRequest) (*pipedservice.PutAnalysisReportResponse, error) {
+	_, pipedID, _, err := rpcauth.ExtractPipedToken(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := a.validateAnalysisReportBelongsToPiped(ctx, req.Report, pipedID); err != nil {
+		return nil, err
+	}
+
+	if err := a.analysisResultStore.PutAnalysisReport(ctx, req.Report); err != nil {
+		a.logger.Error("failed to put the analysis report", zap.Error(err))
+		return nil, status.Error(codes.Internal, "failed to put the analysis report")
+	}
+
+	return &pipedservice.PutAnalysisReportResponse{}, nil
+}
+
 func (a *PipedAPI) GetDesiredVersion(ctx context.Context, _ *pipedservice.GetDesiredVersionRequest) (*pipedservice.GetDesiredVersionResponse, error) {
 	_, pipedID, _, err := rpcauth.ExtractPipedToken(ctx)
 	if err != nil {
@@ -1160,6 +1257,26 @@ func (a *PipedAPI) validateAppBelongsToPiped(ctx context.Context, appID, pipedID
 
 // validateDeploymentBelongsToPiped checks if the given deployment belongs to the given piped.
 // It gives back an error unless the deployment belongs to the piped.
+// validateAnalysisReportBelongsToPiped checks whether the given report is for an ANALYSIS stage
+// of a deployment belonging to the piped because the stage ID is used as a part of the storage path.
+func (a *PipedAPI) validateAnalysisReportBelongsToPiped(ctx context.Context, report *model.AnalysisReport, pipedID string) error {
+	deployment, err := getDeployment(ctx, a.deploymentStore, report.DeploymentId, a.logger)
+	if err != nil {
+		return err
+	}
+	if deployment.PipedId != pipedID {
+		return status.Error(codes.PermissionDenied, "requested deployment doesn't belong to the piped")
+	}
+	if deployment.ApplicationId != report.ApplicationId {
+		return status.Error(codes.InvalidArgument, "requested application doesn't match the deployment")
+	}
+	stage, ok := deployment.Stage(report.StageId)
+	if !ok || stage.Name != model.StageAnalysis.String() {
+		return status.Error(codes.InvalidArgument, "requested stage is not an ANALYSIS stage of the deployment")
+	}
+	return nil
+}
+
 func (a *PipedAPI) validateDeploymentBelongsToPiped(ctx context.Context, deploymentID, pipedID string) error {
 	pid, err := a.deploymentPipedCache.Get(deploymentID)
 	if err == nil {
@@ -21,6 +21,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
 
 	"github.com/pipe-cd/pipecd/pkg/cache"
 	"github.com/pipe-cd/pipecd/pkg/cache/cachetest"
@@ -212,3 +213,78 @@ func TestValidateDeploymentBelongsToPiped(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateAnalysisReportBelongsToPiped(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	deployment := &model.Deployment{
+		Id:            "deploymentID",
+		ApplicationId: "appID",
+		PipedId:       "pipedID",
+		Stages: []*model.PipelineStage{
+			{Id: "stage-0", Name: model.StageK8sCanaryRollout.String()},
+			{Id: "stage-1", Name: model.StageAnalysis.String()},
+		},
+	}
+	s := datastoretest.NewMockDeploymentStore(ctrl)
+	s.EXPECT().Get(gomock.Any(), "deploymentID").Return(deployment, nil).AnyTimes()
+	s.EXPECT().Get(gomock.Any(), gomock.Not("deploymentID")).Return(nil, datastore.ErrNotFound).AnyTimes()
+	api := &PipedAPI{
+		deploymentStore: s,
+		logger:          zap.NewNop(),
+	}
+
+	tests := []struct {
+		name    string
+		report  *model.AnalysisReport
+		pipedID string
+		wantErr bool
+	}{
+		{
+			name:    "valid",
+			report:  &model.AnalysisReport{DeploymentId: "deploymentID", ApplicationId: "appID", StageId: "stage-1"},
+			pipedID: "pipedID",
+			wantErr: false,
+		},
+		{
+			name:    "deployment of another piped",
+			report:  &model.AnalysisReport{DeploymentId: "deploymentID", ApplicationId: "appID", StageId: "stage-1"},
+			pipedID: "wrong",
+			wantErr: true,
+		},
+		{
+			name:    "unknown deployment",
+			report:  &model.AnalysisReport{DeploymentId: "unknown", ApplicationId: "appID", StageId: "stage-1"},
+			pipedID: "pipedID",
+			wantErr: true,
+		},
+		{
+			name:    "application not matching the deployment",
+			report:  &model.AnalysisReport{DeploymentId: "deploymentID", ApplicationId: "wrong", StageId: "stage-1"},
+			pipedID: "pipedID",
+			wantErr: true,
+		},
+		{
+			name:    "not an analysis stage",
+			report:  &model.AnalysisReport{DeploymentId: "deploymentID", ApplicationId: "appID", StageId: "stage-0"},
+			pipedID: "pipedID",
+			wantErr: true,
+		},
+		{
+			name:    "stage outside the deployment",
+			report:  &model.AnalysisReport{DeploymentId: "deploymentID", ApplicationId: "appID", StageId: "../otherDeploymentID/stage-1"},
+			pipedID: "pipedID",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := api.validateAnalysisReportBelongsToPiped(ctx, tt.report, tt.pipedID)
+			assert.Equal(t, tt.wantErr, err != nil)
+		})
+	}
+}
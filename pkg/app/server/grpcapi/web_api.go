@@ -32,6 +32,7 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"github.com/pipe-cd/pipecd/pkg/app/server/analysisresultstore"
 	"github.com/pipe-cd/pipecd/pkg/app/server/applicationlivestatestore"
 	"github.com/pipe-cd/pipecd/pkg/app/server/commandstore"
 	"github.com/pipe-cd/pipecd/pkg/app/server/service/webservice"
@@ -41,6 +42,7 @@ import (
 	"github.com/pipe-cd/pipecd/pkg/cache/memorycache"
 	"github.com/pipe-cd/pipecd/pkg/config"
 	"github.com/pipe-cd/pipecd/pkg/datastore"
+	"github.com/pipe-cd/pipecd/pkg/filestore"
 	"github.com/pipe-cd/pipecd/pkg/insight"
 	"github.com/pipe-cd/pipecd/pkg/model"
 	"github.com/pipe-cd/pipecd/pkg/rpc/rpcauth"
@@ -133,6 +135,7 @@ type WebAPI struct {
 	eventStore                webAPIEventStore
 	stageLogStore             stagelogstore.Store
 	applicationLiveStateStore applicationlivestatestore.Store
+	analysisResultStore       analysisresultstore.Store
 	commandStore              commandstore.Store
 	insightProvider           insight.Provider
 	unregisteredAppStore      unregisteredappstore.Store
@@ -155,6 +158,7 @@ func NewWebAPI(
 	sc cache.Cache,
 	sls stagelogstore.Store,
 	alss applicationlivestatestore.Store,
+	las analysisresultstore.Store,
 	uas unregisteredappstore.Store,
 	akluc cache.Cache,
 	ip insight.Provider,
@@ -176,6 +180,7 @@ func NewWebAPI(
 		eventStore:                datastore.NewEventStore(ds, w),
 		stageLogStore:             sls,
 		applicationLiveStateStore: alss,
+		analysisResultStore:       las,
 		commandStore:              commandstore.NewStore(w, ds, sc, logger),
 		insightProvider:           ip,
 		unregisteredAppStore:      uas,
@@ -728,8 +733,9 @@ func (a *WebAPI) SyncApplication(ctx context.Context, req *webservice.SyncApplic
 		Type:          model.Command_SYNC_APPLICATION,
 		Commander:     claims.Subject,
 		SyncApplication: &model.Command_SyncApplication{
-			ApplicationId: app.Id,
-			SyncStrategy:  req.SyncStrategy,
+			ApplicationId:             app.Id,
+			SyncStrategy:              req.SyncStrategy,
+			OverrideDeploymentWindows: req.OverrideDeploymentWindows,
 		},
 	}
 	if err := addCommand(ctx, a.commandStore, &cmd, a.logger); err != nil {
@@ -1102,6 +1108,31 @@ func (a *WebAPI) GetStageLog(ctx context.Context, req *webservice.GetStageLogReq
 	}, nil
 }
 
+func (a *WebAPI) GetAnalysisReport(ctx context.Context, req *webservice.GetAnalysisReportRequest) (*webservice.GetAnalysisReportResponse, error) {
+	claims, err := rpcauth.ExtractClaims(ctx)
+	if err != nil {
+		a.logger.Error("failed to authenticate the current user", zap.Error(err))
+		return nil, err
+	}
+
+	if err := a.validateDeploymentBelongsToProject(ctx, req.DeploymentId, claims.Role.ProjectId); err != nil {
+		return nil, err
+	}
+
+	report, err := a.analysisResultStore.GetAnalysisReport(ctx, req.DeploymentId, req.StageId)
+	if errors.Is(err, filestore.ErrNotFound) {
+		return nil, status.Error(codes.NotFound, "the analysis report is not found")
+	}
+	if err != nil {
+		a.logger.Error("failed to get the analysis report", zap.Error(err))
+		return nil, status.Error(codes.Internal, "failed to get the analysis report")
+	}
+
+	return &webservice.GetAnalysisReportResponse{
+		Report: report,
+	}, nil
+}
+
 func (a *WebAPI) CancelDeployment(ctx context.Context, req *webservice.CancelDeploymentRequest) (*webservice.CancelDeploymentResponse, error) {
 	claims, err := rpcauth.ExtractClaims(ctx)
 	if err != nil {
@@ -1243,6 +1274,59 @@ func (a *WebAPI) ApproveStage(ctx context.Context, req *webservice.ApproveStageR
 	}, nil
 }
 
+func (a *WebAPI) RejectStage(ctx context.Context, req *webservice.RejectStageRequest) (*webservice.RejectStageResponse, error) {
+	claims, err := rpcauth.ExtractClaims(ctx)
+	if err != nil {
+		a.logger.Error("failed to authenticate the current user", zap.Error(err))
+		return nil, err
+	}
+
+	deployment, err := getDeployment(ctx, a.deploymentStore, req.DeploymentId, a.logger)
+	if err != nil {
+		return nil, err
+	}
+	if err := validateApprover(deployment.Stages, claims.Subject, req.StageId); err != nil {
+		return nil, err
+	}
+	if err := a.validateDeploymentBelongsToProject(ctx, req.DeploymentId, claims.Role.ProjectId); err != nil {
+		return nil, err
+	}
+	stage, ok := deployment.StageMap()[req.StageId]
+	if !ok {
+		return nil, status.Error(codes.FailedPrecondition, "The stage was not found in the deployment")
+	}
+	if stage.Name != model.StageWaitApproval.String() {
+		return nil, status.Error(codes.FailedPrecondition, "Only WAIT_APPROVAL stages can be rejected")
+	}
+	if stage.Status.IsCompleted() {
+		return nil, status.Error(codes.FailedPrecondition, "Could not reject the stage because it was already completed")
+	}
+
+	commandID := uuid.New().String()
+	cmd := model.Command{
+		Id:            commandID,
+		PipedId:       deployment.PipedId,
+		ApplicationId: deployment.ApplicationId,
+		ProjectId:     deployment.ProjectId,
+		DeploymentId:  req.DeploymentId,
+		StageId:       req.StageId,
+		Type:          model.Command_REJECT_STAGE,
+		Commander:     claims.Subject,
+		RejectStage: &model.Command_RejectStage{
+			DeploymentId: req.DeploymentId,
+			StageId:      req.StageId,
+			Comment:      req.Comment,
+		},
+	}
+	if err := addCommand(ctx, a.commandStore, &cmd, a.logger); err != nil {
+		return nil, err
+	}
+
+	return &webservice.RejectStageResponse{
+		CommandId: commandID,
+	}, nil
+}
+
 // No error means that the given commander is valid.
 func validateApprover(stages []*model.PipelineStage, commander, stageID string) error {
 	var approvers []string
@@ -1264,7 +1348,7 @@ func validateApprover(stages []*model.PipelineStage, commander, stageID string)
 			return nil
 		}
 	}
-	return status.Error(codes.PermissionDenied, fmt.Sprintf("You can't approve this deployment because you (%s) are not in the approver list: %v", commander, approvers))
+	return status.Error(codes.PermissionDenied, fmt.Sprintf("You can't approve or reject this deployment because you (%s) are not in the approver list: %v", commander, approvers))
 }
 
 func (a *WebAPI) GetApplicationLiveState(ctx context.Context, req *webservice.GetApplicationLiveStateRequest) (*webservice.GetApplicationLiveStateResponse, error) {
@@ -1677,7 +1761,14 @@ func (a *WebAPI) GetInsightData(ctx context.Context, req *webservice.GetInsightD
 		return nil, err
 	}
 
-	var points []*model.InsightDataPoint
+	if err := insight.ValidateDataRange(req.RangeFrom, req.RangeTo, req.Resolution); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	var (
+		points  []*model.InsightDataPoint
+		streams []*model.InsightSampleStream
+	)
 
 	switch req.MetricsKind {
 	case model.InsightMetricsKind_DEPLOYMENT_FREQUENCY:
@@ -1702,6 +1793,39 @@ func (a *WebAPI) GetInsightData(ctx context.Context, req *webservice.GetInsightD
 			req.Resolution,
 		)
 
+	case model.InsightMetricsKind_DEPLOYMENT_DURATION:
+		streams, err = a.insightProvider.GetDeploymentDurationSampleStreams(
+			ctx,
+			claims.Role.ProjectId,
+			req.ApplicationId,
+			req.Labels,
+			req.RangeFrom,
+			req.RangeTo,
+			req.Resolution,
+		)
+
+	case model.InsightMetricsKind_MTTR:
+		streams, err = a.insightProvider.GetDeploymentMTTRSampleStreams(
+			ctx,
+			claims.Role.ProjectId,
+			req.ApplicationId,
+			req.Labels,
+			req.RangeFrom,
+			req.RangeTo,
+			req.Resolution,
+		)
+
+	case model.InsightMetricsKind_LEAD_TIME:
+		streams, err = a.insightProvider.GetDeploymentLeadTimeSampleStreams(
+			ctx,
+			claims.Role.ProjectId,
+			req.ApplicationId,
+			req.Labels,
+			req.RangeFrom,
+			req.RangeTo,
+			req.Resolution,
+		)
+
 	default:
 		return nil, status.Error(codes.Unimplemented, fmt.Sprintf("The insight metrics %s is not implemented yet", req.MetricsKind.String()))
 	}
@@ -1711,11 +1835,16 @@ func (a *WebAPI) GetInsightData(ctx context.Context, req *webservice.GetInsightD
 		return nil, gRPCStoreError(err, "get insight data")
 	}
 
-	return &webservice.GetInsightDataResponse{
-		Type: model.InsightResultType_MATRIX,
-		Matrix: []*model.InsightSampleStream{
+	// The metrics having only one series of data points are returned as a single stream.
+	if streams == nil {
+		streams = []*model.InsightSampleStream{
 			{DataPoints: points},
-		},
+		}
+	}
+
+	return &webservice.GetInsightDataResponse{
+		Type:   model.InsightResultType_MATRIX,
+		Matrix: streams,
 	}, nil
 }
 
@@ -15,6 +15,7 @@
 package httpapi
 
 import (
+	"fmt"
 	"net/http"
 	"path/filepath"
 
@@ -38,8 +39,13 @@ func NewHandler(
 	sharedSSOConfigs map[string]*model.ProjectSSOConfig,
 	projectGetter projectGetter,
 	secureCookie bool,
+	slackApproval *config.ControlPlaneSlackApproval,
+	deploymentGetter deploymentGetter,
+	commandAdder commandAdder,
+	registryWebhooks []config.RegistryWebhook,
+	eventAdder eventAdder,
 	logger *zap.Logger,
-) http.Handler {
+) (http.Handler, error) {
 	mux := http.NewServeMux()
 	a := newAuthHandler(
 		signer,
@@ -76,5 +82,28 @@ func NewHandler(
 	register(callbackPath, http.HandlerFunc(a.handleCallback))
 	register(logoutPath, http.HandlerFunc(a.handleLogout))
 
-	return mux
+	if slackApproval != nil {
+		h, err := newSlackApprovalHandler(
+			slackApproval,
+			deploymentGetter,
+			commandAdder,
+			projectGetter,
+			projectsInConfig,
+			logger,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create slack approval handler: %w", err)
+		}
+		register(slackApprovalPath, http.HandlerFunc(h.handle))
+	}
+
+	if len(registryWebhooks) > 0 {
+		h, err := newRegistryWebhookHandler(registryWebhooks, eventAdder, logger)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create registry webhook handler: %w", err)
+		}
+		register(registryWebhookPathPrefix, http.HandlerFunc(h.handle))
+	}
+
+	return mux, nil
 }
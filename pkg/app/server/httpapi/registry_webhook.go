@@ -0,0 +1,350 @@
+// Copyright 2024 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package httpapi
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"regexp"
+	"strings"
+	"text/template"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/pipe-cd/pipecd/pkg/config"
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+const (
+	// registryWebhookPathPrefix is followed by the name of the source configured in the control plane config.
+	registryWebhookPathPrefix = "/webhooks/registry/"
+
+	registryWebhookMaxRequestSize = 1024 * 1024
+	// registryWebhookTokenQueryKey is the query parameter holding the secret
+	// for the registries which can not set any header to the webhooks.
+	registryWebhookTokenQueryKey = "token"
+	githubSignatureHeader        = "X-Hub-Signature-256"
+	githubSignaturePrefix        = "sha256="
+)
+
+type eventAdder interface {
+	Add(ctx context.Context, e model.Event) error
+}
+
+// registryWebhookHandler converts the push webhooks sent from container registries into events.
+type registryWebhookHandler struct {
+	sources    map[string]*registryWebhookSource
+	eventAdder eventAdder
+	logger     *zap.Logger
+}
+
+type registryWebhookSource struct {
+	config *config.RegistryWebhook
+	secret []byte
+	rules  []*registryWebhookRule
+}
+
+type registryWebhookRule struct {
+	repository *regexp.Regexp
+	tag        *regexp.Regexp
+	eventName  *template.Template
+	labels     map[string]*template.Template
+	data       *template.Template
+}
+
+// registryImage represents an image pushed to a container registry.
+// The exported fields are available as the template arguments of the rules.
+type registryImage struct {
+	// The host of the registry, e.g. ghcr.io.
+	Registry string
+	// The path of the repository in the registry, e.g. org/helloworld.
+	Repository string
+	// The pushed tag, e.g. v0.1.0.
+	Tag string
+	// The digest of the pushed image, e.g. sha256:abc. This may be empty.
+	Digest string
+}
+
+// Image returns the name of the image without tag, e.g. ghcr.io/org/helloworld.
+func (i registryImage) Image() string {
+	return i.Registry + "/" + i.Repository
+}
+
+func newRegistryWebhookHandler(cfgs []config.RegistryWebhook, eventAdder eventAdder, logger *zap.Logger) (*registryWebhookHandler, error) {
+	sources := make(map[string]*registryWebhookSource, len(cfgs))
+	for i := range cfgs {
+		cfg := &cfgs[i]
+		secret, err := cfg.LoadSecret()
+		if err != nil {
+			return nil, fmt.Errorf("failed to load secret of registry webhook %s: %w", cfg.Name, err)
+		}
+		// Anyone could sign the payloads with an empty secret.
+		if secret == "" {
+			return nil, fmt.Errorf("secret of registry webhook %s must not be empty", cfg.Name)
+		}
+		rules := make([]*registryWebhookRule, 0, len(cfg.Rules))
+		for _, r := range cfg.Rules {
+			rule, err := newRegistryWebhookRule(r)
+			if err != nil {
+				return nil, fmt.Errorf("invalid rule of registry webhook %s: %w", cfg.Name, err)
+			}
+			rules = append(rules, rule)
+		}
+		sources[cfg.Name] = &registryWebhookSource{
+			config: cfg,
+			secret: []byte(secret),
+			rules:  rules,
+		}
+	}
+	return &registryWebhookHandler{
+		sources:    sources,
+		eventAdder: eventAdder,
+		logger:     logger.Named("registry-webhook-handler"),
+	}, nil
+}
+
+func newRegistryWebhookRule(cfg config.RegistryWebhookRule) (*registryWebhookRule, error) {
+	var (
+		r   = &registryWebhookRule{labels: make(map[string]*template.Template, len(cfg.Labels))}
+		err error
+	)
+	if r.repository, err = compileRegistryWebhookPattern(cfg.Repository); err != nil {
+		return nil, err
+	}
+	if r.tag, err = compileRegistryWebhookPattern(cfg.Tag); err != nil {
+		return nil, err
+	}
+	if r.eventName, err = parseRegistryWebhookTemplate(cfg.EventName); err != nil {
+		return nil, err
+	}
+	for k, v := range cfg.Labels {
+		if r.labels[k], err = parseRegistryWebhookTemplate(v); err != nil {
+			return nil, err
+		}
+	}
+	if cfg.Data != "" {
+		if r.data, err = parseRegistryWebhookTemplate(cfg.Data); err != nil {
+			return nil, err
+		}
+	}
+	return r, nil
+}
+
+// compileRegistryWebhookPattern compiles the pattern to match the whole value.
+// Any value is matched by the empty pattern.
+func compileRegistryWebhookPattern(pattern string) (*regexp.Regexp, error) {
+	if pattern == "" {
+		pattern = ".*"
+	}
+	return regexp.Compile("^(?:" + pattern + ")$")
+}
+
+func parseRegistryWebhookTemplate(text string) (*template.Template, error) {
+	return template.New("").Option("missingkey=error").Parse(text)
+}
+
+func (r *registryWebhookRule) match(img registryImage) bool {
+	return r.repository.MatchString(img.Repository) && r.tag.MatchString(img.Tag)
+}
+
+// registryEvent holds the rendered contents of an event to be registered.
+type registryEvent struct {
+	name   string
+	labels map[string]string
+	data   string
+}
+
+// makeEvent returns the name, labels and data of the event for the given image.
+func (r *registryWebhookRule) makeEvent(img registryImage) (name string, labels map[string]string, data string, err error) {
+	args := map[string]string{
+		"Registry":   img.Registry,
+		"Repository": img.Repository,
+		"Image":      img.Image(),
+		"Tag":        img.Tag,
+		"Digest":     img.Digest,
+	}
+	render := func(t *template.Template) (string, error) {
+		var b strings.Builder
+		if err := t.Execute(&b, args); err != nil {
+			return "", err
+		}
+		return b.String(), nil
+	}
+
+	if name, err = render(r.eventName); err != nil {
+		return
+	}
+	if len(r.labels) > 0 {
+		labels = make(map[string]string, len(r.labels))
+		for k, t := range r.labels {
+			if labels[k], err = render(t); err != nil {
+				return
+			}
+		}
+	}
+	data = img.Image() + ":" + img.Tag
+	if r.data != nil {
+		data, err = render(r.data)
+	}
+	return
+}
+
+type registryWebhookResponse struct {
+	EventIDs []string `json:"eventIds"`
+}
+
+func (h *registryWebhookHandler) handle(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	name := strings.TrimPrefix(r.URL.Path, registryWebhookPathPrefix)
+	src, ok := h.sources[name]
+	if !ok {
+		http.NotFound(w, r)
+		return
+	}
+	logger := h.logger.With(
+		zap.String("source", name),
+		zap.String("type", string(src.config.Type)),
+	)
+
+	body, err := io.ReadAll(io.LimitReader(r.Body, registryWebhookMaxRequestSize))
+	if err != nil {
+		http.Error(w, "Unable to read request body", http.StatusBadRequest)
+		return
+	}
+	if err := src.authenticate(r, body); err != nil {
+		logger.Warn("received an unauthenticated registry webhook", zap.Error(err))
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	images, err := parseRegistryWebhook(src.config.Type, r.Header, body)
+	if err != nil {
+		logger.Warn("received a malformed registry webhook", zap.Error(err))
+		http.Error(w, "Malformed payload", http.StatusBadRequest)
+		return
+	}
+
+	// Render all events before adding any of them to avoid registering
+	// a part of them twice when the registry retries the failed webhook.
+	events := make([]registryEvent, 0, len(images))
+	for _, img := range images {
+		for _, rule := range src.rules {
+			if !rule.match(img) {
+				continue
+			}
+			name, labels, data, err := rule.makeEvent(img)
+			if err != nil {
+				logger.Error("failed to render event for the pushed image",
+					zap.String("image", img.Image()),
+					zap.String("tag", img.Tag),
+					zap.Error(err),
+				)
+				http.Error(w, "Failed to register event", http.StatusInternalServerError)
+				return
+			}
+			events = append(events, registryEvent{name: name, labels: labels, data: data})
+		}
+	}
+
+	resp := registryWebhookResponse{EventIDs: make([]string, 0, len(events))}
+	for _, e := range events {
+		id, err := h.registerEvent(r.Context(), src.config.ProjectID, e)
+		if err != nil {
+			logger.Error("failed to register event for the pushed image",
+				zap.String("event", e.name),
+				zap.String("data", e.data),
+				zap.Error(err),
+			)
+			http.Error(w, "Failed to register event", http.StatusInternalServerError)
+			return
+		}
+		resp.EventIDs = append(resp.EventIDs, id)
+	}
+	if len(resp.EventIDs) > 0 {
+		logger.Info(fmt.Sprintf("registered %d events from registry webhook", len(resp.EventIDs)))
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(resp)
+}
+
+func (h *registryWebhookHandler) registerEvent(ctx context.Context, projectID string, e registryEvent) (string, error) {
+	id := uuid.New().String()
+	if err := h.eventAdder.Add(ctx, model.Event{
+		Id:                id,
+		Name:              e.name,
+		Data:              e.data,
+		Labels:            e.labels,
+		EventKey:          model.MakeEventKey(e.name, e.labels),
+		ProjectId:         projectID,
+		Status:            model.EventStatus_EVENT_NOT_HANDLED,
+		StatusDescription: fmt.Sprintf("It is going to be replaced by %s", e.data),
+	}); err != nil {
+		return "", err
+	}
+	return id, nil
+}
+
+// authenticate verifies the webhook by the way the registry supports:
+//   - GHCR: the signature of the GitHub webhook
+//   - HARBOR, ECR: the Authorization header set to the secret (optionally prefixed with "Bearer ")
+//   - DOCKER_HUB, ARTIFACT_REGISTRY: the token query parameter of the webhook URL
+func (s *registryWebhookSource) authenticate(r *http.Request, body []byte) error {
+	switch s.config.Type {
+	case config.RegistryWebhookGHCR:
+		sig := r.Header.Get(githubSignatureHeader)
+		if sig == "" {
+			return fmt.Errorf("missing %s header", githubSignatureHeader)
+		}
+		if !hmac.Equal([]byte(makeGitHubSignature(s.secret, body)), []byte(sig)) {
+			return errors.New("signature mismatch")
+		}
+		return nil
+	case config.RegistryWebhookHarbor, config.RegistryWebhookECR:
+		token := strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")
+		return s.verifyToken(token)
+	default:
+		return s.verifyToken(r.URL.Query().Get(registryWebhookTokenQueryKey))
+	}
+}
+
+func (s *registryWebhookSource) verifyToken(token string) error {
+	if token == "" {
+		return errors.New("missing token")
+	}
+	if subtle.ConstantTimeCompare([]byte(token), s.secret) != 1 {
+		return errors.New("token mismatch")
+	}
+	return nil
+}
+
+func makeGitHubSignature(secret, body []byte) string {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write(body)
+	return githubSignaturePrefix + hex.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,265 @@
+// Copyright 2024 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package httpapi
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/pipe-cd/pipecd/pkg/config"
+)
+
+const (
+	dockerHubRegistry = "docker.io"
+	ghcrRegistry      = "ghcr.io"
+
+	githubEventHeader = "X-GitHub-Event"
+)
+
+// parseRegistryWebhook extracts the pushed images from the webhook sent by the given type of registry.
+// No image is returned without error for the webhooks not related to pushing tagged images.
+func parseRegistryWebhook(typ config.RegistryWebhookType, header http.Header, body []byte) ([]registryImage, error) {
+	switch typ {
+	case config.RegistryWebhookDockerHub:
+		return parseDockerHubWebhook(body)
+	case config.RegistryWebhookHarbor:
+		return parseHarborWebhook(body)
+	case config.RegistryWebhookGHCR:
+		return parseGHCRWebhook(header.Get(githubEventHeader), body)
+	case config.RegistryWebhookECR:
+		return parseECRWebhook(body)
+	case config.RegistryWebhookArtifactRegistry:
+		return parseArtifactRegistryWebhook(body)
+	default:
+		return nil, fmt.Errorf("unsupported registry webhook type %s", typ)
+	}
+}
+
+// parseDockerHubWebhook parses the webhook described at
+// https://docs.docker.com/docker-hub/webhooks/
+func parseDockerHubWebhook(body []byte) ([]registryImage, error) {
+	var payload struct {
+		PushData struct {
+			Tag string `json:"tag"`
+		} `json:"push_data"`
+		Repository struct {
+			RepoName string `json:"repo_name"`
+		} `json:"repository"`
+	}
+	if err := json.Unmarshal(body, &payload); err != nil {
+		return nil, err
+	}
+	if payload.Repository.RepoName == "" {
+		return nil, errors.New("missing repository name")
+	}
+	if payload.PushData.Tag == "" {
+		return nil, nil
+	}
+	return []registryImage{{
+		Registry:   dockerHubRegistry,
+		Repository: payload.Repository.RepoName,
+		Tag:        payload.PushData.Tag,
+	}}, nil
+}
+
+// parseHarborWebhook parses the PUSH_ARTIFACT webhook described at
+// https://goharbor.io/docs/main/working-with-projects/project-configuration/configure-webhooks/
+func parseHarborWebhook(body []byte) ([]registryImage, error) {
+	var payload struct {
+		Type      string `json:"type"`
+		EventData struct {
+			Resources []struct {
+				Digest      string `json:"digest"`
+				Tag         string `json:"tag"`
+				ResourceURL string `json:"resource_url"`
+			} `json:"resources"`
+			Repository struct {
+				RepoFullName string `json:"repo_full_name"`
+			} `json:"repository"`
+		} `json:"event_data"`
+	}
+	if err := json.Unmarshal(body, &payload); err != nil {
+		return nil, err
+	}
+	if payload.Type != "PUSH_ARTIFACT" {
+		return nil, nil
+	}
+	repo := payload.EventData.Repository.RepoFullName
+	if repo == "" {
+		return nil, errors.New("missing repository name")
+	}
+
+	images := make([]registryImage, 0, len(payload.EventData.Resources))
+	for _, r := range payload.EventData.Resources {
+		if r.Tag == "" {
+			continue
+		}
+		// The resource URL is in the format of {registry}/{repository}:{tag}.
+		registry, _, ok := strings.Cut(r.ResourceURL, "/")
+		if !ok {
+			return nil, fmt.Errorf("malformed resource url %q", r.ResourceURL)
+		}
+		images = append(images, registryImage{
+			Registry:   registry,
+			Repository: repo,
+			Tag:        r.Tag,
+			Digest:     r.Digest,
+		})
+	}
+	return images, nil
+}
+
+type githubPackage struct {
+	Name        string `json:"name"`
+	PackageType string `json:"package_type"`
+	Owner       struct {
+		Login string `json:"login"`
+	} `json:"owner"`
+	PackageVersion struct {
+		Version           string `json:"version"`
+		ContainerMetadata struct {
+			Tag struct {
+				Name   string `json:"name"`
+				Digest string `json:"digest"`
+			} `json:"tag"`
+		} `json:"container_metadata"`
+	} `json:"package_version"`
+}
+
+// parseGHCRWebhook parses the package and registry_package webhooks of GitHub described at
+// https://docs.github.com/en/webhooks/webhook-events-and-payloads#package
+func parseGHCRWebhook(event string, body []byte) ([]registryImage, error) {
+	if event != "package" && event != "registry_package" {
+		return nil, nil
+	}
+	var payload struct {
+		Action          string         `json:"action"`
+		Package         *githubPackage `json:"package"`
+		RegistryPackage *githubPackage `json:"registry_package"`
+	}
+	if err := json.Unmarshal(body, &payload); err != nil {
+		return nil, err
+	}
+	pkg := payload.Package
+	if pkg == nil {
+		pkg = payload.RegistryPackage
+	}
+	if pkg == nil {
+		return nil, errors.New("missing package")
+	}
+	if payload.Action != "published" || !strings.EqualFold(pkg.PackageType, "container") {
+		return nil, nil
+	}
+	tag := pkg.PackageVersion.ContainerMetadata.Tag
+	if tag.Name == "" {
+		return nil, nil
+	}
+	if pkg.Owner.Login == "" || pkg.Name == "" {
+		return nil, errors.New("missing package owner or name")
+	}
+
+	digest := tag.Digest
+	if digest == "" {
+		digest = pkg.PackageVersion.Version
+	}
+	return []registryImage{{
+		Registry:   ghcrRegistry,
+		Repository: strings.ToLower(pkg.Owner.Login + "/" + pkg.Name),
+		Tag:        tag.Name,
+		Digest:     digest,
+	}}, nil
+}
+
+// parseECRWebhook parses the ECR Image Action event of Amazon EventBridge described at
+// https://docs.aws.amazon.com/AmazonECR/latest/userguide/ecr-eventbridge.html
+func parseECRWebhook(body []byte) ([]registryImage, error) {
+	var payload struct {
+		DetailType string `json:"detail-type"`
+		Account    string `json:"account"`
+		Region     string `json:"region"`
+		Detail     struct {
+			Result         string `json:"result"`
+			ActionType     string `json:"action-type"`
+			RepositoryName string `json:"repository-name"`
+			ImageDigest    string `json:"image-digest"`
+			ImageTag       string `json:"image-tag"`
+		} `json:"detail"`
+	}
+	if err := json.Unmarshal(body, &payload); err != nil {
+		return nil, err
+	}
+	d := payload.Detail
+	if payload.DetailType != "ECR Image Action" || d.ActionType != "PUSH" || d.Result != "SUCCESS" || d.ImageTag == "" {
+		return nil, nil
+	}
+	if payload.Account == "" || payload.Region == "" || d.RepositoryName == "" {
+		return nil, errors.New("missing account, region or repository name")
+	}
+	return []registryImage{{
+		Registry:   fmt.Sprintf("%s.dkr.ecr.%s.amazonaws.com", payload.Account, payload.Region),
+		Repository: d.RepositoryName,
+		Tag:        d.ImageTag,
+		Digest:     d.ImageDigest,
+	}}, nil
+}
+
+// parseArtifactRegistryWebhook parses the Pub/Sub push message of the notification described at
+// https://cloud.google.com/artifact-registry/docs/configure-notifications
+func parseArtifactRegistryWebhook(body []byte) ([]registryImage, error) {
+	var payload struct {
+		Message struct {
+			// Data is encoded in base64 which is decoded automatically into bytes.
+			Data []byte `json:"data"`
+		} `json:"message"`
+	}
+	if err := json.Unmarshal(body, &payload); err != nil {
+		return nil, err
+	}
+	var notification struct {
+		Action string `json:"action"`
+		Digest string `json:"digest"`
+		Tag    string `json:"tag"`
+	}
+	if err := json.Unmarshal(payload.Message.Data, &notification); err != nil {
+		return nil, fmt.Errorf("malformed message data: %w", err)
+	}
+	if notification.Action != "INSERT" || notification.Tag == "" {
+		return nil, nil
+	}
+
+	// The tag is in the format of {location}-docker.pkg.dev/{project}/{repository}/{image}:{tag}.
+	ref := notification.Tag
+	i := strings.LastIndex(ref, ":")
+	if i < 0 || strings.Contains(ref[i:], "/") {
+		return nil, fmt.Errorf("malformed tag %q", ref)
+	}
+	registry, repo, ok := strings.Cut(ref[:i], "/")
+	if !ok {
+		return nil, fmt.Errorf("malformed tag %q", ref)
+	}
+	var digest string
+	if _, d, ok := strings.Cut(notification.Digest, "@"); ok {
+		digest = d
+	}
+	return []registryImage{{
+		Registry:   registry,
+		Repository: repo,
+		Tag:        ref[i+1:],
+		Digest:     digest,
+	}}, nil
+}
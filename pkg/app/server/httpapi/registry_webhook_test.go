@@ -0,0 +1,329 @@
+// Copyright 2024 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package httpapi
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/pipe-cd/pipecd/pkg/config"
+	"github.com/pipe-cd/pipecd/pkg/datastore"
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+// fakeEventDataStore records the events created through the event store.
+type fakeEventDataStore struct {
+	datastore.DataStore
+	events []*model.Event
+}
+
+func (f *fakeEventDataStore) Create(_ context.Context, _ datastore.Collection, _ string, entity interface{}) error {
+	f.events = append(f.events, entity.(*model.Event))
+	return nil
+}
+
+func newTestRegistryWebhookHandler(t *testing.T, ds *fakeEventDataStore) *registryWebhookHandler {
+	secretFile := filepath.Join(t.TempDir(), "secret")
+	require.NoError(t, os.WriteFile(secretFile, []byte("secret\n"), 0600))
+
+	rules := []config.RegistryWebhookRule{
+		{
+			Repository: "(my-project/)?org/helloworld",
+			Tag:        `v[0-9]+\.[0-9]+\.[0-9]+`,
+			EventName:  "helloworld-image-update",
+			Labels:     map[string]string{"registry": "{{ .Registry }}"},
+		},
+	}
+	cfgs := []config.RegistryWebhook{
+		{Name: "dockerhub", Type: config.RegistryWebhookDockerHub, ProjectID: "project", SecretFile: secretFile, Rules: rules},
+		{Name: "harbor", Type: config.RegistryWebhookHarbor, ProjectID: "project", SecretFile: secretFile, Rules: rules},
+		{Name: "ghcr", Type: config.RegistryWebhookGHCR, ProjectID: "project", SecretFile: secretFile, Rules: rules},
+		{Name: "ecr", Type: config.RegistryWebhookECR, ProjectID: "project", SecretFile: secretFile, Rules: rules},
+		{Name: "gar", Type: config.RegistryWebhookArtifactRegistry, ProjectID: "project", SecretFile: secretFile, Rules: rules},
+	}
+	h, err := newRegistryWebhookHandler(cfgs, datastore.NewEventStore(ds, datastore.TestCommander), zap.NewNop())
+	require.NoError(t, err)
+	return h
+}
+
+func TestRegistryWebhookHandler(t *testing.T) {
+	t.Parallel()
+
+	garMessage := base64.StdEncoding.EncodeToString([]byte(`{"action":"INSERT","digest":"us-east1-docker.pkg.dev/my-project/org/helloworld@sha256:abc","tag":"us-east1-docker.pkg.dev/my-project/org/helloworld:v0.1.0"}`))
+	ghcrPayload := `{"action":"published","package":{"name":"HelloWorld","package_type":"CONTAINER","owner":{"login":"org"},"package_version":{"version":"sha256:abc","container_metadata":{"tag":{"name":"v0.1.0","digest":"sha256:abc"}}}}}`
+
+	testcases := []struct {
+		name         string
+		path         string
+		header       map[string]string
+		body         string
+		expectedCode int
+		expected     []*model.Event
+	}{
+		{
+			name:         "docker hub",
+			path:         "/webhooks/registry/dockerhub?token=secret",
+			body:         `{"push_data":{"tag":"v0.1.0"},"repository":{"repo_name":"org/helloworld"}}`,
+			expectedCode: http.StatusOK,
+			expected: []*model.Event{
+				{Name: "helloworld-image-update", Data: "docker.io/org/helloworld:v0.1.0", Labels: map[string]string{"registry": "docker.io"}},
+			},
+		},
+		{
+			name:         "docker hub with wrong token",
+			path:         "/webhooks/registry/dockerhub?token=wrong",
+			body:         `{"push_data":{"tag":"v0.1.0"},"repository":{"repo_name":"org/helloworld"}}`,
+			expectedCode: http.StatusUnauthorized,
+		},
+		{
+			name:         "harbor",
+			path:         "/webhooks/registry/harbor",
+			header:       map[string]string{"Authorization": "secret"},
+			body:         `{"type":"PUSH_ARTIFACT","event_data":{"resources":[{"digest":"sha256:abc","tag":"v0.1.0","resource_url":"harbor.example.com/org/helloworld:v0.1.0"}],"repository":{"repo_full_name":"org/helloworld"}}}`,
+			expectedCode: http.StatusOK,
+			expected: []*model.Event{
+				{Name: "helloworld-image-update", Data: "harbor.example.com/org/helloworld:v0.1.0", Labels: map[string]string{"registry": "harbor.example.com"}},
+			},
+		},
+		{
+			name:         "harbor without authorization",
+			path:         "/webhooks/registry/harbor",
+			body:         `{"type":"PUSH_ARTIFACT"}`,
+			expectedCode: http.StatusUnauthorized,
+		},
+		{
+			name:         "harbor other event",
+			path:         "/webhooks/registry/harbor",
+			header:       map[string]string{"Authorization": "Bearer secret"},
+			body:         `{"type":"DELETE_ARTIFACT","event_data":{"repository":{"repo_full_name":"org/helloworld"}}}`,
+			expectedCode: http.StatusOK,
+		},
+		{
+			name: "ghcr",
+			path: "/webhooks/registry/ghcr",
+			header: map[string]string{
+				"X-GitHub-Event":      "package",
+				"X-Hub-Signature-256": makeGitHubSignature([]byte("secret"), []byte(ghcrPayload)),
+			},
+			body:         ghcrPayload,
+			expectedCode: http.StatusOK,
+			expected: []*model.Event{
+				{Name: "helloworld-image-update", Data: "ghcr.io/org/helloworld:v0.1.0", Labels: map[string]string{"registry": "ghcr.io"}},
+			},
+		},
+		{
+			name: "ghcr with invalid signature",
+			path: "/webhooks/registry/ghcr",
+			header: map[string]string{
+				"X-GitHub-Event":      "package",
+				"X-Hub-Signature-256": makeGitHubSignature([]byte("wrong"), []byte(ghcrPayload)),
+			},
+			body:         ghcrPayload,
+			expectedCode: http.StatusUnauthorized,
+		},
+		{
+			name:         "ecr",
+			path:         "/webhooks/registry/ecr",
+			header:       map[string]string{"Authorization": "Bearer secret"},
+			body:         `{"detail-type":"ECR Image Action","source":"aws.ecr","account":"123456789012","region":"us-west-2","detail":{"result":"SUCCESS","repository-name":"org/helloworld","image-digest":"sha256:abc","action-type":"PUSH","image-tag":"v0.1.0"}}`,
+			expectedCode: http.StatusOK,
+			expected: []*model.Event{
+				{Name: "helloworld-image-update", Data: "123456789012.dkr.ecr.us-west-2.amazonaws.com/org/helloworld:v0.1.0", Labels: map[string]string{"registry": "123456789012.dkr.ecr.us-west-2.amazonaws.com"}},
+			},
+		},
+		{
+			name:         "artifact registry",
+			path:         "/webhooks/registry/gar?token=secret",
+			body:         `{"message":{"data":"` + garMessage + `","messageId":"1"},"subscription":"projects/my-project/subscriptions/pipecd"}`,
+			expectedCode: http.StatusOK,
+			expected: []*model.Event{
+				{Name: "helloworld-image-update", Data: "us-east1-docker.pkg.dev/my-project/org/helloworld:v0.1.0", Labels: map[string]string{"registry": "us-east1-docker.pkg.dev"}},
+			},
+		},
+		{
+			name:         "tag not matching any rule",
+			path:         "/webhooks/registry/dockerhub?token=secret",
+			body:         `{"push_data":{"tag":"latest"},"repository":{"repo_name":"org/helloworld"}}`,
+			expectedCode: http.StatusOK,
+		},
+		{
+			name:         "malformed payload",
+			path:         "/webhooks/registry/dockerhub?token=secret",
+			body:         `{"push_data":`,
+			expectedCode: http.StatusBadRequest,
+		},
+		{
+			name:         "unknown source",
+			path:         "/webhooks/registry/unknown?token=secret",
+			body:         `{}`,
+			expectedCode: http.StatusNotFound,
+		},
+	}
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			events := &fakeEventDataStore{}
+			h := newTestRegistryWebhookHandler(t, events)
+
+			req := httptest.NewRequest(http.MethodPost, tc.path, strings.NewReader(tc.body))
+			for k, v := range tc.header {
+				req.Header.Set(k, v)
+			}
+			rec := httptest.NewRecorder()
+			h.handle(rec, req)
+			require.Equal(t, tc.expectedCode, rec.Code, rec.Body.String())
+			require.Len(t, events.events, len(tc.expected))
+
+			if tc.expectedCode == http.StatusOK {
+				var resp registryWebhookResponse
+				require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+				assert.Len(t, resp.EventIDs, len(tc.expected))
+			}
+			for i, e := range events.events {
+				assert.Equal(t, tc.expected[i].Name, e.Name)
+				assert.Equal(t, tc.expected[i].Data, e.Data)
+				assert.Equal(t, tc.expected[i].Labels, e.Labels)
+				assert.Equal(t, "project", e.ProjectId)
+				assert.Equal(t, model.EventStatus_EVENT_NOT_HANDLED, e.Status)
+				assert.Equal(t, model.MakeEventKey(e.Name, e.Labels), e.EventKey)
+				assert.NotEmpty(t, e.Id)
+			}
+		})
+	}
+}
+
+func TestNewRegistryWebhookHandlerWithEmptySecret(t *testing.T) {
+	t.Parallel()
+
+	secretFile := filepath.Join(t.TempDir(), "secret")
+	require.NoError(t, os.WriteFile(secretFile, []byte("\n"), 0600))
+
+	cfgs := []config.RegistryWebhook{
+		{
+			Name:       "ghcr",
+			Type:       config.RegistryWebhookGHCR,
+			ProjectID:  "project",
+			SecretFile: secretFile,
+			Rules:      []config.RegistryWebhookRule{{EventName: "image-update"}},
+		},
+	}
+	_, err := newRegistryWebhookHandler(cfgs, datastore.NewEventStore(&fakeEventDataStore{}, datastore.TestCommander), zap.NewNop())
+	assert.Error(t, err)
+}
+
+func TestRegistryWebhookHandlerWithRenderFailure(t *testing.T) {
+	t.Parallel()
+
+	secretFile := filepath.Join(t.TempDir(), "secret")
+	require.NoError(t, os.WriteFile(secretFile, []byte("secret"), 0600))
+
+	cfgs := []config.RegistryWebhook{
+		{
+			Name:       "dockerhub",
+			Type:       config.RegistryWebhookDockerHub,
+			ProjectID:  "project",
+			SecretFile: secretFile,
+			Rules: []config.RegistryWebhookRule{
+				{EventName: "image-update"},
+				{EventName: "{{ .Unknown }}"},
+			},
+		},
+	}
+	events := &fakeEventDataStore{}
+	h, err := newRegistryWebhookHandler(cfgs, datastore.NewEventStore(events, datastore.TestCommander), zap.NewNop())
+	require.NoError(t, err)
+
+	body := `{"push_data":{"tag":"v0.1.0"},"repository":{"repo_name":"org/helloworld"}}`
+	req := httptest.NewRequest(http.MethodPost, "/webhooks/registry/dockerhub?token=secret", strings.NewReader(body))
+	rec := httptest.NewRecorder()
+	h.handle(rec, req)
+
+	// No event must be registered when any of them can not be rendered
+	// since the registry retries the whole webhook.
+	assert.Equal(t, http.StatusInternalServerError, rec.Code)
+	assert.Empty(t, events.events)
+}
+
+func TestRegistryWebhookRuleMakeEvent(t *testing.T) {
+	t.Parallel()
+
+	img := registryImage{
+		Registry:   "ghcr.io",
+		Repository: "org/helloworld",
+		Tag:        "v0.1.0",
+		Digest:     "sha256:abc",
+	}
+	testcases := []struct {
+		name           string
+		rule           config.RegistryWebhookRule
+		expectedName   string
+		expectedLabels map[string]string
+		expectedData   string
+		wantErr        bool
+	}{
+		{
+			name:         "default data",
+			rule:         config.RegistryWebhookRule{EventName: "image-update"},
+			expectedName: "image-update",
+			expectedData: "ghcr.io/org/helloworld:v0.1.0",
+		},
+		{
+			name: "templated",
+			rule: config.RegistryWebhookRule{
+				EventName: "{{ .Repository }}-update",
+				Labels:    map[string]string{"tag": "{{ .Tag }}"},
+				Data:      "{{ .Image }}@{{ .Digest }}",
+			},
+			expectedName:   "org/helloworld-update",
+			expectedLabels: map[string]string{"tag": "v0.1.0"},
+			expectedData:   "ghcr.io/org/helloworld@sha256:abc",
+		},
+		{
+			name:    "unknown argument",
+			rule:    config.RegistryWebhookRule{EventName: "{{ .Unknown }}"},
+			wantErr: true,
+		},
+	}
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			rule, err := newRegistryWebhookRule(tc.rule)
+			require.NoError(t, err)
+			require.True(t, rule.match(img))
+
+			name, labels, data, err := rule.makeEvent(img)
+			assert.Equal(t, tc.wantErr, err != nil)
+			if tc.wantErr {
+				return
+			}
+			assert.Equal(t, tc.expectedName, name)
+			assert.Equal(t, tc.expectedLabels, labels)
+			assert.Equal(t, tc.expectedData, data)
+		})
+	}
+}
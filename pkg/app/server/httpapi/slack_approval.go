@@ -0,0 +1,362 @@
+// Copyright 2024 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package httpapi
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/pipe-cd/pipecd/pkg/config"
+	"github.com/pipe-cd/pipecd/pkg/datastore"
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+const (
+	// slackApprovalPath is the path configured as the interactivity request URL of the Slack app.
+	slackApprovalPath = "/slack/approval"
+
+	slackSignatureHeader  = "X-Slack-Signature"
+	slackTimestampHeader  = "X-Slack-Request-Timestamp"
+	slackSignatureVersion = "v0"
+	slackPayloadFormKey   = "payload"
+
+	// Requests older than this are rejected to prevent replay attacks.
+	slackRequestMaxAge  = 5 * time.Minute
+	slackMaxRequestSize = 1024 * 1024
+
+	// slackRejectComment is the comment attached to the stages rejected from Slack.
+	slackRejectComment = "Rejected from Slack"
+)
+
+type deploymentGetter interface {
+	Get(ctx context.Context, id string) (*model.Deployment, error)
+}
+
+type commandAdder interface {
+	AddCommand(ctx context.Context, cmd *model.Command) error
+}
+
+// slackApprovalHandler handles the callbacks of the interactive
+// approval messages sent by piped to Slack.
+type slackApprovalHandler struct {
+	signingSecret    []byte
+	config           *config.ControlPlaneSlackApproval
+	deploymentGetter deploymentGetter
+	commandAdder     commandAdder
+	projectGetter    projectGetter
+	projectsInConfig map[string]config.ControlPlaneProject
+	nowFunc          func() time.Time
+	logger           *zap.Logger
+}
+
+func newSlackApprovalHandler(
+	cfg *config.ControlPlaneSlackApproval,
+	deploymentGetter deploymentGetter,
+	commandAdder commandAdder,
+	projectGetter projectGetter,
+	projectsInConfig map[string]config.ControlPlaneProject,
+	logger *zap.Logger,
+) (*slackApprovalHandler, error) {
+	secret, err := cfg.LoadSigningSecret()
+	if err != nil {
+		return nil, fmt.Errorf("failed to load slack signing secret: %w", err)
+	}
+	return &slackApprovalHandler{
+		signingSecret:    []byte(secret),
+		config:           cfg,
+		deploymentGetter: deploymentGetter,
+		commandAdder:     commandAdder,
+		projectGetter:    projectGetter,
+		projectsInConfig: projectsInConfig,
+		nowFunc:          time.Now,
+		logger:           logger.Named("slack-approval-handler"),
+	}, nil
+}
+
+type slackInteractionPayload struct {
+	Type       string `json:"type"`
+	CallbackID string `json:"callback_id"`
+	Actions    []struct {
+		Name  string `json:"name"`
+		Value string `json:"value"`
+	} `json:"actions"`
+	User struct {
+		ID   string `json:"id"`
+		Name string `json:"name"`
+	} `json:"user"`
+}
+
+type slackInteractionResponse struct {
+	ResponseType    string `json:"response_type"`
+	ReplaceOriginal bool   `json:"replace_original"`
+	Text            string `json:"text"`
+}
+
+func (h *slackApprovalHandler) handle(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	body, err := io.ReadAll(io.LimitReader(r.Body, slackMaxRequestSize))
+	if err != nil {
+		http.Error(w, "Unable to read request body", http.StatusBadRequest)
+		return
+	}
+	if err := h.verifySignature(r.Header, body); err != nil {
+		h.logger.Warn("received an unverified slack request", zap.Error(err))
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	form, err := url.ParseQuery(string(body))
+	if err != nil {
+		http.Error(w, "Malformed request body", http.StatusBadRequest)
+		return
+	}
+	var payload slackInteractionPayload
+	if err := json.Unmarshal([]byte(form.Get(slackPayloadFormKey)), &payload); err != nil {
+		http.Error(w, "Malformed payload", http.StatusBadRequest)
+		return
+	}
+	if payload.CallbackID != model.StageApprovalCallbackID || len(payload.Actions) == 0 {
+		http.Error(w, "Unsupported interaction", http.StatusBadRequest)
+		return
+	}
+
+	action := payload.Actions[0]
+	deploymentID, stageID, err := model.ParseStageApprovalValue(action.Value)
+	if err != nil {
+		http.Error(w, "Malformed action value", http.StatusBadRequest)
+		return
+	}
+
+	var text string
+	switch action.Name {
+	case model.StageApprovalActionApprove:
+		text, err = h.approve(r.Context(), payload.User.ID, deploymentID, stageID)
+	case model.StageApprovalActionReject:
+		text, err = h.reject(r.Context(), payload.User.ID, deploymentID, stageID)
+	default:
+		http.Error(w, "Unsupported action", http.StatusBadRequest)
+		return
+	}
+	if err != nil {
+		h.logger.Error("failed to handle slack approval",
+			zap.String("slack-user", payload.User.ID),
+			zap.String("deployment-id", deploymentID),
+			zap.String("stage-id", stageID),
+			zap.Error(err),
+		)
+		text = "Failed to handle the request due to an internal error, please try again from the PipeCD console"
+	}
+
+	// Slack shows the response as an ephemeral message to the user who clicked the button.
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(slackInteractionResponse{
+		ResponseType:    "ephemeral",
+		ReplaceOriginal: false,
+		Text:            text,
+	})
+}
+
+// verifySignature verifies the request by following
+// https://api.slack.com/authentication/verifying-requests-from-slack
+func (h *slackApprovalHandler) verifySignature(header http.Header, body []byte) error {
+	ts := header.Get(slackTimestampHeader)
+	sig := header.Get(slackSignatureHeader)
+	if ts == "" || sig == "" {
+		return errors.New("missing signature headers")
+	}
+
+	sec, err := strconv.ParseInt(ts, 10, 64)
+	if err != nil {
+		return fmt.Errorf("malformed timestamp: %w", err)
+	}
+	if age := h.nowFunc().Sub(time.Unix(sec, 0)); age > slackRequestMaxAge || age < -slackRequestMaxAge {
+		return fmt.Errorf("request timestamp is out of the allowed range: %v", age)
+	}
+
+	want := makeSlackSignature(h.signingSecret, ts, body)
+	if !hmac.Equal([]byte(want), []byte(sig)) {
+		return errors.New("signature mismatch")
+	}
+	return nil
+}
+
+func makeSlackSignature(secret []byte, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, secret)
+	fmt.Fprintf(mac, "%s:%s:", slackSignatureVersion, timestamp)
+	mac.Write(body)
+	return fmt.Sprintf("%s=%s", slackSignatureVersion, hex.EncodeToString(mac.Sum(nil)))
+}
+
+// approve creates an APPROVE_STAGE command on behalf of the PipeCD account mapped to the given Slack user.
+// The returned text is shown to the Slack user, while the returned error indicates an internal failure.
+func (h *slackApprovalHandler) approve(ctx context.Context, slackUserID, deploymentID, stageID string) (string, error) {
+	deployment, user, text, err := h.validateStageCommand(ctx, "approve", slackUserID, deploymentID, stageID)
+	if deployment == nil || err != nil {
+		return text, err
+	}
+
+	cmd := model.Command{
+		Id:            uuid.New().String(),
+		PipedId:       deployment.PipedId,
+		ApplicationId: deployment.ApplicationId,
+		ProjectId:     deployment.ProjectId,
+		DeploymentId:  deploymentID,
+		StageId:       stageID,
+		Type:          model.Command_APPROVE_STAGE,
+		Commander:     user.Username,
+		ApproveStage: &model.Command_ApproveStage{
+			DeploymentId: deploymentID,
+			StageId:      stageID,
+		},
+	}
+	if err := h.commandAdder.AddCommand(ctx, &cmd); err != nil {
+		return "", err
+	}
+
+	h.logger.Info("approved a stage from slack",
+		zap.String("slack-user", slackUserID),
+		zap.String("username", user.Username),
+		zap.String("deployment-id", deploymentID),
+		zap.String("stage-id", stageID),
+	)
+	return fmt.Sprintf("Approved deployment of %q as %s", deployment.ApplicationName, user.Username), nil
+}
+
+// reject creates a REJECT_STAGE command on behalf of the PipeCD account mapped to the given Slack user.
+// The returned text is shown to the Slack user, while the returned error indicates an internal failure.
+func (h *slackApprovalHandler) reject(ctx context.Context, slackUserID, deploymentID, stageID string) (string, error) {
+	deployment, user, text, err := h.validateStageCommand(ctx, "reject", slackUserID, deploymentID, stageID)
+	if deployment == nil || err != nil {
+		return text, err
+	}
+
+	cmd := model.Command{
+		Id:            uuid.New().String(),
+		PipedId:       deployment.PipedId,
+		ApplicationId: deployment.ApplicationId,
+		ProjectId:     deployment.ProjectId,
+		DeploymentId:  deploymentID,
+		StageId:       stageID,
+		Type:          model.Command_REJECT_STAGE,
+		Commander:     user.Username,
+		RejectStage: &model.Command_RejectStage{
+			DeploymentId: deploymentID,
+			StageId:      stageID,
+			Comment:      slackRejectComment,
+		},
+	}
+	if err := h.commandAdder.AddCommand(ctx, &cmd); err != nil {
+		return "", err
+	}
+
+	h.logger.Info("rejected a stage from slack",
+		zap.String("slack-user", slackUserID),
+		zap.String("username", user.Username),
+		zap.String("deployment-id", deploymentID),
+		zap.String("stage-id", stageID),
+	)
+	return fmt.Sprintf("Rejected deployment of %q as %s", deployment.ApplicationName, user.Username), nil
+}
+
+// validateStageCommand checks whether the PipeCD account mapped to the given Slack user
+// is allowed to approve or reject the given stage.
+// A nil deployment is returned when the request is not allowed, along with the text explaining why.
+func (h *slackApprovalHandler) validateStageCommand(ctx context.Context, verb, slackUserID, deploymentID, stageID string) (*model.Deployment, config.SlackApprovalUser, string, error) {
+	var none config.SlackApprovalUser
+
+	deployment, err := h.deploymentGetter.Get(ctx, deploymentID)
+	if errors.Is(err, datastore.ErrNotFound) {
+		return nil, none, "The deployment was not found", nil
+	}
+	if err != nil {
+		return nil, none, "", err
+	}
+
+	user, ok := h.config.FindUser(deployment.ProjectId, slackUserID)
+	if !ok {
+		return nil, none, "Your Slack account is not linked to any PipeCD account of this project", nil
+	}
+	allowed, err := h.hasPermission(ctx, deployment.ProjectId, user.Roles, model.ProjectRBACResource_DEPLOYMENT, model.ProjectRBACPolicy_UPDATE)
+	if err != nil {
+		return nil, none, "", err
+	}
+	if !allowed {
+		return nil, none, fmt.Sprintf("Your PipeCD account (%s) does not have permission to %s deployments", user.Username, verb), nil
+	}
+
+	stage, ok := deployment.StageMap()[stageID]
+	if !ok {
+		return nil, none, "The stage was not found in the deployment", nil
+	}
+	if stage.Status.IsCompleted() {
+		return nil, none, fmt.Sprintf("Could not %s the stage because it was already completed", verb), nil
+	}
+	if approvers := stage.Metadata["Approvers"]; approvers != "" && !containsString(strings.Split(approvers, ","), user.Username) {
+		return nil, none, fmt.Sprintf("You can't %s this deployment because you (%s) are not in the approver list: %s", verb, user.Username, approvers), nil
+	}
+	return deployment, user, "", nil
+}
+
+func (h *slackApprovalHandler) hasPermission(ctx context.Context, projectID string, roleNames []string, typ model.ProjectRBACResource_ResourceType, action model.ProjectRBACPolicy_Action) (bool, error) {
+	var roles []*model.ProjectRBACRole
+	if _, ok := h.projectsInConfig[projectID]; ok {
+		p := &model.Project{Id: projectID}
+		p.SetBuiltinRBACRoles()
+		roles = p.RbacRoles
+	} else {
+		p, err := h.projectGetter.Get(ctx, projectID)
+		if err != nil {
+			return false, err
+		}
+		roles = p.RbacRoles
+	}
+
+	for _, r := range roles {
+		if !containsString(roleNames, r.Name) {
+			continue
+		}
+		if r.HasPermission(typ, action) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func containsString(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
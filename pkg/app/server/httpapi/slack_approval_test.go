@@ -0,0 +1,271 @@
+// Copyright 2024 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package httpapi
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/pipe-cd/pipecd/pkg/config"
+	"github.com/pipe-cd/pipecd/pkg/datastore"
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+type fakeDeploymentGetter map[string]*model.Deployment
+
+func (f fakeDeploymentGetter) Get(_ context.Context, id string) (*model.Deployment, error) {
+	d, ok := f[id]
+	if !ok {
+		return nil, datastore.ErrNotFound
+	}
+	return d, nil
+}
+
+type fakeCommandAdder struct {
+	commands []*model.Command
+}
+
+func (f *fakeCommandAdder) AddCommand(_ context.Context, cmd *model.Command) error {
+	f.commands = append(f.commands, cmd)
+	return nil
+}
+
+func newTestSlackApprovalHandler(now time.Time, commands *fakeCommandAdder) *slackApprovalHandler {
+	return &slackApprovalHandler{
+		signingSecret: []byte("secret"),
+		config: &config.ControlPlaneSlackApproval{
+			Users: []config.SlackApprovalUser{
+				{SlackUserID: "U-EDITOR", ProjectID: "project", Username: "editor", Roles: []string{"Editor"}},
+				{SlackUserID: "U-VIEWER", ProjectID: "project", Username: "viewer", Roles: []string{"Viewer"}},
+				{SlackUserID: "U-OTHER", ProjectID: "project", Username: "other", Roles: []string{"Admin"}},
+			},
+		},
+		deploymentGetter: fakeDeploymentGetter{
+			"deployment": {
+				Id:              "deployment",
+				ApplicationName: "app",
+				ProjectId:       "project",
+				Stages: []*model.PipelineStage{
+					{Id: "approval", Status: model.StageStatus_STAGE_RUNNING, Metadata: map[string]string{"Approvers": "editor,viewer"}},
+					{Id: "completed", Status: model.StageStatus_STAGE_SUCCESS},
+				},
+			},
+		},
+		commandAdder:     commands,
+		projectsInConfig: map[string]config.ControlPlaneProject{"project": {ID: "project"}},
+		nowFunc:          func() time.Time { return now },
+		logger:           zap.NewNop(),
+	}
+}
+
+func makeSlackApprovalRequest(t *testing.T, ts time.Time, secret, slackUser, action, value string) *http.Request {
+	payload, err := json.Marshal(map[string]interface{}{
+		"type":        "interactive_message",
+		"callback_id": model.StageApprovalCallbackID,
+		"actions":     []map[string]string{{"name": action, "value": value}},
+		"user":        map[string]string{"id": slackUser},
+	})
+	require.NoError(t, err)
+
+	body := url.Values{slackPayloadFormKey: {string(payload)}}.Encode()
+	timestamp := fmt.Sprint(ts.Unix())
+	req := httptest.NewRequest(http.MethodPost, slackApprovalPath, strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set(slackTimestampHeader, timestamp)
+	req.Header.Set(slackSignatureHeader, makeSlackSignature([]byte(secret), timestamp, []byte(body)))
+	return req
+}
+
+func TestSlackApprovalHandler(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	testcases := []struct {
+		name         string
+		requestTime  time.Time
+		secret       string
+		slackUser    string
+		action       string
+		value        string
+		wantStatus   int
+		wantText     string
+		wantCommands int
+	}{
+		{
+			name:         "approved",
+			requestTime:  now,
+			secret:       "secret",
+			slackUser:    "U-EDITOR",
+			value:        "deployment:approval",
+			wantStatus:   http.StatusOK,
+			wantText:     `Approved deployment of "app" as editor`,
+			wantCommands: 1,
+		},
+		{
+			name:         "rejected",
+			requestTime:  now,
+			secret:       "secret",
+			slackUser:    "U-EDITOR",
+			action:       model.StageApprovalActionReject,
+			value:        "deployment:approval",
+			wantStatus:   http.StatusOK,
+			wantText:     `Rejected deployment of "app" as editor`,
+			wantCommands: 1,
+		},
+		{
+			name:        "no permission to reject",
+			requestTime: now,
+			secret:      "secret",
+			slackUser:   "U-VIEWER",
+			action:      model.StageApprovalActionReject,
+			value:       "deployment:approval",
+			wantStatus:  http.StatusOK,
+			wantText:    "Your PipeCD account (viewer) does not have permission to reject deployments",
+		},
+		{
+			name:        "unsupported action",
+			requestTime: now,
+			secret:      "secret",
+			slackUser:   "U-EDITOR",
+			action:      "unknown",
+			value:       "deployment:approval",
+			wantStatus:  http.StatusBadRequest,
+		},
+		{
+			name:        "invalid signature",
+			requestTime: now,
+			secret:      "wrong-secret",
+			slackUser:   "U-EDITOR",
+			value:       "deployment:approval",
+			wantStatus:  http.StatusUnauthorized,
+		},
+		{
+			name:        "too old request",
+			requestTime: now.Add(-10 * time.Minute),
+			secret:      "secret",
+			slackUser:   "U-EDITOR",
+			value:       "deployment:approval",
+			wantStatus:  http.StatusUnauthorized,
+		},
+		{
+			name:        "unlinked slack user",
+			requestTime: now,
+			secret:      "secret",
+			slackUser:   "U-UNKNOWN",
+			value:       "deployment:approval",
+			wantStatus:  http.StatusOK,
+			wantText:    "Your Slack account is not linked to any PipeCD account of this project",
+		},
+		{
+			name:        "no permission",
+			requestTime: now,
+			secret:      "secret",
+			slackUser:   "U-VIEWER",
+			value:       "deployment:approval",
+			wantStatus:  http.StatusOK,
+			wantText:    "Your PipeCD account (viewer) does not have permission to approve deployments",
+		},
+		{
+			name:        "not in the approver list",
+			requestTime: now,
+			secret:      "secret",
+			slackUser:   "U-OTHER",
+			value:       "deployment:approval",
+			wantStatus:  http.StatusOK,
+			wantText:    "You can't approve this deployment because you (other) are not in the approver list: editor,viewer",
+		},
+		{
+			name:        "completed stage",
+			requestTime: now,
+			secret:      "secret",
+			slackUser:   "U-EDITOR",
+			value:       "deployment:completed",
+			wantStatus:  http.StatusOK,
+			wantText:    "Could not approve the stage because it was already completed",
+		},
+		{
+			name:        "deployment not found",
+			requestTime: now,
+			secret:      "secret",
+			slackUser:   "U-EDITOR",
+			value:       "unknown:approval",
+			wantStatus:  http.StatusOK,
+			wantText:    "The deployment was not found",
+		},
+		{
+			name:        "malformed value",
+			requestTime: now,
+			secret:      "secret",
+			slackUser:   "U-EDITOR",
+			value:       "deployment",
+			wantStatus:  http.StatusBadRequest,
+		},
+	}
+
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			commands := &fakeCommandAdder{}
+			h := newTestSlackApprovalHandler(now, commands)
+
+			action := tc.action
+			if action == "" {
+				action = model.StageApprovalActionApprove
+			}
+			rec := httptest.NewRecorder()
+			h.handle(rec, makeSlackApprovalRequest(t, tc.requestTime, tc.secret, tc.slackUser, action, tc.value))
+
+			require.Equal(t, tc.wantStatus, rec.Code)
+			require.Len(t, commands.commands, tc.wantCommands)
+			if tc.wantStatus != http.StatusOK {
+				return
+			}
+
+			var resp slackInteractionResponse
+			require.NoError(t, json.NewDecoder(rec.Body).Decode(&resp))
+			assert.Equal(t, "ephemeral", resp.ResponseType)
+			assert.Equal(t, tc.wantText, resp.Text)
+
+			if tc.wantCommands == 0 {
+				return
+			}
+			cmd := commands.commands[0]
+			assert.Equal(t, "editor", cmd.Commander)
+			switch action {
+			case model.StageApprovalActionApprove:
+				assert.Equal(t, model.Command_APPROVE_STAGE, cmd.Type)
+				assert.Equal(t, "deployment", cmd.ApproveStage.DeploymentId)
+				assert.Equal(t, "approval", cmd.ApproveStage.StageId)
+			case model.StageApprovalActionReject:
+				assert.Equal(t, model.Command_REJECT_STAGE, cmd.Type)
+				assert.Equal(t, "deployment", cmd.RejectStage.DeploymentId)
+				assert.Equal(t, "approval", cmd.RejectStage.StageId)
+				assert.Equal(t, slackRejectComment, cmd.RejectStage.Comment)
+			}
+		})
+	}
+}
@@ -34,6 +34,7 @@ type APIServiceClient interface {
 	UpdateApplicationDeployTargets(ctx context.Context, in *UpdateApplicationDeployTargetsRequest, opts ...grpc.CallOption) (*UpdateApplicationDeployTargetsResponse, error)
 	GetDeployment(ctx context.Context, in *GetDeploymentRequest, opts ...grpc.CallOption) (*GetDeploymentResponse, error)
 	ListDeployments(ctx context.Context, in *ListDeploymentsRequest, opts ...grpc.CallOption) (*ListDeploymentsResponse, error)
+	RejectStage(ctx context.Context, in *RejectStageRequest, opts ...grpc.CallOption) (*RejectStageResponse, error)
 	GetCommand(ctx context.Context, in *GetCommandRequest, opts ...grpc.CallOption) (*GetCommandResponse, error)
 	GetPiped(ctx context.Context, in *GetPipedRequest, opts ...grpc.CallOption) (*GetPipedResponse, error)
 	RegisterPiped(ctx context.Context, in *RegisterPipedRequest, opts ...grpc.CallOption) (*RegisterPipedResponse, error)
@@ -45,6 +46,7 @@ type APIServiceClient interface {
 	GetPlanPreviewResults(ctx context.Context, in *GetPlanPreviewResultsRequest, opts ...grpc.CallOption) (*GetPlanPreviewResultsResponse, error)
 	Encrypt(ctx context.Context, in *EncryptRequest, opts ...grpc.CallOption) (*EncryptResponse, error)
 	ListStageLogs(ctx context.Context, in *ListStageLogsRequest, opts ...grpc.CallOption) (*ListStageLogsResponse, error)
+	GetAnalysisReport(ctx context.Context, in *GetAnalysisReportRequest, opts ...grpc.CallOption) (*GetAnalysisReportResponse, error)
 }
 
 type aPIServiceClient struct {
@@ -163,6 +165,15 @@ func (c *aPIServiceClient) ListDeployments(ctx context.Context, in *ListDeployme
 	return out, nil
 }
 
+func (c *aPIServiceClient) RejectStage(ctx context.Context, in *RejectStageRequest, opts ...grpc.CallOption) (*RejectStageResponse, error) {
+	out := new(RejectStageResponse)
+	err := c.cc.Invoke(ctx, "/grpc.service.apiservice.APIService/RejectStage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *aPIServiceClient) GetCommand(ctx context.Context, in *GetCommandRequest, opts ...grpc.CallOption) (*GetCommandResponse, error) {
 	out := new(GetCommandResponse)
 	err := c.cc.Invoke(ctx, "/grpc.service.apiservice.APIService/GetCommand", in, out, opts...)
@@ -262,6 +273,15 @@ func (c *aPIServiceClient) ListStageLogs(ctx context.Context, in *ListStageLogsR
 	return out, nil
 }
 
+func (c *aPIServiceClient) GetAnalysisReport(ctx context.Context, in *GetAnalysisReportRequest, opts ...grpc.CallOption) (*GetAnalysisReportResponse, error) {
+	out := new(GetAnalysisReportResponse)
+	err := c.cc.Invoke(ctx, "/grpc.service.apiservice.APIService/GetAnalysisReport", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // APIServiceServer is the server API for APIService service.
 // All implementations must embed UnimplementedAPIServiceServer
 // for forward compatibility
@@ -278,6 +298,7 @@ type APIServiceServer interface {
 	UpdateApplicationDeployTargets(context.Context, *UpdateApplicationDeployTargetsRequest) (*UpdateApplicationDeployTargetsResponse, error)
 	GetDeployment(context.Context, *GetDeploymentRequest) (*GetDeploymentResponse, error)
 	ListDeployments(context.Context, *ListDeploymentsRequest) (*ListDeploymentsResponse, error)
+	RejectStage(context.Context, *RejectStageRequest) (*RejectStageResponse, error)
 	GetCommand(context.Context, *GetCommandRequest) (*GetCommandResponse, error)
 	GetPiped(context.Context, *GetPipedRequest) (*GetPipedResponse, error)
 	RegisterPiped(context.Context, *RegisterPipedRequest) (*RegisterPipedResponse, error)
@@ -289,6 +310,7 @@ type APIServiceServer interface {
 	GetPlanPreviewResults(context.Context, *GetPlanPreviewResultsRequest) (*GetPlanPreviewResultsResponse, error)
 	Encrypt(context.Context, *EncryptRequest) (*EncryptResponse, error)
 	ListStageLogs(context.Context, *ListStageLogsRequest) (*ListStageLogsResponse, error)
+	GetAnalysisReport(context.Context, *GetAnalysisReportRequest) (*GetAnalysisReportResponse, error)
 	mustEmbedUnimplementedAPIServiceServer()
 }
 
@@ -332,6 +354,9 @@ func (UnimplementedAPIServiceServer) GetDeployment(context.Context, *GetDeployme
 func (UnimplementedAPIServiceServer) ListDeployments(context.Context, *ListDeploymentsRequest) (*ListDeploymentsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListDeployments not implemented")
 }
+func (UnimplementedAPIServiceServer) RejectStage(context.Context, *RejectStageRequest) (*RejectStageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RejectStage not implemented")
+}
 func (UnimplementedAPIServiceServer) GetCommand(context.Context, *GetCommandRequest) (*GetCommandResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetCommand not implemented")
 }
@@ -365,6 +390,9 @@ func (UnimplementedAPIServiceServer) Encrypt(context.Context, *EncryptRequest) (
 func (UnimplementedAPIServiceServer) ListStageLogs(context.Context, *ListStageLogsRequest) (*ListStageLogsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListStageLogs not implemented")
 }
+func (UnimplementedAPIServiceServer) GetAnalysisReport(context.Context, *GetAnalysisReportRequest) (*GetAnalysisReportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAnalysisReport not implemented")
+}
 func (UnimplementedAPIServiceServer) mustEmbedUnimplementedAPIServiceServer() {}
 
 // UnsafeAPIServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -594,6 +622,24 @@ func _APIService_ListDeployments_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _APIService_RejectStage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RejectStageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServiceServer).RejectStage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpc.service.apiservice.APIService/RejectStage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServiceServer).RejectStage(ctx, req.(*RejectStageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _APIService_GetCommand_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetCommandRequest)
 	if err := dec(in); err != nil {
@@ -792,6 +838,24 @@ func _APIService_ListStageLogs_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _APIService_GetAnalysisReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAnalysisReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServiceServer).GetAnalysisReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpc.service.apiservice.APIService/GetAnalysisReport",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServiceServer).GetAnalysisReport(ctx, req.(*GetAnalysisReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // APIService_ServiceDesc is the grpc.ServiceDesc for APIService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -847,6 +911,10 @@ var APIService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListDeployments",
 			Handler:    _APIService_ListDeployments_Handler,
 		},
+		{
+			MethodName: "RejectStage",
+			Handler:    _APIService_RejectStage_Handler,
+		},
 		{
 			MethodName: "GetCommand",
 			Handler:    _APIService_GetCommand_Handler,
@@ -891,6 +959,10 @@ var APIService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListStageLogs",
 			Handler:    _APIService_ListStageLogs_Handler,
 		},
+		{
+			MethodName: "GetAnalysisReport",
+			Handler:    _APIService_GetAnalysisReport_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "pkg/app/server/service/apiservice/service.proto",
@@ -553,6 +553,8 @@ func (m *ReportPipedMetaResponse) validate(all bool) error {
 
 	// no validation rules for WebBaseUrl
 
+	// no validation rules for ProjectDeploymentWindows
+
 	if len(errors) > 0 {
 		return ReportPipedMetaResponseMultiError(errors)
 	}
@@ -2990,7 +2992,7 @@ func (m *ReportDeploymentStatusChangedRequest) validate(all bool) error {
 	if _, ok := _ReportDeploymentStatusChangedRequest_Status_InLookup[m.GetStatus()]; !ok {
 		err := ReportDeploymentStatusChangedRequestValidationError{
 			field:  "Status",
-			reason: "value must be in list [2 3]",
+			reason: "value must be in list [0 2 3]",
 		}
 		if !all {
 			return err
@@ -3087,6 +3089,7 @@ var _ interface {
 } = ReportDeploymentStatusChangedRequestValidationError{}
 
 var _ReportDeploymentStatusChangedRequest_Status_InLookup = map[model.DeploymentStatus]struct{}{
+	0: {},
 	2: {},
 	3: {},
 }
@@ -6882,31 +6885,42 @@ var _ interface {
 	ErrorName() string
 } = ReportEventStatusesResponseValidationError{}
 
-// Validate checks the field values on GetLatestAnalysisResultRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on RegisterEventRequest with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetLatestAnalysisResultRequest) Validate() error {
+func (m *RegisterEventRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetLatestAnalysisResultRequest with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the result is a list of violation errors wrapped in
-// GetLatestAnalysisResultRequestMultiError, or nil if none found.
-func (m *GetLatestAnalysisResultRequest) ValidateAll() error {
+// ValidateAll checks the field values on RegisterEventRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RegisterEventRequestMultiError, or nil if none found.
+func (m *RegisterEventRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetLatestAnalysisResultRequest) validate(all bool) error {
+func (m *RegisterEventRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if utf8.RuneCountInString(m.GetApplicationId()) < 1 {
-		err := GetLatestAnalysisResultRequestValidationError{
-			field:  "ApplicationId",
+	if utf8.RuneCountInString(m.GetName()) < 1 {
+		err := RegisterEventRequestValidationError{
+			field:  "Name",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetData()) < 1 {
+		err := RegisterEventRequestValidationError{
+			field:  "Data",
 			reason: "value length must be at least 1 runes",
 		}
 		if !all {
@@ -6915,20 +6929,22 @@ func (m *GetLatestAnalysisResultRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
+	// no validation rules for Labels
+
 	if len(errors) > 0 {
-		return GetLatestAnalysisResultRequestMultiError(errors)
+		return RegisterEventRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetLatestAnalysisResultRequestMultiError is an error wrapping multiple
-// validation errors returned by GetLatestAnalysisResultRequest.ValidateAll()
-// if the designated constraints aren't met.
-type GetLatestAnalysisResultRequestMultiError []error
+// RegisterEventRequestMultiError is an error wrapping multiple validation
+// errors returned by RegisterEventRequest.ValidateAll() if the designated
+// constraints aren't met.
+type RegisterEventRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetLatestAnalysisResultRequestMultiError) Error() string {
+func (m RegisterEventRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -6937,12 +6953,11 @@ func (m GetLatestAnalysisResultRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetLatestAnalysisResultRequestMultiError) AllErrors() []error { return m }
+func (m RegisterEventRequestMultiError) AllErrors() []error { return m }
 
-// GetLatestAnalysisResultRequestValidationError is the validation error
-// returned by GetLatestAnalysisResultRequest.Validate if the designated
-// constraints aren't met.
-type GetLatestAnalysisResultRequestValidationError struct {
+// RegisterEventRequestValidationError is the validation error returned by
+// RegisterEventRequest.Validate if the designated constraints aren't met.
+type RegisterEventRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -6950,24 +6965,24 @@ type GetLatestAnalysisResultRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetLatestAnalysisResultRequestValidationError) Field() string { return e.field }
+func (e RegisterEventRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetLatestAnalysisResultRequestValidationError) Reason() string { return e.reason }
+func (e RegisterEventRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetLatestAnalysisResultRequestValidationError) Cause() error { return e.cause }
+func (e RegisterEventRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetLatestAnalysisResultRequestValidationError) Key() bool { return e.key }
+func (e RegisterEventRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetLatestAnalysisResultRequestValidationError) ErrorName() string {
-	return "GetLatestAnalysisResultRequestValidationError"
+func (e RegisterEventRequestValidationError) ErrorName() string {
+	return "RegisterEventRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetLatestAnalysisResultRequestValidationError) Error() string {
+func (e RegisterEventRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -6979,14 +6994,14 @@ func (e GetLatestAnalysisResultRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetLatestAnalysisResultRequest.%s: %s%s",
+		"invalid %sRegisterEventRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetLatestAnalysisResultRequestValidationError{}
+var _ error = RegisterEventRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -6994,84 +7009,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetLatestAnalysisResultRequestValidationError{}
+} = RegisterEventRequestValidationError{}
 
-// Validate checks the field values on GetLatestAnalysisResultResponse with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on RegisterEventResponse with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetLatestAnalysisResultResponse) Validate() error {
+func (m *RegisterEventResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetLatestAnalysisResultResponse with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the result is a list of violation errors wrapped in
-// GetLatestAnalysisResultResponseMultiError, or nil if none found.
-func (m *GetLatestAnalysisResultResponse) ValidateAll() error {
+// ValidateAll checks the field values on RegisterEventResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RegisterEventResponseMultiError, or nil if none found.
+func (m *RegisterEventResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetLatestAnalysisResultResponse) validate(all bool) error {
+func (m *RegisterEventResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if m.GetAnalysisResult() == nil {
-		err := GetLatestAnalysisResultResponseValidationError{
-			field:  "AnalysisResult",
-			reason: "value is required",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if all {
-		switch v := interface{}(m.GetAnalysisResult()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, GetLatestAnalysisResultResponseValidationError{
-					field:  "AnalysisResult",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, GetLatestAnalysisResultResponseValidationError{
-					field:  "AnalysisResult",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetAnalysisResult()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return GetLatestAnalysisResultResponseValidationError{
-				field:  "AnalysisResult",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for EventId
 
 	if len(errors) > 0 {
-		return GetLatestAnalysisResultResponseMultiError(errors)
+		return RegisterEventResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetLatestAnalysisResultResponseMultiError is an error wrapping multiple
-// validation errors returned by GetLatestAnalysisResultResponse.ValidateAll()
-// if the designated constraints aren't met.
-type GetLatestAnalysisResultResponseMultiError []error
+// RegisterEventResponseMultiError is an error wrapping multiple validation
+// errors returned by RegisterEventResponse.ValidateAll() if the designated
+// constraints aren't met.
+type RegisterEventResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetLatestAnalysisResultResponseMultiError) Error() string {
+func (m RegisterEventResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -7080,12 +7057,11 @@ func (m GetLatestAnalysisResultResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetLatestAnalysisResultResponseMultiError) AllErrors() []error { return m }
+func (m RegisterEventResponseMultiError) AllErrors() []error { return m }
 
-// GetLatestAnalysisResultResponseValidationError is the validation error
-// returned by GetLatestAnalysisResultResponse.Validate if the designated
-// constraints aren't met.
-type GetLatestAnalysisResultResponseValidationError struct {
+// RegisterEventResponseValidationError is the validation error returned by
+// RegisterEventResponse.Validate if the designated constraints aren't met.
+type RegisterEventResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -7093,24 +7069,24 @@ type GetLatestAnalysisResultResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetLatestAnalysisResultResponseValidationError) Field() string { return e.field }
+func (e RegisterEventResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetLatestAnalysisResultResponseValidationError) Reason() string { return e.reason }
+func (e RegisterEventResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetLatestAnalysisResultResponseValidationError) Cause() error { return e.cause }
+func (e RegisterEventResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetLatestAnalysisResultResponseValidationError) Key() bool { return e.key }
+func (e RegisterEventResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetLatestAnalysisResultResponseValidationError) ErrorName() string {
-	return "GetLatestAnalysisResultResponseValidationError"
+func (e RegisterEventResponseValidationError) ErrorName() string {
+	return "RegisterEventResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetLatestAnalysisResultResponseValidationError) Error() string {
+func (e RegisterEventResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -7122,14 +7098,14 @@ func (e GetLatestAnalysisResultResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetLatestAnalysisResultResponse.%s: %s%s",
+		"invalid %sRegisterEventResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetLatestAnalysisResultResponseValidationError{}
+var _ error = RegisterEventResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -7137,24 +7113,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetLatestAnalysisResultResponseValidationError{}
+} = RegisterEventResponseValidationError{}
 
-// Validate checks the field values on PutLatestAnalysisResultRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on SyncApplicationRequest with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *PutLatestAnalysisResultRequest) Validate() error {
+func (m *SyncApplicationRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on PutLatestAnalysisResultRequest with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the result is a list of violation errors wrapped in
-// PutLatestAnalysisResultRequestMultiError, or nil if none found.
-func (m *PutLatestAnalysisResultRequest) ValidateAll() error {
+// ValidateAll checks the field values on SyncApplicationRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SyncApplicationRequestMultiError, or nil if none found.
+func (m *SyncApplicationRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *PutLatestAnalysisResultRequest) validate(all bool) error {
+func (m *SyncApplicationRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -7162,7 +7138,7 @@ func (m *PutLatestAnalysisResultRequest) validate(all bool) error {
 	var errors []error
 
 	if utf8.RuneCountInString(m.GetApplicationId()) < 1 {
-		err := PutLatestAnalysisResultRequestValidationError{
+		err := SyncApplicationRequestValidationError{
 			field:  "ApplicationId",
 			reason: "value length must be at least 1 runes",
 		}
@@ -7172,10 +7148,10 @@ func (m *PutLatestAnalysisResultRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if m.GetAnalysisResult() == nil {
-		err := PutLatestAnalysisResultRequestValidationError{
-			field:  "AnalysisResult",
-			reason: "value is required",
+	if _, ok := model.SyncStrategy_name[int32(m.GetSyncStrategy())]; !ok {
+		err := SyncApplicationRequestValidationError{
+			field:  "SyncStrategy",
+			reason: "value must be one of the defined enum values",
 		}
 		if !all {
 			return err
@@ -7183,49 +7159,20 @@ func (m *PutLatestAnalysisResultRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if all {
-		switch v := interface{}(m.GetAnalysisResult()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, PutLatestAnalysisResultRequestValidationError{
-					field:  "AnalysisResult",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, PutLatestAnalysisResultRequestValidationError{
-					field:  "AnalysisResult",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetAnalysisResult()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return PutLatestAnalysisResultRequestValidationError{
-				field:  "AnalysisResult",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
-
 	if len(errors) > 0 {
-		return PutLatestAnalysisResultRequestMultiError(errors)
+		return SyncApplicationRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// PutLatestAnalysisResultRequestMultiError is an error wrapping multiple
-// validation errors returned by PutLatestAnalysisResultRequest.ValidateAll()
-// if the designated constraints aren't met.
-type PutLatestAnalysisResultRequestMultiError []error
+// SyncApplicationRequestMultiError is an error wrapping multiple validation
+// errors returned by SyncApplicationRequest.ValidateAll() if the designated
+// constraints aren't met.
+type SyncApplicationRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m PutLatestAnalysisResultRequestMultiError) Error() string {
+func (m SyncApplicationRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -7234,12 +7181,11 @@ func (m PutLatestAnalysisResultRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m PutLatestAnalysisResultRequestMultiError) AllErrors() []error { return m }
+func (m SyncApplicationRequestMultiError) AllErrors() []error { return m }
 
-// PutLatestAnalysisResultRequestValidationError is the validation error
-// returned by PutLatestAnalysisResultRequest.Validate if the designated
-// constraints aren't met.
-type PutLatestAnalysisResultRequestValidationError struct {
+// SyncApplicationRequestValidationError is the validation error returned by
+// SyncApplicationRequest.Validate if the designated constraints aren't met.
+type SyncApplicationRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -7247,24 +7193,24 @@ type PutLatestAnalysisResultRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e PutLatestAnalysisResultRequestValidationError) Field() string { return e.field }
+func (e SyncApplicationRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e PutLatestAnalysisResultRequestValidationError) Reason() string { return e.reason }
+func (e SyncApplicationRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e PutLatestAnalysisResultRequestValidationError) Cause() error { return e.cause }
+func (e SyncApplicationRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e PutLatestAnalysisResultRequestValidationError) Key() bool { return e.key }
+func (e SyncApplicationRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e PutLatestAnalysisResultRequestValidationError) ErrorName() string {
-	return "PutLatestAnalysisResultRequestValidationError"
+func (e SyncApplicationRequestValidationError) ErrorName() string {
+	return "SyncApplicationRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e PutLatestAnalysisResultRequestValidationError) Error() string {
+func (e SyncApplicationRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -7276,14 +7222,14 @@ func (e PutLatestAnalysisResultRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sPutLatestAnalysisResultRequest.%s: %s%s",
+		"invalid %sSyncApplicationRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = PutLatestAnalysisResultRequestValidationError{}
+var _ error = SyncApplicationRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -7291,44 +7237,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = PutLatestAnalysisResultRequestValidationError{}
+} = SyncApplicationRequestValidationError{}
 
-// Validate checks the field values on PutLatestAnalysisResultResponse with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on SyncApplicationResponse with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *PutLatestAnalysisResultResponse) Validate() error {
+func (m *SyncApplicationResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on PutLatestAnalysisResultResponse with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the result is a list of violation errors wrapped in
-// PutLatestAnalysisResultResponseMultiError, or nil if none found.
-func (m *PutLatestAnalysisResultResponse) ValidateAll() error {
+// ValidateAll checks the field values on SyncApplicationResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SyncApplicationResponseMultiError, or nil if none found.
+func (m *SyncApplicationResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *PutLatestAnalysisResultResponse) validate(all bool) error {
+func (m *SyncApplicationResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for CommandId
+
 	if len(errors) > 0 {
-		return PutLatestAnalysisResultResponseMultiError(errors)
+		return SyncApplicationResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// PutLatestAnalysisResultResponseMultiError is an error wrapping multiple
-// validation errors returned by PutLatestAnalysisResultResponse.ValidateAll()
-// if the designated constraints aren't met.
-type PutLatestAnalysisResultResponseMultiError []error
+// SyncApplicationResponseMultiError is an error wrapping multiple validation
+// errors returned by SyncApplicationResponse.ValidateAll() if the designated
+// constraints aren't met.
+type SyncApplicationResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m PutLatestAnalysisResultResponseMultiError) Error() string {
+func (m SyncApplicationResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -7337,12 +7285,11 @@ func (m PutLatestAnalysisResultResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m PutLatestAnalysisResultResponseMultiError) AllErrors() []error { return m }
+func (m SyncApplicationResponseMultiError) AllErrors() []error { return m }
 
-// PutLatestAnalysisResultResponseValidationError is the validation error
-// returned by PutLatestAnalysisResultResponse.Validate if the designated
-// constraints aren't met.
-type PutLatestAnalysisResultResponseValidationError struct {
+// SyncApplicationResponseValidationError is the validation error returned by
+// SyncApplicationResponse.Validate if the designated constraints aren't met.
+type SyncApplicationResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -7350,24 +7297,24 @@ type PutLatestAnalysisResultResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e PutLatestAnalysisResultResponseValidationError) Field() string { return e.field }
+func (e SyncApplicationResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e PutLatestAnalysisResultResponseValidationError) Reason() string { return e.reason }
+func (e SyncApplicationResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e PutLatestAnalysisResultResponseValidationError) Cause() error { return e.cause }
+func (e SyncApplicationResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e PutLatestAnalysisResultResponseValidationError) Key() bool { return e.key }
+func (e SyncApplicationResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e PutLatestAnalysisResultResponseValidationError) ErrorName() string {
-	return "PutLatestAnalysisResultResponseValidationError"
+func (e SyncApplicationResponseValidationError) ErrorName() string {
+	return "SyncApplicationResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e PutLatestAnalysisResultResponseValidationError) Error() string {
+func (e SyncApplicationResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -7379,14 +7326,14 @@ func (e PutLatestAnalysisResultResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sPutLatestAnalysisResultResponse.%s: %s%s",
+		"invalid %sSyncApplicationResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = PutLatestAnalysisResultResponseValidationError{}
+var _ error = SyncApplicationResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -7394,32 +7341,790 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = PutLatestAnalysisResultResponseValidationError{}
+} = SyncApplicationResponseValidationError{}
 
-// Validate checks the field values on GetDesiredVersionRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on GetLatestAnalysisResultRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetDesiredVersionRequest) Validate() error {
+func (m *GetLatestAnalysisResultRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetDesiredVersionRequest with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// GetDesiredVersionRequestMultiError, or nil if none found.
-func (m *GetDesiredVersionRequest) ValidateAll() error {
+// ValidateAll checks the field values on GetLatestAnalysisResultRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// GetLatestAnalysisResultRequestMultiError, or nil if none found.
+func (m *GetLatestAnalysisResultRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetDesiredVersionRequest) validate(all bool) error {
+func (m *GetLatestAnalysisResultRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if len(errors) > 0 {
-		return GetDesiredVersionRequestMultiError(errors)
+	if utf8.RuneCountInString(m.GetApplicationId()) < 1 {
+		err := GetLatestAnalysisResultRequestValidationError{
+			field:  "ApplicationId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetLatestAnalysisResultRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetLatestAnalysisResultRequestMultiError is an error wrapping multiple
+// validation errors returned by GetLatestAnalysisResultRequest.ValidateAll()
+// if the designated constraints aren't met.
+type GetLatestAnalysisResultRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetLatestAnalysisResultRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetLatestAnalysisResultRequestMultiError) AllErrors() []error { return m }
+
+// GetLatestAnalysisResultRequestValidationError is the validation error
+// returned by GetLatestAnalysisResultRequest.Validate if the designated
+// constraints aren't met.
+type GetLatestAnalysisResultRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetLatestAnalysisResultRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetLatestAnalysisResultRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetLatestAnalysisResultRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetLatestAnalysisResultRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetLatestAnalysisResultRequestValidationError) ErrorName() string {
+	return "GetLatestAnalysisResultRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetLatestAnalysisResultRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetLatestAnalysisResultRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetLatestAnalysisResultRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetLatestAnalysisResultRequestValidationError{}
+
+// Validate checks the field values on GetLatestAnalysisResultResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetLatestAnalysisResultResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetLatestAnalysisResultResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// GetLatestAnalysisResultResponseMultiError, or nil if none found.
+func (m *GetLatestAnalysisResultResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetLatestAnalysisResultResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetAnalysisResult() == nil {
+		err := GetLatestAnalysisResultResponseValidationError{
+			field:  "AnalysisResult",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetAnalysisResult()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetLatestAnalysisResultResponseValidationError{
+					field:  "AnalysisResult",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetLatestAnalysisResultResponseValidationError{
+					field:  "AnalysisResult",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAnalysisResult()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetLatestAnalysisResultResponseValidationError{
+				field:  "AnalysisResult",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetLatestAnalysisResultResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetLatestAnalysisResultResponseMultiError is an error wrapping multiple
+// validation errors returned by GetLatestAnalysisResultResponse.ValidateAll()
+// if the designated constraints aren't met.
+type GetLatestAnalysisResultResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetLatestAnalysisResultResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetLatestAnalysisResultResponseMultiError) AllErrors() []error { return m }
+
+// GetLatestAnalysisResultResponseValidationError is the validation error
+// returned by GetLatestAnalysisResultResponse.Validate if the designated
+// constraints aren't met.
+type GetLatestAnalysisResultResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetLatestAnalysisResultResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetLatestAnalysisResultResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetLatestAnalysisResultResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetLatestAnalysisResultResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetLatestAnalysisResultResponseValidationError) ErrorName() string {
+	return "GetLatestAnalysisResultResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetLatestAnalysisResultResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetLatestAnalysisResultResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetLatestAnalysisResultResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetLatestAnalysisResultResponseValidationError{}
+
+// Validate checks the field values on PutLatestAnalysisResultRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PutLatestAnalysisResultRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PutLatestAnalysisResultRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// PutLatestAnalysisResultRequestMultiError, or nil if none found.
+func (m *PutLatestAnalysisResultRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PutLatestAnalysisResultRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetApplicationId()) < 1 {
+		err := PutLatestAnalysisResultRequestValidationError{
+			field:  "ApplicationId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetAnalysisResult() == nil {
+		err := PutLatestAnalysisResultRequestValidationError{
+			field:  "AnalysisResult",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetAnalysisResult()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PutLatestAnalysisResultRequestValidationError{
+					field:  "AnalysisResult",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PutLatestAnalysisResultRequestValidationError{
+					field:  "AnalysisResult",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAnalysisResult()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PutLatestAnalysisResultRequestValidationError{
+				field:  "AnalysisResult",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return PutLatestAnalysisResultRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// PutLatestAnalysisResultRequestMultiError is an error wrapping multiple
+// validation errors returned by PutLatestAnalysisResultRequest.ValidateAll()
+// if the designated constraints aren't met.
+type PutLatestAnalysisResultRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PutLatestAnalysisResultRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PutLatestAnalysisResultRequestMultiError) AllErrors() []error { return m }
+
+// PutLatestAnalysisResultRequestValidationError is the validation error
+// returned by PutLatestAnalysisResultRequest.Validate if the designated
+// constraints aren't met.
+type PutLatestAnalysisResultRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PutLatestAnalysisResultRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PutLatestAnalysisResultRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PutLatestAnalysisResultRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PutLatestAnalysisResultRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PutLatestAnalysisResultRequestValidationError) ErrorName() string {
+	return "PutLatestAnalysisResultRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PutLatestAnalysisResultRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPutLatestAnalysisResultRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PutLatestAnalysisResultRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PutLatestAnalysisResultRequestValidationError{}
+
+// Validate checks the field values on PutLatestAnalysisResultResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PutLatestAnalysisResultResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PutLatestAnalysisResultResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// PutLatestAnalysisResultResponseMultiError, or nil if none found.
+func (m *PutLatestAnalysisResultResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PutLatestAnalysisResultResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return PutLatestAnalysisResultResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// PutLatestAnalysisResultResponseMultiError is an error wrapping multiple
+// validation errors returned by PutLatestAnalysisResultResponse.ValidateAll()
+// if the designated constraints aren't met.
+type PutLatestAnalysisResultResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PutLatestAnalysisResultResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PutLatestAnalysisResultResponseMultiError) AllErrors() []error { return m }
+
+// PutLatestAnalysisResultResponseValidationError is the validation error
+// returned by PutLatestAnalysisResultResponse.Validate if the designated
+// constraints aren't met.
+type PutLatestAnalysisResultResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PutLatestAnalysisResultResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PutLatestAnalysisResultResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PutLatestAnalysisResultResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PutLatestAnalysisResultResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PutLatestAnalysisResultResponseValidationError) ErrorName() string {
+	return "PutLatestAnalysisResultResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PutLatestAnalysisResultResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPutLatestAnalysisResultResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PutLatestAnalysisResultResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PutLatestAnalysisResultResponseValidationError{}
+
+// Validate checks the field values on PutAnalysisReportRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PutAnalysisReportRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PutAnalysisReportRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PutAnalysisReportRequestMultiError, or nil if none found.
+func (m *PutAnalysisReportRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PutAnalysisReportRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetReport() == nil {
+		err := PutAnalysisReportRequestValidationError{
+			field:  "Report",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetReport()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PutAnalysisReportRequestValidationError{
+					field:  "Report",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PutAnalysisReportRequestValidationError{
+					field:  "Report",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetReport()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PutAnalysisReportRequestValidationError{
+				field:  "Report",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return PutAnalysisReportRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// PutAnalysisReportRequestMultiError is an error wrapping multiple validation
+// errors returned by PutAnalysisReportRequest.ValidateAll() if the designated
+// constraints aren't met.
+type PutAnalysisReportRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PutAnalysisReportRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PutAnalysisReportRequestMultiError) AllErrors() []error { return m }
+
+// PutAnalysisReportRequestValidationError is the validation error returned by
+// PutAnalysisReportRequest.Validate if the designated constraints aren't met.
+type PutAnalysisReportRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PutAnalysisReportRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PutAnalysisReportRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PutAnalysisReportRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PutAnalysisReportRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PutAnalysisReportRequestValidationError) ErrorName() string {
+	return "PutAnalysisReportRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PutAnalysisReportRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPutAnalysisReportRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PutAnalysisReportRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PutAnalysisReportRequestValidationError{}
+
+// Validate checks the field values on PutAnalysisReportResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PutAnalysisReportResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PutAnalysisReportResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PutAnalysisReportResponseMultiError, or nil if none found.
+func (m *PutAnalysisReportResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PutAnalysisReportResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return PutAnalysisReportResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// PutAnalysisReportResponseMultiError is an error wrapping multiple validation
+// errors returned by PutAnalysisReportResponse.ValidateAll() if the
+// designated constraints aren't met.
+type PutAnalysisReportResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PutAnalysisReportResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PutAnalysisReportResponseMultiError) AllErrors() []error { return m }
+
+// PutAnalysisReportResponseValidationError is the validation error returned by
+// PutAnalysisReportResponse.Validate if the designated constraints aren't met.
+type PutAnalysisReportResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PutAnalysisReportResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PutAnalysisReportResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PutAnalysisReportResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PutAnalysisReportResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PutAnalysisReportResponseValidationError) ErrorName() string {
+	return "PutAnalysisReportResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PutAnalysisReportResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPutAnalysisReportResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PutAnalysisReportResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PutAnalysisReportResponseValidationError{}
+
+// Validate checks the field values on GetDesiredVersionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetDesiredVersionRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetDesiredVersionRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetDesiredVersionRequestMultiError, or nil if none found.
+func (m *GetDesiredVersionRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetDesiredVersionRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return GetDesiredVersionRequestMultiError(errors)
 	}
 
 	return nil
@@ -8686,6 +9391,8 @@ func (m *ReportEventStatusesRequest_Event) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
+	// no validation rules for PullRequestUrl
+
 	if len(errors) > 0 {
 		return ReportEventStatusesRequest_EventMultiError(errors)
 	}
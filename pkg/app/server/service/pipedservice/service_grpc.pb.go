@@ -56,7 +56,8 @@ type PipedServiceClient interface {
 	// of a specific deployment to PLANNED.
 	ReportDeploymentPlanned(ctx context.Context, in *ReportDeploymentPlannedRequest, opts ...grpc.CallOption) (*ReportDeploymentPlannedResponse, error)
 	// ReportDeploymentStatusChanged is used to update the status
-	// of a specific deployment to RUNNING or ROLLING_BACK.
+	// of a specific deployment to RUNNING or ROLLING_BACK,
+	// or to update the status reason of a PENDING deployment.
 	ReportDeploymentStatusChanged(ctx context.Context, in *ReportDeploymentStatusChangedRequest, opts ...grpc.CallOption) (*ReportDeploymentStatusChangedResponse, error)
 	// ReportDeploymentCompleted is used to update the status
 	// of a specific deployment to SUCCESS | FAILURE | CANCELLED.
@@ -120,10 +121,18 @@ type PipedServiceClient interface {
 	ListEvents(ctx context.Context, in *ListEventsRequest, opts ...grpc.CallOption) (*ListEventsResponse, error)
 	// ReportEventStatuses reports a status list of events.
 	ReportEventStatuses(ctx context.Context, in *ReportEventStatusesRequest, opts ...grpc.CallOption) (*ReportEventStatusesResponse, error)
+	// RegisterEvent registers a new event found by piped itself,
+	// e.g. a new image tag found by polling container registries.
+	RegisterEvent(ctx context.Context, in *RegisterEventRequest, opts ...grpc.CallOption) (*RegisterEventResponse, error)
+	// SyncApplication creates a command to sync the given application,
+	// e.g. when an event matched with the event watcher config of the application.
+	SyncApplication(ctx context.Context, in *SyncApplicationRequest, opts ...grpc.CallOption) (*SyncApplicationResponse, error)
 	// GetLatestAnalysisResult returns the most successful analysis result.
 	GetLatestAnalysisResult(ctx context.Context, in *GetLatestAnalysisResultRequest, opts ...grpc.CallOption) (*GetLatestAnalysisResultResponse, error)
 	// GetLatestAnalysisResult updates the most successful analysis result.
 	PutLatestAnalysisResult(ctx context.Context, in *PutLatestAnalysisResultRequest, opts ...grpc.CallOption) (*PutLatestAnalysisResultResponse, error)
+	// PutAnalysisReport saves the details of an ANALYSIS stage.
+	PutAnalysisReport(ctx context.Context, in *PutAnalysisReportRequest, opts ...grpc.CallOption) (*PutAnalysisReportResponse, error)
 	// GetDesiredVersion returns the desired version of the given Piped.
 	GetDesiredVersion(ctx context.Context, in *GetDesiredVersionRequest, opts ...grpc.CallOption) (*GetDesiredVersionResponse, error)
 	// UpdateApplicationConfigurations updates application configurations.
@@ -393,6 +402,24 @@ func (c *pipedServiceClient) ReportEventStatuses(ctx context.Context, in *Report
 	return out, nil
 }
 
+func (c *pipedServiceClient) RegisterEvent(ctx context.Context, in *RegisterEventRequest, opts ...grpc.CallOption) (*RegisterEventResponse, error) {
+	out := new(RegisterEventResponse)
+	err := c.cc.Invoke(ctx, "/grpc.service.pipedservice.PipedService/RegisterEvent", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pipedServiceClient) SyncApplication(ctx context.Context, in *SyncApplicationRequest, opts ...grpc.CallOption) (*SyncApplicationResponse, error) {
+	out := new(SyncApplicationResponse)
+	err := c.cc.Invoke(ctx, "/grpc.service.pipedservice.PipedService/SyncApplication", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *pipedServiceClient) GetLatestAnalysisResult(ctx context.Context, in *GetLatestAnalysisResultRequest, opts ...grpc.CallOption) (*GetLatestAnalysisResultResponse, error) {
 	out := new(GetLatestAnalysisResultResponse)
 	err := c.cc.Invoke(ctx, "/grpc.service.pipedservice.PipedService/GetLatestAnalysisResult", in, out, opts...)
@@ -411,6 +438,15 @@ func (c *pipedServiceClient) PutLatestAnalysisResult(ctx context.Context, in *Pu
 	return out, nil
 }
 
+func (c *pipedServiceClient) PutAnalysisReport(ctx context.Context, in *PutAnalysisReportRequest, opts ...grpc.CallOption) (*PutAnalysisReportResponse, error) {
+	out := new(PutAnalysisReportResponse)
+	err := c.cc.Invoke(ctx, "/grpc.service.pipedservice.PipedService/PutAnalysisReport", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *pipedServiceClient) GetDesiredVersion(ctx context.Context, in *GetDesiredVersionRequest, opts ...grpc.CallOption) (*GetDesiredVersionResponse, error) {
 	out := new(GetDesiredVersionResponse)
 	err := c.cc.Invoke(ctx, "/grpc.service.pipedservice.PipedService/GetDesiredVersion", in, out, opts...)
@@ -494,7 +530,8 @@ type PipedServiceServer interface {
 	// of a specific deployment to PLANNED.
 	ReportDeploymentPlanned(context.Context, *ReportDeploymentPlannedRequest) (*ReportDeploymentPlannedResponse, error)
 	// ReportDeploymentStatusChanged is used to update the status
-	// of a specific deployment to RUNNING or ROLLING_BACK.
+	// of a specific deployment to RUNNING or ROLLING_BACK,
+	// or to update the status reason of a PENDING deployment.
 	ReportDeploymentStatusChanged(context.Context, *ReportDeploymentStatusChangedRequest) (*ReportDeploymentStatusChangedResponse, error)
 	// ReportDeploymentCompleted is used to update the status
 	// of a specific deployment to SUCCESS | FAILURE | CANCELLED.
@@ -558,10 +595,18 @@ type PipedServiceServer interface {
 	ListEvents(context.Context, *ListEventsRequest) (*ListEventsResponse, error)
 	// ReportEventStatuses reports a status list of events.
 	ReportEventStatuses(context.Context, *ReportEventStatusesRequest) (*ReportEventStatusesResponse, error)
+	// RegisterEvent registers a new event found by piped itself,
+	// e.g. a new image tag found by polling container registries.
+	RegisterEvent(context.Context, *RegisterEventRequest) (*RegisterEventResponse, error)
+	// SyncApplication creates a command to sync the given application,
+	// e.g. when an event matched with the event watcher config of the application.
+	SyncApplication(context.Context, *SyncApplicationRequest) (*SyncApplicationResponse, error)
 	// GetLatestAnalysisResult returns the most successful analysis result.
 	GetLatestAnalysisResult(context.Context, *GetLatestAnalysisResultRequest) (*GetLatestAnalysisResultResponse, error)
 	// GetLatestAnalysisResult updates the most successful analysis result.
 	PutLatestAnalysisResult(context.Context, *PutLatestAnalysisResultRequest) (*PutLatestAnalysisResultResponse, error)
+	// PutAnalysisReport saves the details of an ANALYSIS stage.
+	PutAnalysisReport(context.Context, *PutAnalysisReportRequest) (*PutAnalysisReportResponse, error)
 	// GetDesiredVersion returns the desired version of the given Piped.
 	GetDesiredVersion(context.Context, *GetDesiredVersionRequest) (*GetDesiredVersionResponse, error)
 	// UpdateApplicationConfigurations updates application configurations.
@@ -665,12 +710,21 @@ func (UnimplementedPipedServiceServer) ListEvents(context.Context, *ListEventsRe
 func (UnimplementedPipedServiceServer) ReportEventStatuses(context.Context, *ReportEventStatusesRequest) (*ReportEventStatusesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ReportEventStatuses not implemented")
 }
+func (UnimplementedPipedServiceServer) RegisterEvent(context.Context, *RegisterEventRequest) (*RegisterEventResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterEvent not implemented")
+}
+func (UnimplementedPipedServiceServer) SyncApplication(context.Context, *SyncApplicationRequest) (*SyncApplicationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SyncApplication not implemented")
+}
 func (UnimplementedPipedServiceServer) GetLatestAnalysisResult(context.Context, *GetLatestAnalysisResultRequest) (*GetLatestAnalysisResultResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetLatestAnalysisResult not implemented")
 }
 func (UnimplementedPipedServiceServer) PutLatestAnalysisResult(context.Context, *PutLatestAnalysisResultRequest) (*PutLatestAnalysisResultResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PutLatestAnalysisResult not implemented")
 }
+func (UnimplementedPipedServiceServer) PutAnalysisReport(context.Context, *PutAnalysisReportRequest) (*PutAnalysisReportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PutAnalysisReport not implemented")
+}
 func (UnimplementedPipedServiceServer) GetDesiredVersion(context.Context, *GetDesiredVersionRequest) (*GetDesiredVersionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetDesiredVersion not implemented")
 }
@@ -1185,6 +1239,42 @@ func _PipedService_ReportEventStatuses_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PipedService_RegisterEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegisterEventRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PipedServiceServer).RegisterEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpc.service.pipedservice.PipedService/RegisterEvent",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PipedServiceServer).RegisterEvent(ctx, req.(*RegisterEventRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PipedService_SyncApplication_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SyncApplicationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PipedServiceServer).SyncApplication(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpc.service.pipedservice.PipedService/SyncApplication",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PipedServiceServer).SyncApplication(ctx, req.(*SyncApplicationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _PipedService_GetLatestAnalysisResult_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetLatestAnalysisResultRequest)
 	if err := dec(in); err != nil {
@@ -1221,6 +1311,24 @@ func _PipedService_PutLatestAnalysisResult_Handler(srv interface{}, ctx context.
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PipedService_PutAnalysisReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PutAnalysisReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PipedServiceServer).PutAnalysisReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpc.service.pipedservice.PipedService/PutAnalysisReport",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PipedServiceServer).PutAnalysisReport(ctx, req.(*PutAnalysisReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _PipedService_GetDesiredVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetDesiredVersionRequest)
 	if err := dec(in); err != nil {
@@ -1426,6 +1534,14 @@ var PipedService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ReportEventStatuses",
 			Handler:    _PipedService_ReportEventStatuses_Handler,
 		},
+		{
+			MethodName: "RegisterEvent",
+			Handler:    _PipedService_RegisterEvent_Handler,
+		},
+		{
+			MethodName: "SyncApplication",
+			Handler:    _PipedService_SyncApplication_Handler,
+		},
 		{
 			MethodName: "GetLatestAnalysisResult",
 			Handler:    _PipedService_GetLatestAnalysisResult_Handler,
@@ -1434,6 +1550,10 @@ var PipedService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "PutLatestAnalysisResult",
 			Handler:    _PipedService_PutLatestAnalysisResult_Handler,
 		},
+		{
+			MethodName: "PutAnalysisReport",
+			Handler:    _PipedService_PutAnalysisReport_Handler,
+		},
 		{
 			MethodName: "GetDesiredVersion",
 			Handler:    _PipedService_GetDesiredVersion_Handler,
@@ -4299,6 +4299,8 @@ func (m *SyncApplicationRequest) validate(all bool) error {
 
 	// no validation rules for SyncStrategy
 
+	// no validation rules for OverrideDeploymentWindows
+
 	if len(errors) > 0 {
 		return SyncApplicationRequestMultiError(errors)
 	}
@@ -6003,6 +6005,261 @@ var _ interface {
 	ErrorName() string
 } = GetStageLogResponseValidationError{}
 
+// Validate checks the field values on GetAnalysisReportRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetAnalysisReportRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetAnalysisReportRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetAnalysisReportRequestMultiError, or nil if none found.
+func (m *GetAnalysisReportRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetAnalysisReportRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetDeploymentId()) < 1 {
+		err := GetAnalysisReportRequestValidationError{
+			field:  "DeploymentId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetStageId()) < 1 {
+		err := GetAnalysisReportRequestValidationError{
+			field:  "StageId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetAnalysisReportRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetAnalysisReportRequestMultiError is an error wrapping multiple validation
+// errors returned by GetAnalysisReportRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetAnalysisReportRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetAnalysisReportRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetAnalysisReportRequestMultiError) AllErrors() []error { return m }
+
+// GetAnalysisReportRequestValidationError is the validation error returned by
+// GetAnalysisReportRequest.Validate if the designated constraints aren't met.
+type GetAnalysisReportRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetAnalysisReportRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetAnalysisReportRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetAnalysisReportRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetAnalysisReportRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetAnalysisReportRequestValidationError) ErrorName() string {
+	return "GetAnalysisReportRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetAnalysisReportRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetAnalysisReportRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetAnalysisReportRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetAnalysisReportRequestValidationError{}
+
+// Validate checks the field values on GetAnalysisReportResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetAnalysisReportResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetAnalysisReportResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetAnalysisReportResponseMultiError, or nil if none found.
+func (m *GetAnalysisReportResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetAnalysisReportResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetReport()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetAnalysisReportResponseValidationError{
+					field:  "Report",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetAnalysisReportResponseValidationError{
+					field:  "Report",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetReport()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetAnalysisReportResponseValidationError{
+				field:  "Report",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetAnalysisReportResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetAnalysisReportResponseMultiError is an error wrapping multiple validation
+// errors returned by GetAnalysisReportResponse.ValidateAll() if the
+// designated constraints aren't met.
+type GetAnalysisReportResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetAnalysisReportResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetAnalysisReportResponseMultiError) AllErrors() []error { return m }
+
+// GetAnalysisReportResponseValidationError is the validation error returned by
+// GetAnalysisReportResponse.Validate if the designated constraints aren't met.
+type GetAnalysisReportResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetAnalysisReportResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetAnalysisReportResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetAnalysisReportResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetAnalysisReportResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetAnalysisReportResponseValidationError) ErrorName() string {
+	return "GetAnalysisReportResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetAnalysisReportResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetAnalysisReportResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetAnalysisReportResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetAnalysisReportResponseValidationError{}
+
 // Validate checks the field values on CancelDeploymentRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
@@ -6678,6 +6935,236 @@ var _ interface {
 	ErrorName() string
 } = ApproveStageResponseValidationError{}
 
+// Validate checks the field values on RejectStageRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RejectStageRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RejectStageRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RejectStageRequestMultiError, or nil if none found.
+func (m *RejectStageRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RejectStageRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetDeploymentId()) < 1 {
+		err := RejectStageRequestValidationError{
+			field:  "DeploymentId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetStageId()) < 1 {
+		err := RejectStageRequestValidationError{
+			field:  "StageId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Comment
+
+	if len(errors) > 0 {
+		return RejectStageRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RejectStageRequestMultiError is an error wrapping multiple validation errors
+// returned by RejectStageRequest.ValidateAll() if the designated constraints
+// aren't met.
+type RejectStageRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RejectStageRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RejectStageRequestMultiError) AllErrors() []error { return m }
+
+// RejectStageRequestValidationError is the validation error returned by
+// RejectStageRequest.Validate if the designated constraints aren't met.
+type RejectStageRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RejectStageRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RejectStageRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RejectStageRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RejectStageRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RejectStageRequestValidationError) ErrorName() string {
+	return "RejectStageRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RejectStageRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRejectStageRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RejectStageRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RejectStageRequestValidationError{}
+
+// Validate checks the field values on RejectStageResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RejectStageResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RejectStageResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RejectStageResponseMultiError, or nil if none found.
+func (m *RejectStageResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RejectStageResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for CommandId
+
+	if len(errors) > 0 {
+		return RejectStageResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// RejectStageResponseMultiError is an error wrapping multiple validation
+// errors returned by RejectStageResponse.ValidateAll() if the designated
+// constraints aren't met.
+type RejectStageResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RejectStageResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RejectStageResponseMultiError) AllErrors() []error { return m }
+
+// RejectStageResponseValidationError is the validation error returned by
+// RejectStageResponse.Validate if the designated constraints aren't met.
+type RejectStageResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RejectStageResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RejectStageResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RejectStageResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RejectStageResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RejectStageResponseValidationError) ErrorName() string {
+	return "RejectStageResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RejectStageResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRejectStageResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RejectStageResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RejectStageResponseValidationError{}
+
 // Validate checks the field values on ListDeploymentTracesRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
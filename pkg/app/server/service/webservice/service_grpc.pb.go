@@ -50,9 +50,11 @@ type WebServiceClient interface {
 	ListDeployments(ctx context.Context, in *ListDeploymentsRequest, opts ...grpc.CallOption) (*ListDeploymentsResponse, error)
 	GetDeployment(ctx context.Context, in *GetDeploymentRequest, opts ...grpc.CallOption) (*GetDeploymentResponse, error)
 	GetStageLog(ctx context.Context, in *GetStageLogRequest, opts ...grpc.CallOption) (*GetStageLogResponse, error)
+	GetAnalysisReport(ctx context.Context, in *GetAnalysisReportRequest, opts ...grpc.CallOption) (*GetAnalysisReportResponse, error)
 	CancelDeployment(ctx context.Context, in *CancelDeploymentRequest, opts ...grpc.CallOption) (*CancelDeploymentResponse, error)
 	SkipStage(ctx context.Context, in *SkipStageRequest, opts ...grpc.CallOption) (*SkipStageResponse, error)
 	ApproveStage(ctx context.Context, in *ApproveStageRequest, opts ...grpc.CallOption) (*ApproveStageResponse, error)
+	RejectStage(ctx context.Context, in *RejectStageRequest, opts ...grpc.CallOption) (*RejectStageResponse, error)
 	// Deployment tracing
 	ListDeploymentTraces(ctx context.Context, in *ListDeploymentTracesRequest, opts ...grpc.CallOption) (*ListDeploymentTracesResponse, error)
 	// ApplicationLiveState
@@ -319,6 +321,15 @@ func (c *webServiceClient) GetStageLog(ctx context.Context, in *GetStageLogReque
 	return out, nil
 }
 
+func (c *webServiceClient) GetAnalysisReport(ctx context.Context, in *GetAnalysisReportRequest, opts ...grpc.CallOption) (*GetAnalysisReportResponse, error) {
+	out := new(GetAnalysisReportResponse)
+	err := c.cc.Invoke(ctx, "/grpc.service.webservice.WebService/GetAnalysisReport", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *webServiceClient) CancelDeployment(ctx context.Context, in *CancelDeploymentRequest, opts ...grpc.CallOption) (*CancelDeploymentResponse, error) {
 	out := new(CancelDeploymentResponse)
 	err := c.cc.Invoke(ctx, "/grpc.service.webservice.WebService/CancelDeployment", in, out, opts...)
@@ -346,6 +357,15 @@ func (c *webServiceClient) ApproveStage(ctx context.Context, in *ApproveStageReq
 	return out, nil
 }
 
+func (c *webServiceClient) RejectStage(ctx context.Context, in *RejectStageRequest, opts ...grpc.CallOption) (*RejectStageResponse, error) {
+	out := new(RejectStageResponse)
+	err := c.cc.Invoke(ctx, "/grpc.service.webservice.WebService/RejectStage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *webServiceClient) ListDeploymentTraces(ctx context.Context, in *ListDeploymentTracesRequest, opts ...grpc.CallOption) (*ListDeploymentTracesResponse, error) {
 	out := new(ListDeploymentTracesResponse)
 	err := c.cc.Invoke(ctx, "/grpc.service.webservice.WebService/ListDeploymentTraces", in, out, opts...)
@@ -585,9 +605,11 @@ type WebServiceServer interface {
 	ListDeployments(context.Context, *ListDeploymentsRequest) (*ListDeploymentsResponse, error)
 	GetDeployment(context.Context, *GetDeploymentRequest) (*GetDeploymentResponse, error)
 	GetStageLog(context.Context, *GetStageLogRequest) (*GetStageLogResponse, error)
+	GetAnalysisReport(context.Context, *GetAnalysisReportRequest) (*GetAnalysisReportResponse, error)
 	CancelDeployment(context.Context, *CancelDeploymentRequest) (*CancelDeploymentResponse, error)
 	SkipStage(context.Context, *SkipStageRequest) (*SkipStageResponse, error)
 	ApproveStage(context.Context, *ApproveStageRequest) (*ApproveStageResponse, error)
+	RejectStage(context.Context, *RejectStageRequest) (*RejectStageResponse, error)
 	// Deployment tracing
 	ListDeploymentTraces(context.Context, *ListDeploymentTracesRequest) (*ListDeploymentTracesResponse, error)
 	// ApplicationLiveState
@@ -701,6 +723,9 @@ func (UnimplementedWebServiceServer) GetDeployment(context.Context, *GetDeployme
 func (UnimplementedWebServiceServer) GetStageLog(context.Context, *GetStageLogRequest) (*GetStageLogResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetStageLog not implemented")
 }
+func (UnimplementedWebServiceServer) GetAnalysisReport(context.Context, *GetAnalysisReportRequest) (*GetAnalysisReportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAnalysisReport not implemented")
+}
 func (UnimplementedWebServiceServer) CancelDeployment(context.Context, *CancelDeploymentRequest) (*CancelDeploymentResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CancelDeployment not implemented")
 }
@@ -710,6 +735,9 @@ func (UnimplementedWebServiceServer) SkipStage(context.Context, *SkipStageReques
 func (UnimplementedWebServiceServer) ApproveStage(context.Context, *ApproveStageRequest) (*ApproveStageResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ApproveStage not implemented")
 }
+func (UnimplementedWebServiceServer) RejectStage(context.Context, *RejectStageRequest) (*RejectStageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RejectStage not implemented")
+}
 func (UnimplementedWebServiceServer) ListDeploymentTraces(context.Context, *ListDeploymentTracesRequest) (*ListDeploymentTracesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListDeploymentTraces not implemented")
 }
@@ -1242,6 +1270,24 @@ func _WebService_GetStageLog_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _WebService_GetAnalysisReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAnalysisReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WebServiceServer).GetAnalysisReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpc.service.webservice.WebService/GetAnalysisReport",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WebServiceServer).GetAnalysisReport(ctx, req.(*GetAnalysisReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _WebService_CancelDeployment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CancelDeploymentRequest)
 	if err := dec(in); err != nil {
@@ -1296,6 +1342,24 @@ func _WebService_ApproveStage_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _WebService_RejectStage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RejectStageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WebServiceServer).RejectStage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpc.service.webservice.WebService/RejectStage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WebServiceServer).RejectStage(ctx, req.(*RejectStageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _WebService_ListDeploymentTraces_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListDeploymentTracesRequest)
 	if err := dec(in); err != nil {
@@ -1817,6 +1881,10 @@ var WebService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetStageLog",
 			Handler:    _WebService_GetStageLog_Handler,
 		},
+		{
+			MethodName: "GetAnalysisReport",
+			Handler:    _WebService_GetAnalysisReport_Handler,
+		},
 		{
 			MethodName: "CancelDeployment",
 			Handler:    _WebService_CancelDeployment_Handler,
@@ -1829,6 +1897,10 @@ var WebService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ApproveStage",
 			Handler:    _WebService_ApproveStage_Handler,
 		},
+		{
+			MethodName: "RejectStage",
+			Handler:    _WebService_RejectStage_Handler,
+		},
 		{
 			MethodName: "ListDeploymentTraces",
 			Handler:    _WebService_ListDeploymentTraces_Handler,
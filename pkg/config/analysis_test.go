@@ -16,6 +16,7 @@ package config
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -63,3 +64,118 @@ func TestAnalysisExpectedString(t *testing.T) {
 		})
 	}
 }
+
+func TestAnalysisLogValidate(t *testing.T) {
+	testcases := []struct {
+		name    string
+		log     AnalysisLog
+		wantErr bool
+	}{
+		{
+			name: "default strategy",
+			log:  AnalysisLog{},
+		},
+		{
+			name: "canary baseline strategy",
+			log:  AnalysisLog{Strategy: AnalysisStrategyCanaryBaseline, MaxCount: 1, MaxRatio: 1.5},
+		},
+		{
+			name:    "previous strategy is not supported",
+			log:     AnalysisLog{Strategy: AnalysisStrategyPrevious},
+			wantErr: true,
+		},
+		{
+			name:    "negative max count",
+			log:     AnalysisLog{MaxCount: -1},
+			wantErr: true,
+		},
+		{
+			name:    "negative max ratio",
+			log:     AnalysisLog{Strategy: AnalysisStrategyCanaryPrimary, MaxRatio: -1},
+			wantErr: true,
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.log.Validate()
+			assert.Equal(t, tc.wantErr, err != nil)
+		})
+	}
+}
+
+func TestAnalysisMetricsValidate(t *testing.T) {
+	valid := func(f func(m *AnalysisMetrics)) AnalysisMetrics {
+		m := AnalysisMetrics{
+			Provider:  "prometheus-dev",
+			Query:     "query",
+			Interval:  Duration(time.Minute),
+			Deviation: AnalysisDeviationEither,
+		}
+		f(&m)
+		return m
+	}
+	testcases := []struct {
+		name    string
+		metrics AnalysisMetrics
+		wantErr bool
+	}{
+		{
+			name:    "default statistical test",
+			metrics: valid(func(m *AnalysisMetrics) {}),
+		},
+		{
+			name:    "bayesian with weight",
+			metrics: valid(func(m *AnalysisMetrics) { m.StatisticalTest = AnalysisStatisticalTestBayesian; m.Weight = 2 }),
+		},
+		{
+			name:    "unknown statistical test",
+			metrics: valid(func(m *AnalysisMetrics) { m.StatisticalTest = "T_TEST" }),
+			wantErr: true,
+		},
+		{
+			name:    "negative weight",
+			metrics: valid(func(m *AnalysisMetrics) { m.Weight = -1 }),
+			wantErr: true,
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.metrics.Validate()
+			assert.Equal(t, tc.wantErr, err != nil)
+		})
+	}
+}
+
+func TestAnalysisScoringValidate(t *testing.T) {
+	testcases := []struct {
+		name    string
+		scoring AnalysisScoring
+		wantErr bool
+	}{
+		{
+			name:    "valid",
+			scoring: AnalysisScoring{Interval: Duration(time.Minute), PassThreshold: 95, MarginalThreshold: 75},
+		},
+		{
+			name:    "missing interval",
+			scoring: AnalysisScoring{PassThreshold: 95, MarginalThreshold: 75},
+			wantErr: true,
+		},
+		{
+			name:    "pass threshold over 100",
+			scoring: AnalysisScoring{Interval: Duration(time.Minute), PassThreshold: 101, MarginalThreshold: 75},
+			wantErr: true,
+		},
+		{
+			name:    "marginal threshold over pass threshold",
+			scoring: AnalysisScoring{Interval: Duration(time.Minute), PassThreshold: 70, MarginalThreshold: 75},
+			wantErr: true,
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.scoring.Validate()
+			assert.Equal(t, tc.wantErr, err != nil)
+		})
+	}
+}
@@ -20,6 +20,9 @@ import (
 	"os"
 	"path/filepath"
 	"strings"
+	"time"
+
+	"github.com/robfig/cron/v3"
 
 	"github.com/pipe-cd/pipecd/pkg/model"
 )
@@ -58,6 +61,8 @@ type GenericApplicationSpec struct {
 	EventWatcher []EventWatcherConfig `json:"eventWatcher"`
 	// Configuration for drift detection
 	DriftDetection *DriftDetection `json:"driftDetection"`
+	// The time windows when deployments of this application are allowed or not.
+	DeploymentWindows *DeploymentWindows `json:"deploymentWindows,omitempty"`
 }
 
 type DeploymentPlanner struct {
@@ -82,6 +87,9 @@ type Trigger struct {
 	// Configurable fields used while deciding the application
 	// should be triggered based on received CHAIN_SYNC command.
 	OnChain OnChain `json:"onChain"`
+	// Configurable fields used while deciding the application
+	// should be triggered or not based on a schedule.
+	OnSchedule OnSchedule `json:"onSchedule"`
 }
 
 type OnCommit struct {
@@ -121,6 +129,49 @@ type OnChain struct {
 	Disabled *bool `json:"disabled,omitempty" default:"true"`
 }
 
+type OnSchedule struct {
+	// Whether to exclude application from triggering target
+	// when the schedule has come.
+	// Default is false.
+	Disabled bool `json:"disabled,omitempty"`
+	// The cron expression of the schedule at which the application should be synced, e.g. "0 3 * * *".
+	// The application is not triggered by schedule when this is empty.
+	Schedule string `json:"schedule,omitempty"`
+	// The timezone used to evaluate the schedule, e.g. Asia/Tokyo.
+	// Default is UTC.
+	Timezone string `json:"timezone,omitempty" default:"UTC"`
+}
+
+func (s *OnSchedule) Validate() error {
+	if s.Schedule == "" {
+		return nil
+	}
+	if _, err := cron.ParseStandard(s.Schedule); err != nil {
+		return fmt.Errorf("invalid trigger.onSchedule.schedule %q: %w", s.Schedule, err)
+	}
+	if _, err := time.LoadLocation(s.Timezone); err != nil {
+		return fmt.Errorf("invalid trigger.onSchedule.timezone %q: %w", s.Timezone, err)
+	}
+	return nil
+}
+
+// NextTime returns the first scheduled time after the given time.
+// A zero time is returned when the schedule is not configured.
+func (s *OnSchedule) NextTime(t time.Time) (time.Time, error) {
+	if s.Schedule == "" {
+		return time.Time{}, nil
+	}
+	loc, err := time.LoadLocation(s.Timezone)
+	if err != nil {
+		return time.Time{}, err
+	}
+	schedule, err := cron.ParseStandard(s.Schedule)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return schedule.Next(t.In(loc)), nil
+}
+
 func (s *GenericApplicationSpec) Validate() error {
 	if s.Pipeline != nil {
 		for _, stage := range s.Pipeline.Stages {
@@ -139,6 +190,11 @@ func (s *GenericApplicationSpec) Validate() error {
 					return err
 				}
 			}
+			if stage.K8sTrafficRoutingStageOptions != nil {
+				if err := stage.K8sTrafficRoutingStageOptions.Validate(); err != nil {
+					return err
+				}
+			}
 		}
 	}
 
@@ -174,6 +230,22 @@ func (s *GenericApplicationSpec) Validate() error {
 		}
 	}
 
+	if dw := s.DeploymentWindows; dw != nil {
+		if err := dw.Validate(); err != nil {
+			return err
+		}
+	}
+
+	for _, e := range s.EventWatcher {
+		if err := e.Validate(); err != nil {
+			return err
+		}
+	}
+
+	if err := s.Trigger.OnSchedule.Validate(); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -462,6 +534,9 @@ type AnalysisStageOptions struct {
 	Logs             []TemplatableAnalysisLog     `json:"logs,omitempty"`
 	HTTPS            []TemplatableAnalysisHTTP    `json:"https,omitempty"`
 	SkipOn           SkipOptions                  `json:"skipOn,omitempty"`
+	// Evaluate the metrics together with the weighted score
+	// instead of the failureLimit of each metrics.
+	Scoring *AnalysisScoring `json:"scoring,omitempty"`
 }
 
 func (a *AnalysisStageOptions) Validate() error {
@@ -481,6 +556,15 @@ func (a *AnalysisStageOptions) Validate() error {
 		}
 	}
 
+	if a.Scoring != nil {
+		if err := a.Scoring.Validate(); err != nil {
+			return fmt.Errorf("scoring configuration of ANALYSIS stage is invalid: %w", err)
+		}
+		if len(a.Metrics) == 0 {
+			return fmt.Errorf("scoring of ANALYSIS stage requires at least one metrics")
+		}
+	}
+
 	for _, l := range a.Logs {
 		if l.Template.Name != "" {
 			if err := l.Template.Validate(); err != nil {
@@ -51,6 +51,9 @@ func TestCloudRunApplicationConfig(t *testing.T) {
 						OnChain: OnChain{
 							Disabled: newBoolPointer(true),
 						},
+						OnSchedule: OnSchedule{
+							Timezone: "UTC",
+						},
 					},
 					Planner: DeploymentPlanner{
 						AutoRollback: newBoolPointer(true),
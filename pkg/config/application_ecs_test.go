@@ -53,6 +53,9 @@ func TestECSApplicationConfig(t *testing.T) {
 						OnChain: OnChain{
 							Disabled: newBoolPointer(true),
 						},
+						OnSchedule: OnSchedule{
+							Timezone: "UTC",
+						},
 					},
 					Planner: DeploymentPlanner{
 						AutoRollback: newBoolPointer(true),
@@ -97,6 +100,9 @@ func TestECSApplicationConfig(t *testing.T) {
 						OnChain: OnChain{
 							Disabled: newBoolPointer(true),
 						},
+						OnSchedule: OnSchedule{
+							Timezone: "UTC",
+						},
 					},
 					Planner: DeploymentPlanner{
 						AutoRollback: newBoolPointer(true),
@@ -134,6 +140,9 @@ func TestECSApplicationConfig(t *testing.T) {
 						OnChain: OnChain{
 							Disabled: newBoolPointer(true),
 						},
+						OnSchedule: OnSchedule{
+							Timezone: "UTC",
+						},
 					},
 					Planner: DeploymentPlanner{
 						AutoRollback: newBoolPointer(true),
@@ -14,6 +14,11 @@
 
 package config
 
+import (
+	"fmt"
+	"strings"
+)
+
 // KubernetesApplicationSpec represents an application configuration for Kubernetes application.
 type KubernetesApplicationSpec struct {
 	GenericApplicationSpec
@@ -50,6 +55,18 @@ func (s *KubernetesApplicationSpec) Validate() error {
 	if err := s.GenericApplicationSpec.Validate(); err != nil {
 		return err
 	}
+	if s.Pipeline != nil {
+		method := DetermineKubernetesTrafficRoutingMethod(s.TrafficRouting)
+		for _, stage := range s.Pipeline.Stages {
+			opts := stage.K8sTrafficRoutingStageOptions
+			if opts == nil || len(opts.CanaryMatches) == 0 {
+				continue
+			}
+			if method != KubernetesTrafficRoutingMethodIstio {
+				return fmt.Errorf("canaryMatches of %s stage is not supported by %s traffic routing method", stage.Name, method)
+			}
+		}
+	}
 	return nil
 }
 
@@ -142,11 +159,16 @@ const (
 	KubernetesTrafficRoutingMethodPodSelector KubernetesTrafficRoutingMethod = "podselector"
 	KubernetesTrafficRoutingMethodIstio       KubernetesTrafficRoutingMethod = "istio"
 	KubernetesTrafficRoutingMethodSMI         KubernetesTrafficRoutingMethod = "smi"
+	KubernetesTrafficRoutingMethodGatewayAPI  KubernetesTrafficRoutingMethod = "gatewayapi"
+	KubernetesTrafficRoutingMethodNGINX       KubernetesTrafficRoutingMethod = "nginx"
 )
 
 type KubernetesTrafficRouting struct {
-	Method KubernetesTrafficRoutingMethod `json:"method"`
-	Istio  *IstioTrafficRouting           `json:"istio"`
+	Method     KubernetesTrafficRoutingMethod `json:"method"`
+	Istio      *IstioTrafficRouting           `json:"istio"`
+	GatewayAPI *GatewayAPITrafficRouting      `json:"gatewayAPI"`
+	SMI        *SMITrafficRouting             `json:"smi"`
+	NGINX      *NGINXTrafficRouting           `json:"nginx"`
 }
 
 // DetermineKubernetesTrafficRoutingMethod determines the routing method should be used based on the TrafficRouting config.
@@ -173,6 +195,49 @@ type IstioTrafficRouting struct {
 	VirtualService K8sResourceReference `json:"virtualService"`
 }
 
+type GatewayAPITrafficRouting struct {
+	// The reference to HTTPRoute manifest.
+	// Empty means the first HTTPRoute resource will be used.
+	HTTPRoute K8sResourceReference `json:"httpRoute"`
+	// The name of the Service of PRIMARY variant referenced by the backendRefs of the HTTPRoute.
+	// Only the rules routing to this Service will be updated.
+	// Empty means the name of the application Service will be used.
+	PrimaryService string `json:"primaryService"`
+	// The name of the Service of CANARY variant.
+	// Empty means the name of the PRIMARY Service suffixed by the CANARY variant label value, e.g. helloworld-canary.
+	CanaryService string `json:"canaryService"`
+	// The name of the Service of BASELINE variant.
+	// Empty means the name of the PRIMARY Service suffixed by the BASELINE variant label value, e.g. helloworld-baseline.
+	BaselineService string `json:"baselineService"`
+}
+
+type SMITrafficRouting struct {
+	// The reference to TrafficSplit manifest.
+	// Empty means the first TrafficSplit resource will be used.
+	TrafficSplit K8sResourceReference `json:"trafficSplit"`
+	// The name of the Service of PRIMARY variant in the backends of the TrafficSplit.
+	// Empty means the name of the application Service suffixed by the PRIMARY variant label value, e.g. helloworld-primary.
+	PrimaryService string `json:"primaryService"`
+	// The name of the Service of CANARY variant.
+	// Empty means the name of the application Service suffixed by the CANARY variant label value, e.g. helloworld-canary.
+	CanaryService string `json:"canaryService"`
+	// The name of the Service of BASELINE variant.
+	// Empty means the name of the application Service suffixed by the BASELINE variant label value, e.g. helloworld-baseline.
+	BaselineService string `json:"baselineService"`
+}
+
+type NGINXTrafficRouting struct {
+	// The reference to Ingress manifest routing traffic to PRIMARY variant.
+	// Empty means the first Ingress resource will be used.
+	Ingress K8sResourceReference `json:"ingress"`
+	// The name of the Service of PRIMARY variant referenced by the Ingress.
+	// Empty means the name of the application Service will be used.
+	PrimaryService string `json:"primaryService"`
+	// The name of the Service of CANARY variant.
+	// Empty means the name of the PRIMARY Service suffixed by the CANARY variant label value, e.g. helloworld-canary.
+	CanaryService string `json:"canaryService"`
+}
+
 type K8sResourceReference struct {
 	Kind string `json:"kind"`
 	Name string `json:"name"`
@@ -184,6 +249,20 @@ type K8sSyncStageOptions struct {
 	AddVariantLabelToSelector bool `json:"addVariantLabelToSelector"`
 	// Whether the resources that are no longer defined in Git should be removed or not.
 	Prune bool `json:"prune"`
+	// Configuration for applying the manifests by server-side apply.
+	ServerSideApply *K8sServerSideApplyOptions `json:"serverSideApply,omitempty"`
+}
+
+// K8sServerSideApplyOptions contains the configurable values for applying the manifests by server-side apply.
+type K8sServerSideApplyOptions struct {
+	// Whether the manifests should be applied by server-side apply.
+	Enabled bool `json:"enabled"`
+	// The name of the field manager used to apply the manifests.
+	// Default is "kubectl".
+	FieldManager string `json:"fieldManager,omitempty"`
+	// Whether the conflicts with the fields owned by other field managers should be forced.
+	// Their ownership is taken over if true, otherwise the sync fails on conflict.
+	ForceConflicts bool `json:"forceConflicts"`
 }
 
 // K8sPrimaryRolloutStageOptions contains all configurable values for a K8S_PRIMARY_ROLLOUT stage.
@@ -280,6 +359,53 @@ type K8sTrafficRoutingStageOptions struct {
 	Canary Percentage `json:"canary"`
 	// The percentage of traffic should be routed to BASELINE variant.
 	Baseline Percentage `json:"baseline"`
+	// List of conditions to route the requests to CANARY variant regardless of the percentages,
+	// e.g. to let the internal testers hit CANARY variant before shifting any traffic.
+	// A request matching any of them is routed to CANARY variant.
+	// The conditions are removed by the next K8S_TRAFFIC_ROUTING stage not having them or rollback.
+	// Currently, this is supported only by the istio traffic routing method.
+	CanaryMatches []K8sTrafficRoutingMatch `json:"canaryMatches,omitempty"`
+}
+
+// K8sTrafficRoutingMatch represents a condition of the requests.
+// All of the specified headers and cookie must be matched.
+type K8sTrafficRoutingMatch struct {
+	// Map of header names to the exact values.
+	Headers map[string]string `json:"headers,omitempty"`
+	// The cookie of the requests in the format of name=value, e.g. canary=always.
+	Cookie string `json:"cookie,omitempty"`
+}
+
+func (m K8sTrafficRoutingMatch) Validate() error {
+	if len(m.Headers) == 0 && m.Cookie == "" {
+		return fmt.Errorf("either headers or cookie must be specified for canaryMatches")
+	}
+	for name := range m.Headers {
+		if name == "" {
+			return fmt.Errorf("header name must not be empty in canaryMatches")
+		}
+	}
+	if m.Cookie == "" {
+		return nil
+	}
+	if name, _, ok := strings.Cut(m.Cookie, "="); !ok || name == "" {
+		return fmt.Errorf("cookie %q must be in the format of name=value", m.Cookie)
+	}
+	for name := range m.Headers {
+		if strings.EqualFold(name, "cookie") {
+			return fmt.Errorf("cookie header can not be used together with cookie field in canaryMatches")
+		}
+	}
+	return nil
+}
+
+func (opts *K8sTrafficRoutingStageOptions) Validate() error {
+	for _, m := range opts.CanaryMatches {
+		if err := m.Validate(); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
 func (opts K8sTrafficRoutingStageOptions) Percentages() (primary, canary, baseline int) {
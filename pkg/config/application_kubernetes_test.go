@@ -99,6 +99,9 @@ func TestKubernetesApplicationConfig(t *testing.T) {
 						OnChain: OnChain{
 							Disabled: newBoolPointer(true),
 						},
+						OnSchedule: OnSchedule{
+							Timezone: "UTC",
+						},
 					},
 				},
 				Input: KubernetesDeploymentInput{
@@ -137,6 +140,9 @@ func TestKubernetesApplicationConfig(t *testing.T) {
 						OnChain: OnChain{
 							Disabled: newBoolPointer(true),
 						},
+						OnSchedule: OnSchedule{
+							Timezone: "UTC",
+						},
 					},
 					Planner: DeploymentPlanner{
 						AutoRollback: newBoolPointer(true),
@@ -193,3 +199,76 @@ func TestKubernetesApplicationConfig(t *testing.T) {
 		})
 	}
 }
+
+func TestKubernetesApplicationSpecValidateCanaryMatches(t *testing.T) {
+	t.Parallel()
+
+	newSpec := func(method KubernetesTrafficRoutingMethod, matches ...K8sTrafficRoutingMatch) *KubernetesApplicationSpec {
+		return &KubernetesApplicationSpec{
+			GenericApplicationSpec: GenericApplicationSpec{
+				Pipeline: &DeploymentPipeline{
+					Stages: []PipelineStage{
+						{
+							Name: model.StageK8sTrafficRouting,
+							K8sTrafficRoutingStageOptions: &K8sTrafficRoutingStageOptions{
+								Primary:       Percentage{Number: 100},
+								CanaryMatches: matches,
+							},
+						},
+					},
+				},
+			},
+			TrafficRouting: &KubernetesTrafficRouting{
+				Method: method,
+			},
+		}
+	}
+
+	testcases := []struct {
+		name    string
+		spec    *KubernetesApplicationSpec
+		wantErr bool
+	}{
+		{
+			name: "valid header and cookie",
+			spec: newSpec(KubernetesTrafficRoutingMethodIstio,
+				K8sTrafficRoutingMatch{Headers: map[string]string{"x-canary": "true"}},
+				K8sTrafficRoutingMatch{Cookie: "canary=always"},
+			),
+		},
+		{
+			name: "no canary matches with podselector",
+			spec: newSpec(KubernetesTrafficRoutingMethodPodSelector),
+		},
+		{
+			name:    "empty match",
+			spec:    newSpec(KubernetesTrafficRoutingMethodIstio, K8sTrafficRoutingMatch{}),
+			wantErr: true,
+		},
+		{
+			name:    "malformed cookie",
+			spec:    newSpec(KubernetesTrafficRoutingMethodIstio, K8sTrafficRoutingMatch{Cookie: "canary"}),
+			wantErr: true,
+		},
+		{
+			name: "cookie header together with cookie",
+			spec: newSpec(KubernetesTrafficRoutingMethodIstio, K8sTrafficRoutingMatch{
+				Headers: map[string]string{"Cookie": "canary=always"},
+				Cookie:  "canary=always",
+			}),
+			wantErr: true,
+		},
+		{
+			name:    "unsupported traffic routing method",
+			spec:    newSpec(KubernetesTrafficRoutingMethodGatewayAPI, K8sTrafficRoutingMatch{Cookie: "canary=always"}),
+			wantErr: true,
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			err := tc.spec.Validate()
+			assert.Equal(t, tc.wantErr, err != nil, err)
+		})
+	}
+}
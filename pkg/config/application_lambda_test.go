@@ -54,6 +54,9 @@ func TestLambdaApplicationConfig(t *testing.T) {
 						OnChain: OnChain{
 							Disabled: newBoolPointer(true),
 						},
+						OnSchedule: OnSchedule{
+							Timezone: "UTC",
+						},
 					},
 					Planner: DeploymentPlanner{
 						AutoRollback: newBoolPointer(true),
@@ -109,6 +112,9 @@ func TestLambdaApplicationConfig(t *testing.T) {
 						OnChain: OnChain{
 							Disabled: newBoolPointer(true),
 						},
+						OnSchedule: OnSchedule{
+							Timezone: "UTC",
+						},
 					},
 					Planner: DeploymentPlanner{
 						AutoRollback: newBoolPointer(true),
@@ -154,6 +160,9 @@ func TestLambdaApplicationConfig(t *testing.T) {
 						OnChain: OnChain{
 							Disabled: newBoolPointer(true),
 						},
+						OnSchedule: OnSchedule{
+							Timezone: "UTC",
+						},
 					},
 					Planner: DeploymentPlanner{
 						AutoRollback: newBoolPointer(true),
@@ -20,6 +20,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/creasty/defaults"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
@@ -426,6 +427,10 @@ func TestGenericTriggerConfiguration(t *testing.T) {
 						OnChain: OnChain{
 							Disabled: newBoolPointer(true),
 						},
+						OnSchedule: OnSchedule{
+							Schedule: "0 3 * * *",
+							Timezone: "Asia/Tokyo",
+						},
 					},
 					Planner: DeploymentPlanner{
 						AutoRollback: newBoolPointer(true),
@@ -480,6 +485,9 @@ func TestTrueByDefaultBoolConfiguration(t *testing.T) {
 						OnChain: OnChain{
 							Disabled: newBoolPointer(true),
 						},
+						OnSchedule: OnSchedule{
+							Timezone: "UTC",
+						},
 					},
 					Planner: DeploymentPlanner{
 						AutoRollback: newBoolPointer(true),
@@ -512,6 +520,9 @@ func TestTrueByDefaultBoolConfiguration(t *testing.T) {
 						OnChain: OnChain{
 							Disabled: newBoolPointer(true),
 						},
+						OnSchedule: OnSchedule{
+							Timezone: "UTC",
+						},
 					},
 					Planner: DeploymentPlanner{
 						AutoRollback: newBoolPointer(true),
@@ -544,6 +555,9 @@ func TestTrueByDefaultBoolConfiguration(t *testing.T) {
 						OnChain: OnChain{
 							Disabled: newBoolPointer(true),
 						},
+						OnSchedule: OnSchedule{
+							Timezone: "UTC",
+						},
 					},
 					Planner: DeploymentPlanner{
 						AutoRollback: newBoolPointer(true),
@@ -598,6 +612,9 @@ func TestGenericPostSyncConfiguration(t *testing.T) {
 						OnChain: OnChain{
 							Disabled: newBoolPointer(true),
 						},
+						OnSchedule: OnSchedule{
+							Timezone: "UTC",
+						},
 					},
 					Planner: DeploymentPlanner{
 						AutoRollback: newBoolPointer(true),
@@ -670,6 +687,9 @@ func TestGenericAnalysisConfiguration(t *testing.T) {
 						OnChain: OnChain{
 							Disabled: newBoolPointer(true),
 						},
+						OnSchedule: OnSchedule{
+							Timezone: "UTC",
+						},
 					},
 					Planner: DeploymentPlanner{
 						AutoRollback: newBoolPointer(true),
@@ -744,6 +764,44 @@ func TestGenericAnalysisConfiguration(t *testing.T) {
 								},
 								With: json.RawMessage(`{"duration":"10m","https":[{"expectedCode":200,"failureLimit":1,"interval":"1m","method":"GET","url":"https://canary-endpoint.dev"}]}`),
 							},
+							{
+								Name: model.StageAnalysis,
+								AnalysisStageOptions: &AnalysisStageOptions{
+									Duration: Duration(30 * time.Minute),
+									Metrics: []TemplatableAnalysisMetrics{
+										{
+											AnalysisMetrics: AnalysisMetrics{
+												Strategy:        AnalysisStrategyCanaryBaseline,
+												Provider:        "prometheus-dev",
+												Query:           "grpc_latency",
+												Interval:        Duration(5 * time.Minute),
+												Timeout:         Duration(30 * time.Second),
+												Deviation:       AnalysisDeviationEither,
+												StatisticalTest: AnalysisStatisticalTestKolmogorovSmirnov,
+												Weight:          2,
+												Critical:        true,
+											},
+										},
+										{
+											AnalysisMetrics: AnalysisMetrics{
+												Strategy:        AnalysisStrategyCanaryBaseline,
+												Provider:        "prometheus-dev",
+												Query:           "grpc_error_percentage",
+												Interval:        Duration(5 * time.Minute),
+												Timeout:         Duration(30 * time.Second),
+												Deviation:       AnalysisDeviationHigh,
+												StatisticalTest: AnalysisStatisticalTestBayesian,
+											},
+										},
+									},
+									Scoring: &AnalysisScoring{
+										Interval:          Duration(5 * time.Minute),
+										PassThreshold:     90,
+										MarginalThreshold: 75,
+									},
+								},
+								With: json.RawMessage(`{"duration":"30m","metrics":[{"critical":true,"interval":"5m","provider":"prometheus-dev","query":"grpc_latency","statisticalTest":"KOLMOGOROV_SMIRNOV","strategy":"CANARY_BASELINE","weight":2},{"deviation":"HIGH","interval":"5m","provider":"prometheus-dev","query":"grpc_error_percentage","statisticalTest":"BAYESIAN","strategy":"CANARY_BASELINE"}],"scoring":{"interval":"5m","passThreshold":90}}`),
+							},
 						},
 					},
 				},
@@ -812,6 +870,9 @@ func TestCustomSyncConfig(t *testing.T) {
 						OnChain: OnChain{
 							Disabled: newBoolPointer(true),
 						},
+						OnSchedule: OnSchedule{
+							Timezone: "UTC",
+						},
 					},
 					Planner: DeploymentPlanner{
 						AutoRollback: newBoolPointer(true),
@@ -870,3 +931,42 @@ func TestScriptSycConfiguration(t *testing.T) {
 		})
 	}
 }
+
+func TestOnScheduleNextTime(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2025, 1, 1, 20, 0, 0, 0, time.UTC)
+	testcases := []struct {
+		name     string
+		schedule OnSchedule
+		want     time.Time
+	}{
+		{
+			name:     "no schedule",
+			schedule: OnSchedule{},
+			want:     time.Time{},
+		},
+		{
+			name:     "default timezone",
+			schedule: OnSchedule{Schedule: "0 3 * * *"},
+			want:     time.Date(2025, 1, 2, 3, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "specified timezone",
+			schedule: OnSchedule{Schedule: "0 3 * * *", Timezone: "Asia/Tokyo"},
+			want:     time.Date(2025, 1, 2, 18, 0, 0, 0, time.UTC),
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			s := tc.schedule
+			require.NoError(t, defaults.Set(&s))
+			require.NoError(t, s.Validate())
+			got, err := s.NextTime(now)
+			require.NoError(t, err)
+			assert.True(t, tc.want.Equal(got), "want %s, got %s", tc.want, got)
+		})
+	}
+}
@@ -15,6 +15,10 @@
 package config
 
 import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
 	"testing"
 	"time"
 
@@ -61,6 +65,49 @@ func TestControlPlaneConfig(t *testing.T) {
 						},
 					},
 				},
+				SlackApproval: &ControlPlaneSlackApproval{
+					SigningSecretFile: "/etc/pipecd-secret/slack-signing-secret",
+					Users: []SlackApprovalUser{
+						{
+							SlackUserID: "U012AB3CD",
+							ProjectID:   "abc",
+							Username:    "test-user",
+							Roles:       []string{"Editor"},
+						},
+					},
+				},
+				DeploymentWindows: []ProjectDeploymentWindows{
+					{
+						ProjectID: "abc",
+						DeploymentWindows: DeploymentWindows{
+							Timezone: "UTC",
+							Action:   DeploymentWindowActionReject,
+							Deny: []DeploymentWindow{
+								{
+									Schedule:    "0 0 29 12 *",
+									Duration:    Duration(120 * time.Hour),
+									Description: "Year-end holidays",
+								},
+							},
+						},
+					},
+				},
+				RegistryWebhooks: []RegistryWebhook{
+					{
+						Name:       "ghcr",
+						Type:       RegistryWebhookGHCR,
+						ProjectID:  "abc",
+						SecretFile: "/etc/pipecd-secret/ghcr-webhook-secret",
+						Rules: []RegistryWebhookRule{
+							{
+								Repository: "org/helloworld",
+								Tag:        `v[0-9]+\.[0-9]+\.[0-9]+`,
+								EventName:  "helloworld-image-update",
+								Labels:     map[string]string{"env": "prod"},
+							},
+						},
+					},
+				},
 				Datastore: ControlPlaneDataStore{
 					Type: model.DataStoreFirestore,
 					FirestoreConfig: &DataStoreFireStoreConfig{
@@ -114,3 +161,163 @@ func TestControlPlaneConfig(t *testing.T) {
 		})
 	}
 }
+
+func TestControlPlaneDataStoreUnmarshalJSON(t *testing.T) {
+	testcases := []struct {
+		name     string
+		data     string
+		expected ControlPlaneDataStore
+	}{
+		{
+			name: "mysql",
+			data: `{"type": "MYSQL", "config": {"url": "localhost:3306", "database": "pipecd"}}`,
+			expected: ControlPlaneDataStore{
+				Type: model.DataStoreMySQL,
+				MySQLConfig: &DataStoreMySQLConfig{
+					URL:      "localhost:3306",
+					Database: "pipecd",
+				},
+			},
+		},
+		{
+			name: "postgresql",
+			data: `{"type": "POSTGRESQL", "config": {"url": "localhost:5432", "database": "pipecd", "usernameFile": "/etc/pipecd-secret/username", "passwordFile": "/etc/pipecd-secret/password"}}`,
+			expected: ControlPlaneDataStore{
+				Type: model.DataStorePostgreSQL,
+				PostgreSQLConfig: &DataStorePostgreSQLConfig{
+					URL:          "localhost:5432",
+					Database:     "pipecd",
+					UsernameFile: "/etc/pipecd-secret/username",
+					PasswordFile: "/etc/pipecd-secret/password",
+				},
+			},
+		},
+		{
+			name: "postgresql without config",
+			data: `{"type": "POSTGRESQL"}`,
+			expected: ControlPlaneDataStore{
+				Type:             model.DataStorePostgreSQL,
+				PostgreSQLConfig: &DataStorePostgreSQLConfig{},
+			},
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			var got ControlPlaneDataStore
+			err := json.Unmarshal([]byte(tc.data), &got)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, got)
+		})
+	}
+}
+
+func TestRegistryWebhookValidate(t *testing.T) {
+	validRules := []RegistryWebhookRule{{EventName: "image-update"}}
+	testcases := []struct {
+		name    string
+		webhook RegistryWebhook
+		wantErr bool
+	}{
+		{
+			name: "valid",
+			webhook: RegistryWebhook{
+				Name:       "dockerhub",
+				Type:       RegistryWebhookDockerHub,
+				ProjectID:  "project",
+				SecretFile: "/etc/pipecd-secret/secret",
+				Rules:      validRules,
+			},
+		},
+		{
+			name: "name containing slash",
+			webhook: RegistryWebhook{
+				Name:       "docker/hub",
+				Type:       RegistryWebhookDockerHub,
+				ProjectID:  "project",
+				SecretFile: "/etc/pipecd-secret/secret",
+				Rules:      validRules,
+			},
+			wantErr: true,
+		},
+		{
+			name: "unsupported type",
+			webhook: RegistryWebhook{
+				Name:       "quay",
+				Type:       "QUAY",
+				ProjectID:  "project",
+				SecretFile: "/etc/pipecd-secret/secret",
+				Rules:      validRules,
+			},
+			wantErr: true,
+		},
+		{
+			name: "missing secret file",
+			webhook: RegistryWebhook{
+				Name:      "harbor",
+				Type:      RegistryWebhookHarbor,
+				ProjectID: "project",
+				Rules:     validRules,
+			},
+			wantErr: true,
+		},
+		{
+			name: "missing rules",
+			webhook: RegistryWebhook{
+				Name:       "ecr",
+				Type:       RegistryWebhookECR,
+				ProjectID:  "project",
+				SecretFile: "/etc/pipecd-secret/secret",
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid tag regex",
+			webhook: RegistryWebhook{
+				Name:       "gar",
+				Type:       RegistryWebhookArtifactRegistry,
+				ProjectID:  "project",
+				SecretFile: "/etc/pipecd-secret/secret",
+				Rules:      []RegistryWebhookRule{{EventName: "image-update", Tag: "v[0-9"}},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.webhook.Validate()
+			assert.Equal(t, tc.wantErr, err != nil)
+		})
+	}
+}
+
+func TestRegistryWebhookLoadSecret(t *testing.T) {
+	dir := t.TempDir()
+	testcases := []struct {
+		name     string
+		content  string
+		expected string
+		wantErr  bool
+	}{
+		{
+			name:     "trimmed secret",
+			content:  "secret\n",
+			expected: "secret",
+		},
+		{
+			name:    "empty secret",
+			content: " \n",
+			wantErr: true,
+		},
+	}
+	for i, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			file := filepath.Join(dir, fmt.Sprintf("secret-%d", i))
+			require.NoError(t, os.WriteFile(file, []byte(tc.content), 0600))
+
+			w := RegistryWebhook{Name: "ghcr", SecretFile: file}
+			got, err := w.LoadSecret()
+			assert.Equal(t, tc.wantErr, err != nil)
+			assert.Equal(t, tc.expected, got)
+		})
+	}
+}
@@ -0,0 +1,178 @@
+// Copyright 2024 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/robfig/cron/v3"
+)
+
+type DeploymentWindowAction string
+
+const (
+	// DeploymentWindowActionQueue keeps the blocked deployments waiting
+	// until deployments are allowed again.
+	DeploymentWindowActionQueue DeploymentWindowAction = "QUEUE"
+	// DeploymentWindowActionReject drops the blocked deployments.
+	DeploymentWindowActionReject DeploymentWindowAction = "REJECT"
+)
+
+// DeploymentWindows restricts the time when deployments are allowed to start.
+// A deployment is allowed only when it is in one of the allow windows (if any are configured)
+// and is not in any of the deny windows.
+type DeploymentWindows struct {
+	// The timezone used to evaluate the schedules, e.g. Asia/Tokyo.
+	// Default is UTC.
+	Timezone string `json:"timezone,omitempty" default:"UTC"`
+	// What to do with the deployments triggered while deployments are not allowed.
+	// Can be QUEUE or REJECT.
+	// Default is QUEUE.
+	Action DeploymentWindowAction `json:"action,omitempty" default:"QUEUE"`
+	// List of windows in which deployments are allowed.
+	// Empty means deployments are allowed at any time except the deny windows.
+	Allow []DeploymentWindow `json:"allow,omitempty"`
+	// List of windows in which deployments are not allowed, e.g. change freezes.
+	// Deny windows take precedence over allow windows.
+	Deny []DeploymentWindow `json:"deny,omitempty"`
+}
+
+// DeploymentWindow is a time window which is opened by a cron schedule.
+type DeploymentWindow struct {
+	// The cron expression at which the window opens, e.g. "0 9 * * 1-5".
+	Schedule string `json:"schedule"`
+	// How long the window stays open after each scheduled time.
+	Duration Duration `json:"duration"`
+	// The timezone used to evaluate the schedule of this window.
+	// Default is the timezone of the parent deploymentWindows.
+	Timezone string `json:"timezone,omitempty"`
+	// The description of the window which is shown as the reason of the blocked deployments.
+	Description string `json:"description,omitempty"`
+}
+
+func (w *DeploymentWindows) Validate() error {
+	switch w.Action {
+	case DeploymentWindowActionQueue, DeploymentWindowActionReject:
+	default:
+		return fmt.Errorf("deploymentWindows.action must be one of %s or %s", DeploymentWindowActionQueue, DeploymentWindowActionReject)
+	}
+	if _, err := time.LoadLocation(w.Timezone); err != nil {
+		return fmt.Errorf("invalid deploymentWindows.timezone %q: %w", w.Timezone, err)
+	}
+	for _, dw := range w.Allow {
+		if err := dw.validate(); err != nil {
+			return err
+		}
+	}
+	for _, dw := range w.Deny {
+		if err := dw.validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (w DeploymentWindow) validate() error {
+	if _, err := cron.ParseStandard(w.Schedule); err != nil {
+		return fmt.Errorf("invalid deployment window schedule %q: %w", w.Schedule, err)
+	}
+	if w.Duration <= 0 {
+		return fmt.Errorf("duration of deployment window %q must be greater than 0", w.Schedule)
+	}
+	if w.Timezone != "" {
+		if _, err := time.LoadLocation(w.Timezone); err != nil {
+			return fmt.Errorf("invalid timezone %q of deployment window %q: %w", w.Timezone, w.Schedule, err)
+		}
+	}
+	return nil
+}
+
+// FindBlockingDeploymentWindows returns the first one of the given windows which
+// does not allow deployments at the given time, along with the reason.
+// Nil windows are ignored. Nil is returned when deployments are allowed by all of them.
+func FindBlockingDeploymentWindows(t time.Time, windows ...*DeploymentWindows) (*DeploymentWindows, string, error) {
+	for _, w := range windows {
+		if w == nil {
+			continue
+		}
+		reason, err := w.BlockedReason(t)
+		if err != nil {
+			return nil, "", err
+		}
+		if reason != "" {
+			return w, reason, nil
+		}
+	}
+	return nil, "", nil
+}
+
+// BlockedReason returns the reason why deployments are not allowed at the given time.
+// An empty string means deployments are allowed.
+func (w *DeploymentWindows) BlockedReason(t time.Time) (string, error) {
+	for _, dw := range w.Deny {
+		in, err := dw.contains(t, w.Timezone)
+		if err != nil {
+			return "", err
+		}
+		if in {
+			return fmt.Sprintf("Deployments are not allowed during the deny window %s", dw.name()), nil
+		}
+	}
+
+	if len(w.Allow) == 0 {
+		return "", nil
+	}
+	for _, dw := range w.Allow {
+		in, err := dw.contains(t, w.Timezone)
+		if err != nil {
+			return "", err
+		}
+		if in {
+			return "", nil
+		}
+	}
+	return "Deployments are allowed only during the configured allow windows", nil
+}
+
+// contains checks whether the given time is in one of the periods opened by this window.
+func (w DeploymentWindow) contains(t time.Time, defaultTimezone string) (bool, error) {
+	tz := w.Timezone
+	if tz == "" {
+		tz = defaultTimezone
+	}
+	loc, err := time.LoadLocation(tz)
+	if err != nil {
+		return false, err
+	}
+	schedule, err := cron.ParseStandard(w.Schedule)
+	if err != nil {
+		return false, err
+	}
+
+	// The window is open if it has been opened within the last duration.
+	start := schedule.Next(t.In(loc).Add(-w.Duration.Duration()))
+	if start.IsZero() {
+		return false, nil
+	}
+	return !start.After(t), nil
+}
+
+func (w DeploymentWindow) name() string {
+	if w.Description != "" {
+		return fmt.Sprintf("%q", w.Description)
+	}
+	return fmt.Sprintf("%q (for %v)", w.Schedule, w.Duration.Duration())
+}
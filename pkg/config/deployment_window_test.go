@@ -0,0 +1,213 @@
+// Copyright 2024 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeploymentWindowsConfiguration(t *testing.T) {
+	cfg, err := LoadFromYAML("testdata/application/generic-deployment-windows.yaml")
+	require.NoError(t, err)
+
+	spec, ok := cfg.GetGenericApplication()
+	require.True(t, ok)
+
+	expected := &DeploymentWindows{
+		Timezone: "Asia/Tokyo",
+		Action:   DeploymentWindowActionQueue,
+		Allow: []DeploymentWindow{
+			{
+				Schedule: "0 9 * * 1-5",
+				Duration: Duration(9 * time.Hour),
+			},
+		},
+		Deny: []DeploymentWindow{
+			{
+				Schedule:    "0 0 29 12 *",
+				Duration:    Duration(120 * time.Hour),
+				Description: "Year-end holidays",
+			},
+		},
+	}
+	assert.Equal(t, expected, spec.DeploymentWindows)
+}
+
+func TestDeploymentWindowsValidate(t *testing.T) {
+	testcases := []struct {
+		name    string
+		windows DeploymentWindows
+		wantErr bool
+	}{
+		{
+			name: "valid",
+			windows: DeploymentWindows{
+				Timezone: "UTC",
+				Action:   DeploymentWindowActionReject,
+				Allow: []DeploymentWindow{
+					{Schedule: "0 9 * * 1-5", Duration: Duration(time.Hour), Timezone: "Asia/Tokyo"},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "invalid action",
+			windows: DeploymentWindows{
+				Timezone: "UTC",
+				Action:   "DROP",
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid timezone",
+			windows: DeploymentWindows{
+				Timezone: "Unknown/Zone",
+				Action:   DeploymentWindowActionQueue,
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid schedule",
+			windows: DeploymentWindows{
+				Timezone: "UTC",
+				Action:   DeploymentWindowActionQueue,
+				Deny: []DeploymentWindow{
+					{Schedule: "every day", Duration: Duration(time.Hour)},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "missing duration",
+			windows: DeploymentWindows{
+				Timezone: "UTC",
+				Action:   DeploymentWindowActionQueue,
+				Deny: []DeploymentWindow{
+					{Schedule: "0 0 * * *"},
+				},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.windows.Validate()
+			assert.Equal(t, tc.wantErr, err != nil)
+		})
+	}
+}
+
+func TestDeploymentWindowsBlockedReason(t *testing.T) {
+	windows := &DeploymentWindows{
+		Timezone: "Asia/Tokyo",
+		Action:   DeploymentWindowActionQueue,
+		Allow: []DeploymentWindow{
+			// Business hours on weekdays.
+			{Schedule: "0 9 * * 1-5", Duration: Duration(9 * time.Hour)},
+		},
+		Deny: []DeploymentWindow{
+			{Schedule: "0 0 29 12 *", Duration: Duration(120 * time.Hour), Description: "Year-end holidays"},
+			{Schedule: "0 12 * * *", Duration: Duration(time.Hour), Timezone: "UTC"},
+		},
+	}
+	jst, err := time.LoadLocation("Asia/Tokyo")
+	require.NoError(t, err)
+
+	testcases := []struct {
+		name     string
+		now      time.Time
+		expected string
+	}{
+		{
+			name:     "in business hours",
+			now:      time.Date(2024, 12, 2, 10, 0, 0, 0, jst),
+			expected: "",
+		},
+		{
+			name:     "at the beginning of business hours",
+			now:      time.Date(2024, 12, 2, 9, 0, 0, 0, jst),
+			expected: "",
+		},
+		{
+			name:     "after business hours",
+			now:      time.Date(2024, 12, 2, 18, 0, 0, 0, jst),
+			expected: "Deployments are allowed only during the configured allow windows",
+		},
+		{
+			name:     "on weekend",
+			now:      time.Date(2024, 12, 7, 10, 0, 0, 0, jst),
+			expected: "Deployments are allowed only during the configured allow windows",
+		},
+		{
+			name:     "in year-end holidays",
+			now:      time.Date(2025, 1, 2, 10, 0, 0, 0, jst),
+			expected: `Deployments are not allowed during the deny window "Year-end holidays"`,
+		},
+		{
+			name:     "in deny window with its own timezone",
+			now:      time.Date(2024, 12, 2, 21, 30, 0, 0, jst),
+			expected: `Deployments are not allowed during the deny window "0 12 * * *" (for 1h0m0s)`,
+		},
+		{
+			name:     "in business hours but not in deny window with its own timezone",
+			now:      time.Date(2024, 12, 2, 12, 30, 0, 0, jst),
+			expected: "",
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			reason, err := windows.BlockedReason(tc.now)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, reason)
+		})
+	}
+}
+
+func TestFindBlockingDeploymentWindows(t *testing.T) {
+	now := time.Date(2024, 12, 2, 10, 0, 0, 0, time.UTC)
+	project := &DeploymentWindows{
+		Timezone: "UTC",
+		Action:   DeploymentWindowActionReject,
+		Deny: []DeploymentWindow{
+			{Schedule: "0 0 * * 1", Duration: Duration(24 * time.Hour), Description: "No deploy Monday"},
+		},
+	}
+	app := &DeploymentWindows{
+		Timezone: "UTC",
+		Action:   DeploymentWindowActionQueue,
+		Deny: []DeploymentWindow{
+			{Schedule: "0 0 * * *", Duration: Duration(24 * time.Hour)},
+		},
+	}
+
+	w, reason, err := FindBlockingDeploymentWindows(now, nil, app)
+	require.NoError(t, err)
+	assert.Equal(t, app, w)
+	assert.NotEmpty(t, reason)
+
+	w, reason, err = FindBlockingDeploymentWindows(now, project, app)
+	require.NoError(t, err)
+	assert.Equal(t, project, w)
+	assert.Equal(t, `Deployments are not allowed during the deny window "No deploy Monday"`, reason)
+
+	w, reason, err = FindBlockingDeploymentWindows(now, nil, nil)
+	require.NoError(t, err)
+	assert.Nil(t, w)
+	assert.Empty(t, reason)
+}
@@ -21,6 +21,7 @@ import (
 	"strings"
 
 	"github.com/pipe-cd/pipecd/pkg/filematcher"
+	"github.com/pipe-cd/pipecd/pkg/model"
 )
 
 type EventWatcherSpec struct {
@@ -65,9 +66,36 @@ type EventWatcherHandlerConfig struct {
 	// Default message is used if not given.
 	CommitMessage string `json:"commitMessage,omitempty"`
 	// Whether to create a new branch or not when event watcher commits changes.
+	// A pull request is also opened if the hosting provider of the repository is configured in the piped config.
 	MakePullRequest bool `json:"makePullRequest,omitempty"`
+	// Configuration for the pull request opened when makePullRequest is true.
+	PullRequest EventWatcherPullRequest `json:"pullRequest,omitempty"`
 	// List of places where will be replaced when the new event matches.
 	Replacements []EventWatcherReplacement `json:"replacements"`
+	// List of images to be updated when the new event matches.
+	// This is used by the IMAGE_UPDATE handler.
+	ImageUpdates []EventWatcherImageUpdate `json:"imageUpdates,omitempty"`
+	// The strategy used to sync the application when the new event matches.
+	// This is used by the SYNC_APPLICATION handler.
+	// Available values are AUTO, QUICK_SYNC and PIPELINE. Default is AUTO.
+	SyncStrategy string `json:"syncStrategy,omitempty"`
+}
+
+type EventWatcherPullRequest struct {
+	// The title of the pull request.
+	// The same template arguments with commitMessage can be used.
+	// Default is the commit message.
+	Title string `json:"title,omitempty"`
+	// The description of the pull request.
+	// The same template arguments with commitMessage can be used.
+	// Default is generated from the event.
+	Body string `json:"body,omitempty"`
+	// List of users requested to review the pull request.
+	Reviewers []string `json:"reviewers,omitempty"`
+	// List of labels added to the pull request.
+	Labels []string `json:"labels,omitempty"`
+	// Whether to merge the pull request automatically once all requirements are met.
+	AutoMerge bool `json:"autoMerge,omitempty"`
 }
 
 type EventWatcherReplacement struct {
@@ -88,12 +116,43 @@ type EventWatcherReplacement struct {
 	Regex string `json:"regex"`
 }
 
+// EventWatcherImageUpdate represents an image to be updated with the event data.
+// The event data must be either a tag (e.g. `v1.2.0`) or an image reference
+// with a tag or a digest (e.g. `ghcr.io/org/app:v1.2.0`).
+type EventWatcherImageUpdate struct {
+	// The path to the file to be updated.
+	File string `json:"file"`
+	// The name of the image in the images field of the kustomization file.
+	// The image is updated in the same way as `kustomize edit set image`.
+	// Only one of kustomizeImage and helmValues can be used.
+	KustomizeImage string `json:"kustomizeImage,omitempty"`
+	// The fields of the image in the Helm values file.
+	HelmValues *EventWatcherHelmValuesImage `json:"helmValues,omitempty"`
+}
+
+type EventWatcherHelmValuesImage struct {
+	// The YAML path to the field of the image repository, e.g. `$.image.repository`.
+	// The repository is not updated if empty or the event data is only a tag.
+	RepositoryField string `json:"repositoryField,omitempty"`
+	// The YAML path to the field of the image tag, e.g. `$.image.tag`.
+	TagField string `json:"tagField"`
+	// The YAML path to the field of the image digest, e.g. `$.image.digest`.
+	// This is required to handle the event data containing a digest.
+	DigestField string `json:"digestField,omitempty"`
+}
+
 // EventWatcherHandlerType represents the type of an event watcher handler.
 type EventWatcherHandlerType string
 
 const (
 	// EventWatcherHandlerTypeGitUpdate represents the handler type for git updating.
 	EventWatcherHandlerTypeGitUpdate = "GIT_UPDATE"
+	// EventWatcherHandlerTypeSyncApplication represents the handler type
+	// for triggering a sync of the application without updating git.
+	EventWatcherHandlerTypeSyncApplication = "SYNC_APPLICATION"
+	// EventWatcherHandlerTypeImageUpdate represents the handler type for updating
+	// the images in kustomization files and Helm values files.
+	EventWatcherHandlerTypeImageUpdate = "IMAGE_UPDATE"
 )
 
 // LoadEventWatcher gives back parsed EventWatcher config after merging config files placed under
@@ -228,3 +287,37 @@ func (e *EventWatcherEvent) Validate() error {
 	}
 	return nil
 }
+
+func (e *EventWatcherConfig) Validate() error {
+	switch e.Handler.Type {
+	case EventWatcherHandlerTypeSyncApplication:
+		if s := e.Handler.Config.SyncStrategy; s != "" {
+			if _, ok := model.SyncStrategy_value[s]; !ok {
+				return fmt.Errorf("event %q has unknown sync strategy %q", e.Matcher.Name, s)
+			}
+		}
+	case EventWatcherHandlerTypeImageUpdate:
+		if len(e.Handler.Config.ImageUpdates) == 0 {
+			return fmt.Errorf("event %q must have at least one image update", e.Matcher.Name)
+		}
+		for _, u := range e.Handler.Config.ImageUpdates {
+			if err := u.Validate(); err != nil {
+				return fmt.Errorf("event %q has an invalid image update: %w", e.Matcher.Name, err)
+			}
+		}
+	}
+	return nil
+}
+
+func (u *EventWatcherImageUpdate) Validate() error {
+	if u.File == "" {
+		return fmt.Errorf("file must not be empty")
+	}
+	if (u.KustomizeImage == "") == (u.HelmValues == nil) {
+		return fmt.Errorf("one of kustomizeImage and helmValues must be set for %s", u.File)
+	}
+	if u.HelmValues != nil && u.HelmValues.TagField == "" {
+		return fmt.Errorf("helmValues.tagField must not be empty for %s", u.File)
+	}
+	return nil
+}
@@ -192,6 +192,123 @@ func TestEventWatcherValidate(t *testing.T) {
 	}
 }
 
+func TestEventWatcherConfigValidate(t *testing.T) {
+	testcases := []struct {
+		name    string
+		handler EventWatcherHandler
+		wantErr bool
+	}{
+		{
+			name: "git update",
+			handler: EventWatcherHandler{
+				Type: EventWatcherHandlerTypeGitUpdate,
+			},
+			wantErr: false,
+		},
+		{
+			name: "sync application with default strategy",
+			handler: EventWatcherHandler{
+				Type: EventWatcherHandlerTypeSyncApplication,
+			},
+			wantErr: false,
+		},
+		{
+			name: "sync application with quick sync",
+			handler: EventWatcherHandler{
+				Type: EventWatcherHandlerTypeSyncApplication,
+				Config: EventWatcherHandlerConfig{
+					SyncStrategy: "QUICK_SYNC",
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "sync application with unknown strategy",
+			handler: EventWatcherHandler{
+				Type: EventWatcherHandlerTypeSyncApplication,
+				Config: EventWatcherHandlerConfig{
+					SyncStrategy: "FAST",
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "image update",
+			handler: EventWatcherHandler{
+				Type: EventWatcherHandlerTypeImageUpdate,
+				Config: EventWatcherHandlerConfig{
+					ImageUpdates: []EventWatcherImageUpdate{
+						{
+							File:           "kustomization.yaml",
+							KustomizeImage: "helloworld",
+						},
+						{
+							File: "values.yaml",
+							HelmValues: &EventWatcherHelmValuesImage{
+								RepositoryField: "$.image.repository",
+								TagField:        "$.image.tag",
+							},
+						},
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "image update without images",
+			handler: EventWatcherHandler{
+				Type: EventWatcherHandlerTypeImageUpdate,
+			},
+			wantErr: true,
+		},
+		{
+			name: "image update with both editors",
+			handler: EventWatcherHandler{
+				Type: EventWatcherHandlerTypeImageUpdate,
+				Config: EventWatcherHandlerConfig{
+					ImageUpdates: []EventWatcherImageUpdate{
+						{
+							File:           "kustomization.yaml",
+							KustomizeImage: "helloworld",
+							HelmValues: &EventWatcherHelmValuesImage{
+								TagField: "$.image.tag",
+							},
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "image update without tag field",
+			handler: EventWatcherHandler{
+				Type: EventWatcherHandlerTypeImageUpdate,
+				Config: EventWatcherHandlerConfig{
+					ImageUpdates: []EventWatcherImageUpdate{
+						{
+							File: "values.yaml",
+							HelmValues: &EventWatcherHelmValuesImage{
+								RepositoryField: "$.image.repository",
+							},
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			cfg := EventWatcherConfig{
+				Matcher: EventWatcherMatcher{Name: "event"},
+				Handler: tc.handler,
+			}
+			err := cfg.Validate()
+			assert.Equal(t, tc.wantErr, err != nil)
+		})
+	}
+}
+
 func TestFilterEventWatcherFiles(t *testing.T) {
 	testcases := []struct {
 		name     string
@@ -19,9 +19,13 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"net"
 	"os"
+	"regexp"
 	"strings"
 
+	"github.com/Masterminds/semver/v3"
+
 	"github.com/pipe-cd/pipecd/pkg/model"
 )
 
@@ -80,6 +84,9 @@ type PipedSpec struct {
 	EventWatcher PipedEventWatcher `json:"eventWatcher"`
 	// List of labels to filter all applications this piped will handle.
 	AppSelector map[string]string `json:"appSelector,omitempty"`
+	// The project-wide deployment windows received from the control plane.
+	// This is not configurable through the piped configuration.
+	ProjectDeploymentWindows *DeploymentWindows `json:"-"`
 }
 
 func (s *PipedSpec) UnmarshalJSON(data []byte) error {
@@ -122,6 +129,14 @@ func (s *PipedSpec) Validate() error {
 	if err := s.Git.Validate(); err != nil {
 		return err
 	}
+	for _, r := range s.Repositories {
+		if r.HostingProvider == nil {
+			continue
+		}
+		if err := r.HostingProvider.Validate(); err != nil {
+			return fmt.Errorf("invalid repository %s: %w", r.RepoID, err)
+		}
+	}
 	for _, r := range s.ChartRepositories {
 		if err := r.Validate(); err != nil {
 			return err
@@ -146,6 +161,21 @@ func (s *PipedSpec) Validate() error {
 				return err
 			}
 		}
+		if n.Teams != nil {
+			if err := n.Teams.Validate(); err != nil {
+				return err
+			}
+		}
+		if n.Discord != nil {
+			if err := n.Discord.Validate(); err != nil {
+				return err
+			}
+		}
+		if n.Email != nil {
+			if err := n.Email.Validate(); err != nil {
+				return err
+			}
+		}
 	}
 	for _, p := range s.AnalysisProviders {
 		if err := p.Validate(); err != nil {
@@ -179,6 +209,16 @@ func (s *PipedSpec) Mask() {
 		s.PipedKeyData = maskString
 	}
 	s.Git.Mask()
+	for i := 0; i < len(s.Repositories); i++ {
+		if s.Repositories[i].HostingProvider != nil {
+			s.Repositories[i].HostingProvider.Mask()
+		}
+	}
+	for i := 0; i < len(s.EventWatcher.ImagePollers); i++ {
+		if len(s.EventWatcher.ImagePollers[i].PasswordFile) != 0 {
+			s.EventWatcher.ImagePollers[i].PasswordFile = maskString
+		}
+	}
 	for i := 0; i < len(s.ChartRepositories); i++ {
 		s.ChartRepositories[i].Mask()
 	}
@@ -401,6 +441,55 @@ type PipedRepository struct {
 	Remote string `json:"remote"`
 	// The branch will be handled.
 	Branch string `json:"branch"`
+	// The Git hosting service where the repository is hosted.
+	// This is required to open pull requests, e.g. by the event watcher.
+	HostingProvider *GitHostingProvider `json:"hostingProvider,omitempty"`
+}
+
+type GitHostingProviderType string
+
+const (
+	GitHostingProviderGitHub    GitHostingProviderType = "GITHUB"
+	GitHostingProviderGitLab    GitHostingProviderType = "GITLAB"
+	GitHostingProviderBitbucket GitHostingProviderType = "BITBUCKET"
+	GitHostingProviderGitea     GitHostingProviderType = "GITEA"
+)
+
+type GitHostingProvider struct {
+	// The type of the hosting service.
+	// Currently, GITHUB, GITLAB, BITBUCKET and GITEA are supported.
+	Type GitHostingProviderType `json:"type"`
+	// The base URL of the API.
+	// Default is the one of the public service, e.g. https://api.github.com.
+	// This is required for GITEA.
+	APIURL string `json:"apiURL,omitempty"`
+	// The path of the repository on the hosting service, e.g. org/repo.
+	// Default is the one extracted from the remote address.
+	Repository string `json:"repository,omitempty"`
+	// The path to the file containing the access token.
+	TokenFile string `json:"tokenFile"`
+}
+
+func (p *GitHostingProvider) Validate() error {
+	switch p.Type {
+	case GitHostingProviderGitHub, GitHostingProviderGitLab, GitHostingProviderBitbucket:
+	case GitHostingProviderGitea:
+		if p.APIURL == "" {
+			return fmt.Errorf("apiURL must be set for %s hosting provider", p.Type)
+		}
+	default:
+		return fmt.Errorf("unsupported hosting provider type: %s", p.Type)
+	}
+	if p.TokenFile == "" {
+		return errors.New("tokenFile must be set for hosting provider")
+	}
+	return nil
+}
+
+func (p *GitHostingProvider) Mask() {
+	if len(p.TokenFile) != 0 {
+		p.TokenFile = maskString
+	}
 }
 
 type HelmChartRepositoryType string
@@ -765,9 +854,14 @@ type PipedAnalysisProvider struct {
 	Name string                     `json:"name"`
 	Type model.AnalysisProviderType `json:"type"`
 
-	PrometheusConfig  *AnalysisProviderPrometheusConfig
-	DatadogConfig     *AnalysisProviderDatadogConfig
-	StackdriverConfig *AnalysisProviderStackdriverConfig
+	PrometheusConfig      *AnalysisProviderPrometheusConfig
+	DatadogConfig         *AnalysisProviderDatadogConfig
+	StackdriverConfig     *AnalysisProviderStackdriverConfig
+	LokiConfig            *AnalysisProviderLokiConfig
+	ElasticsearchConfig   *AnalysisProviderElasticsearchConfig
+	CloudWatchConfig      *AnalysisProviderCloudWatchConfig
+	NewRelicConfig        *AnalysisProviderNewRelicConfig
+	CloudMonitoringConfig *AnalysisProviderCloudMonitoringConfig
 }
 
 func (p *PipedAnalysisProvider) Mask() {
@@ -780,6 +874,21 @@ func (p *PipedAnalysisProvider) Mask() {
 	if p.StackdriverConfig != nil {
 		p.StackdriverConfig.Mask()
 	}
+	if p.LokiConfig != nil {
+		p.LokiConfig.Mask()
+	}
+	if p.ElasticsearchConfig != nil {
+		p.ElasticsearchConfig.Mask()
+	}
+	if p.CloudWatchConfig != nil {
+		p.CloudWatchConfig.Mask()
+	}
+	if p.NewRelicConfig != nil {
+		p.NewRelicConfig.Mask()
+	}
+	if p.CloudMonitoringConfig != nil {
+		p.CloudMonitoringConfig.Mask()
+	}
 }
 
 type genericPipedAnalysisProvider struct {
@@ -801,6 +910,16 @@ func (p *PipedAnalysisProvider) MarshalJSON() ([]byte, error) {
 		config, err = json.Marshal(p.PrometheusConfig)
 	case model.AnalysisProviderStackdriver:
 		config, err = json.Marshal(p.StackdriverConfig)
+	case model.AnalysisProviderLoki:
+		config, err = json.Marshal(p.LokiConfig)
+	case model.AnalysisProviderElasticsearch:
+		config, err = json.Marshal(p.ElasticsearchConfig)
+	case model.AnalysisProviderCloudWatch:
+		config, err = json.Marshal(p.CloudWatchConfig)
+	case model.AnalysisProviderNewRelic:
+		config, err = json.Marshal(p.NewRelicConfig)
+	case model.AnalysisProviderCloudMonitoring:
+		config, err = json.Marshal(p.CloudMonitoringConfig)
 	default:
 		err = fmt.Errorf("unsupported analysis provider type: %s", p.Name)
 	}
@@ -841,6 +960,31 @@ func (p *PipedAnalysisProvider) UnmarshalJSON(data []byte) error {
 		if len(gp.Config) > 0 {
 			err = json.Unmarshal(gp.Config, p.StackdriverConfig)
 		}
+	case model.AnalysisProviderLoki:
+		p.LokiConfig = &AnalysisProviderLokiConfig{}
+		if len(gp.Config) > 0 {
+			err = json.Unmarshal(gp.Config, p.LokiConfig)
+		}
+	case model.AnalysisProviderElasticsearch:
+		p.ElasticsearchConfig = &AnalysisProviderElasticsearchConfig{}
+		if len(gp.Config) > 0 {
+			err = json.Unmarshal(gp.Config, p.ElasticsearchConfig)
+		}
+	case model.AnalysisProviderCloudWatch:
+		p.CloudWatchConfig = &AnalysisProviderCloudWatchConfig{}
+		if len(gp.Config) > 0 {
+			err = json.Unmarshal(gp.Config, p.CloudWatchConfig)
+		}
+	case model.AnalysisProviderNewRelic:
+		p.NewRelicConfig = &AnalysisProviderNewRelicConfig{}
+		if len(gp.Config) > 0 {
+			err = json.Unmarshal(gp.Config, p.NewRelicConfig)
+		}
+	case model.AnalysisProviderCloudMonitoring:
+		p.CloudMonitoringConfig = &AnalysisProviderCloudMonitoringConfig{}
+		if len(gp.Config) > 0 {
+			err = json.Unmarshal(gp.Config, p.CloudMonitoringConfig)
+		}
 	default:
 		err = fmt.Errorf("unsupported analysis provider type: %s", p.Name)
 	}
@@ -855,6 +999,16 @@ func (p *PipedAnalysisProvider) Validate() error {
 		return p.DatadogConfig.Validate()
 	case model.AnalysisProviderStackdriver:
 		return p.StackdriverConfig.Validate()
+	case model.AnalysisProviderLoki:
+		return p.LokiConfig.Validate()
+	case model.AnalysisProviderElasticsearch:
+		return p.ElasticsearchConfig.Validate()
+	case model.AnalysisProviderCloudWatch:
+		return p.CloudWatchConfig.Validate()
+	case model.AnalysisProviderNewRelic:
+		return p.NewRelicConfig.Validate()
+	case model.AnalysisProviderCloudMonitoring:
+		return p.CloudMonitoringConfig.Validate()
 	default:
 		return fmt.Errorf("unknow provider type: %s", p.Type)
 	}
@@ -932,6 +1086,9 @@ func (a *AnalysisProviderDatadogConfig) Mask() {
 type AnalysisProviderStackdriverConfig struct {
 	// The path to the service account file.
 	ServiceAccountFile string `json:"serviceAccountFile"`
+	// The ID of the project where the logs are read.
+	// Default is the project of the service account.
+	ProjectID string `json:"projectID,omitempty"`
 }
 
 func (a *AnalysisProviderStackdriverConfig) Mask() {
@@ -944,6 +1101,169 @@ func (a *AnalysisProviderStackdriverConfig) Validate() error {
 	return nil
 }
 
+type AnalysisProviderLokiConfig struct {
+	// The address of Loki server.
+	Address string `json:"address"`
+	// The tenant ID sent as X-Scope-OrgID header to a multi-tenant Loki.
+	TenantID string `json:"tenantID,omitempty"`
+	// The path to the username file.
+	UsernameFile string `json:"usernameFile,omitempty"`
+	// The path to the password file.
+	PasswordFile string `json:"passwordFile,omitempty"`
+	// The path to the bearer token file.
+	TokenFile string `json:"tokenFile,omitempty"`
+}
+
+func (a *AnalysisProviderLokiConfig) Validate() error {
+	if a.Address == "" {
+		return fmt.Errorf("loki analysis provider requires the address")
+	}
+	if (a.UsernameFile == "") != (a.PasswordFile == "") {
+		return fmt.Errorf("both usernameFile and passwordFile must be set for loki analysis provider")
+	}
+	if a.UsernameFile != "" && a.TokenFile != "" {
+		return fmt.Errorf("only basic auth or tokenFile can be set for loki analysis provider")
+	}
+	return nil
+}
+
+func (a *AnalysisProviderLokiConfig) Mask() {
+	if len(a.PasswordFile) != 0 {
+		a.PasswordFile = maskString
+	}
+	if len(a.TokenFile) != 0 {
+		a.TokenFile = maskString
+	}
+}
+
+type AnalysisProviderElasticsearchConfig struct {
+	// The address of Elasticsearch or OpenSearch server.
+	Address string `json:"address"`
+	// The index pattern where the logs are searched.
+	// Default is "*".
+	Index string `json:"index,omitempty"`
+	// The field holding the timestamp of the log entries.
+	// Default is "@timestamp".
+	TimestampField string `json:"timestampField,omitempty"`
+	// The field holding the message of the log entries.
+	// Default is "message".
+	MessageField string `json:"messageField,omitempty"`
+	// The field holding the severity of the log entries.
+	// Default is "log.level".
+	SeverityField string `json:"severityField,omitempty"`
+	// The path to the username file.
+	UsernameFile string `json:"usernameFile,omitempty"`
+	// The path to the password file.
+	PasswordFile string `json:"passwordFile,omitempty"`
+	// The path to the base64 encoded API key file.
+	APIKeyFile string `json:"apiKeyFile,omitempty"`
+}
+
+func (a *AnalysisProviderElasticsearchConfig) Validate() error {
+	if a.Address == "" {
+		return fmt.Errorf("elasticsearch analysis provider requires the address")
+	}
+	if (a.UsernameFile == "") != (a.PasswordFile == "") {
+		return fmt.Errorf("both usernameFile and passwordFile must be set for elasticsearch analysis provider")
+	}
+	if a.UsernameFile != "" && a.APIKeyFile != "" {
+		return fmt.Errorf("only basic auth or apiKeyFile can be set for elasticsearch analysis provider")
+	}
+	return nil
+}
+
+func (a *AnalysisProviderElasticsearchConfig) Mask() {
+	if len(a.PasswordFile) != 0 {
+		a.PasswordFile = maskString
+	}
+	if len(a.APIKeyFile) != 0 {
+		a.APIKeyFile = maskString
+	}
+}
+
+type AnalysisProviderCloudWatchConfig struct {
+	// The region to send requests to. This parameter is required.
+	// e.g. "us-west-2"
+	Region string `json:"region"`
+	// Path to the shared credentials file.
+	CredentialsFile string `json:"credentialsFile,omitempty"`
+	// The IAM role arn to use when assuming an role.
+	RoleARN string `json:"roleARN,omitempty"`
+	// Path to the WebIdentity token the SDK should use to assume a role with.
+	TokenFile string `json:"tokenFile,omitempty"`
+	// AWS Profile to extract credentials from the shared credentials file.
+	// If empty, the environment variable "AWS_PROFILE" is used.
+	// "default" is populated if the environment variable is also not set.
+	Profile string `json:"profile,omitempty"`
+}
+
+func (a *AnalysisProviderCloudWatchConfig) Validate() error {
+	if a.Region == "" {
+		return fmt.Errorf("cloudwatch analysis provider requires the region")
+	}
+	return nil
+}
+
+func (a *AnalysisProviderCloudWatchConfig) Mask() {
+	if len(a.CredentialsFile) != 0 {
+		a.CredentialsFile = maskString
+	}
+	if len(a.RoleARN) != 0 {
+		a.RoleARN = maskString
+	}
+	if len(a.TokenFile) != 0 {
+		a.TokenFile = maskString
+	}
+}
+
+type AnalysisProviderNewRelicConfig struct {
+	// The address of NerdGraph API.
+	// Default is "https://api.newrelic.com/graphql".
+	// Use "https://api.eu.newrelic.com/graphql" for the accounts in the EU region.
+	Address string `json:"address,omitempty"`
+	// The ID of the account where the NRQL queries are run.
+	AccountID int64 `json:"accountID"`
+	// The path to the user API key file.
+	APIKeyFile string `json:"apiKeyFile"`
+}
+
+func (a *AnalysisProviderNewRelicConfig) Validate() error {
+	if a.AccountID == 0 {
+		return fmt.Errorf("newrelic analysis provider requires the accountID")
+	}
+	if a.APIKeyFile == "" {
+		return fmt.Errorf("newrelic analysis provider requires the apiKeyFile")
+	}
+	return nil
+}
+
+func (a *AnalysisProviderNewRelicConfig) Mask() {
+	if len(a.APIKeyFile) != 0 {
+		a.APIKeyFile = maskString
+	}
+}
+
+type AnalysisProviderCloudMonitoringConfig struct {
+	// The path to the service account file.
+	ServiceAccountFile string `json:"serviceAccountFile"`
+	// The ID of the scoping project where the metrics are read.
+	// Default is the project of the service account.
+	ProjectID string `json:"projectID,omitempty"`
+}
+
+func (a *AnalysisProviderCloudMonitoringConfig) Validate() error {
+	if a.ServiceAccountFile == "" {
+		return fmt.Errorf("cloud monitoring analysis provider requires the serviceAccountFile")
+	}
+	return nil
+}
+
+func (a *AnalysisProviderCloudMonitoringConfig) Mask() {
+	if len(a.ServiceAccountFile) != 0 {
+		a.ServiceAccountFile = maskString
+	}
+}
+
 type Notifications struct {
 	// List of notification routes.
 	Routes []NotificationRoute `json:"routes,omitempty"`
@@ -974,6 +1294,9 @@ type NotificationReceiver struct {
 	Name    string                       `json:"name"`
 	Slack   *NotificationReceiverSlack   `json:"slack,omitempty"`
 	Webhook *NotificationReceiverWebhook `json:"webhook,omitempty"`
+	Teams   *NotificationReceiverTeams   `json:"teams,omitempty"`
+	Discord *NotificationReceiverDiscord `json:"discord,omitempty"`
+	Email   *NotificationReceiverEmail   `json:"email,omitempty"`
 }
 
 func (n *NotificationReceiver) Mask() {
@@ -983,6 +1306,15 @@ func (n *NotificationReceiver) Mask() {
 	if n.Webhook != nil {
 		n.Webhook.Mask()
 	}
+	if n.Teams != nil {
+		n.Teams.Mask()
+	}
+	if n.Discord != nil {
+		n.Discord.Mask()
+	}
+	if n.Email != nil {
+		n.Email.Mask()
+	}
 }
 
 type NotificationReceiverSlack struct {
@@ -993,6 +1325,9 @@ type NotificationReceiverSlack struct {
 	ChannelID         string   `json:"channelID"`
 	MentionedAccounts []string `json:"mentionedAccounts,omitempty"`
 	MentionedGroups   []string `json:"mentionedGroups,omitempty"`
+	// Whether to attach Approve buttons to the messages of DEPLOYMENT_WAIT_APPROVAL events.
+	// The Slack app must have its interactivity request URL pointed to the control plane.
+	InteractiveApproval bool `json:"interactiveApproval,omitempty"`
 }
 
 func (n *NotificationReceiverSlack) Mask() {
@@ -1082,6 +1417,156 @@ func (n *NotificationReceiverWebhook) LoadSignatureValue() (string, error) {
 	return "", nil
 }
 
+type NotificationReceiverTeams struct {
+	// The incoming webhook URL of the Teams channel.
+	HookURL string `json:"hookURL,omitempty"`
+	// The path to the file containing the incoming webhook URL.
+	HookURLFile string `json:"hookURLFile,omitempty"`
+	// List of user principal names (emails) to be mentioned in every message.
+	MentionedAccounts []string `json:"mentionedAccounts,omitempty"`
+	// List of team tag IDs to be mentioned in every message.
+	MentionedGroups []string `json:"mentionedGroups,omitempty"`
+}
+
+func (n *NotificationReceiverTeams) Mask() {
+	if len(n.HookURL) != 0 {
+		n.HookURL = maskString
+	}
+	if len(n.HookURLFile) != 0 {
+		n.HookURLFile = maskString
+	}
+}
+
+func (n *NotificationReceiverTeams) Validate() error {
+	if n.HookURL == "" && n.HookURLFile == "" {
+		return errors.New("either hookURL or hookURLFile must be set")
+	}
+	if n.HookURL != "" && n.HookURLFile != "" {
+		return errors.New("only either hookURL or hookURLFile can be set")
+	}
+	return nil
+}
+
+func (n *NotificationReceiverTeams) LoadHookURL() (string, error) {
+	return loadHookURL(n.HookURL, n.HookURLFile)
+}
+
+type NotificationReceiverDiscord struct {
+	// The webhook URL of the Discord channel.
+	HookURL string `json:"hookURL,omitempty"`
+	// The path to the file containing the webhook URL.
+	HookURLFile string `json:"hookURLFile,omitempty"`
+	// List of user IDs to be mentioned in every message.
+	MentionedAccounts []string `json:"mentionedAccounts,omitempty"`
+	// List of role IDs to be mentioned in every message.
+	MentionedGroups []string `json:"mentionedGroups,omitempty"`
+}
+
+func (n *NotificationReceiverDiscord) Mask() {
+	if len(n.HookURL) != 0 {
+		n.HookURL = maskString
+	}
+	if len(n.HookURLFile) != 0 {
+		n.HookURLFile = maskString
+	}
+}
+
+func (n *NotificationReceiverDiscord) Validate() error {
+	if n.HookURL == "" && n.HookURLFile == "" {
+		return errors.New("either hookURL or hookURLFile must be set")
+	}
+	if n.HookURL != "" && n.HookURLFile != "" {
+		return errors.New("only either hookURL or hookURLFile can be set")
+	}
+	return nil
+}
+
+func (n *NotificationReceiverDiscord) LoadHookURL() (string, error) {
+	return loadHookURL(n.HookURL, n.HookURLFile)
+}
+
+type NotificationReceiverEmail struct {
+	// The address of the SMTP server in form of "host:port".
+	// STARTTLS is used automatically when the server supports it.
+	SMTPAddress string `json:"smtpAddress"`
+	// The username used to authenticate with the SMTP server.
+	// Authentication is skipped when this is empty.
+	Username string `json:"username,omitempty"`
+	// The password used to authenticate with the SMTP server.
+	Password string `json:"password,omitempty"`
+	// The path to the file containing the password.
+	PasswordFile string `json:"passwordFile,omitempty"`
+	// The sender address of the emails.
+	From string `json:"from"`
+	// List of recipient addresses.
+	To []string `json:"to"`
+	// Configuration for batching events into digest emails.
+	// Every event is sent as an individual email when this is not specified.
+	Digest *NotificationReceiverEmailDigest `json:"digest,omitempty"`
+}
+
+type NotificationReceiverEmailDigest struct {
+	// How long events are buffered before being sent.
+	// All events of an application buffered during this window are sent as one email.
+	Interval Duration `json:"interval,omitempty" default:"5m"`
+}
+
+func (n *NotificationReceiverEmail) Mask() {
+	if len(n.Password) != 0 {
+		n.Password = maskString
+	}
+	if len(n.PasswordFile) != 0 {
+		n.PasswordFile = maskString
+	}
+}
+
+func (n *NotificationReceiverEmail) Validate() error {
+	if n.SMTPAddress == "" {
+		return errors.New("smtpAddress must be set")
+	}
+	if _, _, err := net.SplitHostPort(n.SMTPAddress); err != nil {
+		return fmt.Errorf("invalid smtpAddress %q: %w", n.SMTPAddress, err)
+	}
+	if n.From == "" {
+		return errors.New("from must be set")
+	}
+	if len(n.To) == 0 {
+		return errors.New("at least one address must be set to to")
+	}
+	if n.Password != "" && n.PasswordFile != "" {
+		return errors.New("only either password or passwordFile can be set")
+	}
+	if n.Digest != nil && n.Digest.Interval <= 0 {
+		return errors.New("digest interval must be greater than 0")
+	}
+	return nil
+}
+
+func (n *NotificationReceiverEmail) LoadPassword() (string, error) {
+	if n.Password != "" {
+		return n.Password, nil
+	}
+	if n.PasswordFile != "" {
+		val, err := os.ReadFile(n.PasswordFile)
+		if err != nil {
+			return "", err
+		}
+		return strings.TrimSuffix(string(val), "\n"), nil
+	}
+	return "", nil
+}
+
+func loadHookURL(url, file string) (string, error) {
+	if url != "" {
+		return url, nil
+	}
+	val, err := os.ReadFile(file)
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(string(val)), nil
+}
+
 type SecretManagement struct {
 	// Which management service should be used.
 	// Available values: KEY_PAIR, GCP_KMS, AWS_KMS
@@ -1261,6 +1746,9 @@ type PipedEventWatcher struct {
 	// The configuration list of git repositories to be observed.
 	// Only the repositories in this list will be observed by Piped.
 	GitRepos []PipedEventWatcherGitRepo `json:"gitRepos,omitempty"`
+	// List of container image repositories to be polled for new tags.
+	// An event is registered when a new tag is found.
+	ImagePollers []PipedEventWatcherImagePoller `json:"imagePollers,omitempty"`
 }
 
 func (p *PipedEventWatcher) Validate() error {
@@ -1276,6 +1764,59 @@ func (p *PipedEventWatcher) Validate() error {
 		}
 		seen[repo.RepoID] = struct{}{}
 	}
+	for i, p := range p.ImagePollers {
+		if err := p.Validate(); err != nil {
+			return fmt.Errorf("invalid imagePollers at index %d: %w", i, err)
+		}
+	}
+	return nil
+}
+
+type PipedEventWatcherImagePoller struct {
+	// The image repository to be polled, e.g. ghcr.io/org/helloworld.
+	Image string `json:"image"`
+	// Interval to list the tags of the image repository.
+	// Default is 5m.
+	PollInterval Duration `json:"pollInterval,omitempty"`
+	// The semantic version constraint the tags must satisfy, e.g. ">=1.2.0, <2.0.0".
+	// The highest version satisfying the constraint is chosen.
+	SemVer string `json:"semver,omitempty"`
+	// The regular expression the tags must fully match, e.g. "main-[0-9]{14}".
+	// The lexicographically greatest tag is chosen if semver is not set.
+	Regex string `json:"regex,omitempty"`
+	// The username used for the registry authentication.
+	Username string `json:"username,omitempty"`
+	// The path to the file containing the password used for the registry authentication.
+	PasswordFile string `json:"passwordFile,omitempty"`
+	// Whether to access the registry via plain HTTP.
+	Insecure bool `json:"insecure,omitempty"`
+	// The name of the event registered when a new tag is found.
+	// The data of the event is the image reference with the tag, e.g. ghcr.io/org/helloworld:v0.2.0.
+	EventName string `json:"eventName"`
+	// The labels of the event.
+	Labels map[string]string `json:"labels,omitempty"`
+}
+
+func (p *PipedEventWatcherImagePoller) Validate() error {
+	if p.Image == "" {
+		return errors.New("image must be set")
+	}
+	if p.EventName == "" {
+		return errors.New("eventName must be set")
+	}
+	if p.SemVer == "" && p.Regex == "" {
+		return errors.New("either semver or regex must be set")
+	}
+	if p.SemVer != "" {
+		if _, err := semver.NewConstraint(p.SemVer); err != nil {
+			return fmt.Errorf("invalid semver constraint %q: %w", p.SemVer, err)
+		}
+	}
+	if p.Regex != "" {
+		if _, err := regexp.Compile(p.Regex); err != nil {
+			return fmt.Errorf("invalid regex %q: %w", p.Regex, err)
+		}
+	}
 	return nil
 }
 
@@ -55,6 +55,10 @@ func TestPipedConfig(t *testing.T) {
 						RepoID: "repo1",
 						Remote: "git@github.com:org/repo1.git",
 						Branch: "master",
+						HostingProvider: &GitHostingProvider{
+							Type:      GitHostingProviderGitHub,
+							TokenFile: "/etc/piped-secret/github-token",
+						},
 					},
 					{
 						RepoID: "repo2",
@@ -175,6 +179,48 @@ func TestPipedConfig(t *testing.T) {
 							ServiceAccountFile: "/etc/piped-secret/gcp-service-account.json",
 						},
 					},
+					{
+						Name: "loki-dev",
+						Type: model.AnalysisProviderLoki,
+						LokiConfig: &AnalysisProviderLokiConfig{
+							Address:   "https://your-loki.dev",
+							TenantID:  "dev",
+							TokenFile: "/etc/piped-secret/loki-token",
+						},
+					},
+					{
+						Name: "elasticsearch-dev",
+						Type: model.AnalysisProviderElasticsearch,
+						ElasticsearchConfig: &AnalysisProviderElasticsearchConfig{
+							Address:    "https://your-elasticsearch.dev",
+							Index:      "logs-*",
+							APIKeyFile: "/etc/piped-secret/elasticsearch-api-key",
+						},
+					},
+					{
+						Name: "cloudwatch-dev",
+						Type: model.AnalysisProviderCloudWatch,
+						CloudWatchConfig: &AnalysisProviderCloudWatchConfig{
+							Region:  "us-east-1",
+							Profile: "dev",
+						},
+					},
+					{
+						Name: "newrelic-dev",
+						Type: model.AnalysisProviderNewRelic,
+						NewRelicConfig: &AnalysisProviderNewRelicConfig{
+							AccountID:  1234567,
+							APIKeyFile: "/etc/piped-secret/newrelic-api-key",
+						},
+					},
+					{
+						Name: "cloudmonitoring-dev",
+						Type: model.AnalysisProviderCloudMonitoring,
+						CloudMonitoringConfig: &AnalysisProviderCloudMonitoringConfig{
+							ServiceAccountFile: "/etc/piped-secret/gcp-service-account.json",
+							ProjectID:          "dev-project",
+						},
+					},
 				},
 				Notifications: Notifications{
 					Routes: []NotificationRoute{
@@ -342,6 +388,33 @@ func TestPipedConfig(t *testing.T) {
 								SignatureValue: "random-signature-string",
 							},
 						},
+						{
+							Name: "prod-teams-channel",
+							Teams: &NotificationReceiverTeams{
+								HookURL:           "https://teams.microsoft.com/prod",
+								MentionedAccounts: []string{"oncall@pipecd.dev"},
+							},
+						},
+						{
+							Name: "oss-discord-channel",
+							Discord: &NotificationReceiverDiscord{
+								HookURL:         "https://discord.com/api/webhooks/oss",
+								MentionedGroups: []string{"1234567890"},
+							},
+						},
+						{
+							Name: "oncall-email",
+							Email: &NotificationReceiverEmail{
+								SMTPAddress:  "smtp.pipecd.dev:587",
+								Username:     "pipecd",
+								PasswordFile: "/etc/piped-secret/smtp-password",
+								From:         "pipecd@pipecd.dev",
+								To:           []string{"oncall@pipecd.dev"},
+								Digest: &NotificationReceiverEmailDigest{
+									Interval: Duration(10 * time.Minute),
+								},
+							},
+						},
 					},
 				},
 				SecretManagement: &SecretManagement{
@@ -360,6 +433,15 @@ func TestPipedConfig(t *testing.T) {
 							Includes:      []string{"event-watcher-dev.yaml", "event-watcher-stg.yaml"},
 						},
 					},
+					ImagePollers: []PipedEventWatcherImagePoller{
+						{
+							Image:        "ghcr.io/org/helloworld",
+							PollInterval: Duration(5 * time.Minute),
+							SemVer:       ">=1.0.0, <2.0.0",
+							EventName:    "helloworld-image-update",
+							Labels:       map[string]string{"env": "prod"},
+						},
+					},
 				},
 			},
 			expectedError: nil,
@@ -427,6 +509,72 @@ func TestPipedEventWatcherValidate(t *testing.T) {
 				},
 			},
 		},
+		{
+			name:    "image poller without semver and regex",
+			wantErr: true,
+			eventWatcher: PipedEventWatcher{
+				ImagePollers: []PipedEventWatcherImagePoller{
+					{
+						Image:     "ghcr.io/org/helloworld",
+						EventName: "helloworld-image-update",
+					},
+				},
+			},
+			wantPipedEventWatcher: PipedEventWatcher{
+				ImagePollers: []PipedEventWatcherImagePoller{
+					{
+						Image:     "ghcr.io/org/helloworld",
+						EventName: "helloworld-image-update",
+					},
+				},
+			},
+		},
+		{
+			name:    "image poller with invalid semver",
+			wantErr: true,
+			eventWatcher: PipedEventWatcher{
+				ImagePollers: []PipedEventWatcherImagePoller{
+					{
+						Image:     "ghcr.io/org/helloworld",
+						SemVer:    ">=foo",
+						EventName: "helloworld-image-update",
+					},
+				},
+			},
+			wantPipedEventWatcher: PipedEventWatcher{
+				ImagePollers: []PipedEventWatcherImagePoller{
+					{
+						Image:     "ghcr.io/org/helloworld",
+						SemVer:    ">=foo",
+						EventName: "helloworld-image-update",
+					},
+				},
+			},
+		},
+		{
+			name:    "valid image poller",
+			wantErr: false,
+			eventWatcher: PipedEventWatcher{
+				ImagePollers: []PipedEventWatcherImagePoller{
+					{
+						Image:     "ghcr.io/org/helloworld",
+						SemVer:    ">=1.2.0, <2.0.0",
+						Regex:     "v.*",
+						EventName: "helloworld-image-update",
+					},
+				},
+			},
+			wantPipedEventWatcher: PipedEventWatcher{
+				ImagePollers: []PipedEventWatcherImagePoller{
+					{
+						Image:     "ghcr.io/org/helloworld",
+						SemVer:    ">=1.2.0, <2.0.0",
+						Regex:     "v.*",
+						EventName: "helloworld-image-update",
+					},
+				},
+			},
+		},
 		{
 			name:    "repos are unique",
 			wantErr: false,
@@ -537,6 +685,118 @@ func TestPipedSlackNotificationValidate(t *testing.T) {
 	}
 }
 
+func TestNotificationReceiverTeams_LoadHookURL(t *testing.T) {
+	testcases := []struct {
+		name     string
+		receiver *NotificationReceiverTeams
+		want     string
+		wantErr  bool
+	}{
+		{
+			name: "set hookURL",
+			receiver: &NotificationReceiverTeams{
+				HookURL: "https://example.com",
+			},
+			want:    "https://example.com",
+			wantErr: false,
+		},
+		{
+			name: "set hookURLFile",
+			receiver: &NotificationReceiverTeams{
+				HookURLFile: "testdata/piped/notification-receiver-webhook",
+			},
+			want:    "foo",
+			wantErr: false,
+		},
+		{
+			name: "missing file",
+			receiver: &NotificationReceiverTeams{
+				HookURLFile: "testdata/piped/not-found",
+			},
+			want:    "",
+			wantErr: true,
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := tc.receiver.LoadHookURL()
+			assert.Equal(t, tc.wantErr, err != nil)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestPipedChatNotificationValidate(t *testing.T) {
+	testcases := []struct {
+		name     string
+		receiver interface{ Validate() error }
+		wantErr  bool
+	}{
+		{
+			name:     "valid teams receiver",
+			receiver: &NotificationReceiverTeams{HookURL: "https://example.com"},
+			wantErr:  false,
+		},
+		{
+			name:     "teams receiver without hook URL",
+			receiver: &NotificationReceiverTeams{},
+			wantErr:  true,
+		},
+		{
+			name:     "discord receiver with both hook URL and file",
+			receiver: &NotificationReceiverDiscord{HookURL: "https://example.com", HookURLFile: "/etc/hook"},
+			wantErr:  true,
+		},
+		{
+			name:     "valid discord receiver",
+			receiver: &NotificationReceiverDiscord{HookURLFile: "/etc/hook"},
+			wantErr:  false,
+		},
+		{
+			name: "valid email receiver",
+			receiver: &NotificationReceiverEmail{
+				SMTPAddress: "localhost:25",
+				From:        "pipecd@example.com",
+				To:          []string{"oncall@example.com"},
+			},
+			wantErr: false,
+		},
+		{
+			name: "email receiver without port",
+			receiver: &NotificationReceiverEmail{
+				SMTPAddress: "localhost",
+				From:        "pipecd@example.com",
+				To:          []string{"oncall@example.com"},
+			},
+			wantErr: true,
+		},
+		{
+			name: "email receiver without recipients",
+			receiver: &NotificationReceiverEmail{
+				SMTPAddress: "localhost:25",
+				From:        "pipecd@example.com",
+			},
+			wantErr: true,
+		},
+		{
+			name: "email receiver with zero digest interval",
+			receiver: &NotificationReceiverEmail{
+				SMTPAddress: "localhost:25",
+				From:        "pipecd@example.com",
+				To:          []string{"oncall@example.com"},
+				Digest:      &NotificationReceiverEmailDigest{},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.receiver.Validate()
+			assert.Equal(t, tc.wantErr, err != nil)
+		})
+	}
+}
+
 func TestNotificationReceiverWebhook_LoadSignatureValue(t *testing.T) {
 	testcase := []struct {
 		name    string
@@ -616,6 +876,11 @@ func TestPipedConfigMask(t *testing.T) {
 						RepoID: "foo",
 						Remote: "foo",
 						Branch: "foo",
+						HostingProvider: &GitHostingProvider{
+							Type:      GitHostingProviderGitea,
+							APIURL:    "foo",
+							TokenFile: "foo",
+						},
 					},
 				},
 				ChartRepositories: []HelmChartRepository{
@@ -691,6 +956,31 @@ func TestPipedConfigMask(t *testing.T) {
 						StackdriverConfig: &AnalysisProviderStackdriverConfig{
 							ServiceAccountFile: "foo",
 						},
+						LokiConfig: &AnalysisProviderLokiConfig{
+							Address:      "foo",
+							UsernameFile: "foo",
+							PasswordFile: "foo",
+							TokenFile:    "foo",
+						},
+						ElasticsearchConfig: &AnalysisProviderElasticsearchConfig{
+							Address:      "foo",
+							UsernameFile: "foo",
+							PasswordFile: "foo",
+							APIKeyFile:   "foo",
+						},
+						CloudWatchConfig: &AnalysisProviderCloudWatchConfig{
+							Region:          "foo",
+							CredentialsFile: "foo",
+							RoleARN:         "foo",
+							TokenFile:       "foo",
+						},
+						NewRelicConfig: &AnalysisProviderNewRelicConfig{
+							AccountID:  1,
+							APIKeyFile: "foo",
+						},
+						CloudMonitoringConfig: &AnalysisProviderCloudMonitoringConfig{
+							ServiceAccountFile: "foo",
+						},
 					},
 				},
 				Notifications: Notifications{
@@ -780,6 +1070,11 @@ func TestPipedConfigMask(t *testing.T) {
 						RepoID: "foo",
 						Remote: "foo",
 						Branch: "foo",
+						HostingProvider: &GitHostingProvider{
+							Type:      GitHostingProviderGitea,
+							APIURL:    "foo",
+							TokenFile: maskString,
+						},
 					},
 				},
 				ChartRepositories: []HelmChartRepository{
@@ -855,6 +1150,31 @@ func TestPipedConfigMask(t *testing.T) {
 						StackdriverConfig: &AnalysisProviderStackdriverConfig{
 							ServiceAccountFile: maskString,
 						},
+						LokiConfig: &AnalysisProviderLokiConfig{
+							Address:      "foo",
+							UsernameFile: "foo",
+							PasswordFile: maskString,
+							TokenFile:    maskString,
+						},
+						ElasticsearchConfig: &AnalysisProviderElasticsearchConfig{
+							Address:      "foo",
+							UsernameFile: "foo",
+							PasswordFile: maskString,
+							APIKeyFile:   maskString,
+						},
+						CloudWatchConfig: &AnalysisProviderCloudWatchConfig{
+							Region:          "foo",
+							CredentialsFile: maskString,
+							RoleARN:         maskString,
+							TokenFile:       maskString,
+						},
+						NewRelicConfig: &AnalysisProviderNewRelicConfig{
+							AccountID:  1,
+							APIKeyFile: maskString,
+						},
+						CloudMonitoringConfig: &AnalysisProviderCloudMonitoringConfig{
+							ServiceAccountFile: maskString,
+						},
 					},
 				},
 				Notifications: Notifications{
@@ -1519,3 +1839,156 @@ func TestPipeGitValidate(t *testing.T) {
 		})
 	}
 }
+
+func TestGitHostingProviderValidate(t *testing.T) {
+	testcases := []struct {
+		name     string
+		provider GitHostingProvider
+		wantErr  bool
+	}{
+		{
+			name: "valid github",
+			provider: GitHostingProvider{
+				Type:      GitHostingProviderGitHub,
+				TokenFile: "/etc/piped-secret/token",
+			},
+		},
+		{
+			name: "missing token file",
+			provider: GitHostingProvider{
+				Type: GitHostingProviderGitLab,
+			},
+			wantErr: true,
+		},
+		{
+			name: "gitea without api url",
+			provider: GitHostingProvider{
+				Type:      GitHostingProviderGitea,
+				TokenFile: "/etc/piped-secret/token",
+			},
+			wantErr: true,
+		},
+		{
+			name: "unsupported type",
+			provider: GitHostingProvider{
+				Type:      "UNKNOWN",
+				TokenFile: "/etc/piped-secret/token",
+			},
+			wantErr: true,
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.provider.Validate()
+			assert.Equal(t, tc.wantErr, err != nil)
+		})
+	}
+}
+
+func TestPipedAnalysisProviderValidate(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name     string
+		provider PipedAnalysisProvider
+		wantErr  bool
+	}{
+		{
+			name: "valid loki provider",
+			provider: PipedAnalysisProvider{
+				Type: model.AnalysisProviderLoki,
+				LokiConfig: &AnalysisProviderLokiConfig{
+					Address:      "https://loki.dev",
+					UsernameFile: "/etc/username",
+					PasswordFile: "/etc/password",
+				},
+			},
+		},
+		{
+			name: "loki provider without address",
+			provider: PipedAnalysisProvider{
+				Type:       model.AnalysisProviderLoki,
+				LokiConfig: &AnalysisProviderLokiConfig{},
+			},
+			wantErr: true,
+		},
+		{
+			name: "loki provider with both basic auth and token",
+			provider: PipedAnalysisProvider{
+				Type: model.AnalysisProviderLoki,
+				LokiConfig: &AnalysisProviderLokiConfig{
+					Address:      "https://loki.dev",
+					UsernameFile: "/etc/username",
+					PasswordFile: "/etc/password",
+					TokenFile:    "/etc/token",
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "valid elasticsearch provider",
+			provider: PipedAnalysisProvider{
+				Type: model.AnalysisProviderElasticsearch,
+				ElasticsearchConfig: &AnalysisProviderElasticsearchConfig{
+					Address:    "https://elasticsearch.dev",
+					APIKeyFile: "/etc/api-key",
+				},
+			},
+		},
+		{
+			name: "elasticsearch provider with username only",
+			provider: PipedAnalysisProvider{
+				Type: model.AnalysisProviderElasticsearch,
+				ElasticsearchConfig: &AnalysisProviderElasticsearchConfig{
+					Address:      "https://elasticsearch.dev",
+					UsernameFile: "/etc/username",
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "cloudwatch provider without region",
+			provider: PipedAnalysisProvider{
+				Type:             model.AnalysisProviderCloudWatch,
+				CloudWatchConfig: &AnalysisProviderCloudWatchConfig{},
+			},
+			wantErr: true,
+		},
+		{
+			name: "valid newrelic provider",
+			provider: PipedAnalysisProvider{
+				Type: model.AnalysisProviderNewRelic,
+				NewRelicConfig: &AnalysisProviderNewRelicConfig{
+					AccountID:  1,
+					APIKeyFile: "/etc/api-key",
+				},
+			},
+		},
+		{
+			name: "newrelic provider without account",
+			provider: PipedAnalysisProvider{
+				Type: model.AnalysisProviderNewRelic,
+				NewRelicConfig: &AnalysisProviderNewRelicConfig{
+					APIKeyFile: "/etc/api-key",
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "cloud monitoring provider without service account",
+			provider: PipedAnalysisProvider{
+				Type:                  model.AnalysisProviderCloudMonitoring,
+				CloudMonitoringConfig: &AnalysisProviderCloudMonitoringConfig{},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			err := tc.provider.Validate()
+			assert.Equal(t, tc.wantErr, err != nil)
+		})
+	}
+}
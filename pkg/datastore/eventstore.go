@@ -72,7 +72,7 @@ func (e *eventCollection) Encode(entity interface{}) (map[Shard][]byte, error) {
 type EventStore interface {
 	Add(ctx context.Context, e model.Event) error
 	List(ctx context.Context, opts ListOptions) ([]*model.Event, string, error)
-	UpdateStatus(ctx context.Context, eventID string, status model.EventStatus, statusDescription string) error
+	UpdateStatus(ctx context.Context, eventID string, status model.EventStatus, statusDescription, pullRequestURL string) error
 }
 
 type eventStore struct {
@@ -135,11 +135,14 @@ func (s *eventStore) List(ctx context.Context, opts ListOptions) ([]*model.Event
 	return es, cursor, nil
 }
 
-func (s *eventStore) UpdateStatus(ctx context.Context, eventID string, status model.EventStatus, statusDescription string) error {
+func (s *eventStore) UpdateStatus(ctx context.Context, eventID string, status model.EventStatus, statusDescription, pullRequestURL string) error {
 	return s.ds.Update(ctx, s.col, eventID, func(e interface{}) error {
 		event := e.(*model.Event)
 		event.Status = status
 		event.StatusDescription = statusDescription
+		if pullRequestURL != "" {
+			event.PullRequestUrl = pullRequestURL
+		}
 		if event.IsHandled() {
 			now := s.nowFunc().Unix()
 			event.HandledAt = now
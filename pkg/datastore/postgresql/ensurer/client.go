@@ -0,0 +1,110 @@
+// Copyright 2024 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ensurer
+
+import (
+	"context"
+	"database/sql"
+	_ "embed"
+	"fmt"
+	"strings"
+
+	"go.uber.org/zap"
+
+	"github.com/pipe-cd/pipecd/pkg/datastore/postgresql"
+)
+
+var (
+	//go:embed schema.sql
+	postgresqlDatabaseSchema string
+	//go:embed indexes.sql
+	postgresqlDatabaseIndexes string
+)
+
+type postgresqlEnsurer struct {
+	client *sql.DB
+	logger *zap.Logger
+}
+
+func NewPostgreSQLEnsurer(url, database, usernameFile, passwordFile string, logger *zap.Logger) (SQLEnsurer, error) {
+	dataSourceName, err := postgresql.BuildDataSourceName(url, database, usernameFile, passwordFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to sql database: %w", err)
+	}
+
+	db, err := sql.Open("postgres", dataSourceName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to sql database: %w", err)
+	}
+
+	return &postgresqlEnsurer{
+		client: db,
+		logger: logger.Named("postgresql-ensurer"),
+	}, nil
+}
+
+func (p *postgresqlEnsurer) EnsureIndexes(ctx context.Context) error {
+	// All statements are written with `IF NOT EXISTS` so that they can be applied repeatedly.
+	for _, stmt := range makeCreateIndexStatements(postgresqlDatabaseIndexes) {
+		if _, err := p.client.ExecContext(ctx, stmt); err != nil {
+			return fmt.Errorf("failed to run statement %q: %w", stmt, err)
+		}
+	}
+	return nil
+}
+
+func (p *postgresqlEnsurer) EnsureSchema(ctx context.Context) error {
+	// Multiple statements can be run at once since no parameter is given.
+	_, err := p.client.ExecContext(ctx, postgresqlDatabaseSchema)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *postgresqlEnsurer) Close() error {
+	return p.client.Close()
+}
+
+func (p *postgresqlEnsurer) Ping() error {
+	return p.client.Ping()
+}
+
+func makeCreateIndexStatements(indexesStatements string) []string {
+	items := strings.Split(strings.TrimSpace(indexesStatements), ";")
+	statements := make([]string, 0, len(items))
+	for _, item := range items {
+		item = strings.TrimSpace(removeComments(item))
+		// Ignore dummy statement.
+		if item == "" {
+			continue
+		}
+		statements = append(statements, item)
+	}
+	return statements
+}
+
+// removeComments removes all comment lines from the given statement.
+func removeComments(stmt string) string {
+	lines := strings.Split(stmt, "\n")
+	out := make([]string, 0, len(lines))
+	for _, line := range lines {
+		if strings.HasPrefix(strings.TrimSpace(line), "--") {
+			continue
+		}
+		out = append(out, line)
+	}
+	return strings.Join(out, "\n")
+}
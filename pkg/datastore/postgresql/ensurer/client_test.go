@@ -0,0 +1,67 @@
+// Copyright 2024 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ensurer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMakeCreateIndexStatements(t *testing.T) {
+	testcases := []struct {
+		name               string
+		rawIndexes         string
+		expectedStatements []string
+	}{
+		{
+			name:       "Only one CREATE INDEX statement",
+			rawIndexes: "CREATE INDEX IF NOT EXISTS application_created_at_desc ON Application (CreatedAt DESC);\n",
+			expectedStatements: []string{
+				"CREATE INDEX IF NOT EXISTS application_created_at_desc ON Application (CreatedAt DESC)",
+			},
+		},
+		{
+			name:       "Only one CREATE INDEX statement without `;`",
+			rawIndexes: "CREATE INDEX IF NOT EXISTS application_created_at_desc ON Application (CreatedAt DESC)\n",
+			expectedStatements: []string{
+				"CREATE INDEX IF NOT EXISTS application_created_at_desc ON Application (CreatedAt DESC)",
+			},
+		},
+		{
+			name:       "Multi statements with comments",
+			rawIndexes: "--\n-- Application table indexes\n--\n\n-- index on `Kind` ASC\nALTER TABLE Application ADD COLUMN IF NOT EXISTS Kind INTEGER;\nCREATE INDEX IF NOT EXISTS application_kind ON Application (Kind);\n\n-- TODO: remove this\n",
+			expectedStatements: []string{
+				"ALTER TABLE Application ADD COLUMN IF NOT EXISTS Kind INTEGER",
+				"CREATE INDEX IF NOT EXISTS application_kind ON Application (Kind)",
+			},
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			statements := makeCreateIndexStatements(tc.rawIndexes)
+			assert.Equal(t, tc.expectedStatements, statements)
+		})
+	}
+}
+
+func TestIndexesStatements(t *testing.T) {
+	statements := makeCreateIndexStatements(postgresqlDatabaseIndexes)
+	assert.NotEmpty(t, statements)
+	for _, stmt := range statements {
+		assert.Contains(t, stmt, "IF NOT EXISTS", "statement must be able to be applied repeatedly: %s", stmt)
+	}
+}
@@ -0,0 +1,141 @@
+--
+-- Application table indexes
+--
+
+-- index on `Disabled` and `UpdatedAt` DESC
+CREATE INDEX IF NOT EXISTS application_disabled_updated_at_desc ON Application (Disabled, UpdatedAt DESC);
+
+-- index on `Name` ASC and `UpdatedAt` DESC
+ALTER TABLE Application ADD COLUMN IF NOT EXISTS Name VARCHAR(50) GENERATED ALWAYS AS (COALESCE(data->>'name', '')) STORED NOT NULL;
+CREATE INDEX IF NOT EXISTS application_name_updated_at_desc ON Application (Name, UpdatedAt DESC);
+
+-- index on `Kind` ASC and `UpdatedAt` DESC
+ALTER TABLE Application ADD COLUMN IF NOT EXISTS Kind INTEGER GENERATED ALWAYS AS (COALESCE((data->>'kind')::INTEGER, 0)) STORED NOT NULL;
+CREATE INDEX IF NOT EXISTS application_kind_updated_at_desc ON Application (Kind, UpdatedAt DESC);
+
+-- index on `SyncState.Status` ASC and `UpdatedAt` DESC
+ALTER TABLE Application ADD COLUMN IF NOT EXISTS SyncState_Status INTEGER GENERATED ALWAYS AS (COALESCE((data->'sync_state'->>'status')::INTEGER, 0)) STORED NOT NULL;
+CREATE INDEX IF NOT EXISTS application_sync_state_updated_at_desc ON Application (SyncState_Status, UpdatedAt DESC);
+
+-- index on `ProjectId` ASC and `UpdatedAt` DESC
+CREATE INDEX IF NOT EXISTS application_project_id_updated_at_desc ON Application (ProjectId, UpdatedAt DESC);
+
+-- index on `PipedId` ASC and `UpdatedAt` DESC
+ALTER TABLE Application ADD COLUMN IF NOT EXISTS PipedId VARCHAR(36) GENERATED ALWAYS AS (COALESCE(data->>'piped_id', '')) STORED NOT NULL;
+CREATE INDEX IF NOT EXISTS application_piped_id_updated_at_desc ON Application (PipedId, UpdatedAt DESC);
+
+-- GIN index on `Labels` for containment queries
+ALTER TABLE Application ADD COLUMN IF NOT EXISTS Labels JSONB GENERATED ALWAYS AS (COALESCE(data->'labels', '{}'::JSONB)) STORED NOT NULL;
+CREATE INDEX IF NOT EXISTS application_labels ON Application USING GIN (Labels);
+
+--
+-- Command table indexes
+--
+
+-- index on `Status` ASC and `CreatedAt` ASC
+ALTER TABLE Command ADD COLUMN IF NOT EXISTS Status INTEGER GENERATED ALWAYS AS (COALESCE((data->>'status')::INTEGER, 0)) STORED NOT NULL;
+CREATE INDEX IF NOT EXISTS command_status_created_at_asc ON Command (Status, CreatedAt);
+
+-- index on `PipedId` ASC
+ALTER TABLE Command ADD COLUMN IF NOT EXISTS PipedId VARCHAR(36) GENERATED ALWAYS AS (COALESCE(data->>'piped_id', '')) STORED NOT NULL;
+CREATE INDEX IF NOT EXISTS command_piped_id ON Command (PipedId);
+
+--
+-- Deployment table indexes
+--
+
+-- index on `ApplicationId` ASC and `UpdatedAt` DESC
+ALTER TABLE Deployment ADD COLUMN IF NOT EXISTS ApplicationId VARCHAR(36) GENERATED ALWAYS AS (COALESCE(data->>'application_id', '')) STORED NOT NULL;
+CREATE INDEX IF NOT EXISTS deployment_application_id_updated_at_desc ON Deployment (ApplicationId, UpdatedAt DESC);
+
+-- index on `ApplicationName` ASC and `UpdatedAt` DESC
+ALTER TABLE Deployment ADD COLUMN IF NOT EXISTS ApplicationName VARCHAR(50) GENERATED ALWAYS AS (COALESCE(data->>'application_name', '')) STORED NOT NULL;
+CREATE INDEX IF NOT EXISTS deployment_application_name_updated_at_desc ON Deployment (ApplicationName, UpdatedAt DESC);
+
+-- index on `ProjectId` ASC and `UpdatedAt` DESC
+CREATE INDEX IF NOT EXISTS deployment_project_id_updated_at_desc ON Deployment (ProjectId, UpdatedAt DESC);
+
+-- index on `Kind` ASC and `UpdatedAt` DESC
+ALTER TABLE Deployment ADD COLUMN IF NOT EXISTS Kind INTEGER GENERATED ALWAYS AS (COALESCE((data->>'kind')::INTEGER, 0)) STORED NOT NULL;
+CREATE INDEX IF NOT EXISTS deployment_kind_updated_at_desc ON Deployment (Kind, UpdatedAt DESC);
+
+-- index on `Status` ASC and `UpdatedAt` DESC
+ALTER TABLE Deployment ADD COLUMN IF NOT EXISTS Status INTEGER GENERATED ALWAYS AS (COALESCE((data->>'status')::INTEGER, 0)) STORED NOT NULL;
+CREATE INDEX IF NOT EXISTS deployment_status_updated_at_desc ON Deployment (Status, UpdatedAt DESC);
+
+-- index on `PipedId` ASC
+ALTER TABLE Deployment ADD COLUMN IF NOT EXISTS PipedId VARCHAR(36) GENERATED ALWAYS AS (COALESCE(data->>'piped_id', '')) STORED NOT NULL;
+CREATE INDEX IF NOT EXISTS deployment_piped_id ON Deployment (PipedId);
+
+-- index on `CompletedAt` DESC and `Id` ASC
+ALTER TABLE Deployment ADD COLUMN IF NOT EXISTS CompletedAt BIGINT GENERATED ALWAYS AS ((data->>'completed_at')::BIGINT) STORED NULL;
+CREATE INDEX IF NOT EXISTS deployment_completed_at_desc_id ON Deployment (CompletedAt DESC, Id);
+
+-- index on `CompletedAt` ASC and `Id` ASC
+CREATE INDEX IF NOT EXISTS deployment_completed_at_id_asc ON Deployment (CompletedAt, Id);
+
+-- index on `DeploymentChainId` ASC and `UpdatedAt` DESC
+ALTER TABLE Deployment ADD COLUMN IF NOT EXISTS DeploymentChainId VARCHAR(36) GENERATED ALWAYS AS (COALESCE(data->>'deployment_chain_id', '')) STORED NOT NULL;
+CREATE INDEX IF NOT EXISTS deployment_chain_id_updated_at_desc ON Deployment (DeploymentChainId, UpdatedAt DESC);
+
+-- index on `DeploymentTraceCommitHash` ASC and `UpdatedAt` DESC
+ALTER TABLE Deployment ADD COLUMN IF NOT EXISTS DeploymentTraceCommitHash VARCHAR(40) GENERATED ALWAYS AS (COALESCE(data->>'deployment_trace_commit_hash', '')) STORED NOT NULL;
+CREATE INDEX IF NOT EXISTS deployment_trace_commit_hash_updated_at_desc ON Deployment (DeploymentTraceCommitHash, UpdatedAt DESC);
+
+--
+-- Event table indexes
+--
+
+-- index on `ProjectId` ASC and `CreatedAt` ASC
+CREATE INDEX IF NOT EXISTS event_project_id_created_at_asc ON Event (ProjectId, CreatedAt);
+-- index on `ProjectId` ASC and `CreatedAt` DESC
+CREATE INDEX IF NOT EXISTS event_project_id_created_at_desc ON Event (ProjectId, CreatedAt DESC);
+-- index on `ProjectId` ASC and `UpdatedAt` DESC
+CREATE INDEX IF NOT EXISTS event_project_id_updated_at_desc ON Event (ProjectId, UpdatedAt DESC);
+
+-- index on `EventKey` ASC, `Name` ASC, `ProjectId` ASC and `CreatedAt` DESC
+ALTER TABLE Event ADD COLUMN IF NOT EXISTS EventKey VARCHAR(64) GENERATED ALWAYS AS (COALESCE(data->>'event_key', '')) STORED NOT NULL;
+ALTER TABLE Event ADD COLUMN IF NOT EXISTS Name VARCHAR(50) GENERATED ALWAYS AS (COALESCE(data->>'name', '')) STORED NOT NULL;
+CREATE INDEX IF NOT EXISTS event_event_key_name_project_id_created_at_desc ON Event (EventKey, Name, ProjectId, CreatedAt DESC);
+
+-- index on `ProjectId` ASC, `Status` ASC, CreatedAt DESC
+ALTER TABLE Event ADD COLUMN IF NOT EXISTS Status INTEGER GENERATED ALWAYS AS (COALESCE((data->>'status')::INTEGER, 0)) STORED NOT NULL;
+CREATE INDEX IF NOT EXISTS event_project_id_status_created_at_desc ON Event (ProjectId, Status, CreatedAt DESC);
+
+-- index on `ProjectId` ASC, `Status` ASC, UpdatedAt DESC
+CREATE INDEX IF NOT EXISTS event_project_id_status_updated_at_desc ON Event (ProjectId, Status, UpdatedAt DESC);
+
+-- index on `Name` ASC, `ProjectId` ASC, `UpdatedAt` DESC
+CREATE INDEX IF NOT EXISTS event_name_project_id_updated_at_desc ON Event (Name, ProjectId, UpdatedAt DESC);
+
+-- index on `Name` ASC, `ProjectId` ASC, `Status` ASC, UpdatedAt DESC
+CREATE INDEX IF NOT EXISTS event_name_project_id_status_updated_at_desc ON Event (Name, ProjectId, Status, UpdatedAt DESC);
+
+--
+-- Piped table indexes
+--
+
+-- index on `ProjectId` ASC
+CREATE INDEX IF NOT EXISTS piped_project_id_asc ON Piped (ProjectId);
+
+--
+-- DeploymentChain table indexes
+--
+
+-- index on `ProjectId` ASC and `UpdatedAt` DESC
+CREATE INDEX IF NOT EXISTS deploymentchain_project_id_updated_at_desc ON DeploymentChain (ProjectId, UpdatedAt DESC);
+
+-- index on `Status` ASC and `UpdatedAt` DESC
+ALTER TABLE DeploymentChain ADD COLUMN IF NOT EXISTS Status INTEGER GENERATED ALWAYS AS (COALESCE((data->>'status')::INTEGER, 0)) STORED NOT NULL;
+CREATE INDEX IF NOT EXISTS deploymentchain_status_updated_at_desc ON DeploymentChain (Status, UpdatedAt DESC);
+
+--
+-- DeploymentTrace table indexes
+--
+
+-- index on `ProjectId` ASC and `UpdatedAt` DESC
+CREATE INDEX IF NOT EXISTS deploymenttrace_project_id_updated_at_desc ON DeploymentTrace (ProjectId, UpdatedAt DESC);
+
+-- index on `CommitHash` ASC and `UpdatedAt` DESC
+ALTER TABLE DeploymentTrace ADD COLUMN IF NOT EXISTS CommitHash VARCHAR(40) GENERATED ALWAYS AS (COALESCE(data->>'commit_hash', '')) STORED NOT NULL;
+CREATE INDEX IF NOT EXISTS deploymenttrace_commit_hash_updated_at_desc ON DeploymentTrace (CommitHash, UpdatedAt DESC);
@@ -0,0 +1,109 @@
+--
+-- Project table
+--
+
+CREATE TABLE IF NOT EXISTS Project (
+  Id UUID PRIMARY KEY,
+  Data JSONB NOT NULL,
+  CreatedAt BIGINT GENERATED ALWAYS AS ((data->>'created_at')::BIGINT) STORED NOT NULL,
+  UpdatedAt BIGINT GENERATED ALWAYS AS ((data->>'updated_at')::BIGINT) STORED NOT NULL
+);
+
+--
+-- Application table
+--
+
+CREATE TABLE IF NOT EXISTS Application (
+  Id UUID PRIMARY KEY,
+  Data JSONB NOT NULL,
+  ProjectId VARCHAR(50) GENERATED ALWAYS AS (data->>'project_id') STORED NOT NULL,
+  Disabled BOOLEAN GENERATED ALWAYS AS (COALESCE((data->>'disabled')::BOOLEAN, FALSE)) STORED NOT NULL,
+  CreatedAt BIGINT GENERATED ALWAYS AS ((data->>'created_at')::BIGINT) STORED NOT NULL,
+  UpdatedAt BIGINT GENERATED ALWAYS AS ((data->>'updated_at')::BIGINT) STORED NOT NULL
+);
+
+--
+-- Command table
+--
+
+CREATE TABLE IF NOT EXISTS Command (
+  Id UUID PRIMARY KEY,
+  Data JSONB NOT NULL,
+  ProjectId VARCHAR(50) GENERATED ALWAYS AS (data->>'project_id') STORED NOT NULL,
+  CreatedAt BIGINT GENERATED ALWAYS AS ((data->>'created_at')::BIGINT) STORED NOT NULL,
+  UpdatedAt BIGINT GENERATED ALWAYS AS ((data->>'updated_at')::BIGINT) STORED NOT NULL
+);
+
+--
+-- Deployment table
+--
+
+CREATE TABLE IF NOT EXISTS Deployment (
+  Id UUID PRIMARY KEY,
+  Data JSONB NOT NULL,
+  ProjectId VARCHAR(50) GENERATED ALWAYS AS (data->>'project_id') STORED NOT NULL,
+  CreatedAt BIGINT GENERATED ALWAYS AS ((data->>'created_at')::BIGINT) STORED NOT NULL,
+  UpdatedAt BIGINT GENERATED ALWAYS AS ((data->>'updated_at')::BIGINT) STORED NOT NULL
+);
+
+--
+-- Piped table
+--
+
+CREATE TABLE IF NOT EXISTS Piped (
+  Id UUID PRIMARY KEY,
+  Data JSONB NOT NULL,
+  ProjectId VARCHAR(50) GENERATED ALWAYS AS (data->>'project_id') STORED NOT NULL,
+  Disabled BOOLEAN GENERATED ALWAYS AS (COALESCE((data->>'disabled')::BOOLEAN, FALSE)) STORED NOT NULL,
+  CreatedAt BIGINT GENERATED ALWAYS AS ((data->>'created_at')::BIGINT) STORED NOT NULL,
+  UpdatedAt BIGINT GENERATED ALWAYS AS ((data->>'updated_at')::BIGINT) STORED NOT NULL
+);
+
+--
+-- APIKey table
+--
+
+CREATE TABLE IF NOT EXISTS APIKey (
+  Id UUID PRIMARY KEY,
+  Data JSONB NOT NULL,
+  ProjectId VARCHAR(50) GENERATED ALWAYS AS (data->>'project_id') STORED NOT NULL,
+  Disabled BOOLEAN GENERATED ALWAYS AS (COALESCE((data->>'disabled')::BOOLEAN, FALSE)) STORED NOT NULL,
+  CreatedAt BIGINT GENERATED ALWAYS AS ((data->>'created_at')::BIGINT) STORED NOT NULL,
+  UpdatedAt BIGINT GENERATED ALWAYS AS ((data->>'updated_at')::BIGINT) STORED NOT NULL
+);
+
+--
+-- Event table
+--
+
+CREATE TABLE IF NOT EXISTS Event (
+  Id UUID PRIMARY KEY,
+  Data JSONB NOT NULL,
+  ProjectId VARCHAR(50) GENERATED ALWAYS AS (data->>'project_id') STORED NOT NULL,
+  CreatedAt BIGINT GENERATED ALWAYS AS ((data->>'created_at')::BIGINT) STORED NOT NULL,
+  UpdatedAt BIGINT GENERATED ALWAYS AS ((data->>'updated_at')::BIGINT) STORED NOT NULL
+);
+
+--
+-- DeploymentChain table
+--
+
+CREATE TABLE IF NOT EXISTS DeploymentChain (
+  Id UUID PRIMARY KEY,
+  Data JSONB NOT NULL,
+  ProjectId VARCHAR(50) GENERATED ALWAYS AS (data->>'project_id') STORED NOT NULL,
+  CreatedAt BIGINT GENERATED ALWAYS AS ((data->>'created_at')::BIGINT) STORED NOT NULL,
+  UpdatedAt BIGINT GENERATED ALWAYS AS ((data->>'updated_at')::BIGINT) STORED NOT NULL
+);
+
+--
+-- DeploymentTrace table
+--
+
+CREATE TABLE IF NOT EXISTS DeploymentTrace (
+  Id UUID PRIMARY KEY,
+  Data JSONB NOT NULL,
+  ProjectId VARCHAR(50) GENERATED ALWAYS AS (data->>'project_id') STORED NOT NULL,
+  CreatedAt BIGINT GENERATED ALWAYS AS ((data->>'created_at')::BIGINT) STORED NOT NULL,
+  UpdatedAt BIGINT GENERATED ALWAYS AS ((data->>'updated_at')::BIGINT) STORED NOT NULL
+);
@@ -0,0 +1,296 @@
+// Copyright 2024 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package postgresql
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math"
+	"reflect"
+	"strings"
+
+	"github.com/pipe-cd/pipecd/pkg/datastore"
+)
+
+var operatorMap = map[datastore.Operator]string{
+	datastore.OperatorEqual:              "=",
+	datastore.OperatorNotEqual:           "!=",
+	datastore.OperatorIn:                 "IN",
+	datastore.OperatorNotIn:              "NOT IN",
+	datastore.OperatorGreaterThan:        ">",
+	datastore.OperatorGreaterThanOrEqual: ">=",
+	datastore.OperatorLessThan:           "<",
+	datastore.OperatorLessThanOrEqual:    "<=",
+	datastore.OperatorContains:           "@>",
+}
+
+// queryArgs holds the values bound to the positional parameters ($1, $2, ...) of a query.
+type queryArgs []interface{}
+
+// bind adds the given value to the list and returns its placeholder.
+func (a *queryArgs) bind(v interface{}) string {
+	*a = append(*a, v)
+	return fmt.Sprintf("$%d", len(*a))
+}
+
+func buildGetQuery(table string) string {
+	return fmt.Sprintf("SELECT Data FROM %s WHERE Id = $1", table)
+}
+
+func buildGetForUpdateQuery(table string) string {
+	return fmt.Sprintf("SELECT Data FROM %s WHERE Id = $1 FOR UPDATE", table)
+}
+
+func buildUpdateQuery(table string) string {
+	return fmt.Sprintf("UPDATE %s SET Data = $1 WHERE Id = $2", table)
+}
+
+func buildCreateQuery(table string) string {
+	return fmt.Sprintf("INSERT INTO %s (Id, Data) VALUES ($1, $2)", table)
+}
+
+func buildFindQuery(table string, opts datastore.ListOptions) (string, []interface{}, error) {
+	var args queryArgs
+
+	conds, err := buildFilterConditions(refineFiltersField(opts.Filters), &args)
+	if err != nil {
+		return "", nil, err
+	}
+	paginationCond, err := buildPaginationCondition(opts, &args)
+	if err != nil {
+		return "", nil, err
+	}
+	if paginationCond != "" {
+		conds = append(conds, paginationCond)
+	}
+	var whereClause string
+	if len(conds) > 0 {
+		whereClause = fmt.Sprintf("WHERE %s", strings.Join(conds, " AND "))
+	}
+
+	orderByClause, err := buildOrderByClause(refineOrdersField(opts.Orders))
+	if err != nil {
+		return "", nil, err
+	}
+	rawQuery := fmt.Sprintf(
+		"SELECT Data FROM %s %s %s %s",
+		table,
+		whereClause,
+		orderByClause,
+		buildLimitClause(opts.Limit),
+	)
+	return strings.Join(strings.Fields(rawQuery), " "), args, nil
+}
+
+func buildFilterConditions(filters []datastore.ListFilter, args *queryArgs) ([]string, error) {
+	conds := make([]string, 0, len(filters))
+	for _, filter := range filters {
+		op, ok := operatorMap[filter.Operator]
+		if !ok {
+			return nil, fmt.Errorf("unsupported operator given: %v", filter.Operator)
+		}
+		switch filter.Operator {
+		case datastore.OperatorIn, datastore.OperatorNotIn:
+			fv := reflect.ValueOf(filter.Value)
+			if fv.Kind() != reflect.Slice && fv.Kind() != reflect.Array {
+				return nil, fmt.Errorf("value of %s filter on %s must be a slice or an array", op, filter.Field)
+			}
+			// PostgreSQL does not accept an empty list, so the condition is resolved here.
+			if fv.Len() == 0 {
+				if filter.Operator == datastore.OperatorIn {
+					conds = append(conds, "FALSE")
+				} else {
+					conds = append(conds, "TRUE")
+				}
+				continue
+			}
+			placeholders := make([]string, fv.Len())
+			for i := 0; i < fv.Len(); i++ {
+				placeholders[i] = args.bind(fv.Index(i).Interface())
+			}
+			conds = append(conds, fmt.Sprintf("%s %s (%s)", filter.Field, op, strings.Join(placeholders, ",")))
+		case datastore.OperatorContains:
+			value, err := makeContainsValue(filter.Value)
+			if err != nil {
+				return nil, fmt.Errorf("invalid value of contains filter on %s: %w", filter.Field, err)
+			}
+			conds = append(conds, fmt.Sprintf("%s %s %s::JSONB", filter.Field, op, args.bind(value)))
+		default:
+			conds = append(conds, fmt.Sprintf("%s %s %s", filter.Field, op, args.bind(filter.Value)))
+		}
+	}
+	return conds, nil
+}
+
+// makeContainsValue returns the JSON document used to check the containment on a JSONB column.
+// A map value is checked against a JSON object (e.g. labels) while
+// any other value is checked as an element of a JSON array.
+func makeContainsValue(value interface{}) (string, error) {
+	var doc interface{} = []interface{}{value}
+	if reflect.ValueOf(value).Kind() == reflect.Map {
+		doc = value
+	}
+	data, err := json.Marshal(doc)
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+// buildPaginationCondition builds the condition to fetch the items after the given cursor.
+// The condition should be in format:
+//
+//	X >= Vx AND Y >= Vy AND ... AND NOT (X = Vx AND Y = Vy AND ... AND Id <= last_iterated_id)
+//
+// with x, y, etc is not Id field and the comparison operators depend on the ordering direction.
+func buildPaginationCondition(opts datastore.ListOptions, args *queryArgs) (string, error) {
+	// Skip on no cursor.
+	if len(opts.Cursor) == 0 {
+		return "", nil
+	}
+
+	cursor, err := decodeCursor(opts.Cursor)
+	if err != nil {
+		return "", err
+	}
+
+	var (
+		orders        = refineOrdersField(opts.Orders)
+		outerSetConds = make([]string, 0, len(orders))
+		subSetConds   = make([]string, 0, len(orders))
+	)
+	for i, o := range orders {
+		// The cursor keeps the original field names.
+		val, ok := cursor[opts.Orders[i].Field]
+		if !ok {
+			return "", fmt.Errorf("cursor does not contain values that match to ordering field %s", opts.Orders[i].Field)
+		}
+		if o.Field == "Id" {
+			id, ok := val.(string)
+			if !ok {
+				return "", fmt.Errorf("cursor contains invalid value of Id field")
+			}
+			subSetConds = append(subSetConds, fmt.Sprintf("%s %s %s", o.Field, makeCompareOperatorForSubSet(o.Direction), args.bind(makeRowID(id))))
+			continue
+		}
+		val = normalizeCursorValue(val)
+		outerSetConds = append(outerSetConds, fmt.Sprintf("%s %s %s", o.Field, makeCompareOperatorForOuterSet(o.Direction), args.bind(val)))
+		subSetConds = append(subSetConds, fmt.Sprintf("%s = %s", o.Field, args.bind(val)))
+	}
+
+	conds := append(outerSetConds, fmt.Sprintf("NOT (%s)", strings.Join(subSetConds, " AND ")))
+	return strings.Join(conds, " AND "), nil
+}
+
+// decodeCursor decodes last object of previous page stored as cursor.
+func decodeCursor(cursor string) (map[string]interface{}, error) {
+	data, err := base64.StdEncoding.DecodeString(cursor)
+	if err != nil {
+		return nil, err
+	}
+	obj := make(map[string]interface{})
+	if err := json.Unmarshal(data, &obj); err != nil {
+		return nil, err
+	}
+	return obj, nil
+}
+
+// normalizeCursorValue converts integral numbers decoded from JSON into int64
+// since they can not be compared with integer columns when sent as float values.
+func normalizeCursorValue(val interface{}) interface{} {
+	f, ok := val.(float64)
+	if !ok || f != math.Trunc(f) {
+		return val
+	}
+	return int64(f)
+}
+
+func makeCompareOperatorForOuterSet(direction datastore.OrderDirection) string {
+	if direction == datastore.Asc {
+		return ">="
+	}
+	return "<="
+}
+
+func makeCompareOperatorForSubSet(direction datastore.OrderDirection) string {
+	if direction == datastore.Asc {
+		return "<="
+	}
+	return ">="
+}
+
+func buildOrderByClause(orders []datastore.Order) (string, error) {
+	if len(orders) == 0 {
+		return "", nil
+	}
+
+	conds := make([]string, len(orders))
+	hasIDFieldInOrdering := false
+	for i, ord := range orders {
+		if ord.Field == "Id" {
+			hasIDFieldInOrdering = true
+		}
+		conds[i] = fmt.Sprintf("%s %s", ord.Field, toPostgreSQLDirection(ord.Direction))
+	}
+
+	if !hasIDFieldInOrdering {
+		return "", fmt.Errorf("id field is required as ordering field")
+	}
+
+	return fmt.Sprintf("ORDER BY %s", strings.Join(conds, ", ")), nil
+}
+
+func buildLimitClause(limit int) string {
+	var clause string
+	if limit > 0 {
+		clause = fmt.Sprintf("LIMIT %d", limit)
+	}
+	return clause
+}
+
+func toPostgreSQLDirection(d datastore.OrderDirection) string {
+	switch d {
+	case datastore.Asc:
+		return "ASC"
+	case datastore.Desc:
+		return "DESC"
+	default:
+		return ""
+	}
+}
+
+func refineOrdersField(orders []datastore.Order) []datastore.Order {
+	out := make([]datastore.Order, len(orders))
+	for i, order := range orders {
+		order.Field = refineField(order.Field)
+		out[i] = order
+	}
+	return out
+}
+
+func refineFiltersField(filters []datastore.ListFilter) []datastore.ListFilter {
+	out := make([]datastore.ListFilter, len(filters))
+	for i, filter := range filters {
+		filter.Field = refineField(filter.Field)
+		out[i] = filter
+	}
+	return out
+}
+
+// refineField converts the given nested field name to the name of its generated column.
+func refineField(field string) string {
+	return strings.ReplaceAll(field, ".", "_")
+}
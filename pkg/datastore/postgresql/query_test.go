@@ -0,0 +1,271 @@
+// Copyright 2024 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package postgresql
+
+import (
+	"encoding/base64"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/pipe-cd/pipecd/pkg/datastore"
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+func TestBuildFindQuery(t *testing.T) {
+	testcases := []struct {
+		name          string
+		kind          string
+		listOptions   datastore.ListOptions
+		expectedQuery string
+		expectedArgs  []interface{}
+		wantErr       bool
+	}{
+		{
+			name:          "query without filter and order",
+			kind:          "Project",
+			listOptions:   datastore.ListOptions{},
+			expectedQuery: "SELECT Data FROM Project",
+		},
+		{
+			name: "query with one filter",
+			kind: "Project",
+			listOptions: datastore.ListOptions{
+				Filters: []datastore.ListFilter{
+					{
+						Field:    "ProjectId",
+						Operator: datastore.OperatorEqual,
+						Value:    "project-1",
+					},
+				},
+			},
+			expectedQuery: "SELECT Data FROM Project WHERE ProjectId = $1",
+			expectedArgs:  []interface{}{"project-1"},
+		},
+		{
+			name: "query with multi filters, orders and limit",
+			kind: "Deployment",
+			listOptions: datastore.ListOptions{
+				Filters: []datastore.ListFilter{
+					{
+						Field:    "ProjectId",
+						Operator: datastore.OperatorEqual,
+						Value:    "project-1",
+					},
+					{
+						Field:    "Status",
+						Operator: datastore.OperatorIn,
+						Value:    []model.DeploymentStatus{model.DeploymentStatus_DEPLOYMENT_SUCCESS, model.DeploymentStatus_DEPLOYMENT_FAILURE},
+					},
+					{
+						Field:    "UpdatedAt",
+						Operator: datastore.OperatorGreaterThanOrEqual,
+						Value:    int64(100),
+					},
+				},
+				Orders: []datastore.Order{
+					{
+						Field:     "UpdatedAt",
+						Direction: datastore.Desc,
+					},
+					{
+						Field:     "Id",
+						Direction: datastore.Asc,
+					},
+				},
+				Limit: 10,
+			},
+			expectedQuery: "SELECT Data FROM Deployment WHERE ProjectId = $1 AND Status IN ($2,$3) AND UpdatedAt >= $4 ORDER BY UpdatedAt DESC, Id ASC LIMIT 10",
+			expectedArgs:  []interface{}{"project-1", model.DeploymentStatus_DEPLOYMENT_SUCCESS, model.DeploymentStatus_DEPLOYMENT_FAILURE, int64(100)},
+		},
+		{
+			name: "query with empty IN and NOT IN filters",
+			kind: "Application",
+			listOptions: datastore.ListOptions{
+				Filters: []datastore.ListFilter{
+					{
+						Field:    "Kind",
+						Operator: datastore.OperatorIn,
+						Value:    []int{},
+					},
+					{
+						Field:    "Status",
+						Operator: datastore.OperatorNotIn,
+						Value:    []int{},
+					},
+				},
+			},
+			expectedQuery: "SELECT Data FROM Application WHERE FALSE AND TRUE",
+		},
+		{
+			name: "query with IN filter having non slice value",
+			kind: "Application",
+			listOptions: datastore.ListOptions{
+				Filters: []datastore.ListFilter{
+					{
+						Field:    "Kind",
+						Operator: datastore.OperatorIn,
+						Value:    1,
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "query with contains filters",
+			kind: "Application",
+			listOptions: datastore.ListOptions{
+				Filters: []datastore.ListFilter{
+					{
+						Field:    "Labels",
+						Operator: datastore.OperatorContains,
+						Value:    map[string]string{"env": "prod"},
+					},
+					{
+						Field:    "Tags",
+						Operator: datastore.OperatorContains,
+						Value:    "tag-1",
+					},
+				},
+			},
+			expectedQuery: "SELECT Data FROM Application WHERE Labels @> $1::JSONB AND Tags @> $2::JSONB",
+			expectedArgs:  []interface{}{`{"env":"prod"}`, `["tag-1"]`},
+		},
+		{
+			name: "query with nested field",
+			kind: "Application",
+			listOptions: datastore.ListOptions{
+				Filters: []datastore.ListFilter{
+					{
+						Field:    "SyncState.Status",
+						Operator: datastore.OperatorNotEqual,
+						Value:    model.ApplicationSyncStatus_SYNCED,
+					},
+				},
+				Orders: []datastore.Order{
+					{
+						Field:     "SyncState.Status",
+						Direction: datastore.Asc,
+					},
+					{
+						Field:     "Id",
+						Direction: datastore.Asc,
+					},
+				},
+			},
+			expectedQuery: "SELECT Data FROM Application WHERE SyncState_Status != $1 ORDER BY SyncState_Status ASC, Id ASC",
+			expectedArgs:  []interface{}{model.ApplicationSyncStatus_SYNCED},
+		},
+		{
+			name: "query with unsupported operator",
+			kind: "Application",
+			listOptions: datastore.ListOptions{
+				Filters: []datastore.ListFilter{
+					{
+						Field:    "Kind",
+						Operator: datastore.Operator(-1),
+						Value:    1,
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "query without Id in orders",
+			kind: "Application",
+			listOptions: datastore.ListOptions{
+				Orders: []datastore.Order{
+					{
+						Field:     "UpdatedAt",
+						Direction: datastore.Desc,
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "query with filters and cursor",
+			kind: "Deployment",
+			listOptions: datastore.ListOptions{
+				Filters: []datastore.ListFilter{
+					{
+						Field:    "ProjectId",
+						Operator: datastore.OperatorEqual,
+						Value:    "project-1",
+					},
+				},
+				Orders: []datastore.Order{
+					{
+						Field:     "UpdatedAt",
+						Direction: datastore.Desc,
+					},
+					{
+						Field:     "Id",
+						Direction: datastore.Asc,
+					},
+				},
+				Cursor: base64.StdEncoding.EncodeToString([]byte(`{"Id":"dfc55495-7dbd-11eb-8636-42010a920020","UpdatedAt":100}`)),
+			},
+			expectedQuery: "SELECT Data FROM Deployment WHERE ProjectId = $1 AND UpdatedAt <= $2 AND NOT (UpdatedAt = $3 AND Id <= $4) ORDER BY UpdatedAt DESC, Id ASC",
+			expectedArgs:  []interface{}{"project-1", int64(100), int64(100), "dfc55495-7dbd-11eb-8636-42010a920020"},
+		},
+		{
+			name: "query with cursor only",
+			kind: "Deployment",
+			listOptions: datastore.ListOptions{
+				Orders: []datastore.Order{
+					{
+						Field:     "CompletedAt",
+						Direction: datastore.Asc,
+					},
+					{
+						Field:     "Id",
+						Direction: datastore.Desc,
+					},
+				},
+				Cursor: base64.StdEncoding.EncodeToString([]byte(`{"Id":"pipecd","CompletedAt":100}`)),
+			},
+			expectedQuery: "SELECT Data FROM Deployment WHERE CompletedAt >= $1 AND NOT (CompletedAt = $2 AND Id >= $3) ORDER BY CompletedAt ASC, Id DESC",
+			expectedArgs:  []interface{}{int64(100), int64(100), "1b247cf8-ee2c-56db-af91-be9e25ff3b6a"},
+		},
+		{
+			name: "query with cursor missing ordering field",
+			kind: "Deployment",
+			listOptions: datastore.ListOptions{
+				Orders: []datastore.Order{
+					{
+						Field:     "UpdatedAt",
+						Direction: datastore.Desc,
+					},
+					{
+						Field:     "Id",
+						Direction: datastore.Asc,
+					},
+				},
+				Cursor: base64.StdEncoding.EncodeToString([]byte(`{"Id":"pipecd"}`)),
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			query, args, err := buildFindQuery(tc.kind, tc.listOptions)
+			assert.Equal(t, tc.wantErr, err != nil)
+			assert.Equal(t, tc.expectedQuery, query)
+			assert.Equal(t, tc.expectedArgs, args)
+		})
+	}
+}
@@ -0,0 +1,87 @@
+// Copyright 2024 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package githosting
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+type bitbucket struct {
+	*client
+	workspace string
+	repo      string
+}
+
+type bitbucketBranch struct {
+	Branch struct {
+		Name string `json:"name"`
+	} `json:"branch"`
+}
+
+type bitbucketPullRequest struct {
+	ID    int `json:"id"`
+	Links struct {
+		HTML struct {
+			Href string `json:"href"`
+		} `json:"html"`
+	} `json:"links"`
+}
+
+// CreatePullRequest opens a pull request on Bitbucket Cloud.
+// The reviewers must be given as their account IDs or UUIDs.
+// Since Bitbucket does not support labels and auto-merge, an error is returned with the opened pull request if they are given.
+func (b *bitbucket) CreatePullRequest(ctx context.Context, pr PullRequest) (*PullRequestResult, error) {
+	var source, destination bitbucketBranch
+	source.Branch.Name = pr.Head
+	destination.Branch.Name = pr.Base
+
+	reviewers := make([]map[string]string, 0, len(pr.Reviewers))
+	for _, r := range pr.Reviewers {
+		if strings.HasPrefix(r, "{") {
+			reviewers = append(reviewers, map[string]string{"uuid": r})
+			continue
+		}
+		reviewers = append(reviewers, map[string]string{"account_id": r})
+	}
+
+	body := map[string]interface{}{
+		"title":       pr.Title,
+		"description": pr.Body,
+		"source":      source,
+		"destination": destination,
+		"reviewers":   reviewers,
+	}
+	var created bitbucketPullRequest
+	if err := b.do(ctx, http.MethodPost, fmt.Sprintf("/repositories/%s/%s/pullrequests", b.workspace, b.repo), body, &created); err != nil {
+		return nil, fmt.Errorf("failed to create pull request: %w", err)
+	}
+	result := &PullRequestResult{
+		Number: created.ID,
+		URL:    created.Links.HTML.Href,
+	}
+
+	var errs []error
+	if len(pr.Labels) > 0 {
+		errs = append(errs, errors.New("labels are not supported by bitbucket"))
+	}
+	if pr.AutoMerge {
+		errs = append(errs, errors.New("auto-merge is not supported by bitbucket"))
+	}
+	return result, errors.Join(errs...)
+}
@@ -0,0 +1,111 @@
+// Copyright 2024 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package githosting
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+type gitea struct {
+	*client
+	owner string
+	repo  string
+}
+
+type giteaPullRequest struct {
+	Number  int    `json:"number"`
+	HTMLURL string `json:"html_url"`
+}
+
+func (g *gitea) CreatePullRequest(ctx context.Context, pr PullRequest) (*PullRequestResult, error) {
+	var errs []error
+	body := map[string]interface{}{
+		"title": pr.Title,
+		"body":  pr.Body,
+		"head":  pr.Head,
+		"base":  pr.Base,
+	}
+	if len(pr.Labels) > 0 {
+		// The pull request is opened even if some of labels were not found.
+		ids, err := g.findLabelIDs(ctx, pr.Labels)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("failed to add labels: %w", err))
+		}
+		if len(ids) > 0 {
+			body["labels"] = ids
+		}
+	}
+
+	var created giteaPullRequest
+	repoPath := fmt.Sprintf("/repos/%s/%s", g.owner, g.repo)
+	if err := g.do(ctx, http.MethodPost, repoPath+"/pulls", body, &created); err != nil {
+		return nil, fmt.Errorf("failed to create pull request: %w", err)
+	}
+	result := &PullRequestResult{
+		Number: created.Number,
+		URL:    created.HTMLURL,
+	}
+
+	if len(pr.Reviewers) > 0 {
+		body := map[string]interface{}{
+			"reviewers": pr.Reviewers,
+		}
+		if err := g.do(ctx, http.MethodPost, fmt.Sprintf("%s/pulls/%d/requested_reviewers", repoPath, created.Number), body, nil); err != nil {
+			errs = append(errs, fmt.Errorf("failed to request reviewers: %w", err))
+		}
+	}
+	if pr.AutoMerge {
+		body := map[string]interface{}{
+			"Do":                        "merge",
+			"merge_when_checks_succeed": true,
+		}
+		if err := g.do(ctx, http.MethodPost, fmt.Sprintf("%s/pulls/%d/merge", repoPath, created.Number), body, nil); err != nil {
+			errs = append(errs, fmt.Errorf("failed to enable auto-merge: %w", err))
+		}
+	}
+	return result, errors.Join(errs...)
+}
+
+// findLabelIDs returns the IDs of the repository labels having the given names.
+func (g *gitea) findLabelIDs(ctx context.Context, names []string) ([]int, error) {
+	var labels []struct {
+		ID   int    `json:"id"`
+		Name string `json:"name"`
+	}
+	if err := g.do(ctx, http.MethodGet, fmt.Sprintf("/repos/%s/%s/labels?limit=100", g.owner, g.repo), nil, &labels); err != nil {
+		return nil, err
+	}
+	idByName := make(map[string]int, len(labels))
+	for _, l := range labels {
+		idByName[l.Name] = l.ID
+	}
+
+	var (
+		ids  = make([]int, 0, len(names))
+		errs []error
+	)
+	for _, name := range names {
+		id, ok := idByName[name]
+		if !ok {
+			errs = append(errs, fmt.Errorf("label %s was not found", name))
+			continue
+		}
+		ids = append(ids, id)
+	}
+	return ids, errors.Join(errs...)
+}
@@ -0,0 +1,214 @@
+// Copyright 2024 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package githosting provides clients for the Git hosting services
+// such as GitHub, GitLab, Bitbucket and Gitea to open pull requests.
+package githosting
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/pipe-cd/pipecd/pkg/git"
+)
+
+type Kind string
+
+const (
+	KindGitHub    Kind = "GITHUB"
+	KindGitLab    Kind = "GITLAB"
+	KindBitbucket Kind = "BITBUCKET"
+	KindGitea     Kind = "GITEA"
+)
+
+const defaultTimeout = 30 * time.Second
+
+// PullRequest represents a pull request (or a merge request) to be opened.
+type PullRequest struct {
+	// The title of the pull request.
+	Title string
+	// The description of the pull request.
+	Body string
+	// The branch where the changes are implemented.
+	Head string
+	// The branch the changes are pulled into.
+	Base string
+	// The users requested to review the pull request.
+	Reviewers []string
+	// The labels added to the pull request.
+	Labels []string
+	// Whether to merge the pull request automatically once all requirements are met.
+	AutoMerge bool
+}
+
+// PullRequestResult represents an opened pull request.
+type PullRequestResult struct {
+	// The number of the pull request in the repository.
+	Number int
+	// The address to the web page of the pull request.
+	URL string
+}
+
+// Provider opens pull requests on a Git hosting service.
+type Provider interface {
+	// CreatePullRequest opens the given pull request.
+	// Even when an error occurred while requesting reviewers, adding labels or
+	// enabling auto-merge, the opened pull request is returned with the error.
+	CreatePullRequest(ctx context.Context, pr PullRequest) (*PullRequestResult, error)
+}
+
+// Config contains the information to access a repository on a Git hosting service.
+type Config struct {
+	// The kind of the Git hosting service.
+	Kind Kind
+	// The base URL of the API. The public service is used if empty.
+	APIURL string
+	// The path of the repository on the service, e.g. org/repo.
+	Repository string
+	// The token used to authenticate.
+	Token string
+	// The client used to send requests.
+	HTTPClient *http.Client
+}
+
+// NewProvider returns a Provider for the given config.
+func NewProvider(cfg Config) (Provider, error) {
+	if cfg.Repository == "" {
+		return nil, fmt.Errorf("repository must be set")
+	}
+	if cfg.Token == "" {
+		return nil, fmt.Errorf("token must be set")
+	}
+	if cfg.HTTPClient == nil {
+		cfg.HTTPClient = &http.Client{Timeout: defaultTimeout}
+	}
+	owner, repo, ok := strings.Cut(cfg.Repository, "/")
+	if !ok && cfg.Kind != KindGitLab {
+		return nil, fmt.Errorf("repository must be in the format of owner/repo: %s", cfg.Repository)
+	}
+
+	c := &client{
+		httpClient: cfg.HTTPClient,
+		kind:       cfg.Kind,
+	}
+	switch cfg.Kind {
+	case KindGitHub:
+		c.baseURL = apiURL(cfg.APIURL, "https://api.github.com")
+		c.header = http.Header{
+			"Authorization": {"Bearer " + cfg.Token},
+			"Accept":        {"application/vnd.github+json"},
+		}
+		return &github{client: c, owner: owner, repo: repo}, nil
+	case KindGitLab:
+		c.baseURL = apiURL(cfg.APIURL, "https://gitlab.com") + "/api/v4"
+		c.header = http.Header{
+			"Private-Token": {cfg.Token},
+		}
+		return &gitlab{client: c, project: cfg.Repository}, nil
+	case KindBitbucket:
+		c.baseURL = apiURL(cfg.APIURL, "https://api.bitbucket.org/2.0")
+		c.header = http.Header{
+			"Authorization": {"Bearer " + cfg.Token},
+		}
+		return &bitbucket{client: c, workspace: owner, repo: repo}, nil
+	case KindGitea:
+		if cfg.APIURL == "" {
+			return nil, fmt.Errorf("api url must be set for %s", cfg.Kind)
+		}
+		c.baseURL = apiURL(cfg.APIURL, "") + "/api/v1"
+		c.header = http.Header{
+			"Authorization": {"token " + cfg.Token},
+		}
+		return &gitea{client: c, owner: owner, repo: repo}, nil
+	default:
+		return nil, fmt.Errorf("unsupported git hosting provider kind: %s", cfg.Kind)
+	}
+}
+
+// RepositoryFromRemote returns the path of the repository, e.g. org/repo,
+// from the given remote address of the repository.
+func RepositoryFromRemote(remote string) (string, error) {
+	u, err := git.ParseGitURL(remote)
+	if err != nil {
+		return "", err
+	}
+	repo := strings.TrimSuffix(strings.Trim(u.Path, "/"), ".git")
+	if repo == "" {
+		return "", fmt.Errorf("no repository path found in %s", remote)
+	}
+	return repo, nil
+}
+
+func apiURL(url, defaultURL string) string {
+	if url == "" {
+		url = defaultURL
+	}
+	return strings.TrimSuffix(url, "/")
+}
+
+// client is a minimal JSON API client shared by all providers.
+type client struct {
+	httpClient *http.Client
+	kind       Kind
+	baseURL    string
+	header     http.Header
+}
+
+// do sends a request with the given body encoded as JSON to the path under the base URL
+// and decodes the response body into out if it is not nil.
+func (c *client) do(ctx context.Context, method, path string, body, out interface{}) error {
+	var reqBody io.Reader
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+		reqBody = bytes.NewReader(data)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, c.baseURL+path, reqBody)
+	if err != nil {
+		return err
+	}
+	for k, v := range c.header {
+		req.Header[k] = v
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return fmt.Errorf("%s api returned %d on %s %s: %s", strings.ToLower(string(c.kind)), resp.StatusCode, method, path, strings.TrimSpace(string(msg)))
+	}
+	if out == nil {
+		return nil
+	}
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return fmt.Errorf("failed to decode response of %s %s: %w", method, path, err)
+	}
+	return nil
+}
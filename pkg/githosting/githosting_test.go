@@ -0,0 +1,274 @@
+// Copyright 2024 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package githosting
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeRequest struct {
+	Method string
+	Path   string
+	Header http.Header
+	Body   map[string]interface{}
+}
+
+// newFakeServer starts a server that records all requests and
+// responds with the registered body for each "METHOD path".
+func newFakeServer(t *testing.T, responses map[string]string) (*httptest.Server, *[]fakeRequest) {
+	var requests []fakeRequest
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		req := fakeRequest{
+			Method: r.Method,
+			Path:   r.URL.RequestURI(),
+			Header: r.Header,
+		}
+		data, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		if len(data) > 0 {
+			require.NoError(t, json.Unmarshal(data, &req.Body))
+		}
+		requests = append(requests, req)
+
+		resp, ok := responses[r.Method+" "+r.URL.EscapedPath()]
+		if !ok {
+			http.Error(w, `{"message": "not found"}`, http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		io.WriteString(w, resp)
+	}))
+	t.Cleanup(srv.Close)
+	return srv, &requests
+}
+
+func TestNewProvider(t *testing.T) {
+	testcases := []struct {
+		name    string
+		cfg     Config
+		wantErr bool
+	}{
+		{
+			name: "github",
+			cfg:  Config{Kind: KindGitHub, Repository: "org/repo", Token: "token"},
+		},
+		{
+			name: "gitlab with nested group",
+			cfg:  Config{Kind: KindGitLab, Repository: "group/subgroup/repo", Token: "token"},
+		},
+		{
+			name:    "gitea without api url",
+			cfg:     Config{Kind: KindGitea, Repository: "org/repo", Token: "token"},
+			wantErr: true,
+		},
+		{
+			name:    "missing token",
+			cfg:     Config{Kind: KindGitHub, Repository: "org/repo"},
+			wantErr: true,
+		},
+		{
+			name:    "invalid repository",
+			cfg:     Config{Kind: KindBitbucket, Repository: "repo", Token: "token"},
+			wantErr: true,
+		},
+		{
+			name:    "unknown kind",
+			cfg:     Config{Kind: "UNKNOWN", Repository: "org/repo", Token: "token"},
+			wantErr: true,
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := NewProvider(tc.cfg)
+			assert.Equal(t, tc.wantErr, err != nil)
+		})
+	}
+}
+
+func TestRepositoryFromRemote(t *testing.T) {
+	testcases := []struct {
+		remote   string
+		expected string
+		wantErr  bool
+	}{
+		{remote: "git@github.com:org/repo.git", expected: "org/repo"},
+		{remote: "https://gitlab.com/group/subgroup/repo.git", expected: "group/subgroup/repo"},
+		{remote: "ssh://git@bitbucket.org/workspace/repo", expected: "workspace/repo"},
+		{remote: "https://gitea.example.com/", wantErr: true},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.remote, func(t *testing.T) {
+			got, err := RepositoryFromRemote(tc.remote)
+			assert.Equal(t, tc.wantErr, err != nil)
+			assert.Equal(t, tc.expected, got)
+		})
+	}
+}
+
+func TestGitHubCreatePullRequest(t *testing.T) {
+	srv, requests := newFakeServer(t, map[string]string{
+		"POST /api/v3/repos/org/repo/pulls":                        `{"number": 10, "html_url": "https://github.example.com/org/repo/pull/10", "node_id": "PR_1"}`,
+		"POST /api/v3/repos/org/repo/pulls/10/requested_reviewers": `{}`,
+		"POST /api/v3/repos/org/repo/issues/10/labels":             `[]`,
+		"POST /api/graphql":                                        `{"data": {}}`,
+	})
+	p, err := NewProvider(Config{Kind: KindGitHub, APIURL: srv.URL + "/api/v3/", Repository: "org/repo", Token: "token"})
+	require.NoError(t, err)
+
+	got, err := p.CreatePullRequest(context.Background(), PullRequest{
+		Title:     "title",
+		Body:      "body",
+		Head:      "feature",
+		Base:      "main",
+		Reviewers: []string{"user-1", "org/team-1"},
+		Labels:    []string{"pipecd"},
+		AutoMerge: true,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, &PullRequestResult{Number: 10, URL: "https://github.example.com/org/repo/pull/10"}, got)
+
+	require.Len(t, *requests, 4)
+	assert.Equal(t, "Bearer token", (*requests)[0].Header.Get("Authorization"))
+	assert.Equal(t, map[string]interface{}{"title": "title", "body": "body", "head": "feature", "base": "main"}, (*requests)[0].Body)
+	assert.Equal(t, map[string]interface{}{"reviewers": []interface{}{"user-1"}, "team_reviewers": []interface{}{"team-1"}}, (*requests)[1].Body)
+	assert.Equal(t, map[string]interface{}{"labels": []interface{}{"pipecd"}}, (*requests)[2].Body)
+	assert.Equal(t, map[string]interface{}{"id": "PR_1"}, (*requests)[3].Body["variables"])
+}
+
+func TestGitHubCreatePullRequestPartialFailure(t *testing.T) {
+	srv, _ := newFakeServer(t, map[string]string{
+		"POST /repos/org/repo/pulls": `{"number": 10, "html_url": "https://github.com/org/repo/pull/10"}`,
+	})
+	p, err := NewProvider(Config{Kind: KindGitHub, APIURL: srv.URL, Repository: "org/repo", Token: "token"})
+	require.NoError(t, err)
+
+	got, err := p.CreatePullRequest(context.Background(), PullRequest{Title: "title", Head: "feature", Base: "main", Labels: []string{"pipecd"}})
+	assert.Error(t, err)
+	assert.Equal(t, &PullRequestResult{Number: 10, URL: "https://github.com/org/repo/pull/10"}, got)
+}
+
+func TestGitLabCreatePullRequest(t *testing.T) {
+	srv, requests := newFakeServer(t, map[string]string{
+		"GET /api/v4/users": `[{"id": 5}]`,
+		"POST /api/v4/projects/group%2Frepo/merge_requests":        `{"iid": 3, "web_url": "https://gitlab.example.com/group/repo/-/merge_requests/3"}`,
+		"PUT /api/v4/projects/group%2Frepo/merge_requests/3/merge": `{}`,
+	})
+	p, err := NewProvider(Config{Kind: KindGitLab, APIURL: srv.URL, Repository: "group/repo", Token: "token"})
+	require.NoError(t, err)
+
+	got, err := p.CreatePullRequest(context.Background(), PullRequest{
+		Title:     "title",
+		Body:      "body",
+		Head:      "feature",
+		Base:      "main",
+		Reviewers: []string{"user-1"},
+		Labels:    []string{"pipecd", "auto"},
+		AutoMerge: true,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, &PullRequestResult{Number: 3, URL: "https://gitlab.example.com/group/repo/-/merge_requests/3"}, got)
+
+	require.Len(t, *requests, 3)
+	assert.Equal(t, "/api/v4/users?username=user-1", (*requests)[0].Path)
+	assert.Equal(t, "token", (*requests)[1].Header.Get("Private-Token"))
+	assert.Equal(t, map[string]interface{}{
+		"title":         "title",
+		"description":   "body",
+		"source_branch": "feature",
+		"target_branch": "main",
+		"labels":        "pipecd,auto",
+		"reviewer_ids":  []interface{}{float64(5)},
+	}, (*requests)[1].Body)
+	assert.Equal(t, map[string]interface{}{"merge_when_pipeline_succeeds": true}, (*requests)[2].Body)
+}
+
+func TestBitbucketCreatePullRequest(t *testing.T) {
+	srv, requests := newFakeServer(t, map[string]string{
+		"POST /repositories/workspace/repo/pullrequests": `{"id": 7, "links": {"html": {"href": "https://bitbucket.org/workspace/repo/pull-requests/7"}}}`,
+	})
+	p, err := NewProvider(Config{Kind: KindBitbucket, APIURL: srv.URL, Repository: "workspace/repo", Token: "token"})
+	require.NoError(t, err)
+
+	got, err := p.CreatePullRequest(context.Background(), PullRequest{
+		Title:     "title",
+		Body:      "body",
+		Head:      "feature",
+		Base:      "main",
+		Reviewers: []string{"{uuid-1}", "account-1"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, &PullRequestResult{Number: 7, URL: "https://bitbucket.org/workspace/repo/pull-requests/7"}, got)
+
+	require.Len(t, *requests, 1)
+	assert.Equal(t, map[string]interface{}{
+		"title":       "title",
+		"description": "body",
+		"source":      map[string]interface{}{"branch": map[string]interface{}{"name": "feature"}},
+		"destination": map[string]interface{}{"branch": map[string]interface{}{"name": "main"}},
+		"reviewers": []interface{}{
+			map[string]interface{}{"uuid": "{uuid-1}"},
+			map[string]interface{}{"account_id": "account-1"},
+		},
+	}, (*requests)[0].Body)
+
+	// Labels and auto-merge are not supported but the pull request is opened.
+	got, err = p.CreatePullRequest(context.Background(), PullRequest{Title: "title", Head: "feature", Base: "main", AutoMerge: true})
+	assert.Error(t, err)
+	assert.Equal(t, 7, got.Number)
+}
+
+func TestGiteaCreatePullRequest(t *testing.T) {
+	srv, requests := newFakeServer(t, map[string]string{
+		"GET /api/v1/repos/org/repo/labels":                       `[{"id": 1, "name": "pipecd"}, {"id": 2, "name": "other"}]`,
+		"POST /api/v1/repos/org/repo/pulls":                       `{"number": 4, "html_url": "https://gitea.example.com/org/repo/pulls/4"}`,
+		"POST /api/v1/repos/org/repo/pulls/4/requested_reviewers": `{}`,
+		"POST /api/v1/repos/org/repo/pulls/4/merge":               ``,
+	})
+	p, err := NewProvider(Config{Kind: KindGitea, APIURL: srv.URL, Repository: "org/repo", Token: "token"})
+	require.NoError(t, err)
+
+	got, err := p.CreatePullRequest(context.Background(), PullRequest{
+		Title:     "title",
+		Body:      "body",
+		Head:      "feature",
+		Base:      "main",
+		Reviewers: []string{"user-1"},
+		Labels:    []string{"pipecd", "missing"},
+		AutoMerge: true,
+	})
+	// The missing label is reported but the pull request is opened.
+	assert.Error(t, err)
+	assert.Equal(t, &PullRequestResult{Number: 4, URL: "https://gitea.example.com/org/repo/pulls/4"}, got)
+
+	require.Len(t, *requests, 4)
+	assert.Equal(t, "token token", (*requests)[1].Header.Get("Authorization"))
+	assert.Equal(t, map[string]interface{}{
+		"title":  "title",
+		"body":   "body",
+		"head":   "feature",
+		"base":   "main",
+		"labels": []interface{}{float64(1)},
+	}, (*requests)[1].Body)
+	assert.Equal(t, map[string]interface{}{"reviewers": []interface{}{"user-1"}}, (*requests)[2].Body)
+	assert.Equal(t, map[string]interface{}{"Do": "merge", "merge_when_checks_succeed": true}, (*requests)[3].Body)
+}
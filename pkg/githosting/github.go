@@ -0,0 +1,114 @@
+// Copyright 2024 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package githosting
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+type github struct {
+	*client
+	owner string
+	repo  string
+}
+
+type githubPullRequest struct {
+	Number  int    `json:"number"`
+	HTMLURL string `json:"html_url"`
+	NodeID  string `json:"node_id"`
+}
+
+func (g *github) CreatePullRequest(ctx context.Context, pr PullRequest) (*PullRequestResult, error) {
+	var created githubPullRequest
+	body := map[string]interface{}{
+		"title": pr.Title,
+		"body":  pr.Body,
+		"head":  pr.Head,
+		"base":  pr.Base,
+	}
+	if err := g.do(ctx, http.MethodPost, fmt.Sprintf("/repos/%s/%s/pulls", g.owner, g.repo), body, &created); err != nil {
+		return nil, fmt.Errorf("failed to create pull request: %w", err)
+	}
+	result := &PullRequestResult{
+		Number: created.Number,
+		URL:    created.HTMLURL,
+	}
+
+	var errs []error
+	if len(pr.Reviewers) > 0 {
+		// Team reviewers are specified in the format of org/team-slug.
+		var users, teams []string
+		for _, r := range pr.Reviewers {
+			if _, team, ok := strings.Cut(r, "/"); ok {
+				teams = append(teams, team)
+				continue
+			}
+			users = append(users, r)
+		}
+		body := map[string]interface{}{
+			"reviewers":      users,
+			"team_reviewers": teams,
+		}
+		if err := g.do(ctx, http.MethodPost, fmt.Sprintf("/repos/%s/%s/pulls/%d/requested_reviewers", g.owner, g.repo, created.Number), body, nil); err != nil {
+			errs = append(errs, fmt.Errorf("failed to request reviewers: %w", err))
+		}
+	}
+	if len(pr.Labels) > 0 {
+		body := map[string]interface{}{
+			"labels": pr.Labels,
+		}
+		if err := g.do(ctx, http.MethodPost, fmt.Sprintf("/repos/%s/%s/issues/%d/labels", g.owner, g.repo, created.Number), body, nil); err != nil {
+			errs = append(errs, fmt.Errorf("failed to add labels: %w", err))
+		}
+	}
+	if pr.AutoMerge {
+		if err := g.enableAutoMerge(ctx, created.NodeID); err != nil {
+			errs = append(errs, fmt.Errorf("failed to enable auto-merge: %w", err))
+		}
+	}
+	return result, errors.Join(errs...)
+}
+
+// enableAutoMerge enables auto-merge of the pull request.
+// It is only available via the GraphQL API.
+func (g *github) enableAutoMerge(ctx context.Context, nodeID string) error {
+	const mutation = `mutation($id: ID!) { enablePullRequestAutoMerge(input: {pullRequestId: $id}) { clientMutationId } }`
+	body := map[string]interface{}{
+		"query": mutation,
+		"variables": map[string]string{
+			"id": nodeID,
+		},
+	}
+	var resp struct {
+		Errors []struct {
+			Message string `json:"message"`
+		} `json:"errors"`
+	}
+	// The GraphQL endpoint of GitHub Enterprise Server is placed at /api/graphql
+	// while the REST API is placed at /api/v3.
+	c := *g.client
+	c.baseURL = strings.TrimSuffix(c.baseURL, "/v3")
+	if err := c.do(ctx, http.MethodPost, "/graphql", body, &resp); err != nil {
+		return err
+	}
+	if len(resp.Errors) > 0 {
+		return errors.New(resp.Errors[0].Message)
+	}
+	return nil
+}
@@ -0,0 +1,100 @@
+// Copyright 2024 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package githosting
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+type gitlab struct {
+	*client
+	project string
+}
+
+type gitlabMergeRequest struct {
+	IID    int    `json:"iid"`
+	WebURL string `json:"web_url"`
+}
+
+func (g *gitlab) CreatePullRequest(ctx context.Context, pr PullRequest) (*PullRequestResult, error) {
+	var errs []error
+	body := map[string]interface{}{
+		"title":         pr.Title,
+		"description":   pr.Body,
+		"source_branch": pr.Head,
+		"target_branch": pr.Base,
+	}
+	if len(pr.Labels) > 0 {
+		body["labels"] = strings.Join(pr.Labels, ",")
+	}
+	if len(pr.Reviewers) > 0 {
+		// The merge request is opened even if some of reviewers were not found.
+		ids, err := g.findUserIDs(ctx, pr.Reviewers)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("failed to request reviewers: %w", err))
+		}
+		if len(ids) > 0 {
+			body["reviewer_ids"] = ids
+		}
+	}
+
+	var created gitlabMergeRequest
+	projectPath := "/projects/" + url.PathEscape(g.project)
+	if err := g.do(ctx, http.MethodPost, projectPath+"/merge_requests", body, &created); err != nil {
+		return nil, fmt.Errorf("failed to create merge request: %w", err)
+	}
+	result := &PullRequestResult{
+		Number: created.IID,
+		URL:    created.WebURL,
+	}
+
+	if pr.AutoMerge {
+		body := map[string]interface{}{
+			"merge_when_pipeline_succeeds": true,
+		}
+		if err := g.do(ctx, http.MethodPut, fmt.Sprintf("%s/merge_requests/%d/merge", projectPath, created.IID), body, nil); err != nil {
+			errs = append(errs, fmt.Errorf("failed to enable auto-merge: %w", err))
+		}
+	}
+	return result, errors.Join(errs...)
+}
+
+// findUserIDs returns the IDs of the users having the given usernames.
+func (g *gitlab) findUserIDs(ctx context.Context, usernames []string) ([]int, error) {
+	var (
+		ids  = make([]int, 0, len(usernames))
+		errs []error
+	)
+	for _, name := range usernames {
+		var users []struct {
+			ID int `json:"id"`
+		}
+		if err := g.do(ctx, http.MethodGet, "/users?username="+url.QueryEscape(name), nil, &users); err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		if len(users) == 0 {
+			errs = append(errs, fmt.Errorf("user %s was not found", name))
+			continue
+		}
+		ids = append(ids, users[0].ID)
+	}
+	return ids, errors.Join(errs...)
+}
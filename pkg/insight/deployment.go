@@ -26,6 +26,7 @@ type DeploymentData struct {
 	CompletedAt       int64             `json:"completed_at"`
 	CompleteStatus    string            `json:"complete_status"`
 	RollbackStartedAt int64             `json:"rollback_started_at"`
+	CommitCreatedAt   int64             `json:"commit_created_at,omitempty"`
 }
 
 func BuildDeploymentData(d *model.Deployment) DeploymentData {
@@ -42,9 +43,28 @@ func BuildDeploymentData(d *model.Deployment) DeploymentData {
 		CompletedAt:       d.CompletedAt,
 		RollbackStartedAt: rollbackStartedAt,
 		CompleteStatus:    d.Status.String(),
+		CommitCreatedAt:   d.GetTrigger().GetCommit().GetCreatedAt(),
 	}
 }
 
+// ApplicationDeploymentStats represents the aggregated data of the deployments
+// of an application completed within a range.
+type ApplicationDeploymentStats struct {
+	ApplicationID string
+	Kind          string
+	Labels        map[string]string
+	// The number of completed deployments.
+	Count int
+	// The number of failed deployments.
+	FailureCount int
+	// The number of deployments whose duration is known.
+	DurationCount int
+	// The sum of the durations in seconds.
+	DurationSum float64
+	// Map from quantile to the duration in seconds.
+	DurationQuantiles map[float64]float64
+}
+
 func (d *DeploymentData) ContainLabels(labels map[string]string) bool {
 	if len(labels) == 0 {
 		return true
@@ -16,6 +16,8 @@ package insightmetrics
 
 import (
 	"context"
+	"sort"
+	"strings"
 	"time"
 
 	"github.com/prometheus/client_golang/prometheus"
@@ -26,32 +28,72 @@ import (
 )
 
 const (
-	projectKey = "project"
-	appKindKey = "app_kind"
+	projectKey       = "project"
+	appKindKey       = "app_kind"
+	applicationIDKey = "application_id"
+
+	// The prefix of the metric labels converted from the application labels.
+	applicationLabelPrefix = "label_"
+
+	// The range of the deployments used to build the deployment metrics.
+	deploymentMetricsWindow = 24 * time.Hour
 )
 
 type insightMetricsCollector struct {
 	insightProvider insight.Provider
 	projectStore    datastore.ProjectStore
+	nowFunc         func() time.Time
 
-	applicationDesc *prometheus.Desc
+	applicationDesc           *prometheus.Desc
+	deploymentFrequencyDesc   *prometheus.Desc
+	deploymentFailureRateDesc *prometheus.Desc
+	deploymentDurationDesc    *prometheus.Desc
+	applicationInfoName       string
+	applicationInfoHelp       string
 }
 
 func NewInsightMetricsCollector(p insight.Provider, ps datastore.ProjectStore) prometheus.Collector {
 	return &insightMetricsCollector{
 		insightProvider: p,
 		projectStore:    ps,
+		nowFunc:         time.Now,
 		applicationDesc: prometheus.NewDesc(
 			"insight_application_total",
 			"Number of applications currently controlled by control plane",
 			[]string{projectKey, appKindKey},
 			nil,
 		),
+		deploymentFrequencyDesc: prometheus.NewDesc(
+			"insight_deployment_frequency",
+			"Number of deployments completed within the last 24 hours",
+			[]string{projectKey, applicationIDKey, appKindKey},
+			nil,
+		),
+		deploymentFailureRateDesc: prometheus.NewDesc(
+			"insight_deployment_change_failure_rate",
+			"Rate of failed deployments in the deployments completed within the last 24 hours",
+			[]string{projectKey, applicationIDKey, appKindKey},
+			nil,
+		),
+		deploymentDurationDesc: prometheus.NewDesc(
+			"insight_deployment_duration_seconds",
+			"Duration of deployments completed within the last 24 hours",
+			[]string{projectKey, applicationIDKey, appKindKey},
+			nil,
+		),
+		applicationInfoName: "insight_application_info",
+		applicationInfoHelp: "Labels of applications currently controlled by control plane",
 	}
 }
 
+// Describe sends the descriptors of the metrics having the fixed label names.
+// The descriptor of insight_application_info is not sent because its label names
+// depend on the labels of the applications.
 func (i *insightMetricsCollector) Describe(ch chan<- *prometheus.Desc) {
 	ch <- i.applicationDesc
+	ch <- i.deploymentFrequencyDesc
+	ch <- i.deploymentFailureRateDesc
+	ch <- i.deploymentDurationDesc
 }
 
 func (i *insightMetricsCollector) Collect(ch chan<- prometheus.Metric) {
@@ -71,6 +113,137 @@ func (i *insightMetricsCollector) Collect(ch chan<- prometheus.Metric) {
 			)
 		}
 	}
+
+	stats, err := i.collectApplicationDeploymentStats()
+	if err != nil {
+		return
+	}
+
+	for proj, ss := range stats {
+		for _, st := range ss {
+			ch <- prometheus.MustNewConstMetric(
+				i.deploymentFrequencyDesc,
+				prometheus.GaugeValue,
+				float64(st.Count),
+				proj,
+				st.ApplicationID,
+				st.Kind,
+			)
+
+			var failureRate float64
+			if st.Count > 0 {
+				failureRate = float64(st.FailureCount) / float64(st.Count)
+			}
+			ch <- prometheus.MustNewConstMetric(
+				i.deploymentFailureRateDesc,
+				prometheus.GaugeValue,
+				failureRate,
+				proj,
+				st.ApplicationID,
+				st.Kind,
+			)
+
+			ch <- prometheus.MustNewConstSummary(
+				i.deploymentDurationDesc,
+				uint64(st.DurationCount),
+				st.DurationSum,
+				st.DurationQuantiles,
+				proj,
+				st.ApplicationID,
+				st.Kind,
+			)
+		}
+	}
+
+	for _, m := range i.buildApplicationInfoMetrics(stats) {
+		ch <- m
+	}
+}
+
+// collectApplicationDeploymentStats returns a map like map[projectID][]*ApplicationDeploymentStats.
+func (i *insightMetricsCollector) collectApplicationDeploymentStats() (map[string][]*insight.ApplicationDeploymentStats, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 1*time.Minute)
+	defer cancel()
+
+	projects, err := i.projectStore.List(ctx, datastore.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		to   = i.nowFunc()
+		from = to.Add(-deploymentMetricsWindow)
+		data = make(map[string][]*insight.ApplicationDeploymentStats, len(projects))
+	)
+	for idx := range projects {
+		projectID := projects[idx].Id
+		stats, err := i.insightProvider.GetApplicationDeploymentStats(ctx, projectID, from.Unix(), to.Unix())
+		if err != nil {
+			continue
+		}
+		data[projectID] = stats
+	}
+	return data, nil
+}
+
+// buildApplicationInfoMetrics builds the metrics having the labels of each application.
+// All of them have the same label names, which are the union of the labels of all applications,
+// so that they can be grouped into one metric family.
+func (i *insightMetricsCollector) buildApplicationInfoMetrics(stats map[string][]*insight.ApplicationDeploymentStats) []prometheus.Metric {
+	keys := make(map[string]string)
+	for _, ss := range stats {
+		for _, st := range ss {
+			for k := range st.Labels {
+				keys[k] = toMetricLabelName(k)
+			}
+		}
+	}
+
+	labelKeys := make([]string, 0, len(keys))
+	for k := range keys {
+		labelKeys = append(labelKeys, k)
+	}
+	sort.Strings(labelKeys)
+
+	names := []string{projectKey, applicationIDKey, appKindKey}
+	seen := map[string]struct{}{projectKey: {}, applicationIDKey: {}, appKindKey: {}}
+	used := make([]string, 0, len(labelKeys))
+	for _, k := range labelKeys {
+		// Skip the labels whose converted names conflict with others.
+		if _, ok := seen[keys[k]]; ok {
+			continue
+		}
+		seen[keys[k]] = struct{}{}
+		names = append(names, keys[k])
+		used = append(used, k)
+	}
+	desc := prometheus.NewDesc(i.applicationInfoName, i.applicationInfoHelp, names, nil)
+
+	out := make([]prometheus.Metric, 0)
+	for proj, ss := range stats {
+		for _, st := range ss {
+			values := []string{proj, st.ApplicationID, st.Kind}
+			for _, k := range used {
+				values = append(values, st.Labels[k])
+			}
+			out = append(out, prometheus.MustNewConstMetric(desc, prometheus.GaugeValue, 1, values...))
+		}
+	}
+	return out
+}
+
+// toMetricLabelName converts the given application label key to a valid metric label name.
+func toMetricLabelName(key string) string {
+	var b strings.Builder
+	b.WriteString(applicationLabelPrefix)
+	for _, r := range key {
+		if r == '_' || ('a' <= r && r <= 'z') || ('A' <= r && r <= 'Z') || ('0' <= r && r <= '9') {
+			b.WriteRune(r)
+			continue
+		}
+		b.WriteRune('_')
+	}
+	return b.String()
 }
 
 // collectApplicationCount returns a map like map[projectID]map[kind](number-of-applications).
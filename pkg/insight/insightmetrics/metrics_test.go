@@ -0,0 +1,102 @@
+// Copyright 2024 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package insightmetrics
+
+import (
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pipe-cd/pipecd/pkg/insight"
+)
+
+func TestToMetricLabelName(t *testing.T) {
+	testcases := []struct {
+		key      string
+		expected string
+	}{
+		{key: "env", expected: "label_env"},
+		{key: "team_name", expected: "label_team_name"},
+		{key: "app.kubernetes.io/name", expected: "label_app_kubernetes_io_name"},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.key, func(t *testing.T) {
+			assert.Equal(t, tc.expected, toMetricLabelName(tc.key))
+		})
+	}
+}
+
+func TestBuildApplicationInfoMetrics(t *testing.T) {
+	c := NewInsightMetricsCollector(nil, nil).(*insightMetricsCollector)
+	stats := map[string][]*insight.ApplicationDeploymentStats{
+		"project-1": {
+			{ApplicationID: "app-1", Kind: "KUBERNETES", Labels: map[string]string{"env": "prod", "team": "a"}},
+			{ApplicationID: "app-2", Kind: "TERRAFORM", Labels: map[string]string{"env": "dev"}},
+		},
+	}
+
+	metrics := c.buildApplicationInfoMetrics(stats)
+	require.Len(t, metrics, 2)
+
+	got := make(map[string]map[string]string, len(metrics))
+	for _, m := range metrics {
+		var pb dto.Metric
+		require.NoError(t, m.Write(&pb))
+		labels := make(map[string]string, len(pb.Label))
+		for _, l := range pb.Label {
+			labels[l.GetName()] = l.GetValue()
+		}
+		got[labels[applicationIDKey]] = labels
+	}
+
+	expected := map[string]map[string]string{
+		"app-1": {
+			"project":        "project-1",
+			"application_id": "app-1",
+			"app_kind":       "KUBERNETES",
+			"label_env":      "prod",
+			"label_team":     "a",
+		},
+		"app-2": {
+			"project":        "project-1",
+			"application_id": "app-2",
+			"app_kind":       "TERRAFORM",
+			"label_env":      "dev",
+			"label_team":     "",
+		},
+	}
+	assert.Equal(t, expected, got)
+
+	// Ensure that they can be gathered as one metric family.
+	reg := prometheus.NewPedanticRegistry()
+	require.NoError(t, reg.Register(&staticCollector{metrics: metrics}))
+	_, err := reg.Gather()
+	require.NoError(t, err)
+}
+
+type staticCollector struct {
+	metrics []prometheus.Metric
+}
+
+func (c *staticCollector) Describe(chan<- *prometheus.Desc) {}
+
+func (c *staticCollector) Collect(ch chan<- prometheus.Metric) {
+	for _, m := range c.metrics {
+		ch <- m
+	}
+}
@@ -16,6 +16,10 @@ package insight
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
 	"time"
 
 	"github.com/pipe-cd/pipecd/pkg/model"
@@ -25,6 +29,23 @@ type Provider interface {
 	GetApplicationCounts(ctx context.Context, projectID string) (*ApplicationCounts, error)
 	GetDeploymentFrequencyDataPoints(ctx context.Context, projectID, appID string, labels map[string]string, rangeFrom, rangeTo int64, resolution model.InsightResolution) ([]*model.InsightDataPoint, error)
 	GetDeploymentChangeFailureRateDataPoints(ctx context.Context, projectID, appID string, labels map[string]string, rangeFrom, rangeTo int64, resolution model.InsightResolution) ([]*model.InsightDataPoint, error)
+	GetDeploymentDurationSampleStreams(ctx context.Context, projectID, appID string, labels map[string]string, rangeFrom, rangeTo int64, resolution model.InsightResolution) ([]*model.InsightSampleStream, error)
+	GetDeploymentMTTRSampleStreams(ctx context.Context, projectID, appID string, labels map[string]string, rangeFrom, rangeTo int64, resolution model.InsightResolution) ([]*model.InsightSampleStream, error)
+	GetDeploymentLeadTimeSampleStreams(ctx context.Context, projectID, appID string, labels map[string]string, rangeFrom, rangeTo int64, resolution model.InsightResolution) ([]*model.InsightSampleStream, error)
+	GetApplicationDeploymentStats(ctx context.Context, projectID string, rangeFrom, rangeTo int64) ([]*ApplicationDeploymentStats, error)
+}
+
+// The maximum range of data that can be queried with the HOURLY resolution.
+const hourlyResolutionRangeLimit = 31 * 24 * time.Hour
+
+var ErrTooLargeRange = errors.New("too large range for the resolution")
+
+// ValidateDataRange checks whether the data in the given range can be queried with the given resolution.
+func ValidateDataRange(rangeFrom, rangeTo int64, resolution model.InsightResolution) error {
+	if resolution == model.InsightResolution_HOURLY && rangeTo-rangeFrom > int64(hourlyResolutionRangeLimit.Seconds()) {
+		return fmt.Errorf("%w: the range of %s resolution must not be larger than %v", ErrTooLargeRange, resolution, hourlyResolutionRangeLimit)
+	}
+	return nil
 }
 
 type provider struct {
@@ -67,6 +88,49 @@ func (p *provider) GetDeploymentChangeFailureRateDataPoints(ctx context.Context,
 	return fillUpDataPoints(points, rangeFrom, rangeTo, resolution), nil
 }
 
+func (p *provider) GetDeploymentDurationSampleStreams(ctx context.Context, projectID, appID string, labels map[string]string, rangeFrom, rangeTo int64, resolution model.InsightResolution) ([]*model.InsightSampleStream, error) {
+	ds, err := p.store.ListCompletedDeployments(ctx, projectID, rangeFrom, rangeTo)
+	if err != nil {
+		return nil, err
+	}
+
+	samples := buildDeploymentDurationSamples(ds, appID, labels)
+	return buildDurationSampleStreams(samples, rangeFrom, rangeTo, resolution), nil
+}
+
+func (p *provider) GetDeploymentMTTRSampleStreams(ctx context.Context, projectID, appID string, labels map[string]string, rangeFrom, rangeTo int64, resolution model.InsightResolution) ([]*model.InsightSampleStream, error) {
+	ds, err := p.store.ListCompletedDeployments(ctx, projectID, rangeFrom, rangeTo)
+	if err != nil {
+		return nil, err
+	}
+
+	samples := buildDeploymentMTTRSamples(ds, appID, labels)
+	return buildDurationSampleStreams(samples, rangeFrom, rangeTo, resolution), nil
+}
+
+func (p *provider) GetDeploymentLeadTimeSampleStreams(ctx context.Context, projectID, appID string, labels map[string]string, rangeFrom, rangeTo int64, resolution model.InsightResolution) ([]*model.InsightSampleStream, error) {
+	ds, err := p.store.ListCompletedDeployments(ctx, projectID, rangeFrom, rangeTo)
+	if err != nil {
+		return nil, err
+	}
+
+	samples := buildDeploymentLeadTimeSamples(ds, appID, labels)
+	return buildDurationSampleStreams(samples, rangeFrom, rangeTo, resolution), nil
+}
+
+func (p *provider) GetApplicationDeploymentStats(ctx context.Context, projectID string, rangeFrom, rangeTo int64) ([]*ApplicationDeploymentStats, error) {
+	apps, err := p.store.GetApplications(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+	ds, err := p.store.ListCompletedDeployments(ctx, projectID, rangeFrom, rangeTo)
+	if err != nil {
+		return nil, err
+	}
+
+	return buildApplicationDeploymentStats(apps, ds), nil
+}
+
 func buildDeploymentFrequencyDataPoints(ds []*DeploymentData, appID string, labels map[string]string, resolution model.InsightResolution) []*model.InsightDataPoint {
 	ds = filterDeploymentData(ds, appID, labels)
 	if len(ds) == 0 {
@@ -128,6 +192,172 @@ func buildDeploymentChangeFailureRateDataPoints(ds []*DeploymentData, appID stri
 	return out
 }
 
+// buildDeploymentDurationSamples builds the samples of the time in seconds
+// taken to complete each deployment.
+func buildDeploymentDurationSamples(ds []*DeploymentData, appID string, labels map[string]string) []durationSample {
+	ds = filterDeploymentData(ds, appID, labels)
+
+	samples := make([]durationSample, 0, len(ds))
+	for _, d := range ds {
+		if d.StartedAt == 0 || d.StartedAt > d.CompletedAt {
+			continue
+		}
+		samples = append(samples, durationSample{
+			timestamp: d.CompletedAt,
+			duration:  d.CompletedAt - d.StartedAt,
+		})
+	}
+	return samples
+}
+
+// buildDeploymentMTTRSamples builds the samples of the time to recovery in seconds.
+// A recovery is the duration from the first failed deployment of an application
+// to the next successful deployment of that application,
+// and it is counted at the time when the successful deployment was completed.
+func buildDeploymentMTTRSamples(ds []*DeploymentData, appID string, labels map[string]string) []durationSample {
+	ds = filterDeploymentData(ds, appID, labels)
+
+	var (
+		failedAt = make(map[string]int64)
+		samples  = make([]durationSample, 0)
+	)
+	for _, d := range ds {
+		switch d.CompleteStatus {
+		case model.DeploymentStatus_DEPLOYMENT_FAILURE.String():
+			if _, ok := failedAt[d.AppID]; !ok {
+				failedAt[d.AppID] = d.CompletedAt
+			}
+		case model.DeploymentStatus_DEPLOYMENT_SUCCESS.String():
+			if at, ok := failedAt[d.AppID]; ok {
+				samples = append(samples, durationSample{
+					timestamp: d.CompletedAt,
+					duration:  d.CompletedAt - at,
+				})
+				delete(failedAt, d.AppID)
+			}
+		}
+	}
+	return samples
+}
+
+// buildDeploymentLeadTimeSamples builds the samples of the lead time for changes in seconds.
+// The lead time is the duration from the creation of the commit
+// to the completion of the successful deployment of that commit.
+func buildDeploymentLeadTimeSamples(ds []*DeploymentData, appID string, labels map[string]string) []durationSample {
+	ds = filterDeploymentData(ds, appID, labels)
+
+	samples := make([]durationSample, 0, len(ds))
+	for _, d := range ds {
+		if d.CompleteStatus != model.DeploymentStatus_DEPLOYMENT_SUCCESS.String() {
+			continue
+		}
+		// The data collected by the older versions does not contain the commit time.
+		if d.CommitCreatedAt == 0 || d.CommitCreatedAt > d.CompletedAt {
+			continue
+		}
+		samples = append(samples, durationSample{
+			timestamp: d.CompletedAt,
+			duration:  d.CompletedAt - d.CommitCreatedAt,
+		})
+	}
+	return samples
+}
+
+type durationSample struct {
+	timestamp int64
+	duration  int64
+}
+
+// durationAggregations is the list of aggregations returned for duration metrics.
+// The average comes first to keep the clients reading only the first stream working.
+var durationAggregations = []model.InsightAggregation{
+	model.InsightAggregation_AVERAGE,
+	model.InsightAggregation_P50,
+	model.InsightAggregation_P90,
+	model.InsightAggregation_P99,
+}
+
+// buildDurationSampleStreams aggregates the given samples, which must be sorted by timestamp,
+// into one filled up stream for each of durationAggregations.
+func buildDurationSampleStreams(samples []durationSample, rangeFrom, rangeTo int64, resolution model.InsightResolution) []*model.InsightSampleStream {
+	out := make([]*model.InsightSampleStream, 0, len(durationAggregations))
+	for _, a := range durationAggregations {
+		points := buildDurationDataPoints(samples, resolution, a)
+		out = append(out, &model.InsightSampleStream{
+			Labels: map[string]string{
+				model.InsightSampleStreamLabelKey_AGGREGATION.String(): a.String(),
+			},
+			DataPoints: fillUpDataPoints(points, rangeFrom, rangeTo, resolution),
+		})
+	}
+	return out
+}
+
+// buildDurationDataPoints aggregates the given samples, which must be sorted by timestamp,
+// into one data point for each step of the resolution.
+func buildDurationDataPoints(samples []durationSample, resolution model.InsightResolution, aggregation model.InsightAggregation) []*model.InsightDataPoint {
+	var (
+		out       = make([]*model.InsightDataPoint, 0)
+		curPoint  *model.InsightDataPoint
+		durations []int64
+	)
+	for _, s := range samples {
+		ts := roundTimeByResolution(s.timestamp, resolution)
+		if curPoint == nil || curPoint.Timestamp != ts {
+			if curPoint != nil {
+				curPoint.Value = aggregateDurations(durations, aggregation)
+				durations = durations[:0]
+			}
+			curPoint = &model.InsightDataPoint{
+				Timestamp: ts,
+			}
+			out = append(out, curPoint)
+		}
+		durations = append(durations, s.duration)
+	}
+	if curPoint != nil {
+		curPoint.Value = aggregateDurations(durations, aggregation)
+	}
+
+	return out
+}
+
+func aggregateDurations(durations []int64, aggregation model.InsightAggregation) float32 {
+	if len(durations) == 0 {
+		return 0
+	}
+
+	switch aggregation {
+	case model.InsightAggregation_P50:
+		return percentile(durations, 50)
+	case model.InsightAggregation_P90:
+		return percentile(durations, 90)
+	case model.InsightAggregation_P99:
+		return percentile(durations, 99)
+	default:
+		var total int64
+		for _, d := range durations {
+			total += d
+		}
+		return float32(total) / float32(len(durations))
+	}
+}
+
+// percentile returns the p-th percentile of the given values by using the nearest-rank method.
+func percentile(values []int64, p int) float32 {
+	sorted := make([]int64, len(values))
+	copy(sorted, values)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i] < sorted[j]
+	})
+
+	rank := int(math.Ceil(float64(p) / 100 * float64(len(sorted))))
+	if rank < 1 {
+		rank = 1
+	}
+	return float32(sorted[rank-1])
+}
+
 func filterDeploymentData(ds []*DeploymentData, appID string, labels map[string]string) []*DeploymentData {
 	if appID == "" && len(labels) == 0 {
 		return ds
@@ -147,6 +377,57 @@ func filterDeploymentData(ds []*DeploymentData, appID string, labels map[string]
 	return targets
 }
 
+// buildApplicationDeploymentStats builds the deployment stats of each application which is not deleted.
+// The deployments of unknown applications are ignored.
+func buildApplicationDeploymentStats(apps *ProjectApplicationData, ds []*DeploymentData) []*ApplicationDeploymentStats {
+	var (
+		out       = make([]*ApplicationDeploymentStats, 0, len(apps.Applications))
+		stats     = make(map[string]*ApplicationDeploymentStats, len(apps.Applications))
+		durations = make(map[string][]int64, len(apps.Applications))
+	)
+	for _, a := range apps.Applications {
+		if a.Status == model.ApplicationActiveStatus_DELETED.String() {
+			continue
+		}
+		s := &ApplicationDeploymentStats{
+			ApplicationID: a.ID,
+			Kind:          a.Kind,
+			Labels:        a.Labels,
+		}
+		stats[a.ID] = s
+		out = append(out, s)
+	}
+
+	for _, d := range ds {
+		s, ok := stats[d.AppID]
+		if !ok {
+			continue
+		}
+		s.Count++
+		if d.CompleteStatus == model.DeploymentStatus_DEPLOYMENT_FAILURE.String() {
+			s.FailureCount++
+		}
+		if d.StartedAt > 0 && d.StartedAt <= d.CompletedAt {
+			durations[d.AppID] = append(durations[d.AppID], d.CompletedAt-d.StartedAt)
+		}
+	}
+
+	for id, ds := range durations {
+		s := stats[id]
+		s.DurationCount = len(ds)
+		for _, d := range ds {
+			s.DurationSum += float64(d)
+		}
+		s.DurationQuantiles = map[float64]float64{
+			0.5:  float64(percentile(ds, 50)),
+			0.9:  float64(percentile(ds, 90)),
+			0.99: float64(percentile(ds, 99)),
+		}
+	}
+
+	return out
+}
+
 func buildApplicationCounts(d *ProjectApplicationData) ApplicationCounts {
 	if len(d.Applications) == 0 {
 		return ApplicationCounts{
@@ -223,28 +504,33 @@ func fillUpDataPoints(ds []*model.InsightDataPoint, from, to int64, resolution m
 func roundTimeByResolution(n int64, resolution model.InsightResolution) int64 {
 	t := time.Unix(n, 0).UTC()
 
-	if resolution == model.InsightResolution_MONTHLY {
+	switch resolution {
+	case model.InsightResolution_HOURLY:
+		t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), 0, 0, 0, t.Location())
+	case model.InsightResolution_WEEKLY:
+		// Weeks start on Monday.
+		offset := (int(t.Weekday()) + 6) % 7
+		t = time.Date(t.Year(), t.Month(), t.Day()-offset, 0, 0, 0, 0, t.Location())
+	case model.InsightResolution_MONTHLY:
 		t = time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, t.Location())
-		return t.Unix()
+	default:
+		t = time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
 	}
-
-	t = time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
 	return t.Unix()
 }
 
 func nextStep(cur int64, resolution model.InsightResolution) int64 {
 	t := time.Unix(cur, 0).UTC()
 
-	if resolution == model.InsightResolution_DAILY {
-		t = t.Add(24 * time.Hour)
-		return t.Unix()
-	}
-
-	if t.Month() == time.December {
-		t = time.Date(t.Year()+1, time.January, 1, 0, 0, 0, 0, t.Location())
-		return t.Unix()
+	switch resolution {
+	case model.InsightResolution_HOURLY:
+		t = t.Add(time.Hour)
+	case model.InsightResolution_WEEKLY:
+		t = t.AddDate(0, 0, 7)
+	case model.InsightResolution_MONTHLY:
+		t = t.AddDate(0, 1, 0)
+	default:
+		t = t.AddDate(0, 0, 1)
 	}
-
-	t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, t.Location())
 	return t.Unix()
 }
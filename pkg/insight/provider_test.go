@@ -202,6 +202,306 @@ func TestBuildDeploymentChangeFailureRateDataPoint(t *testing.T) {
 	}
 }
 
+func TestBuildDeploymentMTTRSamples(t *testing.T) {
+	const day = 1669334400 // 2022/11/25
+
+	var (
+		success = model.DeploymentStatus_DEPLOYMENT_SUCCESS.String()
+		failure = model.DeploymentStatus_DEPLOYMENT_FAILURE.String()
+	)
+	testcases := []struct {
+		name       string
+		ds         []*DeploymentData
+		appID      string
+		resolution model.InsightResolution
+		expected   []*model.InsightDataPoint
+	}{
+		{
+			name:       "empty",
+			ds:         []*DeploymentData{},
+			resolution: model.InsightResolution_DAILY,
+			expected:   []*model.InsightDataPoint{},
+		},
+		{
+			name: "no failure",
+			ds: []*DeploymentData{
+				{AppID: "app-1", CompletedAt: day + 3600, CompleteStatus: success},
+			},
+			resolution: model.InsightResolution_DAILY,
+			expected:   []*model.InsightDataPoint{},
+		},
+		{
+			name: "daily resolution",
+			ds: []*DeploymentData{
+				{AppID: "app-1", CompletedAt: day + 3600, CompleteStatus: failure},
+				{AppID: "app-2", CompletedAt: day + 3600, CompleteStatus: failure},
+				{AppID: "app-1", CompletedAt: day + 7200, CompleteStatus: failure},
+				{AppID: "app-1", CompletedAt: day + 10800, CompleteStatus: success},
+				{AppID: "app-2", CompletedAt: day + 86400 + 3600, CompleteStatus: success},
+				{AppID: "app-1", CompletedAt: day + 86400 + 7200, CompleteStatus: success},
+			},
+			resolution: model.InsightResolution_DAILY,
+			expected: []*model.InsightDataPoint{
+				{Timestamp: day, Value: 7200},
+				{Timestamp: day + 86400, Value: 86400},
+			},
+		},
+		{
+			name: "filtered by application",
+			ds: []*DeploymentData{
+				{AppID: "app-1", CompletedAt: day + 3600, CompleteStatus: failure},
+				{AppID: "app-2", CompletedAt: day + 3600, CompleteStatus: failure},
+				{AppID: "app-1", CompletedAt: day + 10800, CompleteStatus: success},
+				{AppID: "app-2", CompletedAt: day + 86400 + 3600, CompleteStatus: success},
+			},
+			appID:      "app-2",
+			resolution: model.InsightResolution_MONTHLY,
+			expected: []*model.InsightDataPoint{
+				{Timestamp: 1667260800, Value: 86400},
+			},
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			samples := buildDeploymentMTTRSamples(tc.ds, tc.appID, nil)
+			got := buildDurationDataPoints(samples, tc.resolution, model.InsightAggregation_AVERAGE)
+			assert.Equal(t, tc.expected, got)
+		})
+	}
+}
+
+func TestBuildDeploymentLeadTimeSamples(t *testing.T) {
+	const day = 1669334400 // 2022/11/25
+
+	var (
+		success = model.DeploymentStatus_DEPLOYMENT_SUCCESS.String()
+		failure = model.DeploymentStatus_DEPLOYMENT_FAILURE.String()
+	)
+	testcases := []struct {
+		name       string
+		ds         []*DeploymentData
+		resolution model.InsightResolution
+		expected   []*model.InsightDataPoint
+	}{
+		{
+			name:       "empty",
+			ds:         []*DeploymentData{},
+			resolution: model.InsightResolution_DAILY,
+			expected:   []*model.InsightDataPoint{},
+		},
+		{
+			name: "daily resolution",
+			ds: []*DeploymentData{
+				{CommitCreatedAt: day, CompletedAt: day + 3600, CompleteStatus: success},
+				{CommitCreatedAt: day, CompletedAt: day + 5400, CompleteStatus: failure},
+				{CommitCreatedAt: day, CompletedAt: day + 7200, CompleteStatus: success},
+				{CompletedAt: day + 9000, CompleteStatus: success},
+				{CommitCreatedAt: day, CompletedAt: day + 86400 + 600, CompleteStatus: success},
+			},
+			resolution: model.InsightResolution_DAILY,
+			expected: []*model.InsightDataPoint{
+				{Timestamp: day, Value: 5400},
+				{Timestamp: day + 86400, Value: 87000},
+			},
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			samples := buildDeploymentLeadTimeSamples(tc.ds, "", nil)
+			got := buildDurationDataPoints(samples, tc.resolution, model.InsightAggregation_AVERAGE)
+			assert.Equal(t, tc.expected, got)
+		})
+	}
+}
+
+func TestBuildDurationDataPoints(t *testing.T) {
+	const hour = 1669334400 // 2022/11/25 00:00
+
+	samples := make([]durationSample, 0, 100)
+	for i := 1; i <= 100; i++ {
+		samples = append(samples, durationSample{timestamp: hour + int64(i), duration: int64(i)})
+	}
+	samples = append(samples, durationSample{timestamp: hour + 3600, duration: 10})
+
+	testcases := []struct {
+		name        string
+		aggregation model.InsightAggregation
+		expected    []*model.InsightDataPoint
+	}{
+		{
+			name:        "average",
+			aggregation: model.InsightAggregation_AVERAGE,
+			expected: []*model.InsightDataPoint{
+				{Timestamp: hour, Value: 50.5},
+				{Timestamp: hour + 3600, Value: 10},
+			},
+		},
+		{
+			name:        "p50",
+			aggregation: model.InsightAggregation_P50,
+			expected: []*model.InsightDataPoint{
+				{Timestamp: hour, Value: 50},
+				{Timestamp: hour + 3600, Value: 10},
+			},
+		},
+		{
+			name:        "p90",
+			aggregation: model.InsightAggregation_P90,
+			expected: []*model.InsightDataPoint{
+				{Timestamp: hour, Value: 90},
+				{Timestamp: hour + 3600, Value: 10},
+			},
+		},
+		{
+			name:        "p99",
+			aggregation: model.InsightAggregation_P99,
+			expected: []*model.InsightDataPoint{
+				{Timestamp: hour, Value: 99},
+				{Timestamp: hour + 3600, Value: 10},
+			},
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := buildDurationDataPoints(samples, model.InsightResolution_HOURLY, tc.aggregation)
+			assert.Equal(t, tc.expected, got)
+		})
+	}
+}
+
+func TestBuildDurationSampleStreams(t *testing.T) {
+	const day = 1669334400 // 2022/11/25
+
+	samples := []durationSample{
+		{timestamp: day + 10, duration: 30},
+		{timestamp: day + 20, duration: 10},
+		{timestamp: day + 30, duration: 20},
+	}
+	got := buildDurationSampleStreams(samples, day, day+86400, model.InsightResolution_DAILY)
+
+	expected := []*model.InsightSampleStream{
+		{
+			Labels:     map[string]string{"AGGREGATION": "AVERAGE"},
+			DataPoints: []*model.InsightDataPoint{{Timestamp: day, Value: 20}, {Timestamp: day + 86400, Value: 0}},
+		},
+		{
+			Labels:     map[string]string{"AGGREGATION": "P50"},
+			DataPoints: []*model.InsightDataPoint{{Timestamp: day, Value: 20}, {Timestamp: day + 86400, Value: 0}},
+		},
+		{
+			Labels:     map[string]string{"AGGREGATION": "P90"},
+			DataPoints: []*model.InsightDataPoint{{Timestamp: day, Value: 30}, {Timestamp: day + 86400, Value: 0}},
+		},
+		{
+			Labels:     map[string]string{"AGGREGATION": "P99"},
+			DataPoints: []*model.InsightDataPoint{{Timestamp: day, Value: 30}, {Timestamp: day + 86400, Value: 0}},
+		},
+	}
+	assert.Equal(t, expected, got)
+}
+
+func TestRoundTimeByResolution(t *testing.T) {
+	testcases := []struct {
+		name       string
+		ts         int64
+		resolution model.InsightResolution
+		expected   int64
+	}{
+		{
+			name:       "hourly",
+			ts:         1669338245, // 2022/11/25 01:04:05 (Fri)
+			resolution: model.InsightResolution_HOURLY,
+			expected:   1669338000, // 2022/11/25 01:00:00
+		},
+		{
+			name:       "daily",
+			ts:         1669338245,
+			resolution: model.InsightResolution_DAILY,
+			expected:   1669334400, // 2022/11/25
+		},
+		{
+			name:       "weekly",
+			ts:         1669338245,
+			resolution: model.InsightResolution_WEEKLY,
+			expected:   1668988800, // 2022/11/21 (Mon)
+		},
+		{
+			name:       "weekly on monday",
+			ts:         1668988800,
+			resolution: model.InsightResolution_WEEKLY,
+			expected:   1668988800,
+		},
+		{
+			name:       "weekly on sunday",
+			ts:         1669593599, // 2022/11/27 23:59:59 (Sun)
+			resolution: model.InsightResolution_WEEKLY,
+			expected:   1668988800,
+		},
+		{
+			name:       "monthly",
+			ts:         1669338245,
+			resolution: model.InsightResolution_MONTHLY,
+			expected:   1667260800, // 2022/11/01
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := roundTimeByResolution(tc.ts, tc.resolution)
+			assert.Equal(t, tc.expected, got)
+		})
+	}
+}
+
+func TestValidateDataRange(t *testing.T) {
+	const day = 24 * 60 * 60
+
+	assert.NoError(t, ValidateDataRange(1669334400, 1669334400+31*day, model.InsightResolution_HOURLY))
+	assert.ErrorIs(t, ValidateDataRange(1669334400, 1669334400+32*day, model.InsightResolution_HOURLY), ErrTooLargeRange)
+	assert.NoError(t, ValidateDataRange(1669334400, 1669334400+365*day, model.InsightResolution_WEEKLY))
+}
+
+func TestBuildApplicationDeploymentStats(t *testing.T) {
+	apps := &ProjectApplicationData{
+		Applications: []*ApplicationData{
+			{ID: "app-1", Kind: "KUBERNETES", Labels: map[string]string{"env": "prod"}, Status: model.ApplicationActiveStatus_ENABLED.String()},
+			{ID: "app-2", Kind: "TERRAFORM", Status: model.ApplicationActiveStatus_ENABLED.String()},
+			{ID: "app-3", Kind: "KUBERNETES", Status: model.ApplicationActiveStatus_DELETED.String()},
+		},
+	}
+	ds := []*DeploymentData{
+		{AppID: "app-1", StartedAt: 100, CompletedAt: 110, CompleteStatus: model.DeploymentStatus_DEPLOYMENT_SUCCESS.String()},
+		{AppID: "app-1", StartedAt: 200, CompletedAt: 230, CompleteStatus: model.DeploymentStatus_DEPLOYMENT_FAILURE.String()},
+		{AppID: "app-3", StartedAt: 200, CompletedAt: 230, CompleteStatus: model.DeploymentStatus_DEPLOYMENT_SUCCESS.String()},
+		{AppID: "unknown", StartedAt: 200, CompletedAt: 230, CompleteStatus: model.DeploymentStatus_DEPLOYMENT_SUCCESS.String()},
+	}
+
+	got := buildApplicationDeploymentStats(apps, ds)
+	expected := []*ApplicationDeploymentStats{
+		{
+			ApplicationID: "app-1",
+			Kind:          "KUBERNETES",
+			Labels:        map[string]string{"env": "prod"},
+			Count:         2,
+			FailureCount:  1,
+			DurationCount: 2,
+			DurationSum:   40,
+			DurationQuantiles: map[float64]float64{
+				0.5:  10,
+				0.9:  30,
+				0.99: 30,
+			},
+		},
+		{
+			ApplicationID: "app-2",
+			Kind:          "TERRAFORM",
+		},
+	}
+	assert.Equal(t, expected, got)
+}
+
 func TestFillUpDataPoints(t *testing.T) {
 	testcases := []struct {
 		name       string
@@ -254,6 +554,34 @@ func TestFillUpDataPoints(t *testing.T) {
 				{Timestamp: 259200, Value: 0},
 			},
 		},
+		{
+			name: "hourly resolution: missing both parts",
+			ds: []*model.InsightDataPoint{
+				{Timestamp: 7200, Value: 2},
+			},
+			from:       3600,
+			to:         10800,
+			resolution: model.InsightResolution_HOURLY,
+			want: []*model.InsightDataPoint{
+				{Timestamp: 3600, Value: 0},
+				{Timestamp: 7200, Value: 2},
+				{Timestamp: 10800, Value: 0},
+			},
+		},
+		{
+			name: "weekly resolution: missing both parts",
+			ds: []*model.InsightDataPoint{
+				{Timestamp: 1669593600, Value: 2}, // 2022/11/28
+			},
+			from:       1669338245, // 2022/11/25
+			to:         1670371200, // 2022/12/07
+			resolution: model.InsightResolution_WEEKLY,
+			want: []*model.InsightDataPoint{
+				{Timestamp: 1668988800, Value: 0},
+				{Timestamp: 1669593600, Value: 2},
+				{Timestamp: 1670198400, Value: 0},
+			},
+		},
 		{
 			name: "monthly resolution: missing head part",
 			ds: []*model.InsightDataPoint{
@@ -68,6 +68,42 @@ func (m *AnalysisResult) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
+	// no validation rules for Score
+
+	for idx, item := range m.GetMetricsScores() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AnalysisResultValidationError{
+						field:  fmt.Sprintf("MetricsScores[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AnalysisResultValidationError{
+						field:  fmt.Sprintf("MetricsScores[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AnalysisResultValidationError{
+					field:  fmt.Sprintf("MetricsScores[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
 	if len(errors) > 0 {
 		return AnalysisResultMultiError(errors)
 	}
@@ -145,3 +181,1428 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = AnalysisResultValidationError{}
+
+// Validate checks the field values on AnalysisMetricsScore with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AnalysisMetricsScore) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AnalysisMetricsScore with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AnalysisMetricsScoreMultiError, or nil if none found.
+func (m *AnalysisMetricsScore) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AnalysisMetricsScore) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetId()) < 1 {
+		err := AnalysisMetricsScoreValidationError{
+			field:  "Id",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Provider
+
+	// no validation rules for Query
+
+	// no validation rules for StatisticalTest
+
+	// no validation rules for Weight
+
+	// no validation rules for Score
+
+	// no validation rules for Evaluations
+
+	// no validation rules for Passes
+
+	if len(errors) > 0 {
+		return AnalysisMetricsScoreMultiError(errors)
+	}
+
+	return nil
+}
+
+// AnalysisMetricsScoreMultiError is an error wrapping multiple validation
+// errors returned by AnalysisMetricsScore.ValidateAll() if the designated
+// constraints aren't met.
+type AnalysisMetricsScoreMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AnalysisMetricsScoreMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AnalysisMetricsScoreMultiError) AllErrors() []error { return m }
+
+// AnalysisMetricsScoreValidationError is the validation error returned by
+// AnalysisMetricsScore.Validate if the designated constraints aren't met.
+type AnalysisMetricsScoreValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AnalysisMetricsScoreValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AnalysisMetricsScoreValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AnalysisMetricsScoreValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AnalysisMetricsScoreValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AnalysisMetricsScoreValidationError) ErrorName() string {
+	return "AnalysisMetricsScoreValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AnalysisMetricsScoreValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAnalysisMetricsScore.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AnalysisMetricsScoreValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AnalysisMetricsScoreValidationError{}
+
+// Validate checks the field values on AnalysisReport with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *AnalysisReport) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AnalysisReport with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in AnalysisReportMultiError,
+// or nil if none found.
+func (m *AnalysisReport) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AnalysisReport) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetDeploymentId()) < 1 {
+		err := AnalysisReportValidationError{
+			field:  "DeploymentId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetStageId()) < 1 {
+		err := AnalysisReportValidationError{
+			field:  "StageId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetApplicationId()) < 1 {
+		err := AnalysisReportValidationError{
+			field:  "ApplicationId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := StageStatus_name[int32(m.GetStatus())]; !ok {
+		err := AnalysisReportValidationError{
+			field:  "Status",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Reason
+
+	// no validation rules for Score
+
+	for idx, item := range m.GetIntervalScores() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AnalysisReportValidationError{
+						field:  fmt.Sprintf("IntervalScores[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AnalysisReportValidationError{
+						field:  fmt.Sprintf("IntervalScores[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AnalysisReportValidationError{
+					field:  fmt.Sprintf("IntervalScores[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetMetrics() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AnalysisReportValidationError{
+						field:  fmt.Sprintf("Metrics[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AnalysisReportValidationError{
+						field:  fmt.Sprintf("Metrics[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AnalysisReportValidationError{
+					field:  fmt.Sprintf("Metrics[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetLogs() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AnalysisReportValidationError{
+						field:  fmt.Sprintf("Logs[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AnalysisReportValidationError{
+						field:  fmt.Sprintf("Logs[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AnalysisReportValidationError{
+					field:  fmt.Sprintf("Logs[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetHttps() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AnalysisReportValidationError{
+						field:  fmt.Sprintf("Https[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AnalysisReportValidationError{
+						field:  fmt.Sprintf("Https[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AnalysisReportValidationError{
+					field:  fmt.Sprintf("Https[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if m.GetStartedAt() <= 0 {
+		err := AnalysisReportValidationError{
+			field:  "StartedAt",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetCompletedAt() < 0 {
+		err := AnalysisReportValidationError{
+			field:  "CompletedAt",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return AnalysisReportMultiError(errors)
+	}
+
+	return nil
+}
+
+// AnalysisReportMultiError is an error wrapping multiple validation errors
+// returned by AnalysisReport.ValidateAll() if the designated constraints
+// aren't met.
+type AnalysisReportMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AnalysisReportMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AnalysisReportMultiError) AllErrors() []error { return m }
+
+// AnalysisReportValidationError is the validation error returned by
+// AnalysisReport.Validate if the designated constraints aren't met.
+type AnalysisReportValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AnalysisReportValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AnalysisReportValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AnalysisReportValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AnalysisReportValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AnalysisReportValidationError) ErrorName() string { return "AnalysisReportValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AnalysisReportValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAnalysisReport.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AnalysisReportValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AnalysisReportValidationError{}
+
+// Validate checks the field values on AnalysisIntervalScore with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AnalysisIntervalScore) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AnalysisIntervalScore with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AnalysisIntervalScoreMultiError, or nil if none found.
+func (m *AnalysisIntervalScore) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AnalysisIntervalScore) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetEvaluatedAt() <= 0 {
+		err := AnalysisIntervalScoreValidationError{
+			field:  "EvaluatedAt",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Score
+
+	if len(errors) > 0 {
+		return AnalysisIntervalScoreMultiError(errors)
+	}
+
+	return nil
+}
+
+// AnalysisIntervalScoreMultiError is an error wrapping multiple validation
+// errors returned by AnalysisIntervalScore.ValidateAll() if the designated
+// constraints aren't met.
+type AnalysisIntervalScoreMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AnalysisIntervalScoreMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AnalysisIntervalScoreMultiError) AllErrors() []error { return m }
+
+// AnalysisIntervalScoreValidationError is the validation error returned by
+// AnalysisIntervalScore.Validate if the designated constraints aren't met.
+type AnalysisIntervalScoreValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AnalysisIntervalScoreValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AnalysisIntervalScoreValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AnalysisIntervalScoreValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AnalysisIntervalScoreValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AnalysisIntervalScoreValidationError) ErrorName() string {
+	return "AnalysisIntervalScoreValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AnalysisIntervalScoreValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAnalysisIntervalScore.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AnalysisIntervalScoreValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AnalysisIntervalScoreValidationError{}
+
+// Validate checks the field values on AnalysisMetricsReport with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AnalysisMetricsReport) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AnalysisMetricsReport with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AnalysisMetricsReportMultiError, or nil if none found.
+func (m *AnalysisMetricsReport) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AnalysisMetricsReport) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetId()) < 1 {
+		err := AnalysisMetricsReportValidationError{
+			field:  "Id",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Provider
+
+	// no validation rules for Strategy
+
+	// no validation rules for StatisticalTest
+
+	// no validation rules for Deviation
+
+	// no validation rules for Expected
+
+	// no validation rules for Score
+
+	for idx, item := range m.GetEvaluations() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AnalysisMetricsReportValidationError{
+						field:  fmt.Sprintf("Evaluations[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AnalysisMetricsReportValidationError{
+						field:  fmt.Sprintf("Evaluations[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AnalysisMetricsReportValidationError{
+					field:  fmt.Sprintf("Evaluations[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return AnalysisMetricsReportMultiError(errors)
+	}
+
+	return nil
+}
+
+// AnalysisMetricsReportMultiError is an error wrapping multiple validation
+// errors returned by AnalysisMetricsReport.ValidateAll() if the designated
+// constraints aren't met.
+type AnalysisMetricsReportMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AnalysisMetricsReportMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AnalysisMetricsReportMultiError) AllErrors() []error { return m }
+
+// AnalysisMetricsReportValidationError is the validation error returned by
+// AnalysisMetricsReport.Validate if the designated constraints aren't met.
+type AnalysisMetricsReportValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AnalysisMetricsReportValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AnalysisMetricsReportValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AnalysisMetricsReportValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AnalysisMetricsReportValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AnalysisMetricsReportValidationError) ErrorName() string {
+	return "AnalysisMetricsReportValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AnalysisMetricsReportValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAnalysisMetricsReport.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AnalysisMetricsReportValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AnalysisMetricsReportValidationError{}
+
+// Validate checks the field values on AnalysisLogReport with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *AnalysisLogReport) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AnalysisLogReport with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AnalysisLogReportMultiError, or nil if none found.
+func (m *AnalysisLogReport) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AnalysisLogReport) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetId()) < 1 {
+		err := AnalysisLogReportValidationError{
+			field:  "Id",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Provider
+
+	// no validation rules for Strategy
+
+	// no validation rules for MaxCount
+
+	// no validation rules for MaxRatio
+
+	// no validation rules for Score
+
+	for idx, item := range m.GetEvaluations() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AnalysisLogReportValidationError{
+						field:  fmt.Sprintf("Evaluations[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AnalysisLogReportValidationError{
+						field:  fmt.Sprintf("Evaluations[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AnalysisLogReportValidationError{
+					field:  fmt.Sprintf("Evaluations[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return AnalysisLogReportMultiError(errors)
+	}
+
+	return nil
+}
+
+// AnalysisLogReportMultiError is an error wrapping multiple validation errors
+// returned by AnalysisLogReport.ValidateAll() if the designated constraints
+// aren't met.
+type AnalysisLogReportMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AnalysisLogReportMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AnalysisLogReportMultiError) AllErrors() []error { return m }
+
+// AnalysisLogReportValidationError is the validation error returned by
+// AnalysisLogReport.Validate if the designated constraints aren't met.
+type AnalysisLogReportValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AnalysisLogReportValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AnalysisLogReportValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AnalysisLogReportValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AnalysisLogReportValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AnalysisLogReportValidationError) ErrorName() string {
+	return "AnalysisLogReportValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AnalysisLogReportValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAnalysisLogReport.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AnalysisLogReportValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AnalysisLogReportValidationError{}
+
+// Validate checks the field values on AnalysisHTTPReport with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AnalysisHTTPReport) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AnalysisHTTPReport with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AnalysisHTTPReportMultiError, or nil if none found.
+func (m *AnalysisHTTPReport) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AnalysisHTTPReport) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetId()) < 1 {
+		err := AnalysisHTTPReportValidationError{
+			field:  "Id",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Provider
+
+	// no validation rules for Score
+
+	for idx, item := range m.GetEvaluations() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AnalysisHTTPReportValidationError{
+						field:  fmt.Sprintf("Evaluations[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AnalysisHTTPReportValidationError{
+						field:  fmt.Sprintf("Evaluations[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AnalysisHTTPReportValidationError{
+					field:  fmt.Sprintf("Evaluations[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return AnalysisHTTPReportMultiError(errors)
+	}
+
+	return nil
+}
+
+// AnalysisHTTPReportMultiError is an error wrapping multiple validation errors
+// returned by AnalysisHTTPReport.ValidateAll() if the designated constraints
+// aren't met.
+type AnalysisHTTPReportMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AnalysisHTTPReportMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AnalysisHTTPReportMultiError) AllErrors() []error { return m }
+
+// AnalysisHTTPReportValidationError is the validation error returned by
+// AnalysisHTTPReport.Validate if the designated constraints aren't met.
+type AnalysisHTTPReportValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AnalysisHTTPReportValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AnalysisHTTPReportValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AnalysisHTTPReportValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AnalysisHTTPReportValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AnalysisHTTPReportValidationError) ErrorName() string {
+	return "AnalysisHTTPReportValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AnalysisHTTPReportValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAnalysisHTTPReport.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AnalysisHTTPReportValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AnalysisHTTPReportValidationError{}
+
+// Validate checks the field values on AnalysisEvaluation with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AnalysisEvaluation) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AnalysisEvaluation with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AnalysisEvaluationMultiError, or nil if none found.
+func (m *AnalysisEvaluation) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AnalysisEvaluation) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetEvaluatedAt() <= 0 {
+		err := AnalysisEvaluationValidationError{
+			field:  "EvaluatedAt",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := AnalysisVerdict_name[int32(m.GetVerdict())]; !ok {
+		err := AnalysisEvaluationValidationError{
+			field:  "Verdict",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Reason
+
+	for idx, item := range m.GetQueries() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AnalysisEvaluationValidationError{
+						field:  fmt.Sprintf("Queries[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AnalysisEvaluationValidationError{
+						field:  fmt.Sprintf("Queries[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AnalysisEvaluationValidationError{
+					field:  fmt.Sprintf("Queries[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return AnalysisEvaluationMultiError(errors)
+	}
+
+	return nil
+}
+
+// AnalysisEvaluationMultiError is an error wrapping multiple validation errors
+// returned by AnalysisEvaluation.ValidateAll() if the designated constraints
+// aren't met.
+type AnalysisEvaluationMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AnalysisEvaluationMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AnalysisEvaluationMultiError) AllErrors() []error { return m }
+
+// AnalysisEvaluationValidationError is the validation error returned by
+// AnalysisEvaluation.Validate if the designated constraints aren't met.
+type AnalysisEvaluationValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AnalysisEvaluationValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AnalysisEvaluationValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AnalysisEvaluationValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AnalysisEvaluationValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AnalysisEvaluationValidationError) ErrorName() string {
+	return "AnalysisEvaluationValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AnalysisEvaluationValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAnalysisEvaluation.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AnalysisEvaluationValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AnalysisEvaluationValidationError{}
+
+// Validate checks the field values on AnalysisQuery with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *AnalysisQuery) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AnalysisQuery with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in AnalysisQueryMultiError, or
+// nil if none found.
+func (m *AnalysisQuery) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AnalysisQuery) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Variant
+
+	// no validation rules for Query
+
+	// no validation rules for From
+
+	// no validation rules for To
+
+	for idx, item := range m.GetDataPoints() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AnalysisQueryValidationError{
+						field:  fmt.Sprintf("DataPoints[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AnalysisQueryValidationError{
+						field:  fmt.Sprintf("DataPoints[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AnalysisQueryValidationError{
+					field:  fmt.Sprintf("DataPoints[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for LogEntryCount
+
+	if len(errors) > 0 {
+		return AnalysisQueryMultiError(errors)
+	}
+
+	return nil
+}
+
+// AnalysisQueryMultiError is an error wrapping multiple validation errors
+// returned by AnalysisQuery.ValidateAll() if the designated constraints
+// aren't met.
+type AnalysisQueryMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AnalysisQueryMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AnalysisQueryMultiError) AllErrors() []error { return m }
+
+// AnalysisQueryValidationError is the validation error returned by
+// AnalysisQuery.Validate if the designated constraints aren't met.
+type AnalysisQueryValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AnalysisQueryValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AnalysisQueryValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AnalysisQueryValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AnalysisQueryValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AnalysisQueryValidationError) ErrorName() string { return "AnalysisQueryValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AnalysisQueryValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAnalysisQuery.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AnalysisQueryValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AnalysisQueryValidationError{}
+
+// Validate checks the field values on AnalysisDataPoint with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *AnalysisDataPoint) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AnalysisDataPoint with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AnalysisDataPointMultiError, or nil if none found.
+func (m *AnalysisDataPoint) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AnalysisDataPoint) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Timestamp
+
+	// no validation rules for Value
+
+	if len(errors) > 0 {
+		return AnalysisDataPointMultiError(errors)
+	}
+
+	return nil
+}
+
+// AnalysisDataPointMultiError is an error wrapping multiple validation errors
+// returned by AnalysisDataPoint.ValidateAll() if the designated constraints
+// aren't met.
+type AnalysisDataPointMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AnalysisDataPointMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AnalysisDataPointMultiError) AllErrors() []error { return m }
+
+// AnalysisDataPointValidationError is the validation error returned by
+// AnalysisDataPoint.Validate if the designated constraints aren't met.
+type AnalysisDataPointValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AnalysisDataPointValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AnalysisDataPointValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AnalysisDataPointValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AnalysisDataPointValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AnalysisDataPointValidationError) ErrorName() string {
+	return "AnalysisDataPointValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AnalysisDataPointValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAnalysisDataPoint.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AnalysisDataPointValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AnalysisDataPointValidationError{}
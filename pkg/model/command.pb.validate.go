@@ -346,6 +346,35 @@ func (m *Command) validate(all bool) error {
 		}
 	}
 
+	if all {
+		switch v := interface{}(m.GetRejectStage()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CommandValidationError{
+					field:  "RejectStage",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CommandValidationError{
+					field:  "RejectStage",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRejectStage()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CommandValidationError{
+				field:  "RejectStage",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if m.GetCreatedAt() <= 0 {
 		err := CommandValidationError{
 			field:  "CreatedAt",
@@ -480,6 +509,8 @@ func (m *Command_SyncApplication) validate(all bool) error {
 
 	// no validation rules for SyncStrategy
 
+	// no validation rules for OverrideDeploymentWindows
+
 	if len(errors) > 0 {
 		return Command_SyncApplicationMultiError(errors)
 	}
@@ -1450,3 +1481,129 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = Command_RestartPipedValidationError{}
+
+// Validate checks the field values on Command_RejectStage with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *Command_RejectStage) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Command_RejectStage with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// Command_RejectStageMultiError, or nil if none found.
+func (m *Command_RejectStage) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Command_RejectStage) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetDeploymentId()) < 1 {
+		err := Command_RejectStageValidationError{
+			field:  "DeploymentId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetStageId()) < 1 {
+		err := Command_RejectStageValidationError{
+			field:  "StageId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Comment
+
+	if len(errors) > 0 {
+		return Command_RejectStageMultiError(errors)
+	}
+
+	return nil
+}
+
+// Command_RejectStageMultiError is an error wrapping multiple validation
+// errors returned by Command_RejectStage.ValidateAll() if the designated
+// constraints aren't met.
+type Command_RejectStageMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m Command_RejectStageMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m Command_RejectStageMultiError) AllErrors() []error { return m }
+
+// Command_RejectStageValidationError is the validation error returned by
+// Command_RejectStage.Validate if the designated constraints aren't met.
+type Command_RejectStageValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e Command_RejectStageValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e Command_RejectStageValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e Command_RejectStageValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e Command_RejectStageValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e Command_RejectStageValidationError) ErrorName() string {
+	return "Command_RejectStageValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e Command_RejectStageValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCommand_RejectStage.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = Command_RejectStageValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = Command_RejectStageValidationError{}
@@ -17,9 +17,10 @@ package model
 type DataStoreType string
 
 const (
-	DataStoreFirestore DataStoreType = "FIRESTORE"
-	DataStoreMySQL     DataStoreType = "MYSQL"
-	DataStoreFileDB    DataStoreType = "FILEDB"
+	DataStoreFirestore  DataStoreType = "FIRESTORE"
+	DataStoreMySQL      DataStoreType = "MYSQL"
+	DataStorePostgreSQL DataStoreType = "POSTGRESQL"
+	DataStoreFileDB     DataStoreType = "FILEDB"
 )
 
 func (t DataStoreType) String() string {
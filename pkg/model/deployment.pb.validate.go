@@ -670,6 +670,8 @@ func (m *DeploymentTrigger) validate(all bool) error {
 
 	// no validation rules for StrategySummary
 
+	// no validation rules for OverrideDeploymentWindows
+
 	if len(errors) > 0 {
 		return DeploymentTriggerMultiError(errors)
 	}
@@ -43,6 +43,7 @@ const (
 	InsightMetricsKind_MTTR                 InsightMetricsKind = 2
 	InsightMetricsKind_LEAD_TIME            InsightMetricsKind = 3
 	InsightMetricsKind_APPLICATIONS_COUNT   InsightMetricsKind = 4
+	InsightMetricsKind_DEPLOYMENT_DURATION  InsightMetricsKind = 5
 )
 
 // Enum value maps for InsightMetricsKind.
@@ -53,6 +54,7 @@ var (
 		2: "MTTR",
 		3: "LEAD_TIME",
 		4: "APPLICATIONS_COUNT",
+		5: "DEPLOYMENT_DURATION",
 	}
 	InsightMetricsKind_value = map[string]int32{
 		"DEPLOYMENT_FREQUENCY": 0,
@@ -60,6 +62,7 @@ var (
 		"MTTR":                 2,
 		"LEAD_TIME":            3,
 		"APPLICATIONS_COUNT":   4,
+		"DEPLOYMENT_DURATION":  5,
 	}
 )
 
@@ -141,6 +144,8 @@ type InsightResolution int32
 const (
 	InsightResolution_DAILY   InsightResolution = 0
 	InsightResolution_MONTHLY InsightResolution = 1
+	InsightResolution_HOURLY  InsightResolution = 2
+	InsightResolution_WEEKLY  InsightResolution = 3
 )
 
 // Enum value maps for InsightResolution.
@@ -148,10 +153,14 @@ var (
 	InsightResolution_name = map[int32]string{
 		0: "DAILY",
 		1: "MONTHLY",
+		2: "HOURLY",
+		3: "WEEKLY",
 	}
 	InsightResolution_value = map[string]int32{
 		"DAILY":   0,
 		"MONTHLY": 1,
+		"HOURLY":  2,
+		"WEEKLY":  3,
 	}
 )
 
@@ -182,6 +191,103 @@ func (InsightResolution) EnumDescriptor() ([]byte, []int) {
 	return file_pkg_model_insight_proto_rawDescGZIP(), []int{2}
 }
 
+// The aggregation used to build the data points of duration metrics
+// such as DEPLOYMENT_DURATION, LEAD_TIME and MTTR.
+type InsightAggregation int32
+
+const (
+	InsightAggregation_AVERAGE InsightAggregation = 0
+	InsightAggregation_P50     InsightAggregation = 1
+	InsightAggregation_P90     InsightAggregation = 2
+	InsightAggregation_P99     InsightAggregation = 3
+)
+
+// Enum value maps for InsightAggregation.
+var (
+	InsightAggregation_name = map[int32]string{
+		0: "AVERAGE",
+		1: "P50",
+		2: "P90",
+		3: "P99",
+	}
+	InsightAggregation_value = map[string]int32{
+		"AVERAGE": 0,
+		"P50":     1,
+		"P90":     2,
+		"P99":     3,
+	}
+)
+
+func (x InsightAggregation) Enum() *InsightAggregation {
+	p := new(InsightAggregation)
+	*p = x
+	return p
+}
+
+func (x InsightAggregation) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (InsightAggregation) Descriptor() protoreflect.EnumDescriptor {
+	return file_pkg_model_insight_proto_enumTypes[3].Descriptor()
+}
+
+func (InsightAggregation) Type() protoreflect.EnumType {
+	return &file_pkg_model_insight_proto_enumTypes[3]
+}
+
+func (x InsightAggregation) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use InsightAggregation.Descriptor instead.
+func (InsightAggregation) EnumDescriptor() ([]byte, []int) {
+	return file_pkg_model_insight_proto_rawDescGZIP(), []int{3}
+}
+
+type InsightSampleStreamLabelKey int32
+
+const (
+	InsightSampleStreamLabelKey_AGGREGATION InsightSampleStreamLabelKey = 0
+)
+
+// Enum value maps for InsightSampleStreamLabelKey.
+var (
+	InsightSampleStreamLabelKey_name = map[int32]string{
+		0: "AGGREGATION",
+	}
+	InsightSampleStreamLabelKey_value = map[string]int32{
+		"AGGREGATION": 0,
+	}
+)
+
+func (x InsightSampleStreamLabelKey) Enum() *InsightSampleStreamLabelKey {
+	p := new(InsightSampleStreamLabelKey)
+	*p = x
+	return p
+}
+
+func (x InsightSampleStreamLabelKey) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (InsightSampleStreamLabelKey) Descriptor() protoreflect.EnumDescriptor {
+	return file_pkg_model_insight_proto_enumTypes[4].Descriptor()
+}
+
+func (InsightSampleStreamLabelKey) Type() protoreflect.EnumType {
+	return &file_pkg_model_insight_proto_enumTypes[4]
+}
+
+func (x InsightSampleStreamLabelKey) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use InsightSampleStreamLabelKey.Descriptor instead.
+func (InsightSampleStreamLabelKey) EnumDescriptor() ([]byte, []int) {
+	return file_pkg_model_insight_proto_rawDescGZIP(), []int{4}
+}
+
 type InsightApplicationCountLabelKey int32
 
 const (
@@ -212,11 +318,11 @@ func (x InsightApplicationCountLabelKey) String() string {
 }
 
 func (InsightApplicationCountLabelKey) Descriptor() protoreflect.EnumDescriptor {
-	return file_pkg_model_insight_proto_enumTypes[3].Descriptor()
+	return file_pkg_model_insight_proto_enumTypes[5].Descriptor()
 }
 
 func (InsightApplicationCountLabelKey) Type() protoreflect.EnumType {
-	return &file_pkg_model_insight_proto_enumTypes[3]
+	return &file_pkg_model_insight_proto_enumTypes[5]
 }
 
 func (x InsightApplicationCountLabelKey) Number() protoreflect.EnumNumber {
@@ -225,7 +331,7 @@ func (x InsightApplicationCountLabelKey) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use InsightApplicationCountLabelKey.Descriptor instead.
 func (InsightApplicationCountLabelKey) EnumDescriptor() ([]byte, []int) {
-	return file_pkg_model_insight_proto_rawDescGZIP(), []int{3}
+	return file_pkg_model_insight_proto_rawDescGZIP(), []int{5}
 }
 
 type InsightDataPoint struct {
@@ -496,20 +602,30 @@ var file_pkg_model_insight_proto_rawDesc = []byte{
 	0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
 	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
 	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
-	0x02, 0x38, 0x01, 0x2a, 0x78, 0x0a, 0x12, 0x49, 0x6e, 0x73, 0x69, 0x67, 0x68, 0x74, 0x4d, 0x65,
-	0x74, 0x72, 0x69, 0x63, 0x73, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x18, 0x0a, 0x14, 0x44, 0x45, 0x50,
-	0x4c, 0x4f, 0x59, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x46, 0x52, 0x45, 0x51, 0x55, 0x45, 0x4e, 0x43,
-	0x59, 0x10, 0x00, 0x12, 0x17, 0x0a, 0x13, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x5f, 0x46, 0x41,
-	0x49, 0x4c, 0x55, 0x52, 0x45, 0x5f, 0x52, 0x41, 0x54, 0x45, 0x10, 0x01, 0x12, 0x08, 0x0a, 0x04,
-	0x4d, 0x54, 0x54, 0x52, 0x10, 0x02, 0x12, 0x0d, 0x0a, 0x09, 0x4c, 0x45, 0x41, 0x44, 0x5f, 0x54,
-	0x49, 0x4d, 0x45, 0x10, 0x03, 0x12, 0x16, 0x0a, 0x12, 0x41, 0x50, 0x50, 0x4c, 0x49, 0x43, 0x41,
-	0x54, 0x49, 0x4f, 0x4e, 0x53, 0x5f, 0x43, 0x4f, 0x55, 0x4e, 0x54, 0x10, 0x04, 0x2a, 0x2b, 0x0a,
-	0x11, 0x49, 0x6e, 0x73, 0x69, 0x67, 0x68, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x54, 0x79,
-	0x70, 0x65, 0x12, 0x0a, 0x0a, 0x06, 0x4d, 0x41, 0x54, 0x52, 0x49, 0x58, 0x10, 0x00, 0x12, 0x0a,
-	0x0a, 0x06, 0x56, 0x45, 0x43, 0x54, 0x4f, 0x52, 0x10, 0x01, 0x2a, 0x2b, 0x0a, 0x11, 0x49, 0x6e,
-	0x73, 0x69, 0x67, 0x68, 0x74, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x12,
-	0x09, 0x0a, 0x05, 0x44, 0x41, 0x49, 0x4c, 0x59, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x4d, 0x4f,
-	0x4e, 0x54, 0x48, 0x4c, 0x59, 0x10, 0x01, 0x2a, 0x3e, 0x0a, 0x1f, 0x49, 0x6e, 0x73, 0x69, 0x67,
+	0x02, 0x38, 0x01, 0x2a, 0x91, 0x01, 0x0a, 0x12, 0x49, 0x6e, 0x73, 0x69, 0x67, 0x68, 0x74, 0x4d,
+	0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x18, 0x0a, 0x14, 0x44, 0x45,
+	0x50, 0x4c, 0x4f, 0x59, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x46, 0x52, 0x45, 0x51, 0x55, 0x45, 0x4e,
+	0x43, 0x59, 0x10, 0x00, 0x12, 0x17, 0x0a, 0x13, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x5f, 0x46,
+	0x41, 0x49, 0x4c, 0x55, 0x52, 0x45, 0x5f, 0x52, 0x41, 0x54, 0x45, 0x10, 0x01, 0x12, 0x08, 0x0a,
+	0x04, 0x4d, 0x54, 0x54, 0x52, 0x10, 0x02, 0x12, 0x0d, 0x0a, 0x09, 0x4c, 0x45, 0x41, 0x44, 0x5f,
+	0x54, 0x49, 0x4d, 0x45, 0x10, 0x03, 0x12, 0x16, 0x0a, 0x12, 0x41, 0x50, 0x50, 0x4c, 0x49, 0x43,
+	0x41, 0x54, 0x49, 0x4f, 0x4e, 0x53, 0x5f, 0x43, 0x4f, 0x55, 0x4e, 0x54, 0x10, 0x04, 0x12, 0x17,
+	0x0a, 0x13, 0x44, 0x45, 0x50, 0x4c, 0x4f, 0x59, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x44, 0x55, 0x52,
+	0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x05, 0x2a, 0x2b, 0x0a, 0x11, 0x49, 0x6e, 0x73, 0x69, 0x67,
+	0x68, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0a, 0x0a, 0x06,
+	0x4d, 0x41, 0x54, 0x52, 0x49, 0x58, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x56, 0x45, 0x43, 0x54,
+	0x4f, 0x52, 0x10, 0x01, 0x2a, 0x43, 0x0a, 0x11, 0x49, 0x6e, 0x73, 0x69, 0x67, 0x68, 0x74, 0x52,
+	0x65, 0x73, 0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x09, 0x0a, 0x05, 0x44, 0x41, 0x49,
+	0x4c, 0x59, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x4d, 0x4f, 0x4e, 0x54, 0x48, 0x4c, 0x59, 0x10,
+	0x01, 0x12, 0x0a, 0x0a, 0x06, 0x48, 0x4f, 0x55, 0x52, 0x4c, 0x59, 0x10, 0x02, 0x12, 0x0a, 0x0a,
+	0x06, 0x57, 0x45, 0x45, 0x4b, 0x4c, 0x59, 0x10, 0x03, 0x2a, 0x3c, 0x0a, 0x12, 0x49, 0x6e, 0x73,
+	0x69, 0x67, 0x68, 0x74, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x0b, 0x0a, 0x07, 0x41, 0x56, 0x45, 0x52, 0x41, 0x47, 0x45, 0x10, 0x00, 0x12, 0x07, 0x0a, 0x03,
+	0x50, 0x35, 0x30, 0x10, 0x01, 0x12, 0x07, 0x0a, 0x03, 0x50, 0x39, 0x30, 0x10, 0x02, 0x12, 0x07,
+	0x0a, 0x03, 0x50, 0x39, 0x39, 0x10, 0x03, 0x2a, 0x2e, 0x0a, 0x1b, 0x49, 0x6e, 0x73, 0x69, 0x67,
+	0x68, 0x74, 0x53, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x4c, 0x61,
+	0x62, 0x65, 0x6c, 0x4b, 0x65, 0x79, 0x12, 0x0f, 0x0a, 0x0b, 0x41, 0x47, 0x47, 0x52, 0x45, 0x47,
+	0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x00, 0x2a, 0x3e, 0x0a, 0x1f, 0x49, 0x6e, 0x73, 0x69, 0x67,
 	0x68, 0x74, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x75,
 	0x6e, 0x74, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x4b, 0x65, 0x79, 0x12, 0x08, 0x0a, 0x04, 0x4b, 0x49,
 	0x4e, 0x44, 0x10, 0x00, 0x12, 0x11, 0x0a, 0x0d, 0x41, 0x43, 0x54, 0x49, 0x56, 0x45, 0x5f, 0x53,
@@ -531,27 +647,29 @@ func file_pkg_model_insight_proto_rawDescGZIP() []byte {
 	return file_pkg_model_insight_proto_rawDescData
 }
 
-var file_pkg_model_insight_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
+var file_pkg_model_insight_proto_enumTypes = make([]protoimpl.EnumInfo, 6)
 var file_pkg_model_insight_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
 var file_pkg_model_insight_proto_goTypes = []interface{}{
 	(InsightMetricsKind)(0),              // 0: model.InsightMetricsKind
 	(InsightResultType)(0),               // 1: model.InsightResultType
 	(InsightResolution)(0),               // 2: model.InsightResolution
-	(InsightApplicationCountLabelKey)(0), // 3: model.InsightApplicationCountLabelKey
-	(*InsightDataPoint)(nil),             // 4: model.InsightDataPoint
-	(*InsightSample)(nil),                // 5: model.InsightSample
-	(*InsightSampleStream)(nil),          // 6: model.InsightSampleStream
-	(*InsightApplicationCount)(nil),      // 7: model.InsightApplicationCount
-	nil,                                  // 8: model.InsightSample.LabelsEntry
-	nil,                                  // 9: model.InsightSampleStream.LabelsEntry
-	nil,                                  // 10: model.InsightApplicationCount.LabelsEntry
+	(InsightAggregation)(0),              // 3: model.InsightAggregation
+	(InsightSampleStreamLabelKey)(0),     // 4: model.InsightSampleStreamLabelKey
+	(InsightApplicationCountLabelKey)(0), // 5: model.InsightApplicationCountLabelKey
+	(*InsightDataPoint)(nil),             // 6: model.InsightDataPoint
+	(*InsightSample)(nil),                // 7: model.InsightSample
+	(*InsightSampleStream)(nil),          // 8: model.InsightSampleStream
+	(*InsightApplicationCount)(nil),      // 9: model.InsightApplicationCount
+	nil,                                  // 10: model.InsightSample.LabelsEntry
+	nil,                                  // 11: model.InsightSampleStream.LabelsEntry
+	nil,                                  // 12: model.InsightApplicationCount.LabelsEntry
 }
 var file_pkg_model_insight_proto_depIdxs = []int32{
-	8,  // 0: model.InsightSample.labels:type_name -> model.InsightSample.LabelsEntry
-	4,  // 1: model.InsightSample.data_point:type_name -> model.InsightDataPoint
-	9,  // 2: model.InsightSampleStream.labels:type_name -> model.InsightSampleStream.LabelsEntry
-	4,  // 3: model.InsightSampleStream.data_points:type_name -> model.InsightDataPoint
-	10, // 4: model.InsightApplicationCount.labels:type_name -> model.InsightApplicationCount.LabelsEntry
+	10, // 0: model.InsightSample.labels:type_name -> model.InsightSample.LabelsEntry
+	6,  // 1: model.InsightSample.data_point:type_name -> model.InsightDataPoint
+	11, // 2: model.InsightSampleStream.labels:type_name -> model.InsightSampleStream.LabelsEntry
+	6,  // 3: model.InsightSampleStream.data_points:type_name -> model.InsightDataPoint
+	12, // 4: model.InsightApplicationCount.labels:type_name -> model.InsightApplicationCount.LabelsEntry
 	5,  // [5:5] is the sub-list for method output_type
 	5,  // [5:5] is the sub-list for method input_type
 	5,  // [5:5] is the sub-list for extension type_name
@@ -619,7 +737,7 @@ func file_pkg_model_insight_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_pkg_model_insight_proto_rawDesc,
-			NumEnums:      4,
+			NumEnums:      6,
 			NumMessages:   7,
 			NumExtensions: 0,
 			NumServices:   0,
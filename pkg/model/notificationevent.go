@@ -14,6 +14,21 @@
 
 package model
 
+import (
+	"fmt"
+	"strings"
+)
+
+const (
+	// StageApprovalCallbackID is the identifier attached to the interactive
+	// approval messages sent to chat tools, used to route their callbacks.
+	StageApprovalCallbackID = "pipecd-stage-approval"
+	// StageApprovalActionApprove is the name of the action approving the stage.
+	StageApprovalActionApprove = "approve"
+	// StageApprovalActionReject is the name of the action rejecting the stage.
+	StageApprovalActionReject = "reject"
+)
+
 type NotificationEvent struct {
 	Type     NotificationEventType
 	Metadata interface{}
@@ -60,6 +75,22 @@ func (e *NotificationEventDeploymentApproved) GetLabels() map[string]string {
 	return e.Deployment.Labels
 }
 
+func (e *NotificationEventDeploymentRejected) GetAppName() string {
+	return e.Deployment.ApplicationName
+}
+
+func (e *NotificationEventDeploymentRejected) GetLabels() map[string]string {
+	return e.Deployment.Labels
+}
+
+func (e *NotificationEventDeploymentBlocked) GetAppName() string {
+	return e.Deployment.ApplicationName
+}
+
+func (e *NotificationEventDeploymentBlocked) GetLabels() map[string]string {
+	return e.Deployment.Labels
+}
+
 func (e *NotificationEventDeploymentRollingBack) GetAppName() string {
 	return e.Deployment.ApplicationName
 }
@@ -171,3 +202,19 @@ func (e *NotificationEventStageCancelled) GetAppName() string {
 func (e *NotificationEventStageCancelled) GetLabels() map[string]string {
 	return e.GetDeployment().GetLabels()
 }
+
+// MakeStageApprovalValue builds the value attached to an interactive approval action
+// which identifies the stage waiting for an approval.
+func MakeStageApprovalValue(deploymentID, stageID string) string {
+	return fmt.Sprintf("%s:%s", deploymentID, stageID)
+}
+
+// ParseStageApprovalValue extracts the deployment id and stage id from
+// a value built by MakeStageApprovalValue.
+func ParseStageApprovalValue(value string) (deploymentID, stageID string, err error) {
+	parts := strings.Split(value, ":")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("malformed stage approval value: %q", value)
+	}
+	return parts[0], parts[1], nil
+}
@@ -619,6 +619,304 @@ var _ interface {
 	ErrorName() string
 } = NotificationEventDeploymentApprovedValidationError{}
 
+// Validate checks the field values on NotificationEventDeploymentRejected with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *NotificationEventDeploymentRejected) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on NotificationEventDeploymentRejected
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// NotificationEventDeploymentRejectedMultiError, or nil if none found.
+func (m *NotificationEventDeploymentRejected) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *NotificationEventDeploymentRejected) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetDeployment() == nil {
+		err := NotificationEventDeploymentRejectedValidationError{
+			field:  "Deployment",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetDeployment()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, NotificationEventDeploymentRejectedValidationError{
+					field:  "Deployment",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, NotificationEventDeploymentRejectedValidationError{
+					field:  "Deployment",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDeployment()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return NotificationEventDeploymentRejectedValidationError{
+				field:  "Deployment",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for StageId
+
+	// no validation rules for Rejecter
+
+	// no validation rules for Comment
+
+	if len(errors) > 0 {
+		return NotificationEventDeploymentRejectedMultiError(errors)
+	}
+
+	return nil
+}
+
+// NotificationEventDeploymentRejectedMultiError is an error wrapping multiple
+// validation errors returned by
+// NotificationEventDeploymentRejected.ValidateAll() if the designated
+// constraints aren't met.
+type NotificationEventDeploymentRejectedMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m NotificationEventDeploymentRejectedMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m NotificationEventDeploymentRejectedMultiError) AllErrors() []error { return m }
+
+// NotificationEventDeploymentRejectedValidationError is the validation error
+// returned by NotificationEventDeploymentRejected.Validate if the designated
+// constraints aren't met.
+type NotificationEventDeploymentRejectedValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e NotificationEventDeploymentRejectedValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e NotificationEventDeploymentRejectedValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e NotificationEventDeploymentRejectedValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e NotificationEventDeploymentRejectedValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e NotificationEventDeploymentRejectedValidationError) ErrorName() string {
+	return "NotificationEventDeploymentRejectedValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e NotificationEventDeploymentRejectedValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sNotificationEventDeploymentRejected.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = NotificationEventDeploymentRejectedValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = NotificationEventDeploymentRejectedValidationError{}
+
+// Validate checks the field values on NotificationEventDeploymentBlocked with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *NotificationEventDeploymentBlocked) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on NotificationEventDeploymentBlocked
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// NotificationEventDeploymentBlockedMultiError, or nil if none found.
+func (m *NotificationEventDeploymentBlocked) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *NotificationEventDeploymentBlocked) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetDeployment() == nil {
+		err := NotificationEventDeploymentBlockedValidationError{
+			field:  "Deployment",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetDeployment()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, NotificationEventDeploymentBlockedValidationError{
+					field:  "Deployment",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, NotificationEventDeploymentBlockedValidationError{
+					field:  "Deployment",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDeployment()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return NotificationEventDeploymentBlockedValidationError{
+				field:  "Deployment",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Reason
+
+	if len(errors) > 0 {
+		return NotificationEventDeploymentBlockedMultiError(errors)
+	}
+
+	return nil
+}
+
+// NotificationEventDeploymentBlockedMultiError is an error wrapping multiple
+// validation errors returned by
+// NotificationEventDeploymentBlocked.ValidateAll() if the designated
+// constraints aren't met.
+type NotificationEventDeploymentBlockedMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m NotificationEventDeploymentBlockedMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m NotificationEventDeploymentBlockedMultiError) AllErrors() []error { return m }
+
+// NotificationEventDeploymentBlockedValidationError is the validation error
+// returned by NotificationEventDeploymentBlocked.Validate if the designated
+// constraints aren't met.
+type NotificationEventDeploymentBlockedValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e NotificationEventDeploymentBlockedValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e NotificationEventDeploymentBlockedValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e NotificationEventDeploymentBlockedValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e NotificationEventDeploymentBlockedValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e NotificationEventDeploymentBlockedValidationError) ErrorName() string {
+	return "NotificationEventDeploymentBlockedValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e NotificationEventDeploymentBlockedValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sNotificationEventDeploymentBlocked.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = NotificationEventDeploymentBlockedValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = NotificationEventDeploymentBlockedValidationError{}
+
 // Validate checks the field values on NotificationEventDeploymentRollingBack
 // with the rules defined in the proto definition for this message. If any
 // rules are violated, the first error encountered is returned, or nil if
@@ -1268,6 +1566,8 @@ func (m *NotificationEventDeploymentWaitApproval) validate(all bool) error {
 		}
 	}
 
+	// no validation rules for StageId
+
 	if len(errors) > 0 {
 		return NotificationEventDeploymentWaitApprovalMultiError(errors)
 	}
@@ -69,3 +69,41 @@ func TestGroup(t *testing.T) {
 		})
 	}
 }
+
+func TestParseStageApprovalValue(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name             string
+		value            string
+		wantDeploymentID string
+		wantStageID      string
+		wantErr          bool
+	}{
+		{
+			name:             "valid value",
+			value:            MakeStageApprovalValue("deployment-id", "stage-id"),
+			wantDeploymentID: "deployment-id",
+			wantStageID:      "stage-id",
+		},
+		{
+			name:    "missing stage id",
+			value:   "deployment-id:",
+			wantErr: true,
+		},
+		{
+			name:    "malformed value",
+			value:   "deployment-id",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			deploymentID, stageID, err := ParseStageApprovalValue(tt.value)
+			assert.Equal(t, tt.wantErr, err != nil)
+			assert.Equal(t, tt.wantDeploymentID, deploymentID)
+			assert.Equal(t, tt.wantStageID, stageID)
+		})
+	}
+}
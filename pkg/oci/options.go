@@ -49,10 +49,23 @@ type PullOption interface {
 	applyPullOption(*PullOptions)
 }
 
-// Option is an interface that combines PushOption and PullOption.
+// ListTagsOptions holds options for listing tags in an OCI registry.
+type ListTagsOptions struct {
+	insecure bool
+	username string
+	password string
+}
+
+// ListTagsOption is an interface for applying list tags options.
+type ListTagsOption interface {
+	applyListTagsOption(*ListTagsOptions)
+}
+
+// Option is an interface that combines PushOption, PullOption and ListTagsOption.
 type Option interface {
 	PushOption
 	PullOption
+	ListTagsOption
 }
 
 // usernameOption is an option to specify the username for pushing.
@@ -67,6 +80,10 @@ func (o usernameOption) applyPullOption(opts *PullOptions) {
 	opts.username = string(o)
 }
 
+func (o usernameOption) applyListTagsOption(opts *ListTagsOptions) {
+	opts.username = string(o)
+}
+
 // WithUsername returns a Option that sets the username.
 func WithUsername(username string) Option {
 	return usernameOption(username)
@@ -83,6 +100,10 @@ func (o passwordOption) applyPullOption(opts *PullOptions) {
 	opts.password = string(o)
 }
 
+func (o passwordOption) applyListTagsOption(opts *ListTagsOptions) {
+	opts.password = string(o)
+}
+
 // WithPassword returns a Option that sets the password.
 func WithPassword(password string) Option {
 	return passwordOption(password)
@@ -101,6 +122,11 @@ func (o insecureOption) applyPullOption(opts *PullOptions) {
 	opts.insecure = bool(o)
 }
 
+// applyListTagsOption applies the insecure option to ListTagsOptions.
+func (o insecureOption) applyListTagsOption(opts *ListTagsOptions) {
+	opts.insecure = bool(o)
+}
+
 // WithInsecure returns an Option that enables insecure connections.
 func WithInsecure() Option {
 	return insecureOption(true)
@@ -0,0 +1,72 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package oci
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"oras.land/oras-go/v2/registry/remote"
+	"oras.land/oras-go/v2/registry/remote/auth"
+	"oras.land/oras-go/v2/registry/remote/retry"
+)
+
+// ListTags lists all tags of the repository at the given OCI URL, e.g. oci://ghcr.io/org/repo.
+// The reference part of the URL is ignored if given.
+func ListTags(ctx context.Context, repositoryURL string, opts ...ListTagsOption) ([]string, error) {
+	options := &ListTagsOptions{
+		insecure: false,
+	}
+	for _, opt := range opts {
+		opt.applyListTagsOption(options)
+	}
+
+	repo, _, err := parseOCIURL(repositoryURL)
+	if err != nil {
+		return nil, fmt.Errorf("could not parse OCI URL %s (%w)", repositoryURL, err)
+	}
+
+	r, err := remote.NewRepository(repo)
+	if err != nil {
+		return nil, fmt.Errorf("could not create repository (%w)", err)
+	}
+
+	r.PlainHTTP = options.insecure
+
+	if options.username != "" || options.password != "" {
+		r.Client = &auth.Client{
+			Client: retry.DefaultClient,
+			Header: http.Header{
+				"User-Agent": {"oras-go"},
+			},
+			Credential: func(_ context.Context, _ string) (auth.Credential, error) {
+				return auth.Credential{
+					Username: options.username,
+					Password: options.password,
+				}, nil
+			},
+		}
+	}
+
+	var tags []string
+	if err := r.Tags(ctx, "", func(page []string) error {
+		tags = append(tags, page...)
+		return nil
+	}); err != nil {
+		return nil, fmt.Errorf("could not list tags (%w)", err)
+	}
+	return tags, nil
+}
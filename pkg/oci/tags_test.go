@@ -0,0 +1,48 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package oci
+
+import (
+	"fmt"
+	"os"
+	"slices"
+	"testing"
+)
+
+func TestListTags(t *testing.T) {
+	t.Parallel()
+
+	// OCI_REGISTRY_HOST is set by TestMain in main_test.go
+	ociURL := fmt.Sprintf("oci://%s/test-list-tags", os.Getenv("OCI_REGISTRY_HOST"))
+
+	for _, tag := range []string{"v0.1.0", "v0.2.0"} {
+		pushTestFiles(t, t.TempDir(), ociURL+":"+tag)
+	}
+
+	tags, err := ListTags(
+		t.Context(),
+		ociURL,
+		WithInsecure(),
+		WithUsername("testuser"),
+		WithPassword("testpassword"),
+	)
+	if err != nil {
+		t.Fatalf("could not list tags: %s", err)
+	}
+	slices.Sort(tags)
+	if want := []string{"v0.1.0", "v0.2.0"}; !slices.Equal(tags, want) {
+		t.Errorf("unexpected tags: want %v, got %v", want, tags)
+	}
+}
@@ -2949,3 +2949,752 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = ListStageCommandsResponseValidationError{}
+
+// Validate checks the field values on GetLatestAnalysisResultRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetLatestAnalysisResultRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetLatestAnalysisResultRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// GetLatestAnalysisResultRequestMultiError, or nil if none found.
+func (m *GetLatestAnalysisResultRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetLatestAnalysisResultRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetApplicationId()) < 1 {
+		err := GetLatestAnalysisResultRequestValidationError{
+			field:  "ApplicationId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetLatestAnalysisResultRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetLatestAnalysisResultRequestMultiError is an error wrapping multiple
+// validation errors returned by GetLatestAnalysisResultRequest.ValidateAll()
+// if the designated constraints aren't met.
+type GetLatestAnalysisResultRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetLatestAnalysisResultRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetLatestAnalysisResultRequestMultiError) AllErrors() []error { return m }
+
+// GetLatestAnalysisResultRequestValidationError is the validation error
+// returned by GetLatestAnalysisResultRequest.Validate if the designated
+// constraints aren't met.
+type GetLatestAnalysisResultRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetLatestAnalysisResultRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetLatestAnalysisResultRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetLatestAnalysisResultRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetLatestAnalysisResultRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetLatestAnalysisResultRequestValidationError) ErrorName() string {
+	return "GetLatestAnalysisResultRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetLatestAnalysisResultRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetLatestAnalysisResultRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetLatestAnalysisResultRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetLatestAnalysisResultRequestValidationError{}
+
+// Validate checks the field values on GetLatestAnalysisResultResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetLatestAnalysisResultResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetLatestAnalysisResultResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// GetLatestAnalysisResultResponseMultiError, or nil if none found.
+func (m *GetLatestAnalysisResultResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetLatestAnalysisResultResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetAnalysisResult()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetLatestAnalysisResultResponseValidationError{
+					field:  "AnalysisResult",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetLatestAnalysisResultResponseValidationError{
+					field:  "AnalysisResult",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAnalysisResult()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetLatestAnalysisResultResponseValidationError{
+				field:  "AnalysisResult",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Found
+
+	if len(errors) > 0 {
+		return GetLatestAnalysisResultResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetLatestAnalysisResultResponseMultiError is an error wrapping multiple
+// validation errors returned by GetLatestAnalysisResultResponse.ValidateAll()
+// if the designated constraints aren't met.
+type GetLatestAnalysisResultResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetLatestAnalysisResultResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetLatestAnalysisResultResponseMultiError) AllErrors() []error { return m }
+
+// GetLatestAnalysisResultResponseValidationError is the validation error
+// returned by GetLatestAnalysisResultResponse.Validate if the designated
+// constraints aren't met.
+type GetLatestAnalysisResultResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetLatestAnalysisResultResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetLatestAnalysisResultResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetLatestAnalysisResultResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetLatestAnalysisResultResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetLatestAnalysisResultResponseValidationError) ErrorName() string {
+	return "GetLatestAnalysisResultResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetLatestAnalysisResultResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetLatestAnalysisResultResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetLatestAnalysisResultResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetLatestAnalysisResultResponseValidationError{}
+
+// Validate checks the field values on PutLatestAnalysisResultRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PutLatestAnalysisResultRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PutLatestAnalysisResultRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// PutLatestAnalysisResultRequestMultiError, or nil if none found.
+func (m *PutLatestAnalysisResultRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PutLatestAnalysisResultRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetApplicationId()) < 1 {
+		err := PutLatestAnalysisResultRequestValidationError{
+			field:  "ApplicationId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetAnalysisResult() == nil {
+		err := PutLatestAnalysisResultRequestValidationError{
+			field:  "AnalysisResult",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetAnalysisResult()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PutLatestAnalysisResultRequestValidationError{
+					field:  "AnalysisResult",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PutLatestAnalysisResultRequestValidationError{
+					field:  "AnalysisResult",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAnalysisResult()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PutLatestAnalysisResultRequestValidationError{
+				field:  "AnalysisResult",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return PutLatestAnalysisResultRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// PutLatestAnalysisResultRequestMultiError is an error wrapping multiple
+// validation errors returned by PutLatestAnalysisResultRequest.ValidateAll()
+// if the designated constraints aren't met.
+type PutLatestAnalysisResultRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PutLatestAnalysisResultRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PutLatestAnalysisResultRequestMultiError) AllErrors() []error { return m }
+
+// PutLatestAnalysisResultRequestValidationError is the validation error
+// returned by PutLatestAnalysisResultRequest.Validate if the designated
+// constraints aren't met.
+type PutLatestAnalysisResultRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PutLatestAnalysisResultRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PutLatestAnalysisResultRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PutLatestAnalysisResultRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PutLatestAnalysisResultRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PutLatestAnalysisResultRequestValidationError) ErrorName() string {
+	return "PutLatestAnalysisResultRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PutLatestAnalysisResultRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPutLatestAnalysisResultRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PutLatestAnalysisResultRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PutLatestAnalysisResultRequestValidationError{}
+
+// Validate checks the field values on PutLatestAnalysisResultResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PutLatestAnalysisResultResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PutLatestAnalysisResultResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// PutLatestAnalysisResultResponseMultiError, or nil if none found.
+func (m *PutLatestAnalysisResultResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PutLatestAnalysisResultResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return PutLatestAnalysisResultResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// PutLatestAnalysisResultResponseMultiError is an error wrapping multiple
+// validation errors returned by PutLatestAnalysisResultResponse.ValidateAll()
+// if the designated constraints aren't met.
+type PutLatestAnalysisResultResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PutLatestAnalysisResultResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PutLatestAnalysisResultResponseMultiError) AllErrors() []error { return m }
+
+// PutLatestAnalysisResultResponseValidationError is the validation error
+// returned by PutLatestAnalysisResultResponse.Validate if the designated
+// constraints aren't met.
+type PutLatestAnalysisResultResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PutLatestAnalysisResultResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PutLatestAnalysisResultResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PutLatestAnalysisResultResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PutLatestAnalysisResultResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PutLatestAnalysisResultResponseValidationError) ErrorName() string {
+	return "PutLatestAnalysisResultResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PutLatestAnalysisResultResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPutLatestAnalysisResultResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PutLatestAnalysisResultResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PutLatestAnalysisResultResponseValidationError{}
+
+// Validate checks the field values on PutAnalysisReportRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PutAnalysisReportRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PutAnalysisReportRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PutAnalysisReportRequestMultiError, or nil if none found.
+func (m *PutAnalysisReportRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PutAnalysisReportRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetReport() == nil {
+		err := PutAnalysisReportRequestValidationError{
+			field:  "Report",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetReport()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PutAnalysisReportRequestValidationError{
+					field:  "Report",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PutAnalysisReportRequestValidationError{
+					field:  "Report",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetReport()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PutAnalysisReportRequestValidationError{
+				field:  "Report",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return PutAnalysisReportRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// PutAnalysisReportRequestMultiError is an error wrapping multiple validation
+// errors returned by PutAnalysisReportRequest.ValidateAll() if the designated
+// constraints aren't met.
+type PutAnalysisReportRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PutAnalysisReportRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PutAnalysisReportRequestMultiError) AllErrors() []error { return m }
+
+// PutAnalysisReportRequestValidationError is the validation error returned by
+// PutAnalysisReportRequest.Validate if the designated constraints aren't met.
+type PutAnalysisReportRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PutAnalysisReportRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PutAnalysisReportRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PutAnalysisReportRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PutAnalysisReportRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PutAnalysisReportRequestValidationError) ErrorName() string {
+	return "PutAnalysisReportRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PutAnalysisReportRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPutAnalysisReportRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PutAnalysisReportRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PutAnalysisReportRequestValidationError{}
+
+// Validate checks the field values on PutAnalysisReportResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PutAnalysisReportResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PutAnalysisReportResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PutAnalysisReportResponseMultiError, or nil if none found.
+func (m *PutAnalysisReportResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PutAnalysisReportResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return PutAnalysisReportResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// PutAnalysisReportResponseMultiError is an error wrapping multiple validation
+// errors returned by PutAnalysisReportResponse.ValidateAll() if the
+// designated constraints aren't met.
+type PutAnalysisReportResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PutAnalysisReportResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PutAnalysisReportResponseMultiError) AllErrors() []error { return m }
+
+// PutAnalysisReportResponseValidationError is the validation error returned by
+// PutAnalysisReportResponse.Validate if the designated constraints aren't met.
+type PutAnalysisReportResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PutAnalysisReportResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PutAnalysisReportResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PutAnalysisReportResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PutAnalysisReportResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PutAnalysisReportResponseValidationError) ErrorName() string {
+	return "PutAnalysisReportResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PutAnalysisReportResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPutAnalysisReportResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PutAnalysisReportResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PutAnalysisReportResponseValidationError{}
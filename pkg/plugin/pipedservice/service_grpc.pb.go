@@ -46,6 +46,12 @@ type PluginServiceClient interface {
 	GetDeploymentSharedMetadata(ctx context.Context, in *GetDeploymentSharedMetadataRequest, opts ...grpc.CallOption) (*GetDeploymentSharedMetadataResponse, error)
 	// ListStageCommands lists unhandled commands of the given stage and type.
 	ListStageCommands(ctx context.Context, in *ListStageCommandsRequest, opts ...grpc.CallOption) (*ListStageCommandsResponse, error)
+	// GetLatestAnalysisResult returns the most recent successful analysis result of the given application.
+	GetLatestAnalysisResult(ctx context.Context, in *GetLatestAnalysisResultRequest, opts ...grpc.CallOption) (*GetLatestAnalysisResultResponse, error)
+	// PutLatestAnalysisResult updates the most recent successful analysis result of the given application.
+	PutLatestAnalysisResult(ctx context.Context, in *PutLatestAnalysisResultRequest, opts ...grpc.CallOption) (*PutLatestAnalysisResultResponse, error)
+	// PutAnalysisReport saves the details of an ANALYSIS stage.
+	PutAnalysisReport(ctx context.Context, in *PutAnalysisReportRequest, opts ...grpc.CallOption) (*PutAnalysisReportResponse, error)
 }
 
 type pluginServiceClient struct {
@@ -155,6 +161,33 @@ func (c *pluginServiceClient) ListStageCommands(ctx context.Context, in *ListSta
 	return out, nil
 }
 
+func (c *pluginServiceClient) GetLatestAnalysisResult(ctx context.Context, in *GetLatestAnalysisResultRequest, opts ...grpc.CallOption) (*GetLatestAnalysisResultResponse, error) {
+	out := new(GetLatestAnalysisResultResponse)
+	err := c.cc.Invoke(ctx, "/grpc.piped.service.PluginService/GetLatestAnalysisResult", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pluginServiceClient) PutLatestAnalysisResult(ctx context.Context, in *PutLatestAnalysisResultRequest, opts ...grpc.CallOption) (*PutLatestAnalysisResultResponse, error) {
+	out := new(PutLatestAnalysisResultResponse)
+	err := c.cc.Invoke(ctx, "/grpc.piped.service.PluginService/PutLatestAnalysisResult", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pluginServiceClient) PutAnalysisReport(ctx context.Context, in *PutAnalysisReportRequest, opts ...grpc.CallOption) (*PutAnalysisReportResponse, error) {
+	out := new(PutAnalysisReportResponse)
+	err := c.cc.Invoke(ctx, "/grpc.piped.service.PluginService/PutAnalysisReport", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // PluginServiceServer is the server API for PluginService service.
 // All implementations must embed UnimplementedPluginServiceServer
 // for forward compatibility
@@ -183,6 +216,12 @@ type PluginServiceServer interface {
 	GetDeploymentSharedMetadata(context.Context, *GetDeploymentSharedMetadataRequest) (*GetDeploymentSharedMetadataResponse, error)
 	// ListStageCommands lists unhandled commands of the given stage and type.
 	ListStageCommands(context.Context, *ListStageCommandsRequest) (*ListStageCommandsResponse, error)
+	// GetLatestAnalysisResult returns the most recent successful analysis result of the given application.
+	GetLatestAnalysisResult(context.Context, *GetLatestAnalysisResultRequest) (*GetLatestAnalysisResultResponse, error)
+	// PutLatestAnalysisResult updates the most recent successful analysis result of the given application.
+	PutLatestAnalysisResult(context.Context, *PutLatestAnalysisResultRequest) (*PutLatestAnalysisResultResponse, error)
+	// PutAnalysisReport saves the details of an ANALYSIS stage.
+	PutAnalysisReport(context.Context, *PutAnalysisReportRequest) (*PutAnalysisReportResponse, error)
 	mustEmbedUnimplementedPluginServiceServer()
 }
 
@@ -223,6 +262,15 @@ func (UnimplementedPluginServiceServer) GetDeploymentSharedMetadata(context.Cont
 func (UnimplementedPluginServiceServer) ListStageCommands(context.Context, *ListStageCommandsRequest) (*ListStageCommandsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListStageCommands not implemented")
 }
+func (UnimplementedPluginServiceServer) GetLatestAnalysisResult(context.Context, *GetLatestAnalysisResultRequest) (*GetLatestAnalysisResultResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLatestAnalysisResult not implemented")
+}
+func (UnimplementedPluginServiceServer) PutLatestAnalysisResult(context.Context, *PutLatestAnalysisResultRequest) (*PutLatestAnalysisResultResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PutLatestAnalysisResult not implemented")
+}
+func (UnimplementedPluginServiceServer) PutAnalysisReport(context.Context, *PutAnalysisReportRequest) (*PutAnalysisReportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PutAnalysisReport not implemented")
+}
 func (UnimplementedPluginServiceServer) mustEmbedUnimplementedPluginServiceServer() {}
 
 // UnsafePluginServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -434,6 +482,60 @@ func _PluginService_ListStageCommands_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PluginService_GetLatestAnalysisResult_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLatestAnalysisResultRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PluginServiceServer).GetLatestAnalysisResult(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpc.piped.service.PluginService/GetLatestAnalysisResult",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PluginServiceServer).GetLatestAnalysisResult(ctx, req.(*GetLatestAnalysisResultRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PluginService_PutLatestAnalysisResult_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PutLatestAnalysisResultRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PluginServiceServer).PutLatestAnalysisResult(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpc.piped.service.PluginService/PutLatestAnalysisResult",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PluginServiceServer).PutLatestAnalysisResult(ctx, req.(*PutLatestAnalysisResultRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PluginService_PutAnalysisReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PutAnalysisReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PluginServiceServer).PutAnalysisReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpc.piped.service.PluginService/PutAnalysisReport",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PluginServiceServer).PutAnalysisReport(ctx, req.(*PutAnalysisReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // PluginService_ServiceDesc is the grpc.ServiceDesc for PluginService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -485,6 +587,18 @@ var PluginService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListStageCommands",
 			Handler:    _PluginService_ListStageCommands_Handler,
 		},
+		{
+			MethodName: "GetLatestAnalysisResult",
+			Handler:    _PluginService_GetLatestAnalysisResult_Handler,
+		},
+		{
+			MethodName: "PutLatestAnalysisResult",
+			Handler:    _PluginService_PutLatestAnalysisResult_Handler,
+		},
+		{
+			MethodName: "PutAnalysisReport",
+			Handler:    _PluginService_PutAnalysisReport_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "pkg/plugin/pipedservice/service.proto",
@@ -190,6 +190,42 @@ func (c *Client) GetDeploymentSharedMetadata(ctx context.Context, key string) (s
 	return resp.Value, err
 }
 
+// GetLatestAnalysisResult gets the most recent successful analysis result of the application.
+// It returns nil if no analysis result has been stored yet.
+func (c *Client) GetLatestAnalysisResult(ctx context.Context) (*model.AnalysisResult, error) {
+	resp, err := c.base.GetLatestAnalysisResult(ctx, &pipedservice.GetLatestAnalysisResultRequest{
+		ApplicationId: c.applicationID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if !resp.Found {
+		return nil, nil
+	}
+	return resp.AnalysisResult, nil
+}
+
+// PutLatestAnalysisResult stores the given analysis result as the most recent successful one of the application.
+func (c *Client) PutLatestAnalysisResult(ctx context.Context, result *model.AnalysisResult) error {
+	_, err := c.base.PutLatestAnalysisResult(ctx, &pipedservice.PutLatestAnalysisResultRequest{
+		ApplicationId:  c.applicationID,
+		AnalysisResult: result,
+	})
+	return err
+}
+
+// PutAnalysisReport stores the details of the analysis performed in the current stage.
+// The deployment, stage and application of the report are set to the ones of the client.
+func (c *Client) PutAnalysisReport(ctx context.Context, report *model.AnalysisReport) error {
+	report.DeploymentId = c.deploymentID
+	report.StageId = c.stageID
+	report.ApplicationId = c.applicationID
+	_, err := c.base.PutAnalysisReport(ctx, &pipedservice.PutAnalysisReportRequest{
+		Report: report,
+	})
+	return err
+}
+
 // LogPersister returns the stage log persister.
 // Use this to persist the stage logs and make it viewable on the UI.
 // This method should be called only when the client is working with a specific stage, for example, when this client is passed as the ExecuteStage method's argument.
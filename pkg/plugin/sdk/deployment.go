@@ -77,8 +77,8 @@ func (s *DeploymentPluginServiceServer[Config, DeployTargetConfig, ApplicationCo
 
 	cfg := fields.config
 	if cfg.Config != nil {
-		if err := json.Unmarshal(cfg.Config, &s.config); err != nil {
-			s.logger.Fatal("failed to unmarshal the plugin config", zap.Error(err))
+		if err := parseConfig(cfg.Config, &s.config); err != nil {
+			s.logger.Fatal("failed to parse the plugin config", zap.Error(err))
 			return err
 		}
 	}
@@ -236,8 +236,8 @@ func (s *StagePluginServiceServer[Config, DeployTargetConfig, ApplicationConfigS
 
 	cfg := fields.config
 	if cfg.Config != nil {
-		if err := json.Unmarshal(cfg.Config, &s.config); err != nil {
-			s.logger.Fatal("failed to unmarshal the plugin config", zap.Error(err))
+		if err := parseConfig(cfg.Config, &s.config); err != nil {
+			s.logger.Fatal("failed to parse the plugin config", zap.Error(err))
 			return err
 		}
 	}
@@ -663,6 +663,9 @@ func (o StageStatus) String() string {
 type StageCommand struct {
 	Commander string
 	Type      CommandType
+	// Comment is the comment given by the commander.
+	// Only available for the CommandTypeRejectStage command.
+	Comment string
 }
 
 // CommandType represents the type of the command.
@@ -671,6 +674,7 @@ type CommandType int32
 const (
 	CommandTypeApproveStage CommandType = iota
 	CommandTypeSkipStage
+	CommandTypeRejectStage
 )
 
 // newStageCommand converts the model.Command to the internal representation.
@@ -686,6 +690,12 @@ func newStageCommand(c *model.Command) (StageCommand, error) {
 			Commander: c.GetCommander(),
 			Type:      CommandTypeSkipStage,
 		}, nil
+	case model.Command_REJECT_STAGE:
+		return StageCommand{
+			Commander: c.GetCommander(),
+			Type:      CommandTypeRejectStage,
+			Comment:   c.GetRejectStage().GetComment(),
+		}, nil
 	default:
 		return StageCommand{}, fmt.Errorf("invalid command type: %d", c.Type)
 	}
@@ -60,8 +60,8 @@ func (s *LivestatePluginServer[Config, DeployTargetConfig, ApplicationConfigSpec
 
 	cfg := fields.config
 	if cfg.Config != nil {
-		if err := json.Unmarshal(cfg.Config, &s.config); err != nil {
-			s.logger.Fatal("failed to unmarshal the plugin config", zap.Error(err))
+		if err := parseConfig(cfg.Config, &s.config); err != nil {
+			s.logger.Fatal("failed to parse the plugin config", zap.Error(err))
 			return err
 		}
 	}
@@ -16,6 +16,7 @@ package sdk
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"net/http/pprof"
@@ -73,6 +74,25 @@ func (c commonFields) withLogger(logger *zap.Logger) commonFields {
 	return c
 }
 
+// parseConfig parses the plugin-wide config given in the piped spec.
+// The config is validated if it implements the Validate method
+// so that the plugin can fail fast on an invalid config.
+func parseConfig[Config any](data []byte, cfg *Config) error {
+	if err := json.Unmarshal(data, cfg); err != nil {
+		return fmt.Errorf("failed to unmarshal the plugin config: %w", err)
+	}
+
+	// Validate the config if it implements the Validate method.
+	// The method set of the pointer also contains the value receiver methods.
+	if v, ok := any(cfg).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return fmt.Errorf("failed to validate the plugin config: %w", err)
+		}
+	}
+
+	return nil
+}
+
 // PluginOption is a function that configures the plugin.
 type PluginOption[Config, DeployTargetConfig, ApplicationConfigSpec any] func(*Plugin[Config, DeployTargetConfig, ApplicationConfigSpec])
 
@@ -17,6 +17,9 @@ package sdk
 import (
 	"context"
 	"log"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
 )
 
 var (
@@ -175,3 +178,50 @@ func ExampleWithLivestatePlugin() {
 	// plugin.Run()
 	_ = plugin
 }
+
+func TestParseConfig(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name    string
+		data    string
+		want    testPluginSpec
+		wantErr bool
+	}{
+		{
+			name: "valid config",
+			data: `{"name": "test", "require": "yes"}`,
+			want: testPluginSpec{
+				Name:    "test",
+				Require: "yes",
+			},
+			wantErr: false,
+		},
+		{
+			name:    "invalid json",
+			data:    `{invalid-json`,
+			wantErr: true,
+		},
+		{
+			name:    "validation failure",
+			data:    `{"name": "test"}`,
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			var cfg testPluginSpec
+			err := parseConfig([]byte(tc.data), &cfg)
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tc.want, cfg)
+		})
+	}
+}
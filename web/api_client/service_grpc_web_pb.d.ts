@@ -183,6 +183,13 @@ export class WebServiceClient {
                response: pkg_app_server_service_webservice_service_pb.GetStageLogResponse) => void
   ): grpcWeb.ClientReadableStream<pkg_app_server_service_webservice_service_pb.GetStageLogResponse>;
 
+  getAnalysisReport(
+    request: pkg_app_server_service_webservice_service_pb.GetAnalysisReportRequest,
+    metadata: grpcWeb.Metadata | undefined,
+    callback: (err: grpcWeb.RpcError,
+               response: pkg_app_server_service_webservice_service_pb.GetAnalysisReportResponse) => void
+  ): grpcWeb.ClientReadableStream<pkg_app_server_service_webservice_service_pb.GetAnalysisReportResponse>;
+
   cancelDeployment(
     request: pkg_app_server_service_webservice_service_pb.CancelDeploymentRequest,
     metadata: grpcWeb.Metadata | undefined,
@@ -204,6 +211,13 @@ export class WebServiceClient {
                response: pkg_app_server_service_webservice_service_pb.ApproveStageResponse) => void
   ): grpcWeb.ClientReadableStream<pkg_app_server_service_webservice_service_pb.ApproveStageResponse>;
 
+  rejectStage(
+    request: pkg_app_server_service_webservice_service_pb.RejectStageRequest,
+    metadata: grpcWeb.Metadata | undefined,
+    callback: (err: grpcWeb.RpcError,
+               response: pkg_app_server_service_webservice_service_pb.RejectStageResponse) => void
+  ): grpcWeb.ClientReadableStream<pkg_app_server_service_webservice_service_pb.RejectStageResponse>;
+
   listDeploymentTraces(
     request: pkg_app_server_service_webservice_service_pb.ListDeploymentTracesRequest,
     metadata: grpcWeb.Metadata | undefined,
@@ -497,6 +511,11 @@ export class WebServicePromiseClient {
     metadata?: grpcWeb.Metadata
   ): Promise<pkg_app_server_service_webservice_service_pb.GetStageLogResponse>;
 
+  getAnalysisReport(
+    request: pkg_app_server_service_webservice_service_pb.GetAnalysisReportRequest,
+    metadata?: grpcWeb.Metadata
+  ): Promise<pkg_app_server_service_webservice_service_pb.GetAnalysisReportResponse>;
+
   cancelDeployment(
     request: pkg_app_server_service_webservice_service_pb.CancelDeploymentRequest,
     metadata?: grpcWeb.Metadata
@@ -512,6 +531,11 @@ export class WebServicePromiseClient {
     metadata?: grpcWeb.Metadata
   ): Promise<pkg_app_server_service_webservice_service_pb.ApproveStageResponse>;
 
+  rejectStage(
+    request: pkg_app_server_service_webservice_service_pb.RejectStageRequest,
+    metadata?: grpcWeb.Metadata
+  ): Promise<pkg_app_server_service_webservice_service_pb.RejectStageResponse>;
+
   listDeploymentTraces(
     request: pkg_app_server_service_webservice_service_pb.ListDeploymentTracesRequest,
     metadata?: grpcWeb.Metadata
@@ -36,6 +36,8 @@ var pkg_model_deployment_chain_pb = require('pipecd/web/model/deployment_chain_p
 
 var pkg_model_logblock_pb = require('pipecd/web/model/logblock_pb.js')
 
+var pkg_model_analysis_result_pb = require('pipecd/web/model/analysis_result_pb.js')
+
 var pkg_model_piped_pb = require('pipecd/web/model/piped_pb.js')
 
 var pkg_model_rbac_pb = require('pipecd/web/model/rbac_pb.js')
@@ -1631,6 +1633,67 @@ proto.grpc.service.webservice.WebServicePromiseClient.prototype.getStageLog =
 };
 
 
+/**
+ * @const
+ * @type {!grpc.web.MethodDescriptor<
+ *   !proto.grpc.service.webservice.GetAnalysisReportRequest,
+ *   !proto.grpc.service.webservice.GetAnalysisReportResponse>}
+ */
+const methodDescriptor_WebService_GetAnalysisReport = new grpc.web.MethodDescriptor(
+  '/grpc.service.webservice.WebService/GetAnalysisReport',
+  grpc.web.MethodType.UNARY,
+  proto.grpc.service.webservice.GetAnalysisReportRequest,
+  proto.grpc.service.webservice.GetAnalysisReportResponse,
+  /**
+   * @param {!proto.grpc.service.webservice.GetAnalysisReportRequest} request
+   * @return {!Uint8Array}
+   */
+  function(request) {
+    return request.serializeBinary();
+  },
+  proto.grpc.service.webservice.GetAnalysisReportResponse.deserializeBinary
+);
+
+
+/**
+ * @param {!proto.grpc.service.webservice.GetAnalysisReportRequest} request The
+ *     request proto
+ * @param {?Object<string, string>} metadata User defined
+ *     call metadata
+ * @param {function(?grpc.web.RpcError, ?proto.grpc.service.webservice.GetAnalysisReportResponse)}
+ *     callback The callback function(error, response)
+ * @return {!grpc.web.ClientReadableStream<!proto.grpc.service.webservice.GetAnalysisReportResponse>|undefined}
+ *     The XHR Node Readable Stream
+ */
+proto.grpc.service.webservice.WebServiceClient.prototype.getAnalysisReport =
+    function(request, metadata, callback) {
+  return this.client_.rpcCall(this.hostname_ +
+      '/grpc.service.webservice.WebService/GetAnalysisReport',
+      request,
+      metadata || {},
+      methodDescriptor_WebService_GetAnalysisReport,
+      callback);
+};
+
+
+/**
+ * @param {!proto.grpc.service.webservice.GetAnalysisReportRequest} request The
+ *     request proto
+ * @param {?Object<string, string>=} metadata User defined
+ *     call metadata
+ * @return {!Promise<!proto.grpc.service.webservice.GetAnalysisReportResponse>}
+ *     Promise that resolves to the response
+ */
+proto.grpc.service.webservice.WebServicePromiseClient.prototype.getAnalysisReport =
+    function(request, metadata) {
+  return this.client_.unaryCall(this.hostname_ +
+      '/grpc.service.webservice.WebService/GetAnalysisReport',
+      request,
+      metadata || {},
+      methodDescriptor_WebService_GetAnalysisReport);
+};
+
+
 /**
  * @const
  * @type {!grpc.web.MethodDescriptor<
@@ -1814,6 +1877,67 @@ proto.grpc.service.webservice.WebServicePromiseClient.prototype.approveStage =
 };
 
 
+/**
+ * @const
+ * @type {!grpc.web.MethodDescriptor<
+ *   !proto.grpc.service.webservice.RejectStageRequest,
+ *   !proto.grpc.service.webservice.RejectStageResponse>}
+ */
+const methodDescriptor_WebService_RejectStage = new grpc.web.MethodDescriptor(
+  '/grpc.service.webservice.WebService/RejectStage',
+  grpc.web.MethodType.UNARY,
+  proto.grpc.service.webservice.RejectStageRequest,
+  proto.grpc.service.webservice.RejectStageResponse,
+  /**
+   * @param {!proto.grpc.service.webservice.RejectStageRequest} request
+   * @return {!Uint8Array}
+   */
+  function(request) {
+    return request.serializeBinary();
+  },
+  proto.grpc.service.webservice.RejectStageResponse.deserializeBinary
+);
+
+
+/**
+ * @param {!proto.grpc.service.webservice.RejectStageRequest} request The
+ *     request proto
+ * @param {?Object<string, string>} metadata User defined
+ *     call metadata
+ * @param {function(?grpc.web.RpcError, ?proto.grpc.service.webservice.RejectStageResponse)}
+ *     callback The callback function(error, response)
+ * @return {!grpc.web.ClientReadableStream<!proto.grpc.service.webservice.RejectStageResponse>|undefined}
+ *     The XHR Node Readable Stream
+ */
+proto.grpc.service.webservice.WebServiceClient.prototype.rejectStage =
+    function(request, metadata, callback) {
+  return this.client_.rpcCall(this.hostname_ +
+      '/grpc.service.webservice.WebService/RejectStage',
+      request,
+      metadata || {},
+      methodDescriptor_WebService_RejectStage,
+      callback);
+};
+
+
+/**
+ * @param {!proto.grpc.service.webservice.RejectStageRequest} request The
+ *     request proto
+ * @param {?Object<string, string>=} metadata User defined
+ *     call metadata
+ * @return {!Promise<!proto.grpc.service.webservice.RejectStageResponse>}
+ *     Promise that resolves to the response
+ */
+proto.grpc.service.webservice.WebServicePromiseClient.prototype.rejectStage =
+    function(request, metadata) {
+  return this.client_.unaryCall(this.hostname_ +
+      '/grpc.service.webservice.WebService/RejectStage',
+      request,
+      metadata || {},
+      methodDescriptor_WebService_RejectStage);
+};
+
+
 /**
  * @const
  * @type {!grpc.web.MethodDescriptor<
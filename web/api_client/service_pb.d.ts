@@ -10,6 +10,7 @@ import * as pkg_model_deployment_pb from 'pipecd/web/model/deployment_pb';
 import * as pkg_model_deployment_trace_pb from 'pipecd/web/model/deployment_trace_pb';
 import * as pkg_model_deployment_chain_pb from 'pipecd/web/model/deployment_chain_pb';
 import * as pkg_model_logblock_pb from 'pipecd/web/model/logblock_pb';
+import * as pkg_model_analysis_result_pb from 'pipecd/web/model/analysis_result_pb';
 import * as pkg_model_piped_pb from 'pipecd/web/model/piped_pb';
 import * as pkg_model_rbac_pb from 'pipecd/web/model/rbac_pb';
 import * as pkg_model_project_pb from 'pipecd/web/model/project_pb';
@@ -792,6 +793,9 @@ export class SyncApplicationRequest extends jspb.Message {
   getSyncStrategy(): pkg_model_common_pb.SyncStrategy;
   setSyncStrategy(value: pkg_model_common_pb.SyncStrategy): SyncApplicationRequest;
 
+  getOverrideDeploymentWindows(): boolean;
+  setOverrideDeploymentWindows(value: boolean): SyncApplicationRequest;
+
   serializeBinary(): Uint8Array;
   toObject(includeInstance?: boolean): SyncApplicationRequest.AsObject;
   static toObject(includeInstance: boolean, msg: SyncApplicationRequest): SyncApplicationRequest.AsObject;
@@ -804,6 +808,7 @@ export namespace SyncApplicationRequest {
   export type AsObject = {
     applicationId: string,
     syncStrategy: pkg_model_common_pb.SyncStrategy,
+    overrideDeploymentWindows: boolean,
   }
 }
 
@@ -1130,6 +1135,48 @@ export namespace GetStageLogResponse {
   }
 }
 
+export class GetAnalysisReportRequest extends jspb.Message {
+  getDeploymentId(): string;
+  setDeploymentId(value: string): GetAnalysisReportRequest;
+
+  getStageId(): string;
+  setStageId(value: string): GetAnalysisReportRequest;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): GetAnalysisReportRequest.AsObject;
+  static toObject(includeInstance: boolean, msg: GetAnalysisReportRequest): GetAnalysisReportRequest.AsObject;
+  static serializeBinaryToWriter(message: GetAnalysisReportRequest, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): GetAnalysisReportRequest;
+  static deserializeBinaryFromReader(message: GetAnalysisReportRequest, reader: jspb.BinaryReader): GetAnalysisReportRequest;
+}
+
+export namespace GetAnalysisReportRequest {
+  export type AsObject = {
+    deploymentId: string,
+    stageId: string,
+  }
+}
+
+export class GetAnalysisReportResponse extends jspb.Message {
+  getReport(): pkg_model_analysis_result_pb.AnalysisReport | undefined;
+  setReport(value?: pkg_model_analysis_result_pb.AnalysisReport): GetAnalysisReportResponse;
+  hasReport(): boolean;
+  clearReport(): GetAnalysisReportResponse;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): GetAnalysisReportResponse.AsObject;
+  static toObject(includeInstance: boolean, msg: GetAnalysisReportResponse): GetAnalysisReportResponse.AsObject;
+  static serializeBinaryToWriter(message: GetAnalysisReportResponse, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): GetAnalysisReportResponse;
+  static deserializeBinaryFromReader(message: GetAnalysisReportResponse, reader: jspb.BinaryReader): GetAnalysisReportResponse;
+}
+
+export namespace GetAnalysisReportResponse {
+  export type AsObject = {
+    report?: pkg_model_analysis_result_pb.AnalysisReport.AsObject,
+  }
+}
+
 export class CancelDeploymentRequest extends jspb.Message {
   getDeploymentId(): string;
   setDeploymentId(value: string): CancelDeploymentRequest;
@@ -1254,6 +1301,50 @@ export namespace ApproveStageResponse {
   }
 }
 
+export class RejectStageRequest extends jspb.Message {
+  getDeploymentId(): string;
+  setDeploymentId(value: string): RejectStageRequest;
+
+  getStageId(): string;
+  setStageId(value: string): RejectStageRequest;
+
+  getComment(): string;
+  setComment(value: string): RejectStageRequest;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): RejectStageRequest.AsObject;
+  static toObject(includeInstance: boolean, msg: RejectStageRequest): RejectStageRequest.AsObject;
+  static serializeBinaryToWriter(message: RejectStageRequest, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): RejectStageRequest;
+  static deserializeBinaryFromReader(message: RejectStageRequest, reader: jspb.BinaryReader): RejectStageRequest;
+}
+
+export namespace RejectStageRequest {
+  export type AsObject = {
+    deploymentId: string,
+    stageId: string,
+    comment: string,
+  }
+}
+
+export class RejectStageResponse extends jspb.Message {
+  getCommandId(): string;
+  setCommandId(value: string): RejectStageResponse;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): RejectStageResponse.AsObject;
+  static toObject(includeInstance: boolean, msg: RejectStageResponse): RejectStageResponse.AsObject;
+  static serializeBinaryToWriter(message: RejectStageResponse, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): RejectStageResponse;
+  static deserializeBinaryFromReader(message: RejectStageResponse, reader: jspb.BinaryReader): RejectStageResponse;
+}
+
+export namespace RejectStageResponse {
+  export type AsObject = {
+    commandId: string,
+  }
+}
+
 export class ListDeploymentTracesRequest extends jspb.Message {
   getOptions(): ListDeploymentTracesRequest.Options | undefined;
   setOptions(value?: ListDeploymentTracesRequest.Options): ListDeploymentTracesRequest;
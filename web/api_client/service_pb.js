@@ -41,6 +41,8 @@ var pkg_model_deployment_chain_pb = require('pipecd/web/model/deployment_chain_p
 goog.object.extend(proto, pkg_model_deployment_chain_pb);
 var pkg_model_logblock_pb = require('pipecd/web/model/logblock_pb.js');
 goog.object.extend(proto, pkg_model_logblock_pb);
+var pkg_model_analysis_result_pb = require('pipecd/web/model/analysis_result_pb.js');
+goog.object.extend(proto, pkg_model_analysis_result_pb);
 var pkg_model_piped_pb = require('pipecd/web/model/piped_pb.js');
 goog.object.extend(proto, pkg_model_piped_pb);
 var pkg_model_rbac_pb = require('pipecd/web/model/rbac_pb.js');
@@ -91,6 +93,8 @@ goog.exportSymbol('proto.grpc.service.webservice.GenerateAPIKeyRequest', null, g
 goog.exportSymbol('proto.grpc.service.webservice.GenerateAPIKeyResponse', null, global);
 goog.exportSymbol('proto.grpc.service.webservice.GenerateApplicationSealedSecretRequest', null, global);
 goog.exportSymbol('proto.grpc.service.webservice.GenerateApplicationSealedSecretResponse', null, global);
+goog.exportSymbol('proto.grpc.service.webservice.GetAnalysisReportRequest', null, global);
+goog.exportSymbol('proto.grpc.service.webservice.GetAnalysisReportResponse', null, global);
 goog.exportSymbol('proto.grpc.service.webservice.GetApplicationLiveStateRequest', null, global);
 goog.exportSymbol('proto.grpc.service.webservice.GetApplicationLiveStateResponse', null, global);
 goog.exportSymbol('proto.grpc.service.webservice.GetApplicationRequest', null, global);
@@ -145,6 +149,8 @@ goog.exportSymbol('proto.grpc.service.webservice.RecreatePipedKeyRequest', null,
 goog.exportSymbol('proto.grpc.service.webservice.RecreatePipedKeyResponse', null, global);
 goog.exportSymbol('proto.grpc.service.webservice.RegisterPipedRequest', null, global);
 goog.exportSymbol('proto.grpc.service.webservice.RegisterPipedResponse', null, global);
+goog.exportSymbol('proto.grpc.service.webservice.RejectStageRequest', null, global);
+goog.exportSymbol('proto.grpc.service.webservice.RejectStageResponse', null, global);
 goog.exportSymbol('proto.grpc.service.webservice.RestartPipedRequest', null, global);
 goog.exportSymbol('proto.grpc.service.webservice.RestartPipedResponse', null, global);
 goog.exportSymbol('proto.grpc.service.webservice.SkipStageRequest', null, global);
@@ -1278,6 +1284,48 @@ if (goog.DEBUG && !COMPILED) {
    */
   proto.grpc.service.webservice.GetStageLogResponse.displayName = 'proto.grpc.service.webservice.GetStageLogResponse';
 }
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.grpc.service.webservice.GetAnalysisReportRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.grpc.service.webservice.GetAnalysisReportRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.grpc.service.webservice.GetAnalysisReportRequest.displayName = 'proto.grpc.service.webservice.GetAnalysisReportRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.grpc.service.webservice.GetAnalysisReportResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.grpc.service.webservice.GetAnalysisReportResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.grpc.service.webservice.GetAnalysisReportResponse.displayName = 'proto.grpc.service.webservice.GetAnalysisReportResponse';
+}
 /**
  * Generated by JsPbCodeGenerator.
  * @param {Array=} opt_data Optional initial data array, typically from a
@@ -1404,6 +1452,48 @@ if (goog.DEBUG && !COMPILED) {
    */
   proto.grpc.service.webservice.ApproveStageResponse.displayName = 'proto.grpc.service.webservice.ApproveStageResponse';
 }
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.grpc.service.webservice.RejectStageRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.grpc.service.webservice.RejectStageRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.grpc.service.webservice.RejectStageRequest.displayName = 'proto.grpc.service.webservice.RejectStageRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.grpc.service.webservice.RejectStageResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.grpc.service.webservice.RejectStageResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.grpc.service.webservice.RejectStageResponse.displayName = 'proto.grpc.service.webservice.RejectStageResponse';
+}
 /**
  * Generated by JsPbCodeGenerator.
  * @param {Array=} opt_data Optional initial data array, typically from a
@@ -8181,7 +8271,8 @@ proto.grpc.service.webservice.SyncApplicationRequest.prototype.toObject = functi
 proto.grpc.service.webservice.SyncApplicationRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
     applicationId: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    syncStrategy: jspb.Message.getFieldWithDefault(msg, 2, 0)
+    syncStrategy: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    overrideDeploymentWindows: jspb.Message.getBooleanFieldWithDefault(msg, 3, false)
   };
 
   if (includeInstance) {
@@ -8226,6 +8317,10 @@ proto.grpc.service.webservice.SyncApplicationRequest.deserializeBinaryFromReader
       var value = /** @type {!proto.model.SyncStrategy} */ (reader.readEnum());
       msg.setSyncStrategy(value);
       break;
+    case 3:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setOverrideDeploymentWindows(value);
+      break;
     default:
       reader.skipField();
       break;
@@ -8269,6 +8364,13 @@ proto.grpc.service.webservice.SyncApplicationRequest.serializeBinaryToWriter = f
       f
     );
   }
+  f = message.getOverrideDeploymentWindows();
+  if (f) {
+    writer.writeBool(
+      3,
+      f
+    );
+  }
 };
 
 
@@ -8308,6 +8410,24 @@ proto.grpc.service.webservice.SyncApplicationRequest.prototype.setSyncStrategy =
 };
 
 
+/**
+ * optional bool override_deployment_windows = 3;
+ * @return {boolean}
+ */
+proto.grpc.service.webservice.SyncApplicationRequest.prototype.getOverrideDeploymentWindows = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 3, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.grpc.service.webservice.SyncApplicationRequest} returns this
+ */
+proto.grpc.service.webservice.SyncApplicationRequest.prototype.setOverrideDeploymentWindows = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 3, value);
+};
+
+
 
 
 
@@ -10760,8 +10880,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.grpc.service.webservice.CancelDeploymentRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.grpc.service.webservice.CancelDeploymentRequest.toObject(opt_includeInstance, this);
+proto.grpc.service.webservice.GetAnalysisReportRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.grpc.service.webservice.GetAnalysisReportRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -10770,15 +10890,14 @@ proto.grpc.service.webservice.CancelDeploymentRequest.prototype.toObject = funct
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.grpc.service.webservice.CancelDeploymentRequest} msg The msg instance to transform.
+ * @param {!proto.grpc.service.webservice.GetAnalysisReportRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.grpc.service.webservice.CancelDeploymentRequest.toObject = function(includeInstance, msg) {
+proto.grpc.service.webservice.GetAnalysisReportRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
     deploymentId: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    forceRollback: jspb.Message.getBooleanFieldWithDefault(msg, 2, false),
-    forceNoRollback: jspb.Message.getBooleanFieldWithDefault(msg, 3, false)
+    stageId: jspb.Message.getFieldWithDefault(msg, 2, "")
   };
 
   if (includeInstance) {
@@ -10792,23 +10911,23 @@ proto.grpc.service.webservice.CancelDeploymentRequest.toObject = function(includ
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.grpc.service.webservice.CancelDeploymentRequest}
+ * @return {!proto.grpc.service.webservice.GetAnalysisReportRequest}
  */
-proto.grpc.service.webservice.CancelDeploymentRequest.deserializeBinary = function(bytes) {
+proto.grpc.service.webservice.GetAnalysisReportRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.grpc.service.webservice.CancelDeploymentRequest;
-  return proto.grpc.service.webservice.CancelDeploymentRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.grpc.service.webservice.GetAnalysisReportRequest;
+  return proto.grpc.service.webservice.GetAnalysisReportRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.grpc.service.webservice.CancelDeploymentRequest} msg The message object to deserialize into.
+ * @param {!proto.grpc.service.webservice.GetAnalysisReportRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.grpc.service.webservice.CancelDeploymentRequest}
+ * @return {!proto.grpc.service.webservice.GetAnalysisReportRequest}
  */
-proto.grpc.service.webservice.CancelDeploymentRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.grpc.service.webservice.GetAnalysisReportRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -10820,12 +10939,8 @@ proto.grpc.service.webservice.CancelDeploymentRequest.deserializeBinaryFromReade
       msg.setDeploymentId(value);
       break;
     case 2:
-      var value = /** @type {boolean} */ (reader.readBool());
-      msg.setForceRollback(value);
-      break;
-    case 3:
-      var value = /** @type {boolean} */ (reader.readBool());
-      msg.setForceNoRollback(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.setStageId(value);
       break;
     default:
       reader.skipField();
@@ -10840,9 +10955,9 @@ proto.grpc.service.webservice.CancelDeploymentRequest.deserializeBinaryFromReade
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.grpc.service.webservice.CancelDeploymentRequest.prototype.serializeBinary = function() {
+proto.grpc.service.webservice.GetAnalysisReportRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.grpc.service.webservice.CancelDeploymentRequest.serializeBinaryToWriter(this, writer);
+  proto.grpc.service.webservice.GetAnalysisReportRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -10850,11 +10965,11 @@ proto.grpc.service.webservice.CancelDeploymentRequest.prototype.serializeBinary
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.grpc.service.webservice.CancelDeploymentRequest} message
+ * @param {!proto.grpc.service.webservice.GetAnalysisReportRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.grpc.service.webservice.CancelDeploymentRequest.serializeBinaryToWriter = function(message, writer) {
+proto.grpc.service.webservice.GetAnalysisReportRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
   f = message.getDeploymentId();
   if (f.length > 0) {
@@ -10863,20 +10978,13 @@ proto.grpc.service.webservice.CancelDeploymentRequest.serializeBinaryToWriter =
       f
     );
   }
-  f = message.getForceRollback();
-  if (f) {
-    writer.writeBool(
+  f = message.getStageId();
+  if (f.length > 0) {
+    writer.writeString(
       2,
       f
     );
   }
-  f = message.getForceNoRollback();
-  if (f) {
-    writer.writeBool(
-      3,
-      f
-    );
-  }
 };
 
 
@@ -10884,53 +10992,35 @@ proto.grpc.service.webservice.CancelDeploymentRequest.serializeBinaryToWriter =
  * optional string deployment_id = 1;
  * @return {string}
  */
-proto.grpc.service.webservice.CancelDeploymentRequest.prototype.getDeploymentId = function() {
+proto.grpc.service.webservice.GetAnalysisReportRequest.prototype.getDeploymentId = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.grpc.service.webservice.CancelDeploymentRequest} returns this
+ * @return {!proto.grpc.service.webservice.GetAnalysisReportRequest} returns this
  */
-proto.grpc.service.webservice.CancelDeploymentRequest.prototype.setDeploymentId = function(value) {
+proto.grpc.service.webservice.GetAnalysisReportRequest.prototype.setDeploymentId = function(value) {
   return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * optional bool force_rollback = 2;
- * @return {boolean}
- */
-proto.grpc.service.webservice.CancelDeploymentRequest.prototype.getForceRollback = function() {
-  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 2, false));
-};
-
-
-/**
- * @param {boolean} value
- * @return {!proto.grpc.service.webservice.CancelDeploymentRequest} returns this
- */
-proto.grpc.service.webservice.CancelDeploymentRequest.prototype.setForceRollback = function(value) {
-  return jspb.Message.setProto3BooleanField(this, 2, value);
-};
-
-
-/**
- * optional bool force_no_rollback = 3;
- * @return {boolean}
+ * optional string stage_id = 2;
+ * @return {string}
  */
-proto.grpc.service.webservice.CancelDeploymentRequest.prototype.getForceNoRollback = function() {
-  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 3, false));
+proto.grpc.service.webservice.GetAnalysisReportRequest.prototype.getStageId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
 };
 
 
 /**
- * @param {boolean} value
- * @return {!proto.grpc.service.webservice.CancelDeploymentRequest} returns this
+ * @param {string} value
+ * @return {!proto.grpc.service.webservice.GetAnalysisReportRequest} returns this
  */
-proto.grpc.service.webservice.CancelDeploymentRequest.prototype.setForceNoRollback = function(value) {
-  return jspb.Message.setProto3BooleanField(this, 3, value);
+proto.grpc.service.webservice.GetAnalysisReportRequest.prototype.setStageId = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
 };
 
 
@@ -10950,8 +11040,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.grpc.service.webservice.CancelDeploymentResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.grpc.service.webservice.CancelDeploymentResponse.toObject(opt_includeInstance, this);
+proto.grpc.service.webservice.GetAnalysisReportResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.grpc.service.webservice.GetAnalysisReportResponse.toObject(opt_includeInstance, this);
 };
 
 
@@ -10960,13 +11050,13 @@ proto.grpc.service.webservice.CancelDeploymentResponse.prototype.toObject = func
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.grpc.service.webservice.CancelDeploymentResponse} msg The msg instance to transform.
+ * @param {!proto.grpc.service.webservice.GetAnalysisReportResponse} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.grpc.service.webservice.CancelDeploymentResponse.toObject = function(includeInstance, msg) {
+proto.grpc.service.webservice.GetAnalysisReportResponse.toObject = function(includeInstance, msg) {
   var f, obj = {
-    commandId: jspb.Message.getFieldWithDefault(msg, 1, "")
+    report: (f = msg.getReport()) && pkg_model_analysis_result_pb.AnalysisReport.toObject(includeInstance, f)
   };
 
   if (includeInstance) {
@@ -10980,23 +11070,23 @@ proto.grpc.service.webservice.CancelDeploymentResponse.toObject = function(inclu
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.grpc.service.webservice.CancelDeploymentResponse}
+ * @return {!proto.grpc.service.webservice.GetAnalysisReportResponse}
  */
-proto.grpc.service.webservice.CancelDeploymentResponse.deserializeBinary = function(bytes) {
+proto.grpc.service.webservice.GetAnalysisReportResponse.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.grpc.service.webservice.CancelDeploymentResponse;
-  return proto.grpc.service.webservice.CancelDeploymentResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.grpc.service.webservice.GetAnalysisReportResponse;
+  return proto.grpc.service.webservice.GetAnalysisReportResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.grpc.service.webservice.CancelDeploymentResponse} msg The message object to deserialize into.
+ * @param {!proto.grpc.service.webservice.GetAnalysisReportResponse} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.grpc.service.webservice.CancelDeploymentResponse}
+ * @return {!proto.grpc.service.webservice.GetAnalysisReportResponse}
  */
-proto.grpc.service.webservice.CancelDeploymentResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.grpc.service.webservice.GetAnalysisReportResponse.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -11004,8 +11094,9 @@ proto.grpc.service.webservice.CancelDeploymentResponse.deserializeBinaryFromRead
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setCommandId(value);
+      var value = new pkg_model_analysis_result_pb.AnalysisReport;
+      reader.readMessage(value,pkg_model_analysis_result_pb.AnalysisReport.deserializeBinaryFromReader);
+      msg.setReport(value);
       break;
     default:
       reader.skipField();
@@ -11020,9 +11111,9 @@ proto.grpc.service.webservice.CancelDeploymentResponse.deserializeBinaryFromRead
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.grpc.service.webservice.CancelDeploymentResponse.prototype.serializeBinary = function() {
+proto.grpc.service.webservice.GetAnalysisReportResponse.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.grpc.service.webservice.CancelDeploymentResponse.serializeBinaryToWriter(this, writer);
+  proto.grpc.service.webservice.GetAnalysisReportResponse.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -11030,37 +11121,57 @@ proto.grpc.service.webservice.CancelDeploymentResponse.prototype.serializeBinary
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.grpc.service.webservice.CancelDeploymentResponse} message
+ * @param {!proto.grpc.service.webservice.GetAnalysisReportResponse} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.grpc.service.webservice.CancelDeploymentResponse.serializeBinaryToWriter = function(message, writer) {
+proto.grpc.service.webservice.GetAnalysisReportResponse.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getCommandId();
-  if (f.length > 0) {
-    writer.writeString(
+  f = message.getReport();
+  if (f != null) {
+    writer.writeMessage(
       1,
-      f
+      f,
+      pkg_model_analysis_result_pb.AnalysisReport.serializeBinaryToWriter
     );
   }
 };
 
 
 /**
- * optional string command_id = 1;
- * @return {string}
+ * optional model.AnalysisReport report = 1;
+ * @return {?proto.model.AnalysisReport}
  */
-proto.grpc.service.webservice.CancelDeploymentResponse.prototype.getCommandId = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+proto.grpc.service.webservice.GetAnalysisReportResponse.prototype.getReport = function() {
+  return /** @type{?proto.model.AnalysisReport} */ (
+    jspb.Message.getWrapperField(this, pkg_model_analysis_result_pb.AnalysisReport, 1));
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.grpc.service.webservice.CancelDeploymentResponse} returns this
+ * @param {?proto.model.AnalysisReport|undefined} value
+ * @return {!proto.grpc.service.webservice.GetAnalysisReportResponse} returns this
+*/
+proto.grpc.service.webservice.GetAnalysisReportResponse.prototype.setReport = function(value) {
+  return jspb.Message.setWrapperField(this, 1, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.grpc.service.webservice.GetAnalysisReportResponse} returns this
  */
-proto.grpc.service.webservice.CancelDeploymentResponse.prototype.setCommandId = function(value) {
-  return jspb.Message.setProto3StringField(this, 1, value);
+proto.grpc.service.webservice.GetAnalysisReportResponse.prototype.clearReport = function() {
+  return this.setReport(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.grpc.service.webservice.GetAnalysisReportResponse.prototype.hasReport = function() {
+  return jspb.Message.getField(this, 1) != null;
 };
 
 
@@ -11080,8 +11191,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.grpc.service.webservice.SkipStageRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.grpc.service.webservice.SkipStageRequest.toObject(opt_includeInstance, this);
+proto.grpc.service.webservice.CancelDeploymentRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.grpc.service.webservice.CancelDeploymentRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -11090,14 +11201,15 @@ proto.grpc.service.webservice.SkipStageRequest.prototype.toObject = function(opt
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.grpc.service.webservice.SkipStageRequest} msg The msg instance to transform.
+ * @param {!proto.grpc.service.webservice.CancelDeploymentRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.grpc.service.webservice.SkipStageRequest.toObject = function(includeInstance, msg) {
+proto.grpc.service.webservice.CancelDeploymentRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
     deploymentId: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    stageId: jspb.Message.getFieldWithDefault(msg, 2, "")
+    forceRollback: jspb.Message.getBooleanFieldWithDefault(msg, 2, false),
+    forceNoRollback: jspb.Message.getBooleanFieldWithDefault(msg, 3, false)
   };
 
   if (includeInstance) {
@@ -11111,23 +11223,23 @@ proto.grpc.service.webservice.SkipStageRequest.toObject = function(includeInstan
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.grpc.service.webservice.SkipStageRequest}
+ * @return {!proto.grpc.service.webservice.CancelDeploymentRequest}
  */
-proto.grpc.service.webservice.SkipStageRequest.deserializeBinary = function(bytes) {
+proto.grpc.service.webservice.CancelDeploymentRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.grpc.service.webservice.SkipStageRequest;
-  return proto.grpc.service.webservice.SkipStageRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.grpc.service.webservice.CancelDeploymentRequest;
+  return proto.grpc.service.webservice.CancelDeploymentRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.grpc.service.webservice.SkipStageRequest} msg The message object to deserialize into.
+ * @param {!proto.grpc.service.webservice.CancelDeploymentRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.grpc.service.webservice.SkipStageRequest}
+ * @return {!proto.grpc.service.webservice.CancelDeploymentRequest}
  */
-proto.grpc.service.webservice.SkipStageRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.grpc.service.webservice.CancelDeploymentRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -11139,8 +11251,12 @@ proto.grpc.service.webservice.SkipStageRequest.deserializeBinaryFromReader = fun
       msg.setDeploymentId(value);
       break;
     case 2:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setStageId(value);
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setForceRollback(value);
+      break;
+    case 3:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setForceNoRollback(value);
       break;
     default:
       reader.skipField();
@@ -11155,9 +11271,9 @@ proto.grpc.service.webservice.SkipStageRequest.deserializeBinaryFromReader = fun
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.grpc.service.webservice.SkipStageRequest.prototype.serializeBinary = function() {
+proto.grpc.service.webservice.CancelDeploymentRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.grpc.service.webservice.SkipStageRequest.serializeBinaryToWriter(this, writer);
+  proto.grpc.service.webservice.CancelDeploymentRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -11165,7 +11281,322 @@ proto.grpc.service.webservice.SkipStageRequest.prototype.serializeBinary = funct
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.grpc.service.webservice.SkipStageRequest} message
+ * @param {!proto.grpc.service.webservice.CancelDeploymentRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.grpc.service.webservice.CancelDeploymentRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getDeploymentId();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getForceRollback();
+  if (f) {
+    writer.writeBool(
+      2,
+      f
+    );
+  }
+  f = message.getForceNoRollback();
+  if (f) {
+    writer.writeBool(
+      3,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string deployment_id = 1;
+ * @return {string}
+ */
+proto.grpc.service.webservice.CancelDeploymentRequest.prototype.getDeploymentId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.grpc.service.webservice.CancelDeploymentRequest} returns this
+ */
+proto.grpc.service.webservice.CancelDeploymentRequest.prototype.setDeploymentId = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional bool force_rollback = 2;
+ * @return {boolean}
+ */
+proto.grpc.service.webservice.CancelDeploymentRequest.prototype.getForceRollback = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 2, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.grpc.service.webservice.CancelDeploymentRequest} returns this
+ */
+proto.grpc.service.webservice.CancelDeploymentRequest.prototype.setForceRollback = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 2, value);
+};
+
+
+/**
+ * optional bool force_no_rollback = 3;
+ * @return {boolean}
+ */
+proto.grpc.service.webservice.CancelDeploymentRequest.prototype.getForceNoRollback = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 3, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.grpc.service.webservice.CancelDeploymentRequest} returns this
+ */
+proto.grpc.service.webservice.CancelDeploymentRequest.prototype.setForceNoRollback = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 3, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.grpc.service.webservice.CancelDeploymentResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.grpc.service.webservice.CancelDeploymentResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.grpc.service.webservice.CancelDeploymentResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.grpc.service.webservice.CancelDeploymentResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    commandId: jspb.Message.getFieldWithDefault(msg, 1, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.grpc.service.webservice.CancelDeploymentResponse}
+ */
+proto.grpc.service.webservice.CancelDeploymentResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.grpc.service.webservice.CancelDeploymentResponse;
+  return proto.grpc.service.webservice.CancelDeploymentResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.grpc.service.webservice.CancelDeploymentResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.grpc.service.webservice.CancelDeploymentResponse}
+ */
+proto.grpc.service.webservice.CancelDeploymentResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setCommandId(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.grpc.service.webservice.CancelDeploymentResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.grpc.service.webservice.CancelDeploymentResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.grpc.service.webservice.CancelDeploymentResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.grpc.service.webservice.CancelDeploymentResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getCommandId();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string command_id = 1;
+ * @return {string}
+ */
+proto.grpc.service.webservice.CancelDeploymentResponse.prototype.getCommandId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.grpc.service.webservice.CancelDeploymentResponse} returns this
+ */
+proto.grpc.service.webservice.CancelDeploymentResponse.prototype.setCommandId = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.grpc.service.webservice.SkipStageRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.grpc.service.webservice.SkipStageRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.grpc.service.webservice.SkipStageRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.grpc.service.webservice.SkipStageRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    deploymentId: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    stageId: jspb.Message.getFieldWithDefault(msg, 2, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.grpc.service.webservice.SkipStageRequest}
+ */
+proto.grpc.service.webservice.SkipStageRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.grpc.service.webservice.SkipStageRequest;
+  return proto.grpc.service.webservice.SkipStageRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.grpc.service.webservice.SkipStageRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.grpc.service.webservice.SkipStageRequest}
+ */
+proto.grpc.service.webservice.SkipStageRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setDeploymentId(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setStageId(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.grpc.service.webservice.SkipStageRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.grpc.service.webservice.SkipStageRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.grpc.service.webservice.SkipStageRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
@@ -11192,16 +11623,306 @@ proto.grpc.service.webservice.SkipStageRequest.serializeBinaryToWriter = functio
  * optional string deployment_id = 1;
  * @return {string}
  */
-proto.grpc.service.webservice.SkipStageRequest.prototype.getDeploymentId = function() {
+proto.grpc.service.webservice.SkipStageRequest.prototype.getDeploymentId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.grpc.service.webservice.SkipStageRequest} returns this
+ */
+proto.grpc.service.webservice.SkipStageRequest.prototype.setDeploymentId = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional string stage_id = 2;
+ * @return {string}
+ */
+proto.grpc.service.webservice.SkipStageRequest.prototype.getStageId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.grpc.service.webservice.SkipStageRequest} returns this
+ */
+proto.grpc.service.webservice.SkipStageRequest.prototype.setStageId = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.grpc.service.webservice.SkipStageResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.grpc.service.webservice.SkipStageResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.grpc.service.webservice.SkipStageResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.grpc.service.webservice.SkipStageResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    commandId: jspb.Message.getFieldWithDefault(msg, 1, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.grpc.service.webservice.SkipStageResponse}
+ */
+proto.grpc.service.webservice.SkipStageResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.grpc.service.webservice.SkipStageResponse;
+  return proto.grpc.service.webservice.SkipStageResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.grpc.service.webservice.SkipStageResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.grpc.service.webservice.SkipStageResponse}
+ */
+proto.grpc.service.webservice.SkipStageResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setCommandId(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.grpc.service.webservice.SkipStageResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.grpc.service.webservice.SkipStageResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.grpc.service.webservice.SkipStageResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.grpc.service.webservice.SkipStageResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getCommandId();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string command_id = 1;
+ * @return {string}
+ */
+proto.grpc.service.webservice.SkipStageResponse.prototype.getCommandId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.grpc.service.webservice.SkipStageResponse} returns this
+ */
+proto.grpc.service.webservice.SkipStageResponse.prototype.setCommandId = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.grpc.service.webservice.ApproveStageRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.grpc.service.webservice.ApproveStageRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.grpc.service.webservice.ApproveStageRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.grpc.service.webservice.ApproveStageRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    deploymentId: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    stageId: jspb.Message.getFieldWithDefault(msg, 2, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.grpc.service.webservice.ApproveStageRequest}
+ */
+proto.grpc.service.webservice.ApproveStageRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.grpc.service.webservice.ApproveStageRequest;
+  return proto.grpc.service.webservice.ApproveStageRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.grpc.service.webservice.ApproveStageRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.grpc.service.webservice.ApproveStageRequest}
+ */
+proto.grpc.service.webservice.ApproveStageRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setDeploymentId(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setStageId(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.grpc.service.webservice.ApproveStageRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.grpc.service.webservice.ApproveStageRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.grpc.service.webservice.ApproveStageRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.grpc.service.webservice.ApproveStageRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getDeploymentId();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getStageId();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string deployment_id = 1;
+ * @return {string}
+ */
+proto.grpc.service.webservice.ApproveStageRequest.prototype.getDeploymentId = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.grpc.service.webservice.SkipStageRequest} returns this
+ * @return {!proto.grpc.service.webservice.ApproveStageRequest} returns this
  */
-proto.grpc.service.webservice.SkipStageRequest.prototype.setDeploymentId = function(value) {
+proto.grpc.service.webservice.ApproveStageRequest.prototype.setDeploymentId = function(value) {
   return jspb.Message.setProto3StringField(this, 1, value);
 };
 
@@ -11210,16 +11931,16 @@ proto.grpc.service.webservice.SkipStageRequest.prototype.setDeploymentId = funct
  * optional string stage_id = 2;
  * @return {string}
  */
-proto.grpc.service.webservice.SkipStageRequest.prototype.getStageId = function() {
+proto.grpc.service.webservice.ApproveStageRequest.prototype.getStageId = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.grpc.service.webservice.SkipStageRequest} returns this
+ * @return {!proto.grpc.service.webservice.ApproveStageRequest} returns this
  */
-proto.grpc.service.webservice.SkipStageRequest.prototype.setStageId = function(value) {
+proto.grpc.service.webservice.ApproveStageRequest.prototype.setStageId = function(value) {
   return jspb.Message.setProto3StringField(this, 2, value);
 };
 
@@ -11240,8 +11961,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.grpc.service.webservice.SkipStageResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.grpc.service.webservice.SkipStageResponse.toObject(opt_includeInstance, this);
+proto.grpc.service.webservice.ApproveStageResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.grpc.service.webservice.ApproveStageResponse.toObject(opt_includeInstance, this);
 };
 
 
@@ -11250,11 +11971,11 @@ proto.grpc.service.webservice.SkipStageResponse.prototype.toObject = function(op
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.grpc.service.webservice.SkipStageResponse} msg The msg instance to transform.
+ * @param {!proto.grpc.service.webservice.ApproveStageResponse} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.grpc.service.webservice.SkipStageResponse.toObject = function(includeInstance, msg) {
+proto.grpc.service.webservice.ApproveStageResponse.toObject = function(includeInstance, msg) {
   var f, obj = {
     commandId: jspb.Message.getFieldWithDefault(msg, 1, "")
   };
@@ -11270,23 +11991,23 @@ proto.grpc.service.webservice.SkipStageResponse.toObject = function(includeInsta
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.grpc.service.webservice.SkipStageResponse}
+ * @return {!proto.grpc.service.webservice.ApproveStageResponse}
  */
-proto.grpc.service.webservice.SkipStageResponse.deserializeBinary = function(bytes) {
+proto.grpc.service.webservice.ApproveStageResponse.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.grpc.service.webservice.SkipStageResponse;
-  return proto.grpc.service.webservice.SkipStageResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.grpc.service.webservice.ApproveStageResponse;
+  return proto.grpc.service.webservice.ApproveStageResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.grpc.service.webservice.SkipStageResponse} msg The message object to deserialize into.
+ * @param {!proto.grpc.service.webservice.ApproveStageResponse} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.grpc.service.webservice.SkipStageResponse}
+ * @return {!proto.grpc.service.webservice.ApproveStageResponse}
  */
-proto.grpc.service.webservice.SkipStageResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.grpc.service.webservice.ApproveStageResponse.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -11310,9 +12031,9 @@ proto.grpc.service.webservice.SkipStageResponse.deserializeBinaryFromReader = fu
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.grpc.service.webservice.SkipStageResponse.prototype.serializeBinary = function() {
+proto.grpc.service.webservice.ApproveStageResponse.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.grpc.service.webservice.SkipStageResponse.serializeBinaryToWriter(this, writer);
+  proto.grpc.service.webservice.ApproveStageResponse.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -11320,11 +12041,11 @@ proto.grpc.service.webservice.SkipStageResponse.prototype.serializeBinary = func
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.grpc.service.webservice.SkipStageResponse} message
+ * @param {!proto.grpc.service.webservice.ApproveStageResponse} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.grpc.service.webservice.SkipStageResponse.serializeBinaryToWriter = function(message, writer) {
+proto.grpc.service.webservice.ApproveStageResponse.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
   f = message.getCommandId();
   if (f.length > 0) {
@@ -11340,16 +12061,16 @@ proto.grpc.service.webservice.SkipStageResponse.serializeBinaryToWriter = functi
  * optional string command_id = 1;
  * @return {string}
  */
-proto.grpc.service.webservice.SkipStageResponse.prototype.getCommandId = function() {
+proto.grpc.service.webservice.ApproveStageResponse.prototype.getCommandId = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.grpc.service.webservice.SkipStageResponse} returns this
+ * @return {!proto.grpc.service.webservice.ApproveStageResponse} returns this
  */
-proto.grpc.service.webservice.SkipStageResponse.prototype.setCommandId = function(value) {
+proto.grpc.service.webservice.ApproveStageResponse.prototype.setCommandId = function(value) {
   return jspb.Message.setProto3StringField(this, 1, value);
 };
 
@@ -11370,8 +12091,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.grpc.service.webservice.ApproveStageRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.grpc.service.webservice.ApproveStageRequest.toObject(opt_includeInstance, this);
+proto.grpc.service.webservice.RejectStageRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.grpc.service.webservice.RejectStageRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -11380,14 +12101,15 @@ proto.grpc.service.webservice.ApproveStageRequest.prototype.toObject = function(
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.grpc.service.webservice.ApproveStageRequest} msg The msg instance to transform.
+ * @param {!proto.grpc.service.webservice.RejectStageRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.grpc.service.webservice.ApproveStageRequest.toObject = function(includeInstance, msg) {
+proto.grpc.service.webservice.RejectStageRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
     deploymentId: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    stageId: jspb.Message.getFieldWithDefault(msg, 2, "")
+    stageId: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    comment: jspb.Message.getFieldWithDefault(msg, 3, "")
   };
 
   if (includeInstance) {
@@ -11401,23 +12123,23 @@ proto.grpc.service.webservice.ApproveStageRequest.toObject = function(includeIns
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.grpc.service.webservice.ApproveStageRequest}
+ * @return {!proto.grpc.service.webservice.RejectStageRequest}
  */
-proto.grpc.service.webservice.ApproveStageRequest.deserializeBinary = function(bytes) {
+proto.grpc.service.webservice.RejectStageRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.grpc.service.webservice.ApproveStageRequest;
-  return proto.grpc.service.webservice.ApproveStageRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.grpc.service.webservice.RejectStageRequest;
+  return proto.grpc.service.webservice.RejectStageRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.grpc.service.webservice.ApproveStageRequest} msg The message object to deserialize into.
+ * @param {!proto.grpc.service.webservice.RejectStageRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.grpc.service.webservice.ApproveStageRequest}
+ * @return {!proto.grpc.service.webservice.RejectStageRequest}
  */
-proto.grpc.service.webservice.ApproveStageRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.grpc.service.webservice.RejectStageRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -11432,6 +12154,10 @@ proto.grpc.service.webservice.ApproveStageRequest.deserializeBinaryFromReader =
       var value = /** @type {string} */ (reader.readString());
       msg.setStageId(value);
       break;
+    case 3:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setComment(value);
+      break;
     default:
       reader.skipField();
       break;
@@ -11445,9 +12171,9 @@ proto.grpc.service.webservice.ApproveStageRequest.deserializeBinaryFromReader =
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.grpc.service.webservice.ApproveStageRequest.prototype.serializeBinary = function() {
+proto.grpc.service.webservice.RejectStageRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.grpc.service.webservice.ApproveStageRequest.serializeBinaryToWriter(this, writer);
+  proto.grpc.service.webservice.RejectStageRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -11455,11 +12181,11 @@ proto.grpc.service.webservice.ApproveStageRequest.prototype.serializeBinary = fu
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.grpc.service.webservice.ApproveStageRequest} message
+ * @param {!proto.grpc.service.webservice.RejectStageRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.grpc.service.webservice.ApproveStageRequest.serializeBinaryToWriter = function(message, writer) {
+proto.grpc.service.webservice.RejectStageRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
   f = message.getDeploymentId();
   if (f.length > 0) {
@@ -11475,6 +12201,13 @@ proto.grpc.service.webservice.ApproveStageRequest.serializeBinaryToWriter = func
       f
     );
   }
+  f = message.getComment();
+  if (f.length > 0) {
+    writer.writeString(
+      3,
+      f
+    );
+  }
 };
 
 
@@ -11482,16 +12215,16 @@ proto.grpc.service.webservice.ApproveStageRequest.serializeBinaryToWriter = func
  * optional string deployment_id = 1;
  * @return {string}
  */
-proto.grpc.service.webservice.ApproveStageRequest.prototype.getDeploymentId = function() {
+proto.grpc.service.webservice.RejectStageRequest.prototype.getDeploymentId = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.grpc.service.webservice.ApproveStageRequest} returns this
+ * @return {!proto.grpc.service.webservice.RejectStageRequest} returns this
  */
-proto.grpc.service.webservice.ApproveStageRequest.prototype.setDeploymentId = function(value) {
+proto.grpc.service.webservice.RejectStageRequest.prototype.setDeploymentId = function(value) {
   return jspb.Message.setProto3StringField(this, 1, value);
 };
 
@@ -11500,20 +12233,38 @@ proto.grpc.service.webservice.ApproveStageRequest.prototype.setDeploymentId = fu
  * optional string stage_id = 2;
  * @return {string}
  */
-proto.grpc.service.webservice.ApproveStageRequest.prototype.getStageId = function() {
+proto.grpc.service.webservice.RejectStageRequest.prototype.getStageId = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.grpc.service.webservice.ApproveStageRequest} returns this
+ * @return {!proto.grpc.service.webservice.RejectStageRequest} returns this
  */
-proto.grpc.service.webservice.ApproveStageRequest.prototype.setStageId = function(value) {
+proto.grpc.service.webservice.RejectStageRequest.prototype.setStageId = function(value) {
   return jspb.Message.setProto3StringField(this, 2, value);
 };
 
 
+/**
+ * optional string comment = 3;
+ * @return {string}
+ */
+proto.grpc.service.webservice.RejectStageRequest.prototype.getComment = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.grpc.service.webservice.RejectStageRequest} returns this
+ */
+proto.grpc.service.webservice.RejectStageRequest.prototype.setComment = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
+};
+
+
 
 
 
@@ -11530,8 +12281,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.grpc.service.webservice.ApproveStageResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.grpc.service.webservice.ApproveStageResponse.toObject(opt_includeInstance, this);
+proto.grpc.service.webservice.RejectStageResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.grpc.service.webservice.RejectStageResponse.toObject(opt_includeInstance, this);
 };
 
 
@@ -11540,11 +12291,11 @@ proto.grpc.service.webservice.ApproveStageResponse.prototype.toObject = function
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.grpc.service.webservice.ApproveStageResponse} msg The msg instance to transform.
+ * @param {!proto.grpc.service.webservice.RejectStageResponse} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.grpc.service.webservice.ApproveStageResponse.toObject = function(includeInstance, msg) {
+proto.grpc.service.webservice.RejectStageResponse.toObject = function(includeInstance, msg) {
   var f, obj = {
     commandId: jspb.Message.getFieldWithDefault(msg, 1, "")
   };
@@ -11560,23 +12311,23 @@ proto.grpc.service.webservice.ApproveStageResponse.toObject = function(includeIn
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.grpc.service.webservice.ApproveStageResponse}
+ * @return {!proto.grpc.service.webservice.RejectStageResponse}
  */
-proto.grpc.service.webservice.ApproveStageResponse.deserializeBinary = function(bytes) {
+proto.grpc.service.webservice.RejectStageResponse.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.grpc.service.webservice.ApproveStageResponse;
-  return proto.grpc.service.webservice.ApproveStageResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.grpc.service.webservice.RejectStageResponse;
+  return proto.grpc.service.webservice.RejectStageResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.grpc.service.webservice.ApproveStageResponse} msg The message object to deserialize into.
+ * @param {!proto.grpc.service.webservice.RejectStageResponse} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.grpc.service.webservice.ApproveStageResponse}
+ * @return {!proto.grpc.service.webservice.RejectStageResponse}
  */
-proto.grpc.service.webservice.ApproveStageResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.grpc.service.webservice.RejectStageResponse.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -11600,9 +12351,9 @@ proto.grpc.service.webservice.ApproveStageResponse.deserializeBinaryFromReader =
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.grpc.service.webservice.ApproveStageResponse.prototype.serializeBinary = function() {
+proto.grpc.service.webservice.RejectStageResponse.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.grpc.service.webservice.ApproveStageResponse.serializeBinaryToWriter(this, writer);
+  proto.grpc.service.webservice.RejectStageResponse.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -11610,11 +12361,11 @@ proto.grpc.service.webservice.ApproveStageResponse.prototype.serializeBinary = f
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.grpc.service.webservice.ApproveStageResponse} message
+ * @param {!proto.grpc.service.webservice.RejectStageResponse} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.grpc.service.webservice.ApproveStageResponse.serializeBinaryToWriter = function(message, writer) {
+proto.grpc.service.webservice.RejectStageResponse.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
   f = message.getCommandId();
   if (f.length > 0) {
@@ -11630,16 +12381,16 @@ proto.grpc.service.webservice.ApproveStageResponse.serializeBinaryToWriter = fun
  * optional string command_id = 1;
  * @return {string}
  */
-proto.grpc.service.webservice.ApproveStageResponse.prototype.getCommandId = function() {
+proto.grpc.service.webservice.RejectStageResponse.prototype.getCommandId = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.grpc.service.webservice.ApproveStageResponse} returns this
+ * @return {!proto.grpc.service.webservice.RejectStageResponse} returns this
  */
-proto.grpc.service.webservice.ApproveStageResponse.prototype.setCommandId = function(value) {
+proto.grpc.service.webservice.RejectStageResponse.prototype.setCommandId = function(value) {
   return jspb.Message.setProto3StringField(this, 1, value);
 };
 
@@ -1,12 +1,21 @@
 import * as jspb from 'google-protobuf'
 
 
+import * as pkg_model_deployment_pb from 'pipecd/web/model/deployment_pb';
 
 
 export class AnalysisResult extends jspb.Message {
   getStartTime(): number;
   setStartTime(value: number): AnalysisResult;
 
+  getScore(): number;
+  setScore(value: number): AnalysisResult;
+
+  getMetricsScoresList(): Array<AnalysisMetricsScore>;
+  setMetricsScoresList(value: Array<AnalysisMetricsScore>): AnalysisResult;
+  clearMetricsScoresList(): AnalysisResult;
+  addMetricsScores(value?: AnalysisMetricsScore, index?: number): AnalysisMetricsScore;
+
   serializeBinary(): Uint8Array;
   toObject(includeInstance?: boolean): AnalysisResult.AsObject;
   static toObject(includeInstance: boolean, msg: AnalysisResult): AnalysisResult.AsObject;
@@ -18,6 +27,370 @@ export class AnalysisResult extends jspb.Message {
 export namespace AnalysisResult {
   export type AsObject = {
     startTime: number,
+    score: number,
+    metricsScoresList: Array<AnalysisMetricsScore.AsObject>,
+  }
+}
+
+export class AnalysisMetricsScore extends jspb.Message {
+  getId(): string;
+  setId(value: string): AnalysisMetricsScore;
+
+  getProvider(): string;
+  setProvider(value: string): AnalysisMetricsScore;
+
+  getQuery(): string;
+  setQuery(value: string): AnalysisMetricsScore;
+
+  getStatisticalTest(): string;
+  setStatisticalTest(value: string): AnalysisMetricsScore;
+
+  getWeight(): number;
+  setWeight(value: number): AnalysisMetricsScore;
+
+  getScore(): number;
+  setScore(value: number): AnalysisMetricsScore;
+
+  getEvaluations(): number;
+  setEvaluations(value: number): AnalysisMetricsScore;
+
+  getPasses(): number;
+  setPasses(value: number): AnalysisMetricsScore;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): AnalysisMetricsScore.AsObject;
+  static toObject(includeInstance: boolean, msg: AnalysisMetricsScore): AnalysisMetricsScore.AsObject;
+  static serializeBinaryToWriter(message: AnalysisMetricsScore, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): AnalysisMetricsScore;
+  static deserializeBinaryFromReader(message: AnalysisMetricsScore, reader: jspb.BinaryReader): AnalysisMetricsScore;
+}
+
+export namespace AnalysisMetricsScore {
+  export type AsObject = {
+    id: string,
+    provider: string,
+    query: string,
+    statisticalTest: string,
+    weight: number,
+    score: number,
+    evaluations: number,
+    passes: number,
+  }
+}
+
+export class AnalysisReport extends jspb.Message {
+  getDeploymentId(): string;
+  setDeploymentId(value: string): AnalysisReport;
+
+  getStageId(): string;
+  setStageId(value: string): AnalysisReport;
+
+  getApplicationId(): string;
+  setApplicationId(value: string): AnalysisReport;
+
+  getStatus(): pkg_model_deployment_pb.StageStatus;
+  setStatus(value: pkg_model_deployment_pb.StageStatus): AnalysisReport;
+
+  getReason(): string;
+  setReason(value: string): AnalysisReport;
+
+  getScore(): number;
+  setScore(value: number): AnalysisReport;
+
+  getIntervalScoresList(): Array<AnalysisIntervalScore>;
+  setIntervalScoresList(value: Array<AnalysisIntervalScore>): AnalysisReport;
+  clearIntervalScoresList(): AnalysisReport;
+  addIntervalScores(value?: AnalysisIntervalScore, index?: number): AnalysisIntervalScore;
+
+  getMetricsList(): Array<AnalysisMetricsReport>;
+  setMetricsList(value: Array<AnalysisMetricsReport>): AnalysisReport;
+  clearMetricsList(): AnalysisReport;
+  addMetrics(value?: AnalysisMetricsReport, index?: number): AnalysisMetricsReport;
+
+  getLogsList(): Array<AnalysisLogReport>;
+  setLogsList(value: Array<AnalysisLogReport>): AnalysisReport;
+  clearLogsList(): AnalysisReport;
+  addLogs(value?: AnalysisLogReport, index?: number): AnalysisLogReport;
+
+  getHttpsList(): Array<AnalysisHTTPReport>;
+  setHttpsList(value: Array<AnalysisHTTPReport>): AnalysisReport;
+  clearHttpsList(): AnalysisReport;
+  addHttps(value?: AnalysisHTTPReport, index?: number): AnalysisHTTPReport;
+
+  getStartedAt(): number;
+  setStartedAt(value: number): AnalysisReport;
+
+  getCompletedAt(): number;
+  setCompletedAt(value: number): AnalysisReport;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): AnalysisReport.AsObject;
+  static toObject(includeInstance: boolean, msg: AnalysisReport): AnalysisReport.AsObject;
+  static serializeBinaryToWriter(message: AnalysisReport, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): AnalysisReport;
+  static deserializeBinaryFromReader(message: AnalysisReport, reader: jspb.BinaryReader): AnalysisReport;
+}
+
+export namespace AnalysisReport {
+  export type AsObject = {
+    deploymentId: string,
+    stageId: string,
+    applicationId: string,
+    status: pkg_model_deployment_pb.StageStatus,
+    reason: string,
+    score: number,
+    intervalScoresList: Array<AnalysisIntervalScore.AsObject>,
+    metricsList: Array<AnalysisMetricsReport.AsObject>,
+    logsList: Array<AnalysisLogReport.AsObject>,
+    httpsList: Array<AnalysisHTTPReport.AsObject>,
+    startedAt: number,
+    completedAt: number,
+  }
+}
+
+export class AnalysisIntervalScore extends jspb.Message {
+  getEvaluatedAt(): number;
+  setEvaluatedAt(value: number): AnalysisIntervalScore;
+
+  getScore(): number;
+  setScore(value: number): AnalysisIntervalScore;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): AnalysisIntervalScore.AsObject;
+  static toObject(includeInstance: boolean, msg: AnalysisIntervalScore): AnalysisIntervalScore.AsObject;
+  static serializeBinaryToWriter(message: AnalysisIntervalScore, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): AnalysisIntervalScore;
+  static deserializeBinaryFromReader(message: AnalysisIntervalScore, reader: jspb.BinaryReader): AnalysisIntervalScore;
+}
+
+export namespace AnalysisIntervalScore {
+  export type AsObject = {
+    evaluatedAt: number,
+    score: number,
+  }
+}
+
+export class AnalysisMetricsReport extends jspb.Message {
+  getId(): string;
+  setId(value: string): AnalysisMetricsReport;
+
+  getProvider(): string;
+  setProvider(value: string): AnalysisMetricsReport;
+
+  getStrategy(): string;
+  setStrategy(value: string): AnalysisMetricsReport;
+
+  getStatisticalTest(): string;
+  setStatisticalTest(value: string): AnalysisMetricsReport;
+
+  getDeviation(): string;
+  setDeviation(value: string): AnalysisMetricsReport;
+
+  getExpected(): string;
+  setExpected(value: string): AnalysisMetricsReport;
+
+  getScore(): number;
+  setScore(value: number): AnalysisMetricsReport;
+
+  getEvaluationsList(): Array<AnalysisEvaluation>;
+  setEvaluationsList(value: Array<AnalysisEvaluation>): AnalysisMetricsReport;
+  clearEvaluationsList(): AnalysisMetricsReport;
+  addEvaluations(value?: AnalysisEvaluation, index?: number): AnalysisEvaluation;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): AnalysisMetricsReport.AsObject;
+  static toObject(includeInstance: boolean, msg: AnalysisMetricsReport): AnalysisMetricsReport.AsObject;
+  static serializeBinaryToWriter(message: AnalysisMetricsReport, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): AnalysisMetricsReport;
+  static deserializeBinaryFromReader(message: AnalysisMetricsReport, reader: jspb.BinaryReader): AnalysisMetricsReport;
+}
+
+export namespace AnalysisMetricsReport {
+  export type AsObject = {
+    id: string,
+    provider: string,
+    strategy: string,
+    statisticalTest: string,
+    deviation: string,
+    expected: string,
+    score: number,
+    evaluationsList: Array<AnalysisEvaluation.AsObject>,
   }
 }
 
+export class AnalysisLogReport extends jspb.Message {
+  getId(): string;
+  setId(value: string): AnalysisLogReport;
+
+  getProvider(): string;
+  setProvider(value: string): AnalysisLogReport;
+
+  getStrategy(): string;
+  setStrategy(value: string): AnalysisLogReport;
+
+  getMaxCount(): number;
+  setMaxCount(value: number): AnalysisLogReport;
+
+  getMaxRatio(): number;
+  setMaxRatio(value: number): AnalysisLogReport;
+
+  getScore(): number;
+  setScore(value: number): AnalysisLogReport;
+
+  getEvaluationsList(): Array<AnalysisEvaluation>;
+  setEvaluationsList(value: Array<AnalysisEvaluation>): AnalysisLogReport;
+  clearEvaluationsList(): AnalysisLogReport;
+  addEvaluations(value?: AnalysisEvaluation, index?: number): AnalysisEvaluation;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): AnalysisLogReport.AsObject;
+  static toObject(includeInstance: boolean, msg: AnalysisLogReport): AnalysisLogReport.AsObject;
+  static serializeBinaryToWriter(message: AnalysisLogReport, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): AnalysisLogReport;
+  static deserializeBinaryFromReader(message: AnalysisLogReport, reader: jspb.BinaryReader): AnalysisLogReport;
+}
+
+export namespace AnalysisLogReport {
+  export type AsObject = {
+    id: string,
+    provider: string,
+    strategy: string,
+    maxCount: number,
+    maxRatio: number,
+    score: number,
+    evaluationsList: Array<AnalysisEvaluation.AsObject>,
+  }
+}
+
+export class AnalysisHTTPReport extends jspb.Message {
+  getId(): string;
+  setId(value: string): AnalysisHTTPReport;
+
+  getProvider(): string;
+  setProvider(value: string): AnalysisHTTPReport;
+
+  getScore(): number;
+  setScore(value: number): AnalysisHTTPReport;
+
+  getEvaluationsList(): Array<AnalysisEvaluation>;
+  setEvaluationsList(value: Array<AnalysisEvaluation>): AnalysisHTTPReport;
+  clearEvaluationsList(): AnalysisHTTPReport;
+  addEvaluations(value?: AnalysisEvaluation, index?: number): AnalysisEvaluation;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): AnalysisHTTPReport.AsObject;
+  static toObject(includeInstance: boolean, msg: AnalysisHTTPReport): AnalysisHTTPReport.AsObject;
+  static serializeBinaryToWriter(message: AnalysisHTTPReport, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): AnalysisHTTPReport;
+  static deserializeBinaryFromReader(message: AnalysisHTTPReport, reader: jspb.BinaryReader): AnalysisHTTPReport;
+}
+
+export namespace AnalysisHTTPReport {
+  export type AsObject = {
+    id: string,
+    provider: string,
+    score: number,
+    evaluationsList: Array<AnalysisEvaluation.AsObject>,
+  }
+}
+
+export class AnalysisEvaluation extends jspb.Message {
+  getEvaluatedAt(): number;
+  setEvaluatedAt(value: number): AnalysisEvaluation;
+
+  getVerdict(): AnalysisVerdict;
+  setVerdict(value: AnalysisVerdict): AnalysisEvaluation;
+
+  getReason(): string;
+  setReason(value: string): AnalysisEvaluation;
+
+  getQueriesList(): Array<AnalysisQuery>;
+  setQueriesList(value: Array<AnalysisQuery>): AnalysisEvaluation;
+  clearQueriesList(): AnalysisEvaluation;
+  addQueries(value?: AnalysisQuery, index?: number): AnalysisQuery;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): AnalysisEvaluation.AsObject;
+  static toObject(includeInstance: boolean, msg: AnalysisEvaluation): AnalysisEvaluation.AsObject;
+  static serializeBinaryToWriter(message: AnalysisEvaluation, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): AnalysisEvaluation;
+  static deserializeBinaryFromReader(message: AnalysisEvaluation, reader: jspb.BinaryReader): AnalysisEvaluation;
+}
+
+export namespace AnalysisEvaluation {
+  export type AsObject = {
+    evaluatedAt: number,
+    verdict: AnalysisVerdict,
+    reason: string,
+    queriesList: Array<AnalysisQuery.AsObject>,
+  }
+}
+
+export class AnalysisQuery extends jspb.Message {
+  getVariant(): string;
+  setVariant(value: string): AnalysisQuery;
+
+  getQuery(): string;
+  setQuery(value: string): AnalysisQuery;
+
+  getFrom(): number;
+  setFrom(value: number): AnalysisQuery;
+
+  getTo(): number;
+  setTo(value: number): AnalysisQuery;
+
+  getDataPointsList(): Array<AnalysisDataPoint>;
+  setDataPointsList(value: Array<AnalysisDataPoint>): AnalysisQuery;
+  clearDataPointsList(): AnalysisQuery;
+  addDataPoints(value?: AnalysisDataPoint, index?: number): AnalysisDataPoint;
+
+  getLogEntryCount(): number;
+  setLogEntryCount(value: number): AnalysisQuery;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): AnalysisQuery.AsObject;
+  static toObject(includeInstance: boolean, msg: AnalysisQuery): AnalysisQuery.AsObject;
+  static serializeBinaryToWriter(message: AnalysisQuery, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): AnalysisQuery;
+  static deserializeBinaryFromReader(message: AnalysisQuery, reader: jspb.BinaryReader): AnalysisQuery;
+}
+
+export namespace AnalysisQuery {
+  export type AsObject = {
+    variant: string,
+    query: string,
+    from: number,
+    to: number,
+    dataPointsList: Array<AnalysisDataPoint.AsObject>,
+    logEntryCount: number,
+  }
+}
+
+export class AnalysisDataPoint extends jspb.Message {
+  getTimestamp(): number;
+  setTimestamp(value: number): AnalysisDataPoint;
+
+  getValue(): number;
+  setValue(value: number): AnalysisDataPoint;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): AnalysisDataPoint.AsObject;
+  static toObject(includeInstance: boolean, msg: AnalysisDataPoint): AnalysisDataPoint.AsObject;
+  static serializeBinaryToWriter(message: AnalysisDataPoint, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): AnalysisDataPoint;
+  static deserializeBinaryFromReader(message: AnalysisDataPoint, reader: jspb.BinaryReader): AnalysisDataPoint;
+}
+
+export namespace AnalysisDataPoint {
+  export type AsObject = {
+    timestamp: number,
+    value: number,
+  }
+}
+
+export enum AnalysisVerdict { 
+  VERDICT_UNKNOWN = 0,
+  VERDICT_PASSED = 1,
+  VERDICT_FAILED = 2,
+  VERDICT_SKIPPED = 3,
+}
@@ -23,7 +23,19 @@ var global =
 
 
 
+var pkg_model_deployment_pb = require('pipecd/web/model/deployment_pb.js');
+goog.object.extend(proto, pkg_model_deployment_pb);
+goog.exportSymbol('proto.model.AnalysisDataPoint', null, global);
+goog.exportSymbol('proto.model.AnalysisEvaluation', null, global);
+goog.exportSymbol('proto.model.AnalysisHTTPReport', null, global);
+goog.exportSymbol('proto.model.AnalysisIntervalScore', null, global);
+goog.exportSymbol('proto.model.AnalysisLogReport', null, global);
+goog.exportSymbol('proto.model.AnalysisMetricsReport', null, global);
+goog.exportSymbol('proto.model.AnalysisMetricsScore', null, global);
+goog.exportSymbol('proto.model.AnalysisQuery', null, global);
+goog.exportSymbol('proto.model.AnalysisReport', null, global);
 goog.exportSymbol('proto.model.AnalysisResult', null, global);
+goog.exportSymbol('proto.model.AnalysisVerdict', null, global);
 /**
  * Generated by JsPbCodeGenerator.
  * @param {Array=} opt_data Optional initial data array, typically from a
@@ -35,7 +47,7 @@ goog.exportSymbol('proto.model.AnalysisResult', null, global);
  * @constructor
  */
 proto.model.AnalysisResult = function(opt_data) {
-  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.model.AnalysisResult.repeatedFields_, null);
 };
 goog.inherits(proto.model.AnalysisResult, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
@@ -45,6 +57,202 @@ if (goog.DEBUG && !COMPILED) {
    */
   proto.model.AnalysisResult.displayName = 'proto.model.AnalysisResult';
 }
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.model.AnalysisMetricsScore = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.model.AnalysisMetricsScore, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.model.AnalysisMetricsScore.displayName = 'proto.model.AnalysisMetricsScore';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.model.AnalysisReport = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.model.AnalysisReport.repeatedFields_, null);
+};
+goog.inherits(proto.model.AnalysisReport, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.model.AnalysisReport.displayName = 'proto.model.AnalysisReport';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.model.AnalysisIntervalScore = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.model.AnalysisIntervalScore, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.model.AnalysisIntervalScore.displayName = 'proto.model.AnalysisIntervalScore';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.model.AnalysisMetricsReport = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.model.AnalysisMetricsReport.repeatedFields_, null);
+};
+goog.inherits(proto.model.AnalysisMetricsReport, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.model.AnalysisMetricsReport.displayName = 'proto.model.AnalysisMetricsReport';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.model.AnalysisLogReport = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.model.AnalysisLogReport.repeatedFields_, null);
+};
+goog.inherits(proto.model.AnalysisLogReport, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.model.AnalysisLogReport.displayName = 'proto.model.AnalysisLogReport';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.model.AnalysisHTTPReport = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.model.AnalysisHTTPReport.repeatedFields_, null);
+};
+goog.inherits(proto.model.AnalysisHTTPReport, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.model.AnalysisHTTPReport.displayName = 'proto.model.AnalysisHTTPReport';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.model.AnalysisEvaluation = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.model.AnalysisEvaluation.repeatedFields_, null);
+};
+goog.inherits(proto.model.AnalysisEvaluation, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.model.AnalysisEvaluation.displayName = 'proto.model.AnalysisEvaluation';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.model.AnalysisQuery = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.model.AnalysisQuery.repeatedFields_, null);
+};
+goog.inherits(proto.model.AnalysisQuery, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.model.AnalysisQuery.displayName = 'proto.model.AnalysisQuery';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.model.AnalysisDataPoint = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.model.AnalysisDataPoint, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.model.AnalysisDataPoint.displayName = 'proto.model.AnalysisDataPoint';
+}
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.model.AnalysisResult.repeatedFields_ = [5];
 
 
 
@@ -77,7 +285,10 @@ proto.model.AnalysisResult.prototype.toObject = function(opt_includeInstance) {
  */
 proto.model.AnalysisResult.toObject = function(includeInstance, msg) {
   var f, obj = {
-    startTime: jspb.Message.getFieldWithDefault(msg, 1, 0)
+    startTime: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    score: jspb.Message.getFloatingPointFieldWithDefault(msg, 4, 0.0),
+    metricsScoresList: jspb.Message.toObjectList(msg.getMetricsScoresList(),
+    proto.model.AnalysisMetricsScore.toObject, includeInstance)
   };
 
   if (includeInstance) {
@@ -118,6 +329,15 @@ proto.model.AnalysisResult.deserializeBinaryFromReader = function(msg, reader) {
       var value = /** @type {number} */ (reader.readInt64());
       msg.setStartTime(value);
       break;
+    case 4:
+      var value = /** @type {number} */ (reader.readDouble());
+      msg.setScore(value);
+      break;
+    case 5:
+      var value = new proto.model.AnalysisMetricsScore;
+      reader.readMessage(value,proto.model.AnalysisMetricsScore.deserializeBinaryFromReader);
+      msg.addMetricsScores(value);
+      break;
     default:
       reader.skipField();
       break;
@@ -154,6 +374,21 @@ proto.model.AnalysisResult.serializeBinaryToWriter = function(message, writer) {
       f
     );
   }
+  f = message.getScore();
+  if (f !== 0.0) {
+    writer.writeDouble(
+      4,
+      f
+    );
+  }
+  f = message.getMetricsScoresList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      5,
+      f,
+      proto.model.AnalysisMetricsScore.serializeBinaryToWriter
+    );
+  }
 };
 
 
@@ -175,4 +410,2809 @@ proto.model.AnalysisResult.prototype.setStartTime = function(value) {
 };
 
 
+/**
+ * optional double score = 4;
+ * @return {number}
+ */
+proto.model.AnalysisResult.prototype.getScore = function() {
+  return /** @type {number} */ (jspb.Message.getFloatingPointFieldWithDefault(this, 4, 0.0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.model.AnalysisResult} returns this
+ */
+proto.model.AnalysisResult.prototype.setScore = function(value) {
+  return jspb.Message.setProto3FloatField(this, 4, value);
+};
+
+
+/**
+ * repeated AnalysisMetricsScore metrics_scores = 5;
+ * @return {!Array<!proto.model.AnalysisMetricsScore>}
+ */
+proto.model.AnalysisResult.prototype.getMetricsScoresList = function() {
+  return /** @type{!Array<!proto.model.AnalysisMetricsScore>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.model.AnalysisMetricsScore, 5));
+};
+
+
+/**
+ * @param {!Array<!proto.model.AnalysisMetricsScore>} value
+ * @return {!proto.model.AnalysisResult} returns this
+*/
+proto.model.AnalysisResult.prototype.setMetricsScoresList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 5, value);
+};
+
+
+/**
+ * @param {!proto.model.AnalysisMetricsScore=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.model.AnalysisMetricsScore}
+ */
+proto.model.AnalysisResult.prototype.addMetricsScores = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 5, opt_value, proto.model.AnalysisMetricsScore, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.model.AnalysisResult} returns this
+ */
+proto.model.AnalysisResult.prototype.clearMetricsScoresList = function() {
+  return this.setMetricsScoresList([]);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.model.AnalysisMetricsScore.prototype.toObject = function(opt_includeInstance) {
+  return proto.model.AnalysisMetricsScore.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.model.AnalysisMetricsScore} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.model.AnalysisMetricsScore.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    id: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    provider: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    query: jspb.Message.getFieldWithDefault(msg, 3, ""),
+    statisticalTest: jspb.Message.getFieldWithDefault(msg, 4, ""),
+    weight: jspb.Message.getFloatingPointFieldWithDefault(msg, 5, 0.0),
+    score: jspb.Message.getFloatingPointFieldWithDefault(msg, 6, 0.0),
+    evaluations: jspb.Message.getFieldWithDefault(msg, 7, 0),
+    passes: jspb.Message.getFieldWithDefault(msg, 8, 0)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.model.AnalysisMetricsScore}
+ */
+proto.model.AnalysisMetricsScore.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.model.AnalysisMetricsScore;
+  return proto.model.AnalysisMetricsScore.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.model.AnalysisMetricsScore} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.model.AnalysisMetricsScore}
+ */
+proto.model.AnalysisMetricsScore.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setId(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setProvider(value);
+      break;
+    case 3:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setQuery(value);
+      break;
+    case 4:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setStatisticalTest(value);
+      break;
+    case 5:
+      var value = /** @type {number} */ (reader.readDouble());
+      msg.setWeight(value);
+      break;
+    case 6:
+      var value = /** @type {number} */ (reader.readDouble());
+      msg.setScore(value);
+      break;
+    case 7:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setEvaluations(value);
+      break;
+    case 8:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setPasses(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.model.AnalysisMetricsScore.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.model.AnalysisMetricsScore.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.model.AnalysisMetricsScore} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.model.AnalysisMetricsScore.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getId();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getProvider();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getQuery();
+  if (f.length > 0) {
+    writer.writeString(
+      3,
+      f
+    );
+  }
+  f = message.getStatisticalTest();
+  if (f.length > 0) {
+    writer.writeString(
+      4,
+      f
+    );
+  }
+  f = message.getWeight();
+  if (f !== 0.0) {
+    writer.writeDouble(
+      5,
+      f
+    );
+  }
+  f = message.getScore();
+  if (f !== 0.0) {
+    writer.writeDouble(
+      6,
+      f
+    );
+  }
+  f = message.getEvaluations();
+  if (f !== 0) {
+    writer.writeInt32(
+      7,
+      f
+    );
+  }
+  f = message.getPasses();
+  if (f !== 0) {
+    writer.writeInt32(
+      8,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string id = 1;
+ * @return {string}
+ */
+proto.model.AnalysisMetricsScore.prototype.getId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.model.AnalysisMetricsScore} returns this
+ */
+proto.model.AnalysisMetricsScore.prototype.setId = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional string provider = 2;
+ * @return {string}
+ */
+proto.model.AnalysisMetricsScore.prototype.getProvider = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.model.AnalysisMetricsScore} returns this
+ */
+proto.model.AnalysisMetricsScore.prototype.setProvider = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+/**
+ * optional string query = 3;
+ * @return {string}
+ */
+proto.model.AnalysisMetricsScore.prototype.getQuery = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.model.AnalysisMetricsScore} returns this
+ */
+proto.model.AnalysisMetricsScore.prototype.setQuery = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
+};
+
+
+/**
+ * optional string statistical_test = 4;
+ * @return {string}
+ */
+proto.model.AnalysisMetricsScore.prototype.getStatisticalTest = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.model.AnalysisMetricsScore} returns this
+ */
+proto.model.AnalysisMetricsScore.prototype.setStatisticalTest = function(value) {
+  return jspb.Message.setProto3StringField(this, 4, value);
+};
+
+
+/**
+ * optional double weight = 5;
+ * @return {number}
+ */
+proto.model.AnalysisMetricsScore.prototype.getWeight = function() {
+  return /** @type {number} */ (jspb.Message.getFloatingPointFieldWithDefault(this, 5, 0.0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.model.AnalysisMetricsScore} returns this
+ */
+proto.model.AnalysisMetricsScore.prototype.setWeight = function(value) {
+  return jspb.Message.setProto3FloatField(this, 5, value);
+};
+
+
+/**
+ * optional double score = 6;
+ * @return {number}
+ */
+proto.model.AnalysisMetricsScore.prototype.getScore = function() {
+  return /** @type {number} */ (jspb.Message.getFloatingPointFieldWithDefault(this, 6, 0.0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.model.AnalysisMetricsScore} returns this
+ */
+proto.model.AnalysisMetricsScore.prototype.setScore = function(value) {
+  return jspb.Message.setProto3FloatField(this, 6, value);
+};
+
+
+/**
+ * optional int32 evaluations = 7;
+ * @return {number}
+ */
+proto.model.AnalysisMetricsScore.prototype.getEvaluations = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 7, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.model.AnalysisMetricsScore} returns this
+ */
+proto.model.AnalysisMetricsScore.prototype.setEvaluations = function(value) {
+  return jspb.Message.setProto3IntField(this, 7, value);
+};
+
+
+/**
+ * optional int32 passes = 8;
+ * @return {number}
+ */
+proto.model.AnalysisMetricsScore.prototype.getPasses = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 8, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.model.AnalysisMetricsScore} returns this
+ */
+proto.model.AnalysisMetricsScore.prototype.setPasses = function(value) {
+  return jspb.Message.setProto3IntField(this, 8, value);
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.model.AnalysisReport.repeatedFields_ = [7,8,9,10];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.model.AnalysisReport.prototype.toObject = function(opt_includeInstance) {
+  return proto.model.AnalysisReport.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.model.AnalysisReport} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.model.AnalysisReport.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    deploymentId: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    stageId: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    applicationId: jspb.Message.getFieldWithDefault(msg, 3, ""),
+    status: jspb.Message.getFieldWithDefault(msg, 4, 0),
+    reason: jspb.Message.getFieldWithDefault(msg, 5, ""),
+    score: jspb.Message.getFloatingPointFieldWithDefault(msg, 6, 0.0),
+    intervalScoresList: jspb.Message.toObjectList(msg.getIntervalScoresList(),
+    proto.model.AnalysisIntervalScore.toObject, includeInstance),
+    metricsList: jspb.Message.toObjectList(msg.getMetricsList(),
+    proto.model.AnalysisMetricsReport.toObject, includeInstance),
+    logsList: jspb.Message.toObjectList(msg.getLogsList(),
+    proto.model.AnalysisLogReport.toObject, includeInstance),
+    httpsList: jspb.Message.toObjectList(msg.getHttpsList(),
+    proto.model.AnalysisHTTPReport.toObject, includeInstance),
+    startedAt: jspb.Message.getFieldWithDefault(msg, 20, 0),
+    completedAt: jspb.Message.getFieldWithDefault(msg, 21, 0)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.model.AnalysisReport}
+ */
+proto.model.AnalysisReport.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.model.AnalysisReport;
+  return proto.model.AnalysisReport.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.model.AnalysisReport} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.model.AnalysisReport}
+ */
+proto.model.AnalysisReport.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setDeploymentId(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setStageId(value);
+      break;
+    case 3:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setApplicationId(value);
+      break;
+    case 4:
+      var value = /** @type {!proto.model.StageStatus} */ (reader.readEnum());
+      msg.setStatus(value);
+      break;
+    case 5:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setReason(value);
+      break;
+    case 6:
+      var value = /** @type {number} */ (reader.readDouble());
+      msg.setScore(value);
+      break;
+    case 7:
+      var value = new proto.model.AnalysisIntervalScore;
+      reader.readMessage(value,proto.model.AnalysisIntervalScore.deserializeBinaryFromReader);
+      msg.addIntervalScores(value);
+      break;
+    case 8:
+      var value = new proto.model.AnalysisMetricsReport;
+      reader.readMessage(value,proto.model.AnalysisMetricsReport.deserializeBinaryFromReader);
+      msg.addMetrics(value);
+      break;
+    case 9:
+      var value = new proto.model.AnalysisLogReport;
+      reader.readMessage(value,proto.model.AnalysisLogReport.deserializeBinaryFromReader);
+      msg.addLogs(value);
+      break;
+    case 10:
+      var value = new proto.model.AnalysisHTTPReport;
+      reader.readMessage(value,proto.model.AnalysisHTTPReport.deserializeBinaryFromReader);
+      msg.addHttps(value);
+      break;
+    case 20:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setStartedAt(value);
+      break;
+    case 21:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setCompletedAt(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.model.AnalysisReport.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.model.AnalysisReport.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.model.AnalysisReport} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.model.AnalysisReport.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getDeploymentId();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getStageId();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getApplicationId();
+  if (f.length > 0) {
+    writer.writeString(
+      3,
+      f
+    );
+  }
+  f = message.getStatus();
+  if (f !== 0.0) {
+    writer.writeEnum(
+      4,
+      f
+    );
+  }
+  f = message.getReason();
+  if (f.length > 0) {
+    writer.writeString(
+      5,
+      f
+    );
+  }
+  f = message.getScore();
+  if (f !== 0.0) {
+    writer.writeDouble(
+      6,
+      f
+    );
+  }
+  f = message.getIntervalScoresList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      7,
+      f,
+      proto.model.AnalysisIntervalScore.serializeBinaryToWriter
+    );
+  }
+  f = message.getMetricsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      8,
+      f,
+      proto.model.AnalysisMetricsReport.serializeBinaryToWriter
+    );
+  }
+  f = message.getLogsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      9,
+      f,
+      proto.model.AnalysisLogReport.serializeBinaryToWriter
+    );
+  }
+  f = message.getHttpsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      10,
+      f,
+      proto.model.AnalysisHTTPReport.serializeBinaryToWriter
+    );
+  }
+  f = message.getStartedAt();
+  if (f !== 0) {
+    writer.writeInt64(
+      20,
+      f
+    );
+  }
+  f = message.getCompletedAt();
+  if (f !== 0) {
+    writer.writeInt64(
+      21,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string deployment_id = 1;
+ * @return {string}
+ */
+proto.model.AnalysisReport.prototype.getDeploymentId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.model.AnalysisReport} returns this
+ */
+proto.model.AnalysisReport.prototype.setDeploymentId = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional string stage_id = 2;
+ * @return {string}
+ */
+proto.model.AnalysisReport.prototype.getStageId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.model.AnalysisReport} returns this
+ */
+proto.model.AnalysisReport.prototype.setStageId = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+/**
+ * optional string application_id = 3;
+ * @return {string}
+ */
+proto.model.AnalysisReport.prototype.getApplicationId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.model.AnalysisReport} returns this
+ */
+proto.model.AnalysisReport.prototype.setApplicationId = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
+};
+
+
+/**
+ * optional StageStatus status = 4;
+ * @return {!proto.model.StageStatus}
+ */
+proto.model.AnalysisReport.prototype.getStatus = function() {
+  return /** @type {!proto.model.StageStatus} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
+};
+
+
+/**
+ * @param {!proto.model.StageStatus} value
+ * @return {!proto.model.AnalysisReport} returns this
+ */
+proto.model.AnalysisReport.prototype.setStatus = function(value) {
+  return jspb.Message.setProto3EnumField(this, 4, value);
+};
+
+
+/**
+ * optional string reason = 5;
+ * @return {string}
+ */
+proto.model.AnalysisReport.prototype.getReason = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 5, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.model.AnalysisReport} returns this
+ */
+proto.model.AnalysisReport.prototype.setReason = function(value) {
+  return jspb.Message.setProto3StringField(this, 5, value);
+};
+
+
+/**
+ * optional double score = 6;
+ * @return {number}
+ */
+proto.model.AnalysisReport.prototype.getScore = function() {
+  return /** @type {number} */ (jspb.Message.getFloatingPointFieldWithDefault(this, 6, 0.0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.model.AnalysisReport} returns this
+ */
+proto.model.AnalysisReport.prototype.setScore = function(value) {
+  return jspb.Message.setProto3FloatField(this, 6, value);
+};
+
+
+/**
+ * repeated AnalysisIntervalScore interval_scores = 7;
+ * @return {!Array<!proto.model.AnalysisIntervalScore>}
+ */
+proto.model.AnalysisReport.prototype.getIntervalScoresList = function() {
+  return /** @type{!Array<!proto.model.AnalysisIntervalScore>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.model.AnalysisIntervalScore, 7));
+};
+
+
+/**
+ * @param {!Array<!proto.model.AnalysisIntervalScore>} value
+ * @return {!proto.model.AnalysisReport} returns this
+*/
+proto.model.AnalysisReport.prototype.setIntervalScoresList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 7, value);
+};
+
+
+/**
+ * @param {!proto.model.AnalysisIntervalScore=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.model.AnalysisIntervalScore}
+ */
+proto.model.AnalysisReport.prototype.addIntervalScores = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 7, opt_value, proto.model.AnalysisIntervalScore, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.model.AnalysisReport} returns this
+ */
+proto.model.AnalysisReport.prototype.clearIntervalScoresList = function() {
+  return this.setIntervalScoresList([]);
+};
+
+
+/**
+ * repeated AnalysisMetricsReport metrics = 8;
+ * @return {!Array<!proto.model.AnalysisMetricsReport>}
+ */
+proto.model.AnalysisReport.prototype.getMetricsList = function() {
+  return /** @type{!Array<!proto.model.AnalysisMetricsReport>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.model.AnalysisMetricsReport, 8));
+};
+
+
+/**
+ * @param {!Array<!proto.model.AnalysisMetricsReport>} value
+ * @return {!proto.model.AnalysisReport} returns this
+*/
+proto.model.AnalysisReport.prototype.setMetricsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 8, value);
+};
+
+
+/**
+ * @param {!proto.model.AnalysisMetricsReport=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.model.AnalysisMetricsReport}
+ */
+proto.model.AnalysisReport.prototype.addMetrics = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 8, opt_value, proto.model.AnalysisMetricsReport, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.model.AnalysisReport} returns this
+ */
+proto.model.AnalysisReport.prototype.clearMetricsList = function() {
+  return this.setMetricsList([]);
+};
+
+
+/**
+ * repeated AnalysisLogReport logs = 9;
+ * @return {!Array<!proto.model.AnalysisLogReport>}
+ */
+proto.model.AnalysisReport.prototype.getLogsList = function() {
+  return /** @type{!Array<!proto.model.AnalysisLogReport>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.model.AnalysisLogReport, 9));
+};
+
+
+/**
+ * @param {!Array<!proto.model.AnalysisLogReport>} value
+ * @return {!proto.model.AnalysisReport} returns this
+*/
+proto.model.AnalysisReport.prototype.setLogsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 9, value);
+};
+
+
+/**
+ * @param {!proto.model.AnalysisLogReport=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.model.AnalysisLogReport}
+ */
+proto.model.AnalysisReport.prototype.addLogs = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 9, opt_value, proto.model.AnalysisLogReport, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.model.AnalysisReport} returns this
+ */
+proto.model.AnalysisReport.prototype.clearLogsList = function() {
+  return this.setLogsList([]);
+};
+
+
+/**
+ * repeated AnalysisHTTPReport https = 10;
+ * @return {!Array<!proto.model.AnalysisHTTPReport>}
+ */
+proto.model.AnalysisReport.prototype.getHttpsList = function() {
+  return /** @type{!Array<!proto.model.AnalysisHTTPReport>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.model.AnalysisHTTPReport, 10));
+};
+
+
+/**
+ * @param {!Array<!proto.model.AnalysisHTTPReport>} value
+ * @return {!proto.model.AnalysisReport} returns this
+*/
+proto.model.AnalysisReport.prototype.setHttpsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 10, value);
+};
+
+
+/**
+ * @param {!proto.model.AnalysisHTTPReport=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.model.AnalysisHTTPReport}
+ */
+proto.model.AnalysisReport.prototype.addHttps = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 10, opt_value, proto.model.AnalysisHTTPReport, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.model.AnalysisReport} returns this
+ */
+proto.model.AnalysisReport.prototype.clearHttpsList = function() {
+  return this.setHttpsList([]);
+};
+
+
+/**
+ * optional int64 started_at = 20;
+ * @return {number}
+ */
+proto.model.AnalysisReport.prototype.getStartedAt = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 20, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.model.AnalysisReport} returns this
+ */
+proto.model.AnalysisReport.prototype.setStartedAt = function(value) {
+  return jspb.Message.setProto3IntField(this, 20, value);
+};
+
+
+/**
+ * optional int64 completed_at = 21;
+ * @return {number}
+ */
+proto.model.AnalysisReport.prototype.getCompletedAt = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 21, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.model.AnalysisReport} returns this
+ */
+proto.model.AnalysisReport.prototype.setCompletedAt = function(value) {
+  return jspb.Message.setProto3IntField(this, 21, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.model.AnalysisIntervalScore.prototype.toObject = function(opt_includeInstance) {
+  return proto.model.AnalysisIntervalScore.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.model.AnalysisIntervalScore} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.model.AnalysisIntervalScore.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    evaluatedAt: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    score: jspb.Message.getFloatingPointFieldWithDefault(msg, 2, 0.0)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.model.AnalysisIntervalScore}
+ */
+proto.model.AnalysisIntervalScore.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.model.AnalysisIntervalScore;
+  return proto.model.AnalysisIntervalScore.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.model.AnalysisIntervalScore} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.model.AnalysisIntervalScore}
+ */
+proto.model.AnalysisIntervalScore.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setEvaluatedAt(value);
+      break;
+    case 2:
+      var value = /** @type {number} */ (reader.readDouble());
+      msg.setScore(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.model.AnalysisIntervalScore.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.model.AnalysisIntervalScore.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.model.AnalysisIntervalScore} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.model.AnalysisIntervalScore.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getEvaluatedAt();
+  if (f !== 0) {
+    writer.writeInt64(
+      1,
+      f
+    );
+  }
+  f = message.getScore();
+  if (f !== 0.0) {
+    writer.writeDouble(
+      2,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional int64 evaluated_at = 1;
+ * @return {number}
+ */
+proto.model.AnalysisIntervalScore.prototype.getEvaluatedAt = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.model.AnalysisIntervalScore} returns this
+ */
+proto.model.AnalysisIntervalScore.prototype.setEvaluatedAt = function(value) {
+  return jspb.Message.setProto3IntField(this, 1, value);
+};
+
+
+/**
+ * optional double score = 2;
+ * @return {number}
+ */
+proto.model.AnalysisIntervalScore.prototype.getScore = function() {
+  return /** @type {number} */ (jspb.Message.getFloatingPointFieldWithDefault(this, 2, 0.0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.model.AnalysisIntervalScore} returns this
+ */
+proto.model.AnalysisIntervalScore.prototype.setScore = function(value) {
+  return jspb.Message.setProto3FloatField(this, 2, value);
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.model.AnalysisMetricsReport.repeatedFields_ = [8];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.model.AnalysisMetricsReport.prototype.toObject = function(opt_includeInstance) {
+  return proto.model.AnalysisMetricsReport.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.model.AnalysisMetricsReport} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.model.AnalysisMetricsReport.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    id: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    provider: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    strategy: jspb.Message.getFieldWithDefault(msg, 3, ""),
+    statisticalTest: jspb.Message.getFieldWithDefault(msg, 4, ""),
+    deviation: jspb.Message.getFieldWithDefault(msg, 5, ""),
+    expected: jspb.Message.getFieldWithDefault(msg, 6, ""),
+    score: jspb.Message.getFloatingPointFieldWithDefault(msg, 7, 0.0),
+    evaluationsList: jspb.Message.toObjectList(msg.getEvaluationsList(),
+    proto.model.AnalysisEvaluation.toObject, includeInstance)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.model.AnalysisMetricsReport}
+ */
+proto.model.AnalysisMetricsReport.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.model.AnalysisMetricsReport;
+  return proto.model.AnalysisMetricsReport.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.model.AnalysisMetricsReport} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.model.AnalysisMetricsReport}
+ */
+proto.model.AnalysisMetricsReport.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setId(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setProvider(value);
+      break;
+    case 3:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setStrategy(value);
+      break;
+    case 4:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setStatisticalTest(value);
+      break;
+    case 5:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setDeviation(value);
+      break;
+    case 6:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setExpected(value);
+      break;
+    case 7:
+      var value = /** @type {number} */ (reader.readDouble());
+      msg.setScore(value);
+      break;
+    case 8:
+      var value = new proto.model.AnalysisEvaluation;
+      reader.readMessage(value,proto.model.AnalysisEvaluation.deserializeBinaryFromReader);
+      msg.addEvaluations(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.model.AnalysisMetricsReport.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.model.AnalysisMetricsReport.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.model.AnalysisMetricsReport} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.model.AnalysisMetricsReport.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getId();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getProvider();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getStrategy();
+  if (f.length > 0) {
+    writer.writeString(
+      3,
+      f
+    );
+  }
+  f = message.getStatisticalTest();
+  if (f.length > 0) {
+    writer.writeString(
+      4,
+      f
+    );
+  }
+  f = message.getDeviation();
+  if (f.length > 0) {
+    writer.writeString(
+      5,
+      f
+    );
+  }
+  f = message.getExpected();
+  if (f.length > 0) {
+    writer.writeString(
+      6,
+      f
+    );
+  }
+  f = message.getScore();
+  if (f !== 0.0) {
+    writer.writeDouble(
+      7,
+      f
+    );
+  }
+  f = message.getEvaluationsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      8,
+      f,
+      proto.model.AnalysisEvaluation.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * optional string id = 1;
+ * @return {string}
+ */
+proto.model.AnalysisMetricsReport.prototype.getId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.model.AnalysisMetricsReport} returns this
+ */
+proto.model.AnalysisMetricsReport.prototype.setId = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional string provider = 2;
+ * @return {string}
+ */
+proto.model.AnalysisMetricsReport.prototype.getProvider = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.model.AnalysisMetricsReport} returns this
+ */
+proto.model.AnalysisMetricsReport.prototype.setProvider = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+/**
+ * optional string strategy = 3;
+ * @return {string}
+ */
+proto.model.AnalysisMetricsReport.prototype.getStrategy = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.model.AnalysisMetricsReport} returns this
+ */
+proto.model.AnalysisMetricsReport.prototype.setStrategy = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
+};
+
+
+/**
+ * optional string statistical_test = 4;
+ * @return {string}
+ */
+proto.model.AnalysisMetricsReport.prototype.getStatisticalTest = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.model.AnalysisMetricsReport} returns this
+ */
+proto.model.AnalysisMetricsReport.prototype.setStatisticalTest = function(value) {
+  return jspb.Message.setProto3StringField(this, 4, value);
+};
+
+
+/**
+ * optional string deviation = 5;
+ * @return {string}
+ */
+proto.model.AnalysisMetricsReport.prototype.getDeviation = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 5, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.model.AnalysisMetricsReport} returns this
+ */
+proto.model.AnalysisMetricsReport.prototype.setDeviation = function(value) {
+  return jspb.Message.setProto3StringField(this, 5, value);
+};
+
+
+/**
+ * optional string expected = 6;
+ * @return {string}
+ */
+proto.model.AnalysisMetricsReport.prototype.getExpected = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 6, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.model.AnalysisMetricsReport} returns this
+ */
+proto.model.AnalysisMetricsReport.prototype.setExpected = function(value) {
+  return jspb.Message.setProto3StringField(this, 6, value);
+};
+
+
+/**
+ * optional double score = 7;
+ * @return {number}
+ */
+proto.model.AnalysisMetricsReport.prototype.getScore = function() {
+  return /** @type {number} */ (jspb.Message.getFloatingPointFieldWithDefault(this, 7, 0.0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.model.AnalysisMetricsReport} returns this
+ */
+proto.model.AnalysisMetricsReport.prototype.setScore = function(value) {
+  return jspb.Message.setProto3FloatField(this, 7, value);
+};
+
+
+/**
+ * repeated AnalysisEvaluation evaluations = 8;
+ * @return {!Array<!proto.model.AnalysisEvaluation>}
+ */
+proto.model.AnalysisMetricsReport.prototype.getEvaluationsList = function() {
+  return /** @type{!Array<!proto.model.AnalysisEvaluation>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.model.AnalysisEvaluation, 8));
+};
+
+
+/**
+ * @param {!Array<!proto.model.AnalysisEvaluation>} value
+ * @return {!proto.model.AnalysisMetricsReport} returns this
+*/
+proto.model.AnalysisMetricsReport.prototype.setEvaluationsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 8, value);
+};
+
+
+/**
+ * @param {!proto.model.AnalysisEvaluation=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.model.AnalysisEvaluation}
+ */
+proto.model.AnalysisMetricsReport.prototype.addEvaluations = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 8, opt_value, proto.model.AnalysisEvaluation, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.model.AnalysisMetricsReport} returns this
+ */
+proto.model.AnalysisMetricsReport.prototype.clearEvaluationsList = function() {
+  return this.setEvaluationsList([]);
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.model.AnalysisLogReport.repeatedFields_ = [7];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.model.AnalysisLogReport.prototype.toObject = function(opt_includeInstance) {
+  return proto.model.AnalysisLogReport.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.model.AnalysisLogReport} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.model.AnalysisLogReport.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    id: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    provider: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    strategy: jspb.Message.getFieldWithDefault(msg, 3, ""),
+    maxCount: jspb.Message.getFieldWithDefault(msg, 4, 0),
+    maxRatio: jspb.Message.getFloatingPointFieldWithDefault(msg, 5, 0.0),
+    score: jspb.Message.getFloatingPointFieldWithDefault(msg, 6, 0.0),
+    evaluationsList: jspb.Message.toObjectList(msg.getEvaluationsList(),
+    proto.model.AnalysisEvaluation.toObject, includeInstance)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.model.AnalysisLogReport}
+ */
+proto.model.AnalysisLogReport.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.model.AnalysisLogReport;
+  return proto.model.AnalysisLogReport.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.model.AnalysisLogReport} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.model.AnalysisLogReport}
+ */
+proto.model.AnalysisLogReport.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setId(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setProvider(value);
+      break;
+    case 3:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setStrategy(value);
+      break;
+    case 4:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setMaxCount(value);
+      break;
+    case 5:
+      var value = /** @type {number} */ (reader.readDouble());
+      msg.setMaxRatio(value);
+      break;
+    case 6:
+      var value = /** @type {number} */ (reader.readDouble());
+      msg.setScore(value);
+      break;
+    case 7:
+      var value = new proto.model.AnalysisEvaluation;
+      reader.readMessage(value,proto.model.AnalysisEvaluation.deserializeBinaryFromReader);
+      msg.addEvaluations(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.model.AnalysisLogReport.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.model.AnalysisLogReport.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.model.AnalysisLogReport} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.model.AnalysisLogReport.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getId();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getProvider();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getStrategy();
+  if (f.length > 0) {
+    writer.writeString(
+      3,
+      f
+    );
+  }
+  f = message.getMaxCount();
+  if (f !== 0) {
+    writer.writeInt64(
+      4,
+      f
+    );
+  }
+  f = message.getMaxRatio();
+  if (f !== 0.0) {
+    writer.writeDouble(
+      5,
+      f
+    );
+  }
+  f = message.getScore();
+  if (f !== 0.0) {
+    writer.writeDouble(
+      6,
+      f
+    );
+  }
+  f = message.getEvaluationsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      7,
+      f,
+      proto.model.AnalysisEvaluation.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * optional string id = 1;
+ * @return {string}
+ */
+proto.model.AnalysisLogReport.prototype.getId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.model.AnalysisLogReport} returns this
+ */
+proto.model.AnalysisLogReport.prototype.setId = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional string provider = 2;
+ * @return {string}
+ */
+proto.model.AnalysisLogReport.prototype.getProvider = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.model.AnalysisLogReport} returns this
+ */
+proto.model.AnalysisLogReport.prototype.setProvider = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+/**
+ * optional string strategy = 3;
+ * @return {string}
+ */
+proto.model.AnalysisLogReport.prototype.getStrategy = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.model.AnalysisLogReport} returns this
+ */
+proto.model.AnalysisLogReport.prototype.setStrategy = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
+};
+
+
+/**
+ * optional int64 max_count = 4;
+ * @return {number}
+ */
+proto.model.AnalysisLogReport.prototype.getMaxCount = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.model.AnalysisLogReport} returns this
+ */
+proto.model.AnalysisLogReport.prototype.setMaxCount = function(value) {
+  return jspb.Message.setProto3IntField(this, 4, value);
+};
+
+
+/**
+ * optional double max_ratio = 5;
+ * @return {number}
+ */
+proto.model.AnalysisLogReport.prototype.getMaxRatio = function() {
+  return /** @type {number} */ (jspb.Message.getFloatingPointFieldWithDefault(this, 5, 0.0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.model.AnalysisLogReport} returns this
+ */
+proto.model.AnalysisLogReport.prototype.setMaxRatio = function(value) {
+  return jspb.Message.setProto3FloatField(this, 5, value);
+};
+
+
+/**
+ * optional double score = 6;
+ * @return {number}
+ */
+proto.model.AnalysisLogReport.prototype.getScore = function() {
+  return /** @type {number} */ (jspb.Message.getFloatingPointFieldWithDefault(this, 6, 0.0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.model.AnalysisLogReport} returns this
+ */
+proto.model.AnalysisLogReport.prototype.setScore = function(value) {
+  return jspb.Message.setProto3FloatField(this, 6, value);
+};
+
+
+/**
+ * repeated AnalysisEvaluation evaluations = 7;
+ * @return {!Array<!proto.model.AnalysisEvaluation>}
+ */
+proto.model.AnalysisLogReport.prototype.getEvaluationsList = function() {
+  return /** @type{!Array<!proto.model.AnalysisEvaluation>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.model.AnalysisEvaluation, 7));
+};
+
+
+/**
+ * @param {!Array<!proto.model.AnalysisEvaluation>} value
+ * @return {!proto.model.AnalysisLogReport} returns this
+*/
+proto.model.AnalysisLogReport.prototype.setEvaluationsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 7, value);
+};
+
+
+/**
+ * @param {!proto.model.AnalysisEvaluation=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.model.AnalysisEvaluation}
+ */
+proto.model.AnalysisLogReport.prototype.addEvaluations = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 7, opt_value, proto.model.AnalysisEvaluation, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.model.AnalysisLogReport} returns this
+ */
+proto.model.AnalysisLogReport.prototype.clearEvaluationsList = function() {
+  return this.setEvaluationsList([]);
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.model.AnalysisHTTPReport.repeatedFields_ = [4];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.model.AnalysisHTTPReport.prototype.toObject = function(opt_includeInstance) {
+  return proto.model.AnalysisHTTPReport.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.model.AnalysisHTTPReport} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.model.AnalysisHTTPReport.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    id: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    provider: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    score: jspb.Message.getFloatingPointFieldWithDefault(msg, 3, 0.0),
+    evaluationsList: jspb.Message.toObjectList(msg.getEvaluationsList(),
+    proto.model.AnalysisEvaluation.toObject, includeInstance)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.model.AnalysisHTTPReport}
+ */
+proto.model.AnalysisHTTPReport.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.model.AnalysisHTTPReport;
+  return proto.model.AnalysisHTTPReport.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.model.AnalysisHTTPReport} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.model.AnalysisHTTPReport}
+ */
+proto.model.AnalysisHTTPReport.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setId(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setProvider(value);
+      break;
+    case 3:
+      var value = /** @type {number} */ (reader.readDouble());
+      msg.setScore(value);
+      break;
+    case 4:
+      var value = new proto.model.AnalysisEvaluation;
+      reader.readMessage(value,proto.model.AnalysisEvaluation.deserializeBinaryFromReader);
+      msg.addEvaluations(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.model.AnalysisHTTPReport.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.model.AnalysisHTTPReport.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.model.AnalysisHTTPReport} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.model.AnalysisHTTPReport.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getId();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getProvider();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getScore();
+  if (f !== 0.0) {
+    writer.writeDouble(
+      3,
+      f
+    );
+  }
+  f = message.getEvaluationsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      4,
+      f,
+      proto.model.AnalysisEvaluation.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * optional string id = 1;
+ * @return {string}
+ */
+proto.model.AnalysisHTTPReport.prototype.getId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.model.AnalysisHTTPReport} returns this
+ */
+proto.model.AnalysisHTTPReport.prototype.setId = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional string provider = 2;
+ * @return {string}
+ */
+proto.model.AnalysisHTTPReport.prototype.getProvider = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.model.AnalysisHTTPReport} returns this
+ */
+proto.model.AnalysisHTTPReport.prototype.setProvider = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+/**
+ * optional double score = 3;
+ * @return {number}
+ */
+proto.model.AnalysisHTTPReport.prototype.getScore = function() {
+  return /** @type {number} */ (jspb.Message.getFloatingPointFieldWithDefault(this, 3, 0.0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.model.AnalysisHTTPReport} returns this
+ */
+proto.model.AnalysisHTTPReport.prototype.setScore = function(value) {
+  return jspb.Message.setProto3FloatField(this, 3, value);
+};
+
+
+/**
+ * repeated AnalysisEvaluation evaluations = 4;
+ * @return {!Array<!proto.model.AnalysisEvaluation>}
+ */
+proto.model.AnalysisHTTPReport.prototype.getEvaluationsList = function() {
+  return /** @type{!Array<!proto.model.AnalysisEvaluation>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.model.AnalysisEvaluation, 4));
+};
+
+
+/**
+ * @param {!Array<!proto.model.AnalysisEvaluation>} value
+ * @return {!proto.model.AnalysisHTTPReport} returns this
+*/
+proto.model.AnalysisHTTPReport.prototype.setEvaluationsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 4, value);
+};
+
+
+/**
+ * @param {!proto.model.AnalysisEvaluation=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.model.AnalysisEvaluation}
+ */
+proto.model.AnalysisHTTPReport.prototype.addEvaluations = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 4, opt_value, proto.model.AnalysisEvaluation, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.model.AnalysisHTTPReport} returns this
+ */
+proto.model.AnalysisHTTPReport.prototype.clearEvaluationsList = function() {
+  return this.setEvaluationsList([]);
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.model.AnalysisEvaluation.repeatedFields_ = [4];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.model.AnalysisEvaluation.prototype.toObject = function(opt_includeInstance) {
+  return proto.model.AnalysisEvaluation.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.model.AnalysisEvaluation} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.model.AnalysisEvaluation.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    evaluatedAt: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    verdict: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    reason: jspb.Message.getFieldWithDefault(msg, 3, ""),
+    queriesList: jspb.Message.toObjectList(msg.getQueriesList(),
+    proto.model.AnalysisQuery.toObject, includeInstance)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.model.AnalysisEvaluation}
+ */
+proto.model.AnalysisEvaluation.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.model.AnalysisEvaluation;
+  return proto.model.AnalysisEvaluation.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.model.AnalysisEvaluation} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.model.AnalysisEvaluation}
+ */
+proto.model.AnalysisEvaluation.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setEvaluatedAt(value);
+      break;
+    case 2:
+      var value = /** @type {!proto.model.AnalysisVerdict} */ (reader.readEnum());
+      msg.setVerdict(value);
+      break;
+    case 3:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setReason(value);
+      break;
+    case 4:
+      var value = new proto.model.AnalysisQuery;
+      reader.readMessage(value,proto.model.AnalysisQuery.deserializeBinaryFromReader);
+      msg.addQueries(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.model.AnalysisEvaluation.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.model.AnalysisEvaluation.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.model.AnalysisEvaluation} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.model.AnalysisEvaluation.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getEvaluatedAt();
+  if (f !== 0) {
+    writer.writeInt64(
+      1,
+      f
+    );
+  }
+  f = message.getVerdict();
+  if (f !== 0.0) {
+    writer.writeEnum(
+      2,
+      f
+    );
+  }
+  f = message.getReason();
+  if (f.length > 0) {
+    writer.writeString(
+      3,
+      f
+    );
+  }
+  f = message.getQueriesList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      4,
+      f,
+      proto.model.AnalysisQuery.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * optional int64 evaluated_at = 1;
+ * @return {number}
+ */
+proto.model.AnalysisEvaluation.prototype.getEvaluatedAt = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.model.AnalysisEvaluation} returns this
+ */
+proto.model.AnalysisEvaluation.prototype.setEvaluatedAt = function(value) {
+  return jspb.Message.setProto3IntField(this, 1, value);
+};
+
+
+/**
+ * optional AnalysisVerdict verdict = 2;
+ * @return {!proto.model.AnalysisVerdict}
+ */
+proto.model.AnalysisEvaluation.prototype.getVerdict = function() {
+  return /** @type {!proto.model.AnalysisVerdict} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+};
+
+
+/**
+ * @param {!proto.model.AnalysisVerdict} value
+ * @return {!proto.model.AnalysisEvaluation} returns this
+ */
+proto.model.AnalysisEvaluation.prototype.setVerdict = function(value) {
+  return jspb.Message.setProto3EnumField(this, 2, value);
+};
+
+
+/**
+ * optional string reason = 3;
+ * @return {string}
+ */
+proto.model.AnalysisEvaluation.prototype.getReason = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.model.AnalysisEvaluation} returns this
+ */
+proto.model.AnalysisEvaluation.prototype.setReason = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
+};
+
+
+/**
+ * repeated AnalysisQuery queries = 4;
+ * @return {!Array<!proto.model.AnalysisQuery>}
+ */
+proto.model.AnalysisEvaluation.prototype.getQueriesList = function() {
+  return /** @type{!Array<!proto.model.AnalysisQuery>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.model.AnalysisQuery, 4));
+};
+
+
+/**
+ * @param {!Array<!proto.model.AnalysisQuery>} value
+ * @return {!proto.model.AnalysisEvaluation} returns this
+*/
+proto.model.AnalysisEvaluation.prototype.setQueriesList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 4, value);
+};
+
+
+/**
+ * @param {!proto.model.AnalysisQuery=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.model.AnalysisQuery}
+ */
+proto.model.AnalysisEvaluation.prototype.addQueries = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 4, opt_value, proto.model.AnalysisQuery, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.model.AnalysisEvaluation} returns this
+ */
+proto.model.AnalysisEvaluation.prototype.clearQueriesList = function() {
+  return this.setQueriesList([]);
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.model.AnalysisQuery.repeatedFields_ = [5];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.model.AnalysisQuery.prototype.toObject = function(opt_includeInstance) {
+  return proto.model.AnalysisQuery.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.model.AnalysisQuery} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.model.AnalysisQuery.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    variant: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    query: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    from: jspb.Message.getFieldWithDefault(msg, 3, 0),
+    to: jspb.Message.getFieldWithDefault(msg, 4, 0),
+    dataPointsList: jspb.Message.toObjectList(msg.getDataPointsList(),
+    proto.model.AnalysisDataPoint.toObject, includeInstance),
+    logEntryCount: jspb.Message.getFieldWithDefault(msg, 6, 0)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.model.AnalysisQuery}
+ */
+proto.model.AnalysisQuery.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.model.AnalysisQuery;
+  return proto.model.AnalysisQuery.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.model.AnalysisQuery} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.model.AnalysisQuery}
+ */
+proto.model.AnalysisQuery.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setVariant(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setQuery(value);
+      break;
+    case 3:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setFrom(value);
+      break;
+    case 4:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setTo(value);
+      break;
+    case 5:
+      var value = new proto.model.AnalysisDataPoint;
+      reader.readMessage(value,proto.model.AnalysisDataPoint.deserializeBinaryFromReader);
+      msg.addDataPoints(value);
+      break;
+    case 6:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setLogEntryCount(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.model.AnalysisQuery.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.model.AnalysisQuery.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.model.AnalysisQuery} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.model.AnalysisQuery.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getVariant();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getQuery();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getFrom();
+  if (f !== 0) {
+    writer.writeInt64(
+      3,
+      f
+    );
+  }
+  f = message.getTo();
+  if (f !== 0) {
+    writer.writeInt64(
+      4,
+      f
+    );
+  }
+  f = message.getDataPointsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      5,
+      f,
+      proto.model.AnalysisDataPoint.serializeBinaryToWriter
+    );
+  }
+  f = message.getLogEntryCount();
+  if (f !== 0) {
+    writer.writeInt64(
+      6,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string variant = 1;
+ * @return {string}
+ */
+proto.model.AnalysisQuery.prototype.getVariant = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.model.AnalysisQuery} returns this
+ */
+proto.model.AnalysisQuery.prototype.setVariant = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional string query = 2;
+ * @return {string}
+ */
+proto.model.AnalysisQuery.prototype.getQuery = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.model.AnalysisQuery} returns this
+ */
+proto.model.AnalysisQuery.prototype.setQuery = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+/**
+ * optional int64 from = 3;
+ * @return {number}
+ */
+proto.model.AnalysisQuery.prototype.getFrom = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.model.AnalysisQuery} returns this
+ */
+proto.model.AnalysisQuery.prototype.setFrom = function(value) {
+  return jspb.Message.setProto3IntField(this, 3, value);
+};
+
+
+/**
+ * optional int64 to = 4;
+ * @return {number}
+ */
+proto.model.AnalysisQuery.prototype.getTo = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.model.AnalysisQuery} returns this
+ */
+proto.model.AnalysisQuery.prototype.setTo = function(value) {
+  return jspb.Message.setProto3IntField(this, 4, value);
+};
+
+
+/**
+ * repeated AnalysisDataPoint data_points = 5;
+ * @return {!Array<!proto.model.AnalysisDataPoint>}
+ */
+proto.model.AnalysisQuery.prototype.getDataPointsList = function() {
+  return /** @type{!Array<!proto.model.AnalysisDataPoint>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.model.AnalysisDataPoint, 5));
+};
+
+
+/**
+ * @param {!Array<!proto.model.AnalysisDataPoint>} value
+ * @return {!proto.model.AnalysisQuery} returns this
+*/
+proto.model.AnalysisQuery.prototype.setDataPointsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 5, value);
+};
+
+
+/**
+ * @param {!proto.model.AnalysisDataPoint=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.model.AnalysisDataPoint}
+ */
+proto.model.AnalysisQuery.prototype.addDataPoints = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 5, opt_value, proto.model.AnalysisDataPoint, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.model.AnalysisQuery} returns this
+ */
+proto.model.AnalysisQuery.prototype.clearDataPointsList = function() {
+  return this.setDataPointsList([]);
+};
+
+
+/**
+ * optional int64 log_entry_count = 6;
+ * @return {number}
+ */
+proto.model.AnalysisQuery.prototype.getLogEntryCount = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 6, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.model.AnalysisQuery} returns this
+ */
+proto.model.AnalysisQuery.prototype.setLogEntryCount = function(value) {
+  return jspb.Message.setProto3IntField(this, 6, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.model.AnalysisDataPoint.prototype.toObject = function(opt_includeInstance) {
+  return proto.model.AnalysisDataPoint.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.model.AnalysisDataPoint} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.model.AnalysisDataPoint.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    timestamp: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    value: jspb.Message.getFloatingPointFieldWithDefault(msg, 2, 0.0)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.model.AnalysisDataPoint}
+ */
+proto.model.AnalysisDataPoint.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.model.AnalysisDataPoint;
+  return proto.model.AnalysisDataPoint.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.model.AnalysisDataPoint} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.model.AnalysisDataPoint}
+ */
+proto.model.AnalysisDataPoint.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setTimestamp(value);
+      break;
+    case 2:
+      var value = /** @type {number} */ (reader.readDouble());
+      msg.setValue(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.model.AnalysisDataPoint.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.model.AnalysisDataPoint.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.model.AnalysisDataPoint} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.model.AnalysisDataPoint.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getTimestamp();
+  if (f !== 0) {
+    writer.writeInt64(
+      1,
+      f
+    );
+  }
+  f = message.getValue();
+  if (f !== 0.0) {
+    writer.writeDouble(
+      2,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional int64 timestamp = 1;
+ * @return {number}
+ */
+proto.model.AnalysisDataPoint.prototype.getTimestamp = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.model.AnalysisDataPoint} returns this
+ */
+proto.model.AnalysisDataPoint.prototype.setTimestamp = function(value) {
+  return jspb.Message.setProto3IntField(this, 1, value);
+};
+
+
+/**
+ * optional double value = 2;
+ * @return {number}
+ */
+proto.model.AnalysisDataPoint.prototype.getValue = function() {
+  return /** @type {number} */ (jspb.Message.getFloatingPointFieldWithDefault(this, 2, 0.0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.model.AnalysisDataPoint} returns this
+ */
+proto.model.AnalysisDataPoint.prototype.setValue = function(value) {
+  return jspb.Message.setProto3FloatField(this, 2, value);
+};
+
+
+/**
+ * @enum {number}
+ */
+proto.model.AnalysisVerdict = {
+  VERDICT_UNKNOWN: 0,
+  VERDICT_PASSED: 1,
+  VERDICT_FAILED: 2,
+  VERDICT_SKIPPED: 3
+};
+
 goog.object.extend(exports, proto.model);
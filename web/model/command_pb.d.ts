@@ -78,6 +78,11 @@ export class Command extends jspb.Message {
   hasRestartPiped(): boolean;
   clearRestartPiped(): Command;
 
+  getRejectStage(): Command.RejectStage | undefined;
+  setRejectStage(value?: Command.RejectStage): Command;
+  hasRejectStage(): boolean;
+  clearRejectStage(): Command;
+
   getCreatedAt(): number;
   setCreatedAt(value: number): Command;
 
@@ -113,6 +118,7 @@ export namespace Command {
     chainSyncApplication?: Command.ChainSyncApplication.AsObject,
     skipStage?: Command.SkipStage.AsObject,
     restartPiped?: Command.RestartPiped.AsObject,
+    rejectStage?: Command.RejectStage.AsObject,
     createdAt: number,
     updatedAt: number,
   }
@@ -124,6 +130,9 @@ export namespace Command {
     getSyncStrategy(): pkg_model_common_pb.SyncStrategy;
     setSyncStrategy(value: pkg_model_common_pb.SyncStrategy): SyncApplication;
 
+    getOverrideDeploymentWindows(): boolean;
+    setOverrideDeploymentWindows(value: boolean): SyncApplication;
+
     serializeBinary(): Uint8Array;
     toObject(includeInstance?: boolean): SyncApplication.AsObject;
     static toObject(includeInstance: boolean, msg: SyncApplication): SyncApplication.AsObject;
@@ -136,6 +145,7 @@ export namespace Command {
     export type AsObject = {
       applicationId: string,
       syncStrategy: pkg_model_common_pb.SyncStrategy,
+      overrideDeploymentWindows: boolean,
     }
   }
 
@@ -325,6 +335,33 @@ export namespace Command {
   }
 
 
+  export class RejectStage extends jspb.Message {
+    getDeploymentId(): string;
+    setDeploymentId(value: string): RejectStage;
+
+    getStageId(): string;
+    setStageId(value: string): RejectStage;
+
+    getComment(): string;
+    setComment(value: string): RejectStage;
+
+    serializeBinary(): Uint8Array;
+    toObject(includeInstance?: boolean): RejectStage.AsObject;
+    static toObject(includeInstance: boolean, msg: RejectStage): RejectStage.AsObject;
+    static serializeBinaryToWriter(message: RejectStage, writer: jspb.BinaryWriter): void;
+    static deserializeBinary(bytes: Uint8Array): RejectStage;
+    static deserializeBinaryFromReader(message: RejectStage, reader: jspb.BinaryReader): RejectStage;
+  }
+
+  export namespace RejectStage {
+    export type AsObject = {
+      deploymentId: string,
+      stageId: string,
+      comment: string,
+    }
+  }
+
+
   export enum Type { 
     SYNC_APPLICATION = 0,
     UPDATE_APPLICATION_CONFIG = 1,
@@ -334,6 +371,7 @@ export namespace Command {
     CHAIN_SYNC_APPLICATION = 5,
     SKIP_STAGE = 6,
     RESTART_PIPED = 7,
+    REJECT_STAGE = 8,
   }
 }
 
@@ -30,6 +30,7 @@ goog.exportSymbol('proto.model.Command.ApproveStage', null, global);
 goog.exportSymbol('proto.model.Command.BuildPlanPreview', null, global);
 goog.exportSymbol('proto.model.Command.CancelDeployment', null, global);
 goog.exportSymbol('proto.model.Command.ChainSyncApplication', null, global);
+goog.exportSymbol('proto.model.Command.RejectStage', null, global);
 goog.exportSymbol('proto.model.Command.RestartPiped', null, global);
 goog.exportSymbol('proto.model.Command.SkipStage', null, global);
 goog.exportSymbol('proto.model.Command.SyncApplication', null, global);
@@ -225,6 +226,27 @@ if (goog.DEBUG && !COMPILED) {
    */
   proto.model.Command.RestartPiped.displayName = 'proto.model.Command.RestartPiped';
 }
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.model.Command.RejectStage = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.model.Command.RejectStage, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.model.Command.RejectStage.displayName = 'proto.model.Command.RejectStage';
+}
 
 
 
@@ -276,6 +298,7 @@ proto.model.Command.toObject = function(includeInstance, msg) {
     chainSyncApplication: (f = msg.getChainSyncApplication()) && proto.model.Command.ChainSyncApplication.toObject(includeInstance, f),
     skipStage: (f = msg.getSkipStage()) && proto.model.Command.SkipStage.toObject(includeInstance, f),
     restartPiped: (f = msg.getRestartPiped()) && proto.model.Command.RestartPiped.toObject(includeInstance, f),
+    rejectStage: (f = msg.getRejectStage()) && proto.model.Command.RejectStage.toObject(includeInstance, f),
     createdAt: jspb.Message.getFieldWithDefault(msg, 100, 0),
     updatedAt: jspb.Message.getFieldWithDefault(msg, 101, 0)
   };
@@ -400,6 +423,11 @@ proto.model.Command.deserializeBinaryFromReader = function(msg, reader) {
       reader.readMessage(value,proto.model.Command.RestartPiped.deserializeBinaryFromReader);
       msg.setRestartPiped(value);
       break;
+    case 39:
+      var value = new proto.model.Command.RejectStage;
+      reader.readMessage(value,proto.model.Command.RejectStage.deserializeBinaryFromReader);
+      msg.setRejectStage(value);
+      break;
     case 100:
       var value = /** @type {number} */ (reader.readInt64());
       msg.setCreatedAt(value);
@@ -575,6 +603,14 @@ proto.model.Command.serializeBinaryToWriter = function(message, writer) {
       proto.model.Command.RestartPiped.serializeBinaryToWriter
     );
   }
+  f = message.getRejectStage();
+  if (f != null) {
+    writer.writeMessage(
+      39,
+      f,
+      proto.model.Command.RejectStage.serializeBinaryToWriter
+    );
+  }
   f = message.getCreatedAt();
   if (f !== 0) {
     writer.writeInt64(
@@ -603,7 +639,8 @@ proto.model.Command.Type = {
   BUILD_PLAN_PREVIEW: 4,
   CHAIN_SYNC_APPLICATION: 5,
   SKIP_STAGE: 6,
-  RESTART_PIPED: 7
+  RESTART_PIPED: 7,
+  REJECT_STAGE: 8
 };
 
 
@@ -639,7 +676,8 @@ proto.model.Command.SyncApplication.prototype.toObject = function(opt_includeIns
 proto.model.Command.SyncApplication.toObject = function(includeInstance, msg) {
   var f, obj = {
     applicationId: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    syncStrategy: jspb.Message.getFieldWithDefault(msg, 2, 0)
+    syncStrategy: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    overrideDeploymentWindows: jspb.Message.getBooleanFieldWithDefault(msg, 3, false)
   };
 
   if (includeInstance) {
@@ -684,6 +722,10 @@ proto.model.Command.SyncApplication.deserializeBinaryFromReader = function(msg,
       var value = /** @type {!proto.model.SyncStrategy} */ (reader.readEnum());
       msg.setSyncStrategy(value);
       break;
+    case 3:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setOverrideDeploymentWindows(value);
+      break;
     default:
       reader.skipField();
       break;
@@ -727,6 +769,13 @@ proto.model.Command.SyncApplication.serializeBinaryToWriter = function(message,
       f
     );
   }
+  f = message.getOverrideDeploymentWindows();
+  if (f) {
+    writer.writeBool(
+      3,
+      f
+    );
+  }
 };
 
 
@@ -766,6 +815,24 @@ proto.model.Command.SyncApplication.prototype.setSyncStrategy = function(value)
 };
 
 
+/**
+ * optional bool override_deployment_windows = 3;
+ * @return {boolean}
+ */
+proto.model.Command.SyncApplication.prototype.getOverrideDeploymentWindows = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 3, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.model.Command.SyncApplication} returns this
+ */
+proto.model.Command.SyncApplication.prototype.setOverrideDeploymentWindows = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 3, value);
+};
+
+
 
 
 
@@ -2066,6 +2133,196 @@ proto.model.Command.RestartPiped.prototype.setPipedId = function(value) {
 };
 
 
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.model.Command.RejectStage.prototype.toObject = function(opt_includeInstance) {
+  return proto.model.Command.RejectStage.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.model.Command.RejectStage} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.model.Command.RejectStage.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    deploymentId: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    stageId: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    comment: jspb.Message.getFieldWithDefault(msg, 3, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.model.Command.RejectStage}
+ */
+proto.model.Command.RejectStage.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.model.Command.RejectStage;
+  return proto.model.Command.RejectStage.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.model.Command.RejectStage} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.model.Command.RejectStage}
+ */
+proto.model.Command.RejectStage.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setDeploymentId(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setStageId(value);
+      break;
+    case 3:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setComment(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.model.Command.RejectStage.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.model.Command.RejectStage.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.model.Command.RejectStage} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.model.Command.RejectStage.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getDeploymentId();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getStageId();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getComment();
+  if (f.length > 0) {
+    writer.writeString(
+      3,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string deployment_id = 1;
+ * @return {string}
+ */
+proto.model.Command.RejectStage.prototype.getDeploymentId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.model.Command.RejectStage} returns this
+ */
+proto.model.Command.RejectStage.prototype.setDeploymentId = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional string stage_id = 2;
+ * @return {string}
+ */
+proto.model.Command.RejectStage.prototype.getStageId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.model.Command.RejectStage} returns this
+ */
+proto.model.Command.RejectStage.prototype.setStageId = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+/**
+ * optional string comment = 3;
+ * @return {string}
+ */
+proto.model.Command.RejectStage.prototype.getComment = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.model.Command.RejectStage} returns this
+ */
+proto.model.Command.RejectStage.prototype.setComment = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
+};
+
+
 /**
  * optional string id = 1;
  * @return {string}
@@ -2565,6 +2822,43 @@ proto.model.Command.prototype.hasRestartPiped = function() {
 };
 
 
+/**
+ * optional RejectStage reject_stage = 39;
+ * @return {?proto.model.Command.RejectStage}
+ */
+proto.model.Command.prototype.getRejectStage = function() {
+  return /** @type{?proto.model.Command.RejectStage} */ (
+    jspb.Message.getWrapperField(this, proto.model.Command.RejectStage, 39));
+};
+
+
+/**
+ * @param {?proto.model.Command.RejectStage|undefined} value
+ * @return {!proto.model.Command} returns this
+*/
+proto.model.Command.prototype.setRejectStage = function(value) {
+  return jspb.Message.setWrapperField(this, 39, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.model.Command} returns this
+ */
+proto.model.Command.prototype.clearRejectStage = function() {
+  return this.setRejectStage(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.model.Command.prototype.hasRejectStage = function() {
+  return jspb.Message.getField(this, 39) != null;
+};
+
+
 /**
  * optional int64 created_at = 100;
  * @return {number}
@@ -178,6 +178,9 @@ export class DeploymentTrigger extends jspb.Message {
   getStrategySummary(): string;
   setStrategySummary(value: string): DeploymentTrigger;
 
+  getOverrideDeploymentWindows(): boolean;
+  setOverrideDeploymentWindows(value: boolean): DeploymentTrigger;
+
   serializeBinary(): Uint8Array;
   toObject(includeInstance?: boolean): DeploymentTrigger.AsObject;
   static toObject(includeInstance: boolean, msg: DeploymentTrigger): DeploymentTrigger.AsObject;
@@ -193,6 +196,7 @@ export namespace DeploymentTrigger {
     timestamp: number,
     syncStrategy: pkg_model_common_pb.SyncStrategy,
     strategySummary: string,
+    overrideDeploymentWindows: boolean,
   }
 }
 
@@ -384,6 +388,7 @@ export enum TriggerKind {
   ON_COMMAND = 1,
   ON_OUT_OF_SYNC = 2,
   ON_CHAIN = 3,
+  ON_SCHEDULE = 4,
 }
 export enum ManualOperation { 
   MANUAL_OPERATION_UNKNOWN = 0,
@@ -1443,7 +1443,8 @@ proto.model.DeploymentTrigger.toObject = function(includeInstance, msg) {
     commander: jspb.Message.getFieldWithDefault(msg, 2, ""),
     timestamp: jspb.Message.getFieldWithDefault(msg, 3, 0),
     syncStrategy: jspb.Message.getFieldWithDefault(msg, 4, 0),
-    strategySummary: jspb.Message.getFieldWithDefault(msg, 5, "")
+    strategySummary: jspb.Message.getFieldWithDefault(msg, 5, ""),
+    overrideDeploymentWindows: jspb.Message.getBooleanFieldWithDefault(msg, 6, false)
   };
 
   if (includeInstance) {
@@ -1501,6 +1502,10 @@ proto.model.DeploymentTrigger.deserializeBinaryFromReader = function(msg, reader
       var value = /** @type {string} */ (reader.readString());
       msg.setStrategySummary(value);
       break;
+    case 6:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setOverrideDeploymentWindows(value);
+      break;
     default:
       reader.skipField();
       break;
@@ -1566,6 +1571,13 @@ proto.model.DeploymentTrigger.serializeBinaryToWriter = function(message, writer
       f
     );
   }
+  f = message.getOverrideDeploymentWindows();
+  if (f) {
+    writer.writeBool(
+      6,
+      f
+    );
+  }
 };
 
 
@@ -1678,6 +1690,24 @@ proto.model.DeploymentTrigger.prototype.setStrategySummary = function(value) {
 };
 
 
+/**
+ * optional bool override_deployment_windows = 6;
+ * @return {boolean}
+ */
+proto.model.DeploymentTrigger.prototype.getOverrideDeploymentWindows = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 6, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.model.DeploymentTrigger} returns this
+ */
+proto.model.DeploymentTrigger.prototype.setOverrideDeploymentWindows = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 6, value);
+};
+
+
 
 /**
  * List of repeated fields within this message type.
@@ -2950,7 +2980,8 @@ proto.model.TriggerKind = {
   ON_COMMIT: 0,
   ON_COMMAND: 1,
   ON_OUT_OF_SYNC: 2,
-  ON_CHAIN: 3
+  ON_CHAIN: 3,
+  ON_SCHEDULE: 4
 };
 
 /**
@@ -34,6 +34,9 @@ export class Event extends jspb.Message {
   getTriggerCommitHash(): string;
   setTriggerCommitHash(value: string): Event;
 
+  getPullRequestUrl(): string;
+  setPullRequestUrl(value: string): Event;
+
   getHandledAt(): number;
   setHandledAt(value: number): Event;
 
@@ -63,6 +66,7 @@ export namespace Event {
     statusDescription: string,
     contextsMap: Array<[string, string]>,
     triggerCommitHash: string,
+    pullRequestUrl: string,
     handledAt: number,
     createdAt: number,
     updatedAt: number,
@@ -88,6 +88,7 @@ proto.model.Event.toObject = function(includeInstance, msg) {
     statusDescription: jspb.Message.getFieldWithDefault(msg, 9, ""),
     contextsMap: (f = msg.getContextsMap()) ? f.toObject(includeInstance, undefined) : [],
     triggerCommitHash: jspb.Message.getFieldWithDefault(msg, 11, ""),
+    pullRequestUrl: jspb.Message.getFieldWithDefault(msg, 16, ""),
     handledAt: jspb.Message.getFieldWithDefault(msg, 13, 0),
     createdAt: jspb.Message.getFieldWithDefault(msg, 14, 0),
     updatedAt: jspb.Message.getFieldWithDefault(msg, 15, 0)
@@ -171,6 +172,10 @@ proto.model.Event.deserializeBinaryFromReader = function(msg, reader) {
       var value = /** @type {string} */ (reader.readString());
       msg.setTriggerCommitHash(value);
       break;
+    case 16:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setPullRequestUrl(value);
+      break;
     case 13:
       var value = /** @type {number} */ (reader.readInt64());
       msg.setHandledAt(value);
@@ -276,6 +281,13 @@ proto.model.Event.serializeBinaryToWriter = function(message, writer) {
       f
     );
   }
+  f = message.getPullRequestUrl();
+  if (f.length > 0) {
+    writer.writeString(
+      16,
+      f
+    );
+  }
   f = message.getHandledAt();
   if (f !== 0) {
     writer.writeInt64(
@@ -490,6 +502,24 @@ proto.model.Event.prototype.setTriggerCommitHash = function(value) {
 };
 
 
+/**
+ * optional string pull_request_url = 16;
+ * @return {string}
+ */
+proto.model.Event.prototype.getPullRequestUrl = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 16, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.model.Event} returns this
+ */
+proto.model.Event.prototype.setPullRequestUrl = function(value) {
+  return jspb.Message.setProto3StringField(this, 16, value);
+};
+
+
 /**
  * optional int64 handled_at = 13;
  * @return {number}
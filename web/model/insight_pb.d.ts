@@ -101,6 +101,7 @@ export enum InsightMetricsKind {
   MTTR = 2,
   LEAD_TIME = 3,
   APPLICATIONS_COUNT = 4,
+  DEPLOYMENT_DURATION = 5,
 }
 export enum InsightResultType { 
   MATRIX = 0,
@@ -109,6 +110,17 @@ export enum InsightResultType {
 export enum InsightResolution { 
   DAILY = 0,
   MONTHLY = 1,
+  HOURLY = 2,
+  WEEKLY = 3,
+}
+export enum InsightAggregation { 
+  AVERAGE = 0,
+  P50 = 1,
+  P90 = 2,
+  P99 = 3,
+}
+export enum InsightSampleStreamLabelKey { 
+  AGGREGATION = 0,
 }
 export enum InsightApplicationCountLabelKey { 
   KIND = 0,
@@ -23,6 +23,7 @@ var global =
 
 
 
+goog.exportSymbol('proto.model.InsightAggregation', null, global);
 goog.exportSymbol('proto.model.InsightApplicationCount', null, global);
 goog.exportSymbol('proto.model.InsightApplicationCountLabelKey', null, global);
 goog.exportSymbol('proto.model.InsightDataPoint', null, global);
@@ -31,6 +32,7 @@ goog.exportSymbol('proto.model.InsightResolution', null, global);
 goog.exportSymbol('proto.model.InsightResultType', null, global);
 goog.exportSymbol('proto.model.InsightSample', null, global);
 goog.exportSymbol('proto.model.InsightSampleStream', null, global);
+goog.exportSymbol('proto.model.InsightSampleStreamLabelKey', null, global);
 /**
  * Generated by JsPbCodeGenerator.
  * @param {Array=} opt_data Optional initial data array, typically from a
@@ -826,7 +828,8 @@ proto.model.InsightMetricsKind = {
   CHANGE_FAILURE_RATE: 1,
   MTTR: 2,
   LEAD_TIME: 3,
-  APPLICATIONS_COUNT: 4
+  APPLICATIONS_COUNT: 4,
+  DEPLOYMENT_DURATION: 5
 };
 
 /**
@@ -842,7 +845,26 @@ proto.model.InsightResultType = {
  */
 proto.model.InsightResolution = {
   DAILY: 0,
-  MONTHLY: 1
+  MONTHLY: 1,
+  HOURLY: 2,
+  WEEKLY: 3
+};
+
+/**
+ * @enum {number}
+ */
+proto.model.InsightAggregation = {
+  AVERAGE: 0,
+  P50: 1,
+  P90: 2,
+  P99: 3
+};
+
+/**
+ * @enum {number}
+ */
+proto.model.InsightSampleStreamLabelKey = {
+  AGGREGATION: 0
 };
 
 /**
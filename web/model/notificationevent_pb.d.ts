@@ -141,6 +141,86 @@ export namespace NotificationEventDeploymentApproved {
   }
 }
 
+export class NotificationEventDeploymentRejected extends jspb.Message {
+  getDeployment(): pkg_model_deployment_pb.Deployment | undefined;
+  setDeployment(value?: pkg_model_deployment_pb.Deployment): NotificationEventDeploymentRejected;
+  hasDeployment(): boolean;
+  clearDeployment(): NotificationEventDeploymentRejected;
+
+  getStageId(): string;
+  setStageId(value: string): NotificationEventDeploymentRejected;
+
+  getRejecter(): string;
+  setRejecter(value: string): NotificationEventDeploymentRejected;
+
+  getComment(): string;
+  setComment(value: string): NotificationEventDeploymentRejected;
+
+  getMentionedAccountsList(): Array<string>;
+  setMentionedAccountsList(value: Array<string>): NotificationEventDeploymentRejected;
+  clearMentionedAccountsList(): NotificationEventDeploymentRejected;
+  addMentionedAccounts(value: string, index?: number): NotificationEventDeploymentRejected;
+
+  getMentionedGroupsList(): Array<string>;
+  setMentionedGroupsList(value: Array<string>): NotificationEventDeploymentRejected;
+  clearMentionedGroupsList(): NotificationEventDeploymentRejected;
+  addMentionedGroups(value: string, index?: number): NotificationEventDeploymentRejected;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): NotificationEventDeploymentRejected.AsObject;
+  static toObject(includeInstance: boolean, msg: NotificationEventDeploymentRejected): NotificationEventDeploymentRejected.AsObject;
+  static serializeBinaryToWriter(message: NotificationEventDeploymentRejected, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): NotificationEventDeploymentRejected;
+  static deserializeBinaryFromReader(message: NotificationEventDeploymentRejected, reader: jspb.BinaryReader): NotificationEventDeploymentRejected;
+}
+
+export namespace NotificationEventDeploymentRejected {
+  export type AsObject = {
+    deployment?: pkg_model_deployment_pb.Deployment.AsObject,
+    stageId: string,
+    rejecter: string,
+    comment: string,
+    mentionedAccountsList: Array<string>,
+    mentionedGroupsList: Array<string>,
+  }
+}
+
+export class NotificationEventDeploymentBlocked extends jspb.Message {
+  getDeployment(): pkg_model_deployment_pb.Deployment | undefined;
+  setDeployment(value?: pkg_model_deployment_pb.Deployment): NotificationEventDeploymentBlocked;
+  hasDeployment(): boolean;
+  clearDeployment(): NotificationEventDeploymentBlocked;
+
+  getReason(): string;
+  setReason(value: string): NotificationEventDeploymentBlocked;
+
+  getMentionedAccountsList(): Array<string>;
+  setMentionedAccountsList(value: Array<string>): NotificationEventDeploymentBlocked;
+  clearMentionedAccountsList(): NotificationEventDeploymentBlocked;
+  addMentionedAccounts(value: string, index?: number): NotificationEventDeploymentBlocked;
+
+  getMentionedGroupsList(): Array<string>;
+  setMentionedGroupsList(value: Array<string>): NotificationEventDeploymentBlocked;
+  clearMentionedGroupsList(): NotificationEventDeploymentBlocked;
+  addMentionedGroups(value: string, index?: number): NotificationEventDeploymentBlocked;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): NotificationEventDeploymentBlocked.AsObject;
+  static toObject(includeInstance: boolean, msg: NotificationEventDeploymentBlocked): NotificationEventDeploymentBlocked.AsObject;
+  static serializeBinaryToWriter(message: NotificationEventDeploymentBlocked, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): NotificationEventDeploymentBlocked;
+  static deserializeBinaryFromReader(message: NotificationEventDeploymentBlocked, reader: jspb.BinaryReader): NotificationEventDeploymentBlocked;
+}
+
+export namespace NotificationEventDeploymentBlocked {
+  export type AsObject = {
+    deployment?: pkg_model_deployment_pb.Deployment.AsObject,
+    reason: string,
+    mentionedAccountsList: Array<string>,
+    mentionedGroupsList: Array<string>,
+  }
+}
+
 export class NotificationEventDeploymentRollingBack extends jspb.Message {
   getDeployment(): pkg_model_deployment_pb.Deployment | undefined;
   setDeployment(value?: pkg_model_deployment_pb.Deployment): NotificationEventDeploymentRollingBack;
@@ -281,6 +361,9 @@ export class NotificationEventDeploymentWaitApproval extends jspb.Message {
   clearMentionedGroupsList(): NotificationEventDeploymentWaitApproval;
   addMentionedGroups(value: string, index?: number): NotificationEventDeploymentWaitApproval;
 
+  getStageId(): string;
+  setStageId(value: string): NotificationEventDeploymentWaitApproval;
+
   serializeBinary(): Uint8Array;
   toObject(includeInstance?: boolean): NotificationEventDeploymentWaitApproval.AsObject;
   static toObject(includeInstance: boolean, msg: NotificationEventDeploymentWaitApproval): NotificationEventDeploymentWaitApproval.AsObject;
@@ -294,6 +377,7 @@ export namespace NotificationEventDeploymentWaitApproval {
     deployment?: pkg_model_deployment_pb.Deployment.AsObject,
     mentionedAccountsList: Array<string>,
     mentionedGroupsList: Array<string>,
+    stageId: string,
   }
 }
 
@@ -594,6 +678,8 @@ export enum NotificationEventType {
   EVENT_DEPLOYMENT_WAIT_APPROVAL = 7,
   EVENT_DEPLOYMENT_TRIGGER_FAILED = 8,
   EVENT_DEPLOYMENT_STARTED = 9,
+  EVENT_DEPLOYMENT_REJECTED = 10,
+  EVENT_DEPLOYMENT_BLOCKED = 11,
   EVENT_APPLICATION_SYNCED = 100,
   EVENT_APPLICATION_OUT_OF_SYNC = 101,
   EVENT_APPLICATION_HEALTHY = 200,
@@ -30,9 +30,11 @@ goog.object.extend(proto, pkg_model_deployment_pb);
 goog.exportSymbol('proto.model.NotificationEventApplicationOutOfSync', null, global);
 goog.exportSymbol('proto.model.NotificationEventApplicationSynced', null, global);
 goog.exportSymbol('proto.model.NotificationEventDeploymentApproved', null, global);
+goog.exportSymbol('proto.model.NotificationEventDeploymentBlocked', null, global);
 goog.exportSymbol('proto.model.NotificationEventDeploymentCancelled', null, global);
 goog.exportSymbol('proto.model.NotificationEventDeploymentFailed', null, global);
 goog.exportSymbol('proto.model.NotificationEventDeploymentPlanned', null, global);
+goog.exportSymbol('proto.model.NotificationEventDeploymentRejected', null, global);
 goog.exportSymbol('proto.model.NotificationEventDeploymentRollingBack', null, global);
 goog.exportSymbol('proto.model.NotificationEventDeploymentStarted', null, global);
 goog.exportSymbol('proto.model.NotificationEventDeploymentSucceeded', null, global);
@@ -132,6 +134,48 @@ if (goog.DEBUG && !COMPILED) {
    */
   proto.model.NotificationEventDeploymentApproved.displayName = 'proto.model.NotificationEventDeploymentApproved';
 }
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.model.NotificationEventDeploymentRejected = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.model.NotificationEventDeploymentRejected.repeatedFields_, null);
+};
+goog.inherits(proto.model.NotificationEventDeploymentRejected, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.model.NotificationEventDeploymentRejected.displayName = 'proto.model.NotificationEventDeploymentRejected';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.model.NotificationEventDeploymentBlocked = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.model.NotificationEventDeploymentBlocked.repeatedFields_, null);
+};
+goog.inherits(proto.model.NotificationEventDeploymentBlocked, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.model.NotificationEventDeploymentBlocked.displayName = 'proto.model.NotificationEventDeploymentBlocked';
+}
 /**
  * Generated by JsPbCodeGenerator.
  * @param {Array=} opt_data Optional initial data array, typically from a
@@ -938,16 +982,558 @@ proto.model.NotificationEventDeploymentPlanned.prototype.setMentionedAccountsLis
  * @param {number=} opt_index
  * @return {!proto.model.NotificationEventDeploymentPlanned} returns this
  */
-proto.model.NotificationEventDeploymentPlanned.prototype.addMentionedAccounts = function(value, opt_index) {
+proto.model.NotificationEventDeploymentPlanned.prototype.addMentionedAccounts = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 4, value, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.model.NotificationEventDeploymentPlanned} returns this
+ */
+proto.model.NotificationEventDeploymentPlanned.prototype.clearMentionedAccountsList = function() {
+  return this.setMentionedAccountsList([]);
+};
+
+
+/**
+ * repeated string mentioned_groups = 5;
+ * @return {!Array<string>}
+ */
+proto.model.NotificationEventDeploymentPlanned.prototype.getMentionedGroupsList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 5));
+};
+
+
+/**
+ * @param {!Array<string>} value
+ * @return {!proto.model.NotificationEventDeploymentPlanned} returns this
+ */
+proto.model.NotificationEventDeploymentPlanned.prototype.setMentionedGroupsList = function(value) {
+  return jspb.Message.setField(this, 5, value || []);
+};
+
+
+/**
+ * @param {string} value
+ * @param {number=} opt_index
+ * @return {!proto.model.NotificationEventDeploymentPlanned} returns this
+ */
+proto.model.NotificationEventDeploymentPlanned.prototype.addMentionedGroups = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 5, value, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.model.NotificationEventDeploymentPlanned} returns this
+ */
+proto.model.NotificationEventDeploymentPlanned.prototype.clearMentionedGroupsList = function() {
+  return this.setMentionedGroupsList([]);
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.model.NotificationEventDeploymentStarted.repeatedFields_ = [3,4];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.model.NotificationEventDeploymentStarted.prototype.toObject = function(opt_includeInstance) {
+  return proto.model.NotificationEventDeploymentStarted.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.model.NotificationEventDeploymentStarted} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.model.NotificationEventDeploymentStarted.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    deployment: (f = msg.getDeployment()) && pkg_model_deployment_pb.Deployment.toObject(includeInstance, f),
+    mentionedAccountsList: (f = jspb.Message.getRepeatedField(msg, 3)) == null ? undefined : f,
+    mentionedGroupsList: (f = jspb.Message.getRepeatedField(msg, 4)) == null ? undefined : f
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.model.NotificationEventDeploymentStarted}
+ */
+proto.model.NotificationEventDeploymentStarted.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.model.NotificationEventDeploymentStarted;
+  return proto.model.NotificationEventDeploymentStarted.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.model.NotificationEventDeploymentStarted} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.model.NotificationEventDeploymentStarted}
+ */
+proto.model.NotificationEventDeploymentStarted.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new pkg_model_deployment_pb.Deployment;
+      reader.readMessage(value,pkg_model_deployment_pb.Deployment.deserializeBinaryFromReader);
+      msg.setDeployment(value);
+      break;
+    case 3:
+      var value = /** @type {string} */ (reader.readString());
+      msg.addMentionedAccounts(value);
+      break;
+    case 4:
+      var value = /** @type {string} */ (reader.readString());
+      msg.addMentionedGroups(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.model.NotificationEventDeploymentStarted.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.model.NotificationEventDeploymentStarted.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.model.NotificationEventDeploymentStarted} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.model.NotificationEventDeploymentStarted.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getDeployment();
+  if (f != null) {
+    writer.writeMessage(
+      1,
+      f,
+      pkg_model_deployment_pb.Deployment.serializeBinaryToWriter
+    );
+  }
+  f = message.getMentionedAccountsList();
+  if (f.length > 0) {
+    writer.writeRepeatedString(
+      3,
+      f
+    );
+  }
+  f = message.getMentionedGroupsList();
+  if (f.length > 0) {
+    writer.writeRepeatedString(
+      4,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional Deployment deployment = 1;
+ * @return {?proto.model.Deployment}
+ */
+proto.model.NotificationEventDeploymentStarted.prototype.getDeployment = function() {
+  return /** @type{?proto.model.Deployment} */ (
+    jspb.Message.getWrapperField(this, pkg_model_deployment_pb.Deployment, 1));
+};
+
+
+/**
+ * @param {?proto.model.Deployment|undefined} value
+ * @return {!proto.model.NotificationEventDeploymentStarted} returns this
+*/
+proto.model.NotificationEventDeploymentStarted.prototype.setDeployment = function(value) {
+  return jspb.Message.setWrapperField(this, 1, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.model.NotificationEventDeploymentStarted} returns this
+ */
+proto.model.NotificationEventDeploymentStarted.prototype.clearDeployment = function() {
+  return this.setDeployment(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.model.NotificationEventDeploymentStarted.prototype.hasDeployment = function() {
+  return jspb.Message.getField(this, 1) != null;
+};
+
+
+/**
+ * repeated string mentioned_accounts = 3;
+ * @return {!Array<string>}
+ */
+proto.model.NotificationEventDeploymentStarted.prototype.getMentionedAccountsList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 3));
+};
+
+
+/**
+ * @param {!Array<string>} value
+ * @return {!proto.model.NotificationEventDeploymentStarted} returns this
+ */
+proto.model.NotificationEventDeploymentStarted.prototype.setMentionedAccountsList = function(value) {
+  return jspb.Message.setField(this, 3, value || []);
+};
+
+
+/**
+ * @param {string} value
+ * @param {number=} opt_index
+ * @return {!proto.model.NotificationEventDeploymentStarted} returns this
+ */
+proto.model.NotificationEventDeploymentStarted.prototype.addMentionedAccounts = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 3, value, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.model.NotificationEventDeploymentStarted} returns this
+ */
+proto.model.NotificationEventDeploymentStarted.prototype.clearMentionedAccountsList = function() {
+  return this.setMentionedAccountsList([]);
+};
+
+
+/**
+ * repeated string mentioned_groups = 4;
+ * @return {!Array<string>}
+ */
+proto.model.NotificationEventDeploymentStarted.prototype.getMentionedGroupsList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 4));
+};
+
+
+/**
+ * @param {!Array<string>} value
+ * @return {!proto.model.NotificationEventDeploymentStarted} returns this
+ */
+proto.model.NotificationEventDeploymentStarted.prototype.setMentionedGroupsList = function(value) {
+  return jspb.Message.setField(this, 4, value || []);
+};
+
+
+/**
+ * @param {string} value
+ * @param {number=} opt_index
+ * @return {!proto.model.NotificationEventDeploymentStarted} returns this
+ */
+proto.model.NotificationEventDeploymentStarted.prototype.addMentionedGroups = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 4, value, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.model.NotificationEventDeploymentStarted} returns this
+ */
+proto.model.NotificationEventDeploymentStarted.prototype.clearMentionedGroupsList = function() {
+  return this.setMentionedGroupsList([]);
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.model.NotificationEventDeploymentApproved.repeatedFields_ = [4,5];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.model.NotificationEventDeploymentApproved.prototype.toObject = function(opt_includeInstance) {
+  return proto.model.NotificationEventDeploymentApproved.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.model.NotificationEventDeploymentApproved} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.model.NotificationEventDeploymentApproved.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    deployment: (f = msg.getDeployment()) && pkg_model_deployment_pb.Deployment.toObject(includeInstance, f),
+    approver: jspb.Message.getFieldWithDefault(msg, 3, ""),
+    mentionedAccountsList: (f = jspb.Message.getRepeatedField(msg, 4)) == null ? undefined : f,
+    mentionedGroupsList: (f = jspb.Message.getRepeatedField(msg, 5)) == null ? undefined : f
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.model.NotificationEventDeploymentApproved}
+ */
+proto.model.NotificationEventDeploymentApproved.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.model.NotificationEventDeploymentApproved;
+  return proto.model.NotificationEventDeploymentApproved.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.model.NotificationEventDeploymentApproved} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.model.NotificationEventDeploymentApproved}
+ */
+proto.model.NotificationEventDeploymentApproved.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new pkg_model_deployment_pb.Deployment;
+      reader.readMessage(value,pkg_model_deployment_pb.Deployment.deserializeBinaryFromReader);
+      msg.setDeployment(value);
+      break;
+    case 3:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setApprover(value);
+      break;
+    case 4:
+      var value = /** @type {string} */ (reader.readString());
+      msg.addMentionedAccounts(value);
+      break;
+    case 5:
+      var value = /** @type {string} */ (reader.readString());
+      msg.addMentionedGroups(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.model.NotificationEventDeploymentApproved.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.model.NotificationEventDeploymentApproved.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.model.NotificationEventDeploymentApproved} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.model.NotificationEventDeploymentApproved.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getDeployment();
+  if (f != null) {
+    writer.writeMessage(
+      1,
+      f,
+      pkg_model_deployment_pb.Deployment.serializeBinaryToWriter
+    );
+  }
+  f = message.getApprover();
+  if (f.length > 0) {
+    writer.writeString(
+      3,
+      f
+    );
+  }
+  f = message.getMentionedAccountsList();
+  if (f.length > 0) {
+    writer.writeRepeatedString(
+      4,
+      f
+    );
+  }
+  f = message.getMentionedGroupsList();
+  if (f.length > 0) {
+    writer.writeRepeatedString(
+      5,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional Deployment deployment = 1;
+ * @return {?proto.model.Deployment}
+ */
+proto.model.NotificationEventDeploymentApproved.prototype.getDeployment = function() {
+  return /** @type{?proto.model.Deployment} */ (
+    jspb.Message.getWrapperField(this, pkg_model_deployment_pb.Deployment, 1));
+};
+
+
+/**
+ * @param {?proto.model.Deployment|undefined} value
+ * @return {!proto.model.NotificationEventDeploymentApproved} returns this
+*/
+proto.model.NotificationEventDeploymentApproved.prototype.setDeployment = function(value) {
+  return jspb.Message.setWrapperField(this, 1, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.model.NotificationEventDeploymentApproved} returns this
+ */
+proto.model.NotificationEventDeploymentApproved.prototype.clearDeployment = function() {
+  return this.setDeployment(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.model.NotificationEventDeploymentApproved.prototype.hasDeployment = function() {
+  return jspb.Message.getField(this, 1) != null;
+};
+
+
+/**
+ * optional string approver = 3;
+ * @return {string}
+ */
+proto.model.NotificationEventDeploymentApproved.prototype.getApprover = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.model.NotificationEventDeploymentApproved} returns this
+ */
+proto.model.NotificationEventDeploymentApproved.prototype.setApprover = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
+};
+
+
+/**
+ * repeated string mentioned_accounts = 4;
+ * @return {!Array<string>}
+ */
+proto.model.NotificationEventDeploymentApproved.prototype.getMentionedAccountsList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 4));
+};
+
+
+/**
+ * @param {!Array<string>} value
+ * @return {!proto.model.NotificationEventDeploymentApproved} returns this
+ */
+proto.model.NotificationEventDeploymentApproved.prototype.setMentionedAccountsList = function(value) {
+  return jspb.Message.setField(this, 4, value || []);
+};
+
+
+/**
+ * @param {string} value
+ * @param {number=} opt_index
+ * @return {!proto.model.NotificationEventDeploymentApproved} returns this
+ */
+proto.model.NotificationEventDeploymentApproved.prototype.addMentionedAccounts = function(value, opt_index) {
   return jspb.Message.addToRepeatedField(this, 4, value, opt_index);
 };
 
 
 /**
  * Clears the list making it empty but non-null.
- * @return {!proto.model.NotificationEventDeploymentPlanned} returns this
+ * @return {!proto.model.NotificationEventDeploymentApproved} returns this
  */
-proto.model.NotificationEventDeploymentPlanned.prototype.clearMentionedAccountsList = function() {
+proto.model.NotificationEventDeploymentApproved.prototype.clearMentionedAccountsList = function() {
   return this.setMentionedAccountsList([]);
 };
 
@@ -956,16 +1542,16 @@ proto.model.NotificationEventDeploymentPlanned.prototype.clearMentionedAccountsL
  * repeated string mentioned_groups = 5;
  * @return {!Array<string>}
  */
-proto.model.NotificationEventDeploymentPlanned.prototype.getMentionedGroupsList = function() {
+proto.model.NotificationEventDeploymentApproved.prototype.getMentionedGroupsList = function() {
   return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 5));
 };
 
 
 /**
  * @param {!Array<string>} value
- * @return {!proto.model.NotificationEventDeploymentPlanned} returns this
+ * @return {!proto.model.NotificationEventDeploymentApproved} returns this
  */
-proto.model.NotificationEventDeploymentPlanned.prototype.setMentionedGroupsList = function(value) {
+proto.model.NotificationEventDeploymentApproved.prototype.setMentionedGroupsList = function(value) {
   return jspb.Message.setField(this, 5, value || []);
 };
 
@@ -973,18 +1559,18 @@ proto.model.NotificationEventDeploymentPlanned.prototype.setMentionedGroupsList
 /**
  * @param {string} value
  * @param {number=} opt_index
- * @return {!proto.model.NotificationEventDeploymentPlanned} returns this
+ * @return {!proto.model.NotificationEventDeploymentApproved} returns this
  */
-proto.model.NotificationEventDeploymentPlanned.prototype.addMentionedGroups = function(value, opt_index) {
+proto.model.NotificationEventDeploymentApproved.prototype.addMentionedGroups = function(value, opt_index) {
   return jspb.Message.addToRepeatedField(this, 5, value, opt_index);
 };
 
 
 /**
  * Clears the list making it empty but non-null.
- * @return {!proto.model.NotificationEventDeploymentPlanned} returns this
+ * @return {!proto.model.NotificationEventDeploymentApproved} returns this
  */
-proto.model.NotificationEventDeploymentPlanned.prototype.clearMentionedGroupsList = function() {
+proto.model.NotificationEventDeploymentApproved.prototype.clearMentionedGroupsList = function() {
   return this.setMentionedGroupsList([]);
 };
 
@@ -995,7 +1581,7 @@ proto.model.NotificationEventDeploymentPlanned.prototype.clearMentionedGroupsLis
  * @private {!Array<number>}
  * @const
  */
-proto.model.NotificationEventDeploymentStarted.repeatedFields_ = [3,4];
+proto.model.NotificationEventDeploymentRejected.repeatedFields_ = [5,6];
 
 
 
@@ -1012,8 +1598,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.model.NotificationEventDeploymentStarted.prototype.toObject = function(opt_includeInstance) {
-  return proto.model.NotificationEventDeploymentStarted.toObject(opt_includeInstance, this);
+proto.model.NotificationEventDeploymentRejected.prototype.toObject = function(opt_includeInstance) {
+  return proto.model.NotificationEventDeploymentRejected.toObject(opt_includeInstance, this);
 };
 
 
@@ -1022,15 +1608,18 @@ proto.model.NotificationEventDeploymentStarted.prototype.toObject = function(opt
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.model.NotificationEventDeploymentStarted} msg The msg instance to transform.
+ * @param {!proto.model.NotificationEventDeploymentRejected} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.model.NotificationEventDeploymentStarted.toObject = function(includeInstance, msg) {
+proto.model.NotificationEventDeploymentRejected.toObject = function(includeInstance, msg) {
   var f, obj = {
     deployment: (f = msg.getDeployment()) && pkg_model_deployment_pb.Deployment.toObject(includeInstance, f),
-    mentionedAccountsList: (f = jspb.Message.getRepeatedField(msg, 3)) == null ? undefined : f,
-    mentionedGroupsList: (f = jspb.Message.getRepeatedField(msg, 4)) == null ? undefined : f
+    stageId: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    rejecter: jspb.Message.getFieldWithDefault(msg, 3, ""),
+    comment: jspb.Message.getFieldWithDefault(msg, 4, ""),
+    mentionedAccountsList: (f = jspb.Message.getRepeatedField(msg, 5)) == null ? undefined : f,
+    mentionedGroupsList: (f = jspb.Message.getRepeatedField(msg, 6)) == null ? undefined : f
   };
 
   if (includeInstance) {
@@ -1044,23 +1633,23 @@ proto.model.NotificationEventDeploymentStarted.toObject = function(includeInstan
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.model.NotificationEventDeploymentStarted}
+ * @return {!proto.model.NotificationEventDeploymentRejected}
  */
-proto.model.NotificationEventDeploymentStarted.deserializeBinary = function(bytes) {
+proto.model.NotificationEventDeploymentRejected.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.model.NotificationEventDeploymentStarted;
-  return proto.model.NotificationEventDeploymentStarted.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.model.NotificationEventDeploymentRejected;
+  return proto.model.NotificationEventDeploymentRejected.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.model.NotificationEventDeploymentStarted} msg The message object to deserialize into.
+ * @param {!proto.model.NotificationEventDeploymentRejected} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.model.NotificationEventDeploymentStarted}
+ * @return {!proto.model.NotificationEventDeploymentRejected}
  */
-proto.model.NotificationEventDeploymentStarted.deserializeBinaryFromReader = function(msg, reader) {
+proto.model.NotificationEventDeploymentRejected.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -1072,11 +1661,23 @@ proto.model.NotificationEventDeploymentStarted.deserializeBinaryFromReader = fun
       reader.readMessage(value,pkg_model_deployment_pb.Deployment.deserializeBinaryFromReader);
       msg.setDeployment(value);
       break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setStageId(value);
+      break;
     case 3:
       var value = /** @type {string} */ (reader.readString());
-      msg.addMentionedAccounts(value);
+      msg.setRejecter(value);
       break;
     case 4:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setComment(value);
+      break;
+    case 5:
+      var value = /** @type {string} */ (reader.readString());
+      msg.addMentionedAccounts(value);
+      break;
+    case 6:
       var value = /** @type {string} */ (reader.readString());
       msg.addMentionedGroups(value);
       break;
@@ -1093,9 +1694,9 @@ proto.model.NotificationEventDeploymentStarted.deserializeBinaryFromReader = fun
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.model.NotificationEventDeploymentStarted.prototype.serializeBinary = function() {
+proto.model.NotificationEventDeploymentRejected.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.model.NotificationEventDeploymentStarted.serializeBinaryToWriter(this, writer);
+  proto.model.NotificationEventDeploymentRejected.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -1103,11 +1704,11 @@ proto.model.NotificationEventDeploymentStarted.prototype.serializeBinary = funct
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.model.NotificationEventDeploymentStarted} message
+ * @param {!proto.model.NotificationEventDeploymentRejected} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.model.NotificationEventDeploymentStarted.serializeBinaryToWriter = function(message, writer) {
+proto.model.NotificationEventDeploymentRejected.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
   f = message.getDeployment();
   if (f != null) {
@@ -1117,17 +1718,38 @@ proto.model.NotificationEventDeploymentStarted.serializeBinaryToWriter = functio
       pkg_model_deployment_pb.Deployment.serializeBinaryToWriter
     );
   }
+  f = message.getStageId();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getRejecter();
+  if (f.length > 0) {
+    writer.writeString(
+      3,
+      f
+    );
+  }
+  f = message.getComment();
+  if (f.length > 0) {
+    writer.writeString(
+      4,
+      f
+    );
+  }
   f = message.getMentionedAccountsList();
   if (f.length > 0) {
     writer.writeRepeatedString(
-      3,
+      5,
       f
     );
   }
   f = message.getMentionedGroupsList();
   if (f.length > 0) {
     writer.writeRepeatedString(
-      4,
+      6,
       f
     );
   }
@@ -1138,7 +1760,7 @@ proto.model.NotificationEventDeploymentStarted.serializeBinaryToWriter = functio
  * optional Deployment deployment = 1;
  * @return {?proto.model.Deployment}
  */
-proto.model.NotificationEventDeploymentStarted.prototype.getDeployment = function() {
+proto.model.NotificationEventDeploymentRejected.prototype.getDeployment = function() {
   return /** @type{?proto.model.Deployment} */ (
     jspb.Message.getWrapperField(this, pkg_model_deployment_pb.Deployment, 1));
 };
@@ -1146,18 +1768,18 @@ proto.model.NotificationEventDeploymentStarted.prototype.getDeployment = functio
 
 /**
  * @param {?proto.model.Deployment|undefined} value
- * @return {!proto.model.NotificationEventDeploymentStarted} returns this
+ * @return {!proto.model.NotificationEventDeploymentRejected} returns this
 */
-proto.model.NotificationEventDeploymentStarted.prototype.setDeployment = function(value) {
+proto.model.NotificationEventDeploymentRejected.prototype.setDeployment = function(value) {
   return jspb.Message.setWrapperField(this, 1, value);
 };
 
 
 /**
  * Clears the message field making it undefined.
- * @return {!proto.model.NotificationEventDeploymentStarted} returns this
+ * @return {!proto.model.NotificationEventDeploymentRejected} returns this
  */
-proto.model.NotificationEventDeploymentStarted.prototype.clearDeployment = function() {
+proto.model.NotificationEventDeploymentRejected.prototype.clearDeployment = function() {
   return this.setDeployment(undefined);
 };
 
@@ -1166,81 +1788,135 @@ proto.model.NotificationEventDeploymentStarted.prototype.clearDeployment = funct
  * Returns whether this field is set.
  * @return {boolean}
  */
-proto.model.NotificationEventDeploymentStarted.prototype.hasDeployment = function() {
+proto.model.NotificationEventDeploymentRejected.prototype.hasDeployment = function() {
   return jspb.Message.getField(this, 1) != null;
 };
 
 
 /**
- * repeated string mentioned_accounts = 3;
+ * optional string stage_id = 2;
+ * @return {string}
+ */
+proto.model.NotificationEventDeploymentRejected.prototype.getStageId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.model.NotificationEventDeploymentRejected} returns this
+ */
+proto.model.NotificationEventDeploymentRejected.prototype.setStageId = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+/**
+ * optional string rejecter = 3;
+ * @return {string}
+ */
+proto.model.NotificationEventDeploymentRejected.prototype.getRejecter = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.model.NotificationEventDeploymentRejected} returns this
+ */
+proto.model.NotificationEventDeploymentRejected.prototype.setRejecter = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
+};
+
+
+/**
+ * optional string comment = 4;
+ * @return {string}
+ */
+proto.model.NotificationEventDeploymentRejected.prototype.getComment = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.model.NotificationEventDeploymentRejected} returns this
+ */
+proto.model.NotificationEventDeploymentRejected.prototype.setComment = function(value) {
+  return jspb.Message.setProto3StringField(this, 4, value);
+};
+
+
+/**
+ * repeated string mentioned_accounts = 5;
  * @return {!Array<string>}
  */
-proto.model.NotificationEventDeploymentStarted.prototype.getMentionedAccountsList = function() {
-  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 3));
+proto.model.NotificationEventDeploymentRejected.prototype.getMentionedAccountsList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 5));
 };
 
 
 /**
  * @param {!Array<string>} value
- * @return {!proto.model.NotificationEventDeploymentStarted} returns this
+ * @return {!proto.model.NotificationEventDeploymentRejected} returns this
  */
-proto.model.NotificationEventDeploymentStarted.prototype.setMentionedAccountsList = function(value) {
-  return jspb.Message.setField(this, 3, value || []);
+proto.model.NotificationEventDeploymentRejected.prototype.setMentionedAccountsList = function(value) {
+  return jspb.Message.setField(this, 5, value || []);
 };
 
 
 /**
  * @param {string} value
  * @param {number=} opt_index
- * @return {!proto.model.NotificationEventDeploymentStarted} returns this
+ * @return {!proto.model.NotificationEventDeploymentRejected} returns this
  */
-proto.model.NotificationEventDeploymentStarted.prototype.addMentionedAccounts = function(value, opt_index) {
-  return jspb.Message.addToRepeatedField(this, 3, value, opt_index);
+proto.model.NotificationEventDeploymentRejected.prototype.addMentionedAccounts = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 5, value, opt_index);
 };
 
 
 /**
  * Clears the list making it empty but non-null.
- * @return {!proto.model.NotificationEventDeploymentStarted} returns this
+ * @return {!proto.model.NotificationEventDeploymentRejected} returns this
  */
-proto.model.NotificationEventDeploymentStarted.prototype.clearMentionedAccountsList = function() {
+proto.model.NotificationEventDeploymentRejected.prototype.clearMentionedAccountsList = function() {
   return this.setMentionedAccountsList([]);
 };
 
 
 /**
- * repeated string mentioned_groups = 4;
+ * repeated string mentioned_groups = 6;
  * @return {!Array<string>}
  */
-proto.model.NotificationEventDeploymentStarted.prototype.getMentionedGroupsList = function() {
-  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 4));
+proto.model.NotificationEventDeploymentRejected.prototype.getMentionedGroupsList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 6));
 };
 
 
 /**
  * @param {!Array<string>} value
- * @return {!proto.model.NotificationEventDeploymentStarted} returns this
+ * @return {!proto.model.NotificationEventDeploymentRejected} returns this
  */
-proto.model.NotificationEventDeploymentStarted.prototype.setMentionedGroupsList = function(value) {
-  return jspb.Message.setField(this, 4, value || []);
+proto.model.NotificationEventDeploymentRejected.prototype.setMentionedGroupsList = function(value) {
+  return jspb.Message.setField(this, 6, value || []);
 };
 
 
 /**
  * @param {string} value
  * @param {number=} opt_index
- * @return {!proto.model.NotificationEventDeploymentStarted} returns this
+ * @return {!proto.model.NotificationEventDeploymentRejected} returns this
  */
-proto.model.NotificationEventDeploymentStarted.prototype.addMentionedGroups = function(value, opt_index) {
-  return jspb.Message.addToRepeatedField(this, 4, value, opt_index);
+proto.model.NotificationEventDeploymentRejected.prototype.addMentionedGroups = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 6, value, opt_index);
 };
 
 
 /**
  * Clears the list making it empty but non-null.
- * @return {!proto.model.NotificationEventDeploymentStarted} returns this
+ * @return {!proto.model.NotificationEventDeploymentRejected} returns this
  */
-proto.model.NotificationEventDeploymentStarted.prototype.clearMentionedGroupsList = function() {
+proto.model.NotificationEventDeploymentRejected.prototype.clearMentionedGroupsList = function() {
   return this.setMentionedGroupsList([]);
 };
 
@@ -1251,7 +1927,7 @@ proto.model.NotificationEventDeploymentStarted.prototype.clearMentionedGroupsLis
  * @private {!Array<number>}
  * @const
  */
-proto.model.NotificationEventDeploymentApproved.repeatedFields_ = [4,5];
+proto.model.NotificationEventDeploymentBlocked.repeatedFields_ = [3,4];
 
 
 
@@ -1268,8 +1944,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.model.NotificationEventDeploymentApproved.prototype.toObject = function(opt_includeInstance) {
-  return proto.model.NotificationEventDeploymentApproved.toObject(opt_includeInstance, this);
+proto.model.NotificationEventDeploymentBlocked.prototype.toObject = function(opt_includeInstance) {
+  return proto.model.NotificationEventDeploymentBlocked.toObject(opt_includeInstance, this);
 };
 
 
@@ -1278,16 +1954,16 @@ proto.model.NotificationEventDeploymentApproved.prototype.toObject = function(op
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.model.NotificationEventDeploymentApproved} msg The msg instance to transform.
+ * @param {!proto.model.NotificationEventDeploymentBlocked} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.model.NotificationEventDeploymentApproved.toObject = function(includeInstance, msg) {
+proto.model.NotificationEventDeploymentBlocked.toObject = function(includeInstance, msg) {
   var f, obj = {
     deployment: (f = msg.getDeployment()) && pkg_model_deployment_pb.Deployment.toObject(includeInstance, f),
-    approver: jspb.Message.getFieldWithDefault(msg, 3, ""),
-    mentionedAccountsList: (f = jspb.Message.getRepeatedField(msg, 4)) == null ? undefined : f,
-    mentionedGroupsList: (f = jspb.Message.getRepeatedField(msg, 5)) == null ? undefined : f
+    reason: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    mentionedAccountsList: (f = jspb.Message.getRepeatedField(msg, 3)) == null ? undefined : f,
+    mentionedGroupsList: (f = jspb.Message.getRepeatedField(msg, 4)) == null ? undefined : f
   };
 
   if (includeInstance) {
@@ -1301,23 +1977,23 @@ proto.model.NotificationEventDeploymentApproved.toObject = function(includeInsta
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.model.NotificationEventDeploymentApproved}
+ * @return {!proto.model.NotificationEventDeploymentBlocked}
  */
-proto.model.NotificationEventDeploymentApproved.deserializeBinary = function(bytes) {
+proto.model.NotificationEventDeploymentBlocked.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.model.NotificationEventDeploymentApproved;
-  return proto.model.NotificationEventDeploymentApproved.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.model.NotificationEventDeploymentBlocked;
+  return proto.model.NotificationEventDeploymentBlocked.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.model.NotificationEventDeploymentApproved} msg The message object to deserialize into.
+ * @param {!proto.model.NotificationEventDeploymentBlocked} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.model.NotificationEventDeploymentApproved}
+ * @return {!proto.model.NotificationEventDeploymentBlocked}
  */
-proto.model.NotificationEventDeploymentApproved.deserializeBinaryFromReader = function(msg, reader) {
+proto.model.NotificationEventDeploymentBlocked.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -1329,15 +2005,15 @@ proto.model.NotificationEventDeploymentApproved.deserializeBinaryFromReader = fu
       reader.readMessage(value,pkg_model_deployment_pb.Deployment.deserializeBinaryFromReader);
       msg.setDeployment(value);
       break;
-    case 3:
+    case 2:
       var value = /** @type {string} */ (reader.readString());
-      msg.setApprover(value);
+      msg.setReason(value);
       break;
-    case 4:
+    case 3:
       var value = /** @type {string} */ (reader.readString());
       msg.addMentionedAccounts(value);
       break;
-    case 5:
+    case 4:
       var value = /** @type {string} */ (reader.readString());
       msg.addMentionedGroups(value);
       break;
@@ -1354,9 +2030,9 @@ proto.model.NotificationEventDeploymentApproved.deserializeBinaryFromReader = fu
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.model.NotificationEventDeploymentApproved.prototype.serializeBinary = function() {
+proto.model.NotificationEventDeploymentBlocked.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.model.NotificationEventDeploymentApproved.serializeBinaryToWriter(this, writer);
+  proto.model.NotificationEventDeploymentBlocked.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -1364,11 +2040,11 @@ proto.model.NotificationEventDeploymentApproved.prototype.serializeBinary = func
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.model.NotificationEventDeploymentApproved} message
+ * @param {!proto.model.NotificationEventDeploymentBlocked} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.model.NotificationEventDeploymentApproved.serializeBinaryToWriter = function(message, writer) {
+proto.model.NotificationEventDeploymentBlocked.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
   f = message.getDeployment();
   if (f != null) {
@@ -1378,24 +2054,24 @@ proto.model.NotificationEventDeploymentApproved.serializeBinaryToWriter = functi
       pkg_model_deployment_pb.Deployment.serializeBinaryToWriter
     );
   }
-  f = message.getApprover();
+  f = message.getReason();
   if (f.length > 0) {
     writer.writeString(
-      3,
+      2,
       f
     );
   }
   f = message.getMentionedAccountsList();
   if (f.length > 0) {
     writer.writeRepeatedString(
-      4,
+      3,
       f
     );
   }
   f = message.getMentionedGroupsList();
   if (f.length > 0) {
     writer.writeRepeatedString(
-      5,
+      4,
       f
     );
   }
@@ -1406,7 +2082,7 @@ proto.model.NotificationEventDeploymentApproved.serializeBinaryToWriter = functi
  * optional Deployment deployment = 1;
  * @return {?proto.model.Deployment}
  */
-proto.model.NotificationEventDeploymentApproved.prototype.getDeployment = function() {
+proto.model.NotificationEventDeploymentBlocked.prototype.getDeployment = function() {
   return /** @type{?proto.model.Deployment} */ (
     jspb.Message.getWrapperField(this, pkg_model_deployment_pb.Deployment, 1));
 };
@@ -1414,18 +2090,18 @@ proto.model.NotificationEventDeploymentApproved.prototype.getDeployment = functi
 
 /**
  * @param {?proto.model.Deployment|undefined} value
- * @return {!proto.model.NotificationEventDeploymentApproved} returns this
+ * @return {!proto.model.NotificationEventDeploymentBlocked} returns this
 */
-proto.model.NotificationEventDeploymentApproved.prototype.setDeployment = function(value) {
+proto.model.NotificationEventDeploymentBlocked.prototype.setDeployment = function(value) {
   return jspb.Message.setWrapperField(this, 1, value);
 };
 
 
 /**
  * Clears the message field making it undefined.
- * @return {!proto.model.NotificationEventDeploymentApproved} returns this
+ * @return {!proto.model.NotificationEventDeploymentBlocked} returns this
  */
-proto.model.NotificationEventDeploymentApproved.prototype.clearDeployment = function() {
+proto.model.NotificationEventDeploymentBlocked.prototype.clearDeployment = function() {
   return this.setDeployment(undefined);
 };
 
@@ -1434,99 +2110,99 @@ proto.model.NotificationEventDeploymentApproved.prototype.clearDeployment = func
  * Returns whether this field is set.
  * @return {boolean}
  */
-proto.model.NotificationEventDeploymentApproved.prototype.hasDeployment = function() {
+proto.model.NotificationEventDeploymentBlocked.prototype.hasDeployment = function() {
   return jspb.Message.getField(this, 1) != null;
 };
 
 
 /**
- * optional string approver = 3;
+ * optional string reason = 2;
  * @return {string}
  */
-proto.model.NotificationEventDeploymentApproved.prototype.getApprover = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+proto.model.NotificationEventDeploymentBlocked.prototype.getReason = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.model.NotificationEventDeploymentApproved} returns this
+ * @return {!proto.model.NotificationEventDeploymentBlocked} returns this
  */
-proto.model.NotificationEventDeploymentApproved.prototype.setApprover = function(value) {
-  return jspb.Message.setProto3StringField(this, 3, value);
+proto.model.NotificationEventDeploymentBlocked.prototype.setReason = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
 };
 
 
 /**
- * repeated string mentioned_accounts = 4;
+ * repeated string mentioned_accounts = 3;
  * @return {!Array<string>}
  */
-proto.model.NotificationEventDeploymentApproved.prototype.getMentionedAccountsList = function() {
-  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 4));
+proto.model.NotificationEventDeploymentBlocked.prototype.getMentionedAccountsList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 3));
 };
 
 
 /**
  * @param {!Array<string>} value
- * @return {!proto.model.NotificationEventDeploymentApproved} returns this
+ * @return {!proto.model.NotificationEventDeploymentBlocked} returns this
  */
-proto.model.NotificationEventDeploymentApproved.prototype.setMentionedAccountsList = function(value) {
-  return jspb.Message.setField(this, 4, value || []);
+proto.model.NotificationEventDeploymentBlocked.prototype.setMentionedAccountsList = function(value) {
+  return jspb.Message.setField(this, 3, value || []);
 };
 
 
 /**
  * @param {string} value
  * @param {number=} opt_index
- * @return {!proto.model.NotificationEventDeploymentApproved} returns this
+ * @return {!proto.model.NotificationEventDeploymentBlocked} returns this
  */
-proto.model.NotificationEventDeploymentApproved.prototype.addMentionedAccounts = function(value, opt_index) {
-  return jspb.Message.addToRepeatedField(this, 4, value, opt_index);
+proto.model.NotificationEventDeploymentBlocked.prototype.addMentionedAccounts = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 3, value, opt_index);
 };
 
 
 /**
  * Clears the list making it empty but non-null.
- * @return {!proto.model.NotificationEventDeploymentApproved} returns this
+ * @return {!proto.model.NotificationEventDeploymentBlocked} returns this
  */
-proto.model.NotificationEventDeploymentApproved.prototype.clearMentionedAccountsList = function() {
+proto.model.NotificationEventDeploymentBlocked.prototype.clearMentionedAccountsList = function() {
   return this.setMentionedAccountsList([]);
 };
 
 
 /**
- * repeated string mentioned_groups = 5;
+ * repeated string mentioned_groups = 4;
  * @return {!Array<string>}
  */
-proto.model.NotificationEventDeploymentApproved.prototype.getMentionedGroupsList = function() {
-  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 5));
+proto.model.NotificationEventDeploymentBlocked.prototype.getMentionedGroupsList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 4));
 };
 
 
 /**
  * @param {!Array<string>} value
- * @return {!proto.model.NotificationEventDeploymentApproved} returns this
+ * @return {!proto.model.NotificationEventDeploymentBlocked} returns this
  */
-proto.model.NotificationEventDeploymentApproved.prototype.setMentionedGroupsList = function(value) {
-  return jspb.Message.setField(this, 5, value || []);
+proto.model.NotificationEventDeploymentBlocked.prototype.setMentionedGroupsList = function(value) {
+  return jspb.Message.setField(this, 4, value || []);
 };
 
 
 /**
  * @param {string} value
  * @param {number=} opt_index
- * @return {!proto.model.NotificationEventDeploymentApproved} returns this
+ * @return {!proto.model.NotificationEventDeploymentBlocked} returns this
  */
-proto.model.NotificationEventDeploymentApproved.prototype.addMentionedGroups = function(value, opt_index) {
-  return jspb.Message.addToRepeatedField(this, 5, value, opt_index);
+proto.model.NotificationEventDeploymentBlocked.prototype.addMentionedGroups = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 4, value, opt_index);
 };
 
 
 /**
  * Clears the list making it empty but non-null.
- * @return {!proto.model.NotificationEventDeploymentApproved} returns this
+ * @return {!proto.model.NotificationEventDeploymentBlocked} returns this
  */
-proto.model.NotificationEventDeploymentApproved.prototype.clearMentionedGroupsList = function() {
+proto.model.NotificationEventDeploymentBlocked.prototype.clearMentionedGroupsList = function() {
   return this.setMentionedGroupsList([]);
 };
 
@@ -2551,7 +3227,8 @@ proto.model.NotificationEventDeploymentWaitApproval.toObject = function(includeI
   var f, obj = {
     deployment: (f = msg.getDeployment()) && pkg_model_deployment_pb.Deployment.toObject(includeInstance, f),
     mentionedAccountsList: (f = jspb.Message.getRepeatedField(msg, 3)) == null ? undefined : f,
-    mentionedGroupsList: (f = jspb.Message.getRepeatedField(msg, 4)) == null ? undefined : f
+    mentionedGroupsList: (f = jspb.Message.getRepeatedField(msg, 4)) == null ? undefined : f,
+    stageId: jspb.Message.getFieldWithDefault(msg, 5, "")
   };
 
   if (includeInstance) {
@@ -2601,6 +3278,10 @@ proto.model.NotificationEventDeploymentWaitApproval.deserializeBinaryFromReader
       var value = /** @type {string} */ (reader.readString());
       msg.addMentionedGroups(value);
       break;
+    case 5:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setStageId(value);
+      break;
     default:
       reader.skipField();
       break;
@@ -2652,6 +3333,13 @@ proto.model.NotificationEventDeploymentWaitApproval.serializeBinaryToWriter = fu
       f
     );
   }
+  f = message.getStageId();
+  if (f.length > 0) {
+    writer.writeString(
+      5,
+      f
+    );
+  }
 };
 
 
@@ -2766,6 +3454,24 @@ proto.model.NotificationEventDeploymentWaitApproval.prototype.clearMentionedGrou
 };
 
 
+/**
+ * optional string stage_id = 5;
+ * @return {string}
+ */
+proto.model.NotificationEventDeploymentWaitApproval.prototype.getStageId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 5, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.model.NotificationEventDeploymentWaitApproval} returns this
+ */
+proto.model.NotificationEventDeploymentWaitApproval.prototype.setStageId = function(value) {
+  return jspb.Message.setProto3StringField(this, 5, value);
+};
+
+
 
 /**
  * List of repeated fields within this message type.
@@ -4980,6 +5686,8 @@ proto.model.NotificationEventType = {
   EVENT_DEPLOYMENT_WAIT_APPROVAL: 7,
   EVENT_DEPLOYMENT_TRIGGER_FAILED: 8,
   EVENT_DEPLOYMENT_STARTED: 9,
+  EVENT_DEPLOYMENT_REJECTED: 10,
+  EVENT_DEPLOYMENT_BLOCKED: 11,
   EVENT_APPLICATION_SYNCED: 100,
   EVENT_APPLICATION_OUT_OF_SYNC: 101,
   EVENT_APPLICATION_HEALTHY: 200,